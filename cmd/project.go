@@ -24,6 +24,8 @@ func ProjectCommand(cliConfig *Config) *cli.Command {
 			$ shield project edit
 			$ shield project view
 			$ shield project list
+			$ shield project admin add
+			$ shield project admin remove
 		`),
 		Annotations: map[string]string{
 			"group":  "core",
@@ -35,6 +37,8 @@ func ProjectCommand(cliConfig *Config) *cli.Command {
 	cmd.AddCommand(editProjectCommand(cliConfig))
 	cmd.AddCommand(viewProjectCommand(cliConfig))
 	cmd.AddCommand(listProjectCommand(cliConfig))
+	cmd.AddCommand(adminProjectCommand(cliConfig))
+	cmd.AddCommand(visibilityProjectCommand(cliConfig))
 
 	bindFlagsFromClientConfig(cmd)
 
@@ -277,3 +281,171 @@ func listProjectCommand(cliConfig *Config) *cli.Command {
 
 	return cmd
 }
+
+func adminProjectCommand(cliConfig *Config) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "admin",
+		Short: "Manage admins of a project",
+		Example: heredoc.Doc(`
+			$ shield project admin add
+			$ shield project admin remove
+		`),
+		Annotations: map[string]string{
+			"project:core": "true",
+		},
+	}
+
+	cmd.AddCommand(addProjectAdminCommand(cliConfig))
+	cmd.AddCommand(removeProjectAdminCommand(cliConfig))
+
+	return cmd
+}
+
+func addProjectAdminCommand(cliConfig *Config) *cli.Command {
+	var userIDs []string
+	var header string
+
+	cmd := &cli.Command{
+		Use:   "add",
+		Short: "Add admins to a project",
+		Args:  cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ shield project admin add <project-id> --users=<user-id>,<user-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"project:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd.Context(), cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			projectID := args[0]
+			ctx := setCtxHeader(cmd.Context(), header)
+			res, err := client.AddProjectAdmins(ctx, &shieldv1beta1.AddProjectAdminsRequest{
+				Id:   projectID,
+				Body: &shieldv1beta1.AddProjectAdminsRequestBody{UserIds: userIDs},
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			fmt.Printf("successfully added admins to project %s, it now has %d admin(s)\n", projectID, len(res.GetUsers()))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&userIDs, "users", "u", nil, "Comma separated ids of the users")
+	cmd.MarkFlagRequired("users")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func removeProjectAdminCommand(cliConfig *Config) *cli.Command {
+	var header string
+
+	cmd := &cli.Command{
+		Use:   "remove",
+		Short: "Remove an admin from a project",
+		Args:  cli.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ shield project admin remove <project-id> <user-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"project:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd.Context(), cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			projectID, userID := args[0], args[1]
+			ctx := setCtxHeader(cmd.Context(), header)
+			res, err := client.RemoveProjectAdmin(ctx, &shieldv1beta1.RemoveProjectAdminRequest{
+				Id:     projectID,
+				UserId: userID,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			fmt.Printf("successfully removed admin %s from project %s, it now has %d admin(s)\n", userID, projectID, len(res.GetUsers()))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func visibilityProjectCommand(cliConfig *Config) *cli.Command {
+	var permission, header string
+	var public bool
+
+	cmd := &cli.Command{
+		Use:   "visibility",
+		Short: "Make a permission of a project public or private",
+		Args:  cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ shield project visibility <project-id> --permission=view --public --header=<key>:<value>
+			$ shield project visibility <project-id> --permission=view --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"project:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd.Context(), cliConfig.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := setCtxHeader(cmd.Context(), header)
+			res, err := client.SetProjectVisibility(ctx, &shieldv1beta1.SetProjectVisibilityRequest{
+				Id: args[0],
+				Body: &shieldv1beta1.VisibilityRequestBody{
+					Permission: permission,
+					Public:     public,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			visibility := "private"
+			if public {
+				visibility = "public"
+			}
+
+			spinner.Stop()
+			fmt.Printf("successfully made %s permission of project %s %s\n", permission, res.GetProject().GetId(), visibility)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&permission, "permission", "p", "view", "Permission to make public or private")
+	cmd.Flags().BoolVar(&public, "public", false, "Make the permission public, otherwise it is made private")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
@@ -67,6 +67,24 @@ func TestClientProject(t *testing.T) {
 				subCommands: []string{"view", "123", "-h", "test"},
 				err:         context.DeadlineExceeded,
 			},
+			{
+				name:        "`project` admin add without host should throw error host not found",
+				want:        "",
+				subCommands: []string{"admin", "add", "123"},
+				err:         cmd.ErrClientConfigHostNotFound,
+			},
+			{
+				name:        "`project` admin add with host flag should throw error missing required flag",
+				want:        "",
+				subCommands: []string{"admin", "add", "123", "-h", "test"},
+				err:         errors.New("required flag(s) \"header\", \"users\" not set"),
+			},
+			{
+				name:        "`project` admin remove with host flag should throw error missing required flag",
+				want:        "",
+				subCommands: []string{"admin", "remove", "123", "456", "-h", "test"},
+				err:         errors.New("required flag(s) \"header\" not set"),
+			},
 		}
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
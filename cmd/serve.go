@@ -19,17 +19,21 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/goto/shield/config"
+	"github.com/goto/shield/core/accessrequest"
 	"github.com/goto/shield/core/action"
 	"github.com/goto/shield/core/activity"
 	"github.com/goto/shield/core/group"
+	"github.com/goto/shield/core/invitation"
 	"github.com/goto/shield/core/namespace"
 	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/platform"
 	"github.com/goto/shield/core/policy"
 	"github.com/goto/shield/core/project"
 	"github.com/goto/shield/core/relation"
 	"github.com/goto/shield/core/resource"
 	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/servicedata"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/adapter"
@@ -37,10 +41,12 @@ import (
 	"github.com/goto/shield/internal/schema"
 	"github.com/goto/shield/internal/server"
 	"github.com/goto/shield/internal/store/blob"
+	"github.com/goto/shield/internal/store/embedded"
 	"github.com/goto/shield/internal/store/inmemory"
 	"github.com/goto/shield/internal/store/postgres"
 	"github.com/goto/shield/internal/store/spicedb"
 	"github.com/goto/shield/pkg/db"
+	"github.com/goto/shield/pkg/str"
 
 	"github.com/goto/salt/log"
 	"github.com/goto/salt/telemetry"
@@ -75,96 +81,32 @@ func StartServer(logger *log.Zap, cfg *config.Shield) error {
 		dbClient.Close()
 	}()
 
-	// load resource config
-	if cfg.App.ResourcesConfigPath == "" {
-		return errors.New("resource config path cannot be left empty")
-	}
-
-	parsedResourcesConfigURL, err := url.Parse(cfg.App.ResourcesConfigPath)
+	authzRepository, authzEngine, err := setupAuthz(cfg, logger, dbClient)
 	if err != nil {
 		return err
 	}
 
-	var resourceBlobFS blob.Bucket
-	if parsedResourcesConfigURL.Scheme != schema.RESOURCES_CONFIG_STORAGE_PG {
-		resourceBlobFS, err = blob.NewStore(ctx, cfg.App.ResourcesConfigPath, cfg.App.ResourcesConfigPathSecret)
-		if err != nil {
-			return err
-		}
-	}
-
-	spiceDBClient, err := spicedb.New(cfg.SpiceDB, logger)
+	nrApp, err := setupNewRelic(cfg.NewRelic, logger)
 	if err != nil {
 		return err
 	}
 
-	nrApp, err := setupNewRelic(cfg.NewRelic, logger)
+	activityRepository, err := setupActivityRepository(cfg, logger, dbClient)
 	if err != nil {
 		return err
 	}
 
-	schemaMigrationConfig := schema.NewSchemaMigrationConfig(cfg.App.DefaultSystemEmail, cfg.App.ServiceData.BootstrapEnabled)
-
-	appConfig := activity.AppConfig{Version: config.Version}
-	var activityRepository activity.Repository
-	switch cfg.Log.Activity.Sink {
-	case activity.SinkTypeDB:
-		activityRepository = postgres.NewActivityRepository(dbClient)
-	case activity.SinkTypeStdout:
-		stdoutLogger, err := zap.NewStdLogAt(logger.GetInternalZapLogger().Desugar(), logger.GetInternalZapLogger().Level())
-		if err != nil {
-			return err
-		}
-		activityRepository = activity.NewStdoutRepository(stdoutLogger.Writer())
-	default:
-		activityRepository = activity.NewStdoutRepository(io.Discard)
-	}
-	activityService := activity.NewService(appConfig, activityRepository)
-
-	userRepository := postgres.NewUserRepository(dbClient)
-	userService := user.NewService(logger, user.Config{InactiveEmailTag: cfg.App.InactiveEmailTag}, userRepository, activityService)
-
-	actionRepository := postgres.NewActionRepository(dbClient)
-	actionService := action.NewService(logger, actionRepository, userService, activityService)
-
-	roleRepository := postgres.NewRoleRepository(dbClient)
-	roleService := role.NewService(logger, roleRepository, userService, activityService)
-
-	policyPGRepository := postgres.NewPolicyRepository(dbClient)
-	policySpiceRepository := spicedb.NewPolicyRepository(spiceDBClient)
-	policyService := policy.NewService(logger, policyPGRepository, userService, activityService)
-
-	namespaceRepository := postgres.NewNamespaceRepository(dbClient)
-	namespaceService := namespace.NewService(logger, namespaceRepository, userService, activityService)
-
-	resourcePGRepository := postgres.NewResourceRepository(dbClient)
-	var schemaConfigRepository schema.FileService
-	switch parsedResourcesConfigURL.Scheme {
-	case schema.RESOURCES_CONFIG_STORAGE_PG:
-		schemaConfigRepository = resourcePGRepository
-	case schema.RESOURCES_CONFIG_STORAGE_GS,
-		schema.RESOURCES_CONFIG_STORAGE_FILE,
-		schema.RESOURCES_CONFIG_STORAGE_MEM:
-		schemaConfigRepository = blob.NewSchemaConfigRepository(resourceBlobFS)
-	default:
-		return errors.New("invalid resource config storage")
+	schemaMigrationService, resourceBlobFS, err := setupSchemaMigrationService(ctx, logger, cfg, dbClient, authzRepository, authzEngine, activityRepository)
+	if err != nil {
+		return err
 	}
 
-	schemaMigrationService := schema.NewSchemaMigrationService(
-		logger,
-		schema.AppConfig{ConfigStorage: parsedResourcesConfigURL.Scheme},
-		schemaConfigRepository,
-		resourcePGRepository,
-		namespaceService,
-		roleService,
-		actionService,
-		policyService,
-		policySpiceRepository,
-		userRepository,
-		schemaMigrationConfig,
-	)
-	err = schemaMigrationService.RunMigrations(ctx)
+	// destructive changes of the resources config are only applied by a forced migrate
+	plan, err := schemaMigrationService.Migrate(ctx, false)
 	if err != nil {
+		for _, c := range plan.Destructive() {
+			logger.Error("destructive resources config change", "action", c.Action, "entity", c.Entity, "id", c.ID, "live_relations", c.LiveRelations)
+		}
 		return err
 	}
 
@@ -173,13 +115,16 @@ func StartServer(logger *log.Zap, cfg *config.Shield) error {
 		return err
 	}
 
-	deps, err := BuildAPIDependencies(ctx, logger, activityRepository, pgRuleRepository, schemaMigrationService, dbClient, spiceDBClient, resourceBlobFS, cfg)
+	deps, err := BuildAPIDependencies(ctx, logger, activityRepository, pgRuleRepository, schemaMigrationService, dbClient, authzRepository, resourceBlobFS, cfg)
 	if err != nil {
 		return err
 	}
 
+	// revoking roles of expired access requests
+	go deps.AccessRequestService.RunGrantExpiry(ctx, cfg.App.AccessRequest.ExpiryCheckInterval)
+
 	// serving proxies
-	cbs, cps, err := serveProxies(ctx, logger, cfg.App.IdentityProxyHeader, cfg.App.UserIDHeader, cfg.Proxy, pgRuleRepository, deps.ResourceService, deps.RelationService, deps.UserService, deps.GroupService, deps.ProjectService, deps.RelationAdapter)
+	cbs, cps, err := serveProxies(ctx, logger, cfg.App.IdentityProxyHeader, cfg.App.UserIDHeader, cfg.App.CheckAPILimit, cfg.Proxy, pgRuleRepository, deps.ResourceService, deps.RelationService, deps.UserService, deps.GroupService, deps.ProjectService, deps.ActivityService, deps.ServiceAccountService, deps.PlatformService, deps.RelationAdapter)
 	if err != nil {
 		return err
 	}
@@ -215,7 +160,7 @@ func BuildAPIDependencies(
 	ruleRepository rule.ConfigRepository,
 	schemaMigrationService *schema.SchemaService,
 	dbc *db.Client,
-	sdb *spicedb.SpiceDB,
+	authzRepository relation.AuthzRepository,
 	bucket blob.Bucket,
 	cfg *config.Shield,
 ) (api.Deps, error) {
@@ -244,15 +189,14 @@ func BuildAPIDependencies(
 	roleService := role.NewService(logger, roleRepository, userService, activityService)
 
 	relationPGRepository := postgres.NewRelationRepository(dbc)
-	relationSpiceRepository := spicedb.NewRelationRepository(sdb)
-	relationService := relation.NewService(logger, relationPGRepository, relationSpiceRepository, userService, activityService)
+	relationService := relation.NewService(logger, relationPGRepository, authzRepository, userService, activityService)
 
 	groupRepository := postgres.NewGroupRepository(dbc)
 	cachedGroupRepository := inmemory.NewCachedGroupRepository(cache, groupRepository)
 	groupService := group.NewService(logger, groupRepository, cachedGroupRepository, relationService, userService, activityService)
 
 	organizationRepository := postgres.NewOrganizationRepository(dbc)
-	organizationService := organization.NewService(logger, organizationRepository, relationService, userService, activityService)
+	organizationService := organization.NewService(logger, organizationRepository, relationService, userService, schemaMigrationService, activityService)
 
 	projectRepository := postgres.NewProjectRepository(dbc)
 	projectService := project.NewService(logger, projectRepository, relationService, userService, activityService)
@@ -267,25 +211,42 @@ func BuildAPIDependencies(
 	serviceDataRepository := postgres.NewServiceDataRepository(dbc)
 	serviceDataService := servicedata.NewService(logger, serviceDataRepository, resourceService, relationService, projectService, userService, activityService)
 
+	serviceAccountRepository := postgres.NewServiceAccountRepository(dbc)
+	serviceAccountService := serviceaccount.NewService(logger, serviceAccountRepository, relationService, userService, activityService)
+
+	platformService := platform.NewService(logger, relationService, userService, activityService)
+
+	invitationRepository := postgres.NewInvitationRepository(dbc)
+	invitationService := invitation.NewService(logger, invitationRepository, relationService, userService, activityService,
+		invitation.NewLogNotifier(logger), cfg.App.Invitation.Expiry)
+
+	accessRequestRepository := postgres.NewAccessRequestRepository(dbc)
+	accessRequestService := accessrequest.NewService(logger, accessRequestRepository, relationService, roleService, resourceService,
+		userService, activityService)
+
 	relationAdapter := adapter.NewRelation(groupService, userService, relationService, roleService)
 
 	ruleService := rule.NewService(ruleRepository)
 
 	dependencies := api.Deps{
-		OrgService:         organizationService,
-		UserService:        userService,
-		ProjectService:     projectService,
-		GroupService:       groupService,
-		RelationService:    relationService,
-		ResourceService:    resourceService,
-		RoleService:        roleService,
-		PolicyService:      policyService,
-		ActionService:      actionService,
-		NamespaceService:   namespaceService,
-		RelationAdapter:    relationAdapter,
-		ActivityService:    activityService,
-		ServiceDataService: serviceDataService,
-		RuleService:        ruleService,
+		OrgService:            organizationService,
+		UserService:           userService,
+		ProjectService:        projectService,
+		GroupService:          groupService,
+		RelationService:       relationService,
+		ResourceService:       resourceService,
+		RoleService:           roleService,
+		PolicyService:         policyService,
+		ActionService:         actionService,
+		NamespaceService:      namespaceService,
+		RelationAdapter:       relationAdapter,
+		ActivityService:       activityService,
+		ServiceDataService:    serviceDataService,
+		ServiceAccountService: serviceAccountService,
+		PlatformService:       platformService,
+		InvitationService:     invitationService,
+		AccessRequestService:  accessRequestService,
+		RuleService:           ruleService,
 	}
 	return dependencies, nil
 }
@@ -310,6 +271,134 @@ func setupNewRelic(cfg config.NewRelic, logger log.Logger) (*newrelic.Applicatio
 	return nrApp, nil
 }
 
+func setupActivityRepository(cfg *config.Shield, logger *log.Zap, dbClient *db.Client) (activity.Repository, error) {
+	switch cfg.Log.Activity.Sink {
+	case activity.SinkTypeDB:
+		return postgres.NewActivityRepository(dbClient), nil
+	case activity.SinkTypeStdout:
+		stdoutLogger, err := zap.NewStdLogAt(logger.GetInternalZapLogger().Desugar(), logger.GetInternalZapLogger().Level())
+		if err != nil {
+			return nil, err
+		}
+		return activity.NewStdoutRepository(stdoutLogger.Writer()), nil
+	default:
+		return activity.NewStdoutRepository(io.Discard), nil
+	}
+}
+
+// setupAuthz builds the authz engine evaluating relations and the repository
+// writing the authz schema to it, spicedb unless the embedded engine is configured
+func setupAuthz(cfg *config.Shield, logger log.Logger, dbClient *db.Client) (relation.AuthzRepository, schema.AuthzEngine, error) {
+	switch cfg.Authz.Engine {
+	case config.AuthzEngineSpiceDB, "":
+		spiceDBClient, err := spicedb.New(cfg.SpiceDB, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		return spicedb.NewRelationRepository(spiceDBClient), spicedb.NewPolicyRepository(spiceDBClient), nil
+	case config.AuthzEngineEmbedded:
+		var tuples embedded.TupleStore
+		switch cfg.Authz.TupleStore {
+		case embedded.TupleStorePostgres, "":
+			tuples = embedded.NewPostgresTupleStore(postgres.NewRelationRepository(dbClient))
+		case embedded.TupleStoreMemory:
+			tuples = embedded.NewMemoryTupleStore()
+		default:
+			return nil, nil, fmt.Errorf("invalid tuple store %s of the embedded authz engine", cfg.Authz.TupleStore)
+		}
+
+		engine := embedded.New(tuples, postgres.NewSchemaVersionRepository(dbClient))
+		logger.Info(fmt.Sprintf("using embedded authz engine with %s tuple store", str.DefaultStringIfEmpty(cfg.Authz.TupleStore, embedded.TupleStorePostgres)))
+		return embedded.NewRelationRepository(engine), embedded.NewPolicyRepository(engine), nil
+	default:
+		return nil, nil, fmt.Errorf("invalid authz engine %s", cfg.Authz.Engine)
+	}
+}
+
+// setupSchemaMigrationService builds the service migrating the resources config
+// into namespaces, roles, actions, policies and the authz schema
+func setupSchemaMigrationService(
+	ctx context.Context,
+	logger *log.Zap,
+	cfg *config.Shield,
+	dbClient *db.Client,
+	authzRepository relation.AuthzRepository,
+	authzEngine schema.AuthzEngine,
+	activityRepository activity.Repository,
+) (*schema.SchemaService, blob.Bucket, error) {
+	// load resource config
+	if cfg.App.ResourcesConfigPath == "" {
+		return nil, nil, errors.New("resource config path cannot be left empty")
+	}
+
+	parsedResourcesConfigURL, err := url.Parse(cfg.App.ResourcesConfigPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resourceBlobFS blob.Bucket
+	if parsedResourcesConfigURL.Scheme != schema.RESOURCES_CONFIG_STORAGE_PG {
+		resourceBlobFS, err = blob.NewStore(ctx, cfg.App.ResourcesConfigPath, cfg.App.ResourcesConfigPathSecret)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	schemaMigrationConfig := schema.NewSchemaMigrationConfig(cfg.App.DefaultSystemEmail, cfg.App.ServiceData.BootstrapEnabled)
+
+	appConfig := activity.AppConfig{Version: config.Version}
+	activityService := activity.NewService(appConfig, activityRepository)
+
+	userRepository := postgres.NewUserRepository(dbClient)
+	userService := user.NewService(logger, user.Config{InactiveEmailTag: cfg.App.InactiveEmailTag}, userRepository, activityService)
+
+	actionRepository := postgres.NewActionRepository(dbClient)
+	actionService := action.NewService(logger, actionRepository, userService, activityService)
+
+	roleRepository := postgres.NewRoleRepository(dbClient)
+	roleService := role.NewService(logger, roleRepository, userService, activityService)
+
+	policyPGRepository := postgres.NewPolicyRepository(dbClient)
+	policyService := policy.NewService(logger, policyPGRepository, userService, activityService)
+
+	namespaceRepository := postgres.NewNamespaceRepository(dbClient)
+	namespaceService := namespace.NewService(logger, namespaceRepository, userService, activityService)
+
+	resourcePGRepository := postgres.NewResourceRepository(dbClient)
+	var schemaConfigRepository schema.FileService
+	switch parsedResourcesConfigURL.Scheme {
+	case schema.RESOURCES_CONFIG_STORAGE_PG:
+		schemaConfigRepository = resourcePGRepository
+	case schema.RESOURCES_CONFIG_STORAGE_GS,
+		schema.RESOURCES_CONFIG_STORAGE_FILE,
+		schema.RESOURCES_CONFIG_STORAGE_MEM:
+		schemaConfigRepository = blob.NewSchemaConfigRepository(resourceBlobFS)
+	default:
+		return nil, nil, errors.New("invalid resource config storage")
+	}
+
+	relationRepository := postgres.NewRelationRepository(dbClient)
+	relationService := relation.NewService(logger, relationRepository, authzRepository, userService, activityService)
+
+	schemaMigrationService := schema.NewSchemaMigrationService(
+		logger,
+		schema.AppConfig{ConfigStorage: parsedResourcesConfigURL.Scheme},
+		schemaConfigRepository,
+		resourcePGRepository,
+		namespaceService,
+		roleService,
+		actionService,
+		policyService,
+		authzEngine,
+		userRepository,
+		relationRepository,
+		relationService,
+		postgres.NewSchemaVersionRepository(dbClient),
+		schemaMigrationConfig,
+	)
+	return schemaMigrationService, resourceBlobFS, nil
+}
+
 func setupDB(cfg db.Config, logger log.Logger) (dbc *db.Client, err error) {
 	// prefer use pgx instead of lib/pq for postgres to catch pg error
 	if cfg.Driver == "postgres" {
@@ -7,20 +7,31 @@ import (
 	"net/url"
 
 	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/activity"
 	"github.com/goto/shield/core/group"
+	"github.com/goto/shield/core/platform"
 	"github.com/goto/shield/core/project"
 	"github.com/goto/shield/core/relation"
 	"github.com/goto/shield/core/resource"
 	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/adapter"
 	"github.com/goto/shield/internal/api/v1beta1"
 	"github.com/goto/shield/internal/proxy"
 	"github.com/goto/shield/internal/proxy/hook"
+	activity_hook "github.com/goto/shield/internal/proxy/hook/activity"
 	authz_hook "github.com/goto/shield/internal/proxy/hook/authz"
+	filter_hook "github.com/goto/shield/internal/proxy/hook/filter"
+	header_hook "github.com/goto/shield/internal/proxy/hook/header"
+	status_hook "github.com/goto/shield/internal/proxy/hook/status"
+	"github.com/goto/shield/internal/proxy/middleware"
+	"github.com/goto/shield/internal/proxy/middleware/api_key"
 	"github.com/goto/shield/internal/proxy/middleware/attributes"
 	"github.com/goto/shield/internal/proxy/middleware/authz"
 	"github.com/goto/shield/internal/proxy/middleware/basic_auth"
+	"github.com/goto/shield/internal/proxy/middleware/headers"
+	"github.com/goto/shield/internal/proxy/middleware/impersonation"
 	"github.com/goto/shield/internal/proxy/middleware/observability"
 	"github.com/goto/shield/internal/proxy/middleware/otelpostprocessor"
 	"github.com/goto/shield/internal/proxy/middleware/prefix"
@@ -34,6 +45,7 @@ func serveProxies(
 	logger *log.Zap,
 	identityProxyHeaderKey,
 	userIDHeaderKey string,
+	checkAPILimit int,
 	cfg proxy.ServicesConfig,
 	pgRuleRepository *postgres.RuleRepository,
 	resourceService *resource.Service,
@@ -41,17 +53,27 @@ func serveProxies(
 	userService *user.Service,
 	groupService *group.Service,
 	projectService *project.Service,
+	activityService *activity.Service,
+	serviceAccountService *serviceaccount.Service,
+	platformService *platform.Service,
 	relationAdapter *adapter.Relation,
 ) ([]func() error, []func(ctx context.Context) error, error) {
 	var cleanUpBlobs []func() error
 	var cleanUpProxies []func(ctx context.Context) error
 
+	// userdb files of basic auth rules are reloaded along with the rules
+	basicAuthUserDB := basic_auth.NewUserDB(logger)
+	if err := basicAuthUserDB.InitCache(ctx, ruleCacheRefreshDelay); err != nil {
+		return nil, nil, err
+	}
+	cleanUpBlobs = append(cleanUpBlobs, basicAuthUserDB.Close)
+
 	for _, svcConfig := range cfg.Services {
-		hookPipeline := buildHookPipeline(logger, resourceService, relationService, relationAdapter, identityProxyHeaderKey)
+		hookPipeline := buildHookPipeline(logger, resourceService, relationService, activityService, relationAdapter, identityProxyHeaderKey, userIDHeaderKey, checkAPILimit)
 
 		h2cProxy := proxy.NewH2c(
 			proxy.NewH2cRoundTripper(logger, hookPipeline),
-			proxy.NewDirector(),
+			proxy.NewDirector(ctx, logger, identityProxyHeaderKey, userIDHeaderKey),
 		)
 
 		// load rules sets
@@ -88,7 +110,7 @@ func serveProxies(
 
 		ruleService := rule.NewService(ruleRepository)
 
-		middlewarePipeline := buildMiddlewarePipeline(logger, h2cProxy, identityProxyHeaderKey, userIDHeaderKey, resourceService, userService, groupService, ruleService, projectService)
+		middlewarePipeline := buildMiddlewarePipeline(logger, h2cProxy, middleware.NewErrorWriter(middleware.ErrorVerbosity(svcConfig.ErrorVerbosity)), identityProxyHeaderKey, userIDHeaderKey, resourceService, userService, groupService, ruleService, projectService, serviceAccountService, platformService, basicAuthUserDB)
 
 		cps := proxy.Serve(ctx, logger, svcConfig, middlewarePipeline)
 		cleanUpProxies = append(cleanUpProxies, cps)
@@ -100,32 +122,48 @@ func serveProxies(
 
 func buildHookPipeline(
 	log log.Logger,
-	resourceService v1beta1.ResourceService,
+	resourceService *resource.Service,
 	relationService v1beta1.RelationService,
+	activityService activity_hook.ActivityService,
 	relationAdapter *adapter.Relation,
-	identityProxyHeaderKey string,
+	identityProxyHeaderKey, userIDHeaderKey string,
+	checkAPILimit int,
 ) hook.Service {
-	rootHook := hook.New()
-	return authz_hook.New(log, rootHook, rootHook, resourceService, relationService, relationAdapter, identityProxyHeaderKey)
+	// Note: execution order is the order of hooks in the rule
+	next, escape := hook.Next(), hook.Escape()
+	return hook.NewPipeline(log,
+		authz_hook.New(log, next, escape, resourceService, relationService, relationAdapter, identityProxyHeaderKey),
+		filter_hook.New(log, next, escape, resourceService, identityProxyHeaderKey, checkAPILimit),
+		header_hook.New(log, next, escape),
+		status_hook.New(log, next, escape),
+		activity_hook.New(log, next, escape, activityService, identityProxyHeaderKey, userIDHeaderKey),
+	)
 }
 
 // buildPipeline builds middleware sequence
 func buildMiddlewarePipeline(
 	logger *log.Zap,
 	proxy http.Handler,
+	errWriter middleware.ErrorWriter,
 	identityProxyHeaderKey, userIDHeaderKey string,
 	resourceService *resource.Service,
 	userService *user.Service,
 	groupService *group.Service,
 	ruleService *rule.Service,
 	projectService *project.Service,
+	serviceAccountService *serviceaccount.Service,
+	platformService *platform.Service,
+	basicAuthUserDB *basic_auth.UserDB,
 ) http.Handler {
 	// Note: execution order is bottom up
 	prefixWare := prefix.New(logger, proxy)
-	casbinAuthz := authz.New(logger, prefixWare, userIDHeaderKey, resourceService, userService, groupService)
-	basicAuthn := basic_auth.New(logger, casbinAuthz)
-	attributeExtractor := attributes.New(logger, basicAuthn, identityProxyHeaderKey, projectService)
-	otelPostProcessor := otelpostprocessor.New(attributeExtractor)
+	headerTransformer := headers.New(logger, prefixWare, errWriter, userService, groupService)
+	casbinAuthz := authz.New(logger, headerTransformer, errWriter, userIDHeaderKey, resourceService, userService, groupService)
+	apiKeyAuthn := api_key.New(logger, casbinAuthz, errWriter, identityProxyHeaderKey, serviceAccountService)
+	basicAuthn := basic_auth.New(logger, apiKeyAuthn, errWriter, basicAuthUserDB)
+	attributeExtractor := attributes.New(logger, basicAuthn, errWriter, identityProxyHeaderKey, projectService)
+	impersonator := impersonation.New(logger, attributeExtractor, errWriter, identityProxyHeaderKey, platformService)
+	otelPostProcessor := otelpostprocessor.New(impersonator)
 	matchWare := rulematch.New(logger, otelPostProcessor, rulematch.NewRouteMatcher(ruleService))
 	observability := observability.New(logger, matchWare)
 	return observability
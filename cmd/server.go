@@ -1,12 +1,18 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"strconv"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/salt/log"
+	"github.com/goto/salt/printer"
 	"github.com/goto/shield/config"
+	"github.com/goto/shield/internal/schema"
 	"github.com/goto/shield/internal/store/postgres/migrations"
 	"github.com/goto/shield/pkg/db"
 	shieldlogger "github.com/goto/shield/pkg/logger"
@@ -26,8 +32,10 @@ func ServerCommand() *cobra.Command {
 			$ shield server start -c ./config.yaml
 			$ shield server migrate
 			$ shield server migrate -c ./config.yaml
+			$ shield server migrate --dry-run -c ./config.yaml
 			$ shield server migrate-rollback
 			$ shield server migrate-rollback -c ./config.yaml
+			$ shield server schema rollback --to 3 -c ./config.yaml
 		`),
 	}
 
@@ -35,6 +43,7 @@ func ServerCommand() *cobra.Command {
 	cmd.AddCommand(serverStartCommand())
 	cmd.AddCommand(serverMigrateCommand())
 	cmd.AddCommand(serverMigrateRollbackCommand())
+	cmd.AddCommand(serverSchemaCommand())
 
 	return cmd
 }
@@ -119,28 +128,130 @@ func serverStartCommand() *cobra.Command {
 
 func serverMigrateCommand() *cobra.Command {
 	var configFile string
+	var dryRun bool
+	var force bool
 
 	c := &cli.Command{
-		Use:     "migrate",
-		Short:   "Run DB Schema Migrations",
-		Example: "shield migrate",
+		Use:   "migrate",
+		Short: "Run DB Schema Migrations",
+		Long: heredoc.Doc(`
+			Run DB schema migrations and migrate the resources config into namespaces,
+			roles, actions, policies and the authz schema.
+
+			Removing roles or permissions which still have relations is refused unless --force is set,
+			use --dry-run to only print the changes. Both need the resources config and SpiceDB to be
+			reachable. The dry run doesn't run DB schema migrations and plans against an already
+			migrated DB, it fails if the DB has pending migrations.
+		`),
+		Example: heredoc.Doc(`
+			$ shield server migrate
+			$ shield server migrate --dry-run
+			$ shield server migrate --force
+		`),
 		RunE: func(c *cli.Command, args []string) error {
 			appConfig, err := config.Load(configFile)
 			if err != nil {
 				panic(err)
 			}
+			logger := shieldlogger.InitLogger(shieldlogger.Config{Level: appConfig.Log.Level})
 
-			return db.RunMigrations(db.Config{
+			dbConfig := db.Config{
 				Driver: appConfig.DB.Driver,
 				URL:    appConfig.DB.URL,
-			}, migrations.MigrationFs, migrations.ResourcePath)
+			}
+			if dryRun {
+				pending, err := db.HasPendingMigrations(dbConfig, migrations.MigrationFs, migrations.ResourcePath)
+				if err != nil {
+					return err
+				}
+				if pending {
+					return errors.New("DB has pending schema migrations, the dry run can only plan against a migrated DB")
+				}
+			} else if err := db.RunMigrations(dbConfig, migrations.MigrationFs, migrations.ResourcePath); err != nil {
+				return err
+			}
+
+			return migrateResources(c.Context(), logger, appConfig, dryRun, force)
 		},
 	}
 
 	c.Flags().StringVarP(&configFile, "config", "c", "", "Config file path")
+	c.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes to the resources config without applying them")
+	c.Flags().BoolVar(&force, "force", false, "Apply removals of roles and permissions which still have relations, deleting those relations")
 	return c
 }
 
+func migrateResources(ctx context.Context, logger *log.Zap, cfg *config.Shield, dryRun, force bool) error {
+	schemaMigrationService, closeDB, err := newSchemaMigrationService(ctx, logger, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	var plan schema.Plan
+	if dryRun {
+		plan, err = schemaMigrationService.Plan(ctx)
+	} else {
+		plan, err = schemaMigrationService.Migrate(ctx, force)
+	}
+	printPlan(plan)
+	return err
+}
+
+func newSchemaMigrationService(ctx context.Context, logger *log.Zap, cfg *config.Shield) (*schema.SchemaService, func(), error) {
+	dbClient, err := setupDB(cfg.DB, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeDB := func() { dbClient.Close() }
+
+	authzRepository, authzEngine, err := setupAuthz(cfg, logger, dbClient)
+	if err != nil {
+		closeDB()
+		return nil, nil, err
+	}
+
+	activityRepository, err := setupActivityRepository(cfg, logger, dbClient)
+	if err != nil {
+		closeDB()
+		return nil, nil, err
+	}
+
+	schemaMigrationService, _, err := setupSchemaMigrationService(ctx, logger, cfg, dbClient, authzRepository, authzEngine, activityRepository)
+	if err != nil {
+		closeDB()
+		return nil, nil, err
+	}
+
+	return schemaMigrationService, closeDB, nil
+}
+
+func printPlan(plan schema.Plan) {
+	if len(plan.Changes) == 0 {
+		fmt.Printf("No changes to the resources config.\n")
+		return
+	}
+
+	fmt.Printf(" \nShowing %d changes\n \n", len(plan.Changes))
+
+	report := [][]string{}
+	report = append(report, []string{"ACTION", "ENTITY", "ID", "DETAIL", "LIVE RELATIONS"})
+	for _, c := range plan.Changes {
+		action := string(c.Action)
+		if c.Destructive() {
+			action = fmt.Sprintf("%s (destructive)", action)
+		}
+		report = append(report, []string{
+			action,
+			c.Entity,
+			c.ID,
+			c.Detail,
+			strconv.FormatInt(c.LiveRelations, 10),
+		})
+	}
+	printer.Table(os.Stdout, report)
+}
+
 func serverMigrateRollbackCommand() *cobra.Command {
 	var configFile string
 
@@ -164,3 +275,60 @@ func serverMigrateRollbackCommand() *cobra.Command {
 	c.Flags().StringVarP(&configFile, "config", "c", "", "Config file path")
 	return c
 }
+
+func serverSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema <command>",
+		Short: "Manage the authz schema",
+		Example: heredoc.Doc(`
+			$ shield server schema rollback --to 3
+		`),
+	}
+
+	cmd.AddCommand(serverSchemaRollbackCommand())
+
+	return cmd
+}
+
+func serverSchemaRollbackCommand() *cobra.Command {
+	var configFile string
+	var version int64
+
+	c := &cli.Command{
+		Use:   "rollback",
+		Short: "Restore a previous version of the authz schema",
+		Long: heredoc.Doc(`
+			Restore a previous version of the authz schema in SpiceDB, the restored schema is
+			stored as a new version. Versions are listed with the ListSchemaVersions API.
+
+			The rollback is refused if definitions or relations it removes still have relations.
+			Namespaces, roles, actions and policies are not changed and the next migration
+			writes the schema of the resources config again.
+		`),
+		Example: heredoc.Doc(`
+			$ shield server schema rollback --to 3
+		`),
+		RunE: func(c *cli.Command, args []string) error {
+			appConfig, err := config.Load(configFile)
+			if err != nil {
+				panic(err)
+			}
+			logger := shieldlogger.InitLogger(shieldlogger.Config{Level: appConfig.Log.Level})
+
+			schemaMigrationService, closeDB, err := newSchemaMigrationService(c.Context(), logger, appConfig)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			plan, err := schemaMigrationService.RollbackSchema(c.Context(), version)
+			printPlan(plan)
+			return err
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "Config file path")
+	c.Flags().Int64Var(&version, "to", 0, "Version of the authz schema to restore")
+	c.MarkFlagRequired("to")
+	return c
+}
@@ -28,6 +28,19 @@ type ActivityLogConfig struct {
 	Sink string `yaml:"sink" mapstructure:"sink" default:"none"`
 }
 
+const (
+	AuthzEngineSpiceDB  = "spicedb"
+	AuthzEngineEmbedded = "embedded"
+)
+
+type AuthzConfig struct {
+	// authz engine strategy - spicedb, embedded
+	Engine string `yaml:"engine" mapstructure:"engine" default:"spicedb"`
+
+	// tuple store of the embedded engine - postgres, memory
+	TupleStore string `yaml:"tuple_store" mapstructure:"tuple_store" default:"postgres"`
+}
+
 type Shield struct {
 	// configuration version
 	Version   int                  `yaml:"version"`
@@ -37,6 +50,7 @@ type Shield struct {
 	App       server.Config        `yaml:"app"`
 	DB        db.Config            `yaml:"db"`
 	SpiceDB   spicedb.Config       `yaml:"spicedb"`
+	Authz     AuthzConfig          `yaml:"authz"`
 	Telemetry telemetry.Config     `yaml:"telemetry"`
 }
 
@@ -0,0 +1,112 @@
+package accessrequest
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	AuditEntity = "accessrequest"
+
+	// DefaultExpiryCheckInterval is how often expired grants are revoked when
+	// no interval is configured
+	DefaultExpiryCheckInterval = time.Minute
+)
+
+// State of an access request, requests start pending and are approved or
+// rejected by a reviewer. Approved requests with an expiry become expired
+// once the granted role is revoked.
+type State string
+
+const (
+	StatePending  State = "pending"
+	StateApproved State = "approved"
+	StateRejected State = "rejected"
+	StateExpired  State = "expired"
+)
+
+func (s State) IsValid() bool {
+	switch s {
+	case StatePending, StateApproved, StateRejected, StateExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+type Repository interface {
+	Create(ctx context.Context, accessRequest AccessRequest) (AccessRequest, error)
+	GetByID(ctx context.Context, id string) (AccessRequest, error)
+	List(ctx context.Context, flt Filter) ([]AccessRequest, error)
+	Review(ctx context.Context, review Review) (AccessRequest, error)
+	ListExpired(ctx context.Context, at time.Time) ([]AccessRequest, error)
+	Expire(ctx context.Context, id string) (AccessRequest, error)
+}
+
+// AccessRequest is a request of a user to be granted a role on a resource,
+// project or group, holders of its edit permission review the request
+type AccessRequest struct {
+	ID          string
+	UserID      string
+	NamespaceID string
+	ObjectID    string
+	RoleID      string
+	Reason      string
+	State       State
+	ReviewedBy  string
+	// ReviewedAt is zero for pending requests
+	ReviewedAt    time.Time
+	ReviewComment string
+	// ExpiresAt is set by the reviewer for roles granted for a limited time,
+	// it is zero for roles which are granted until they are removed
+	ExpiresAt time.Time
+	// RelationID is the relation created by the approval of the request
+	RelationID string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Review approves or rejects a pending access request
+type Review struct {
+	ID         string
+	State      State
+	ReviewedBy string
+	Comment    string
+	ExpiresAt  time.Time
+	RelationID string
+}
+
+// Filter lists the access requests of a user or of a resource, project or group
+type Filter struct {
+	UserID      string
+	NamespaceID string
+	ObjectID    string
+	State       State
+}
+
+type LogData struct {
+	Entity      string `mapstructure:"entity"`
+	ID          string `mapstructure:"id"`
+	UserID      string `mapstructure:"user_id"`
+	NamespaceID string `mapstructure:"namespace_id"`
+	ObjectID    string `mapstructure:"object_id"`
+	RoleID      string `mapstructure:"role_id"`
+	State       string `mapstructure:"state"`
+	ExpiresAt   string `mapstructure:"expires_at"`
+}
+
+func (accessRequest AccessRequest) ToLogData() LogData {
+	logData := LogData{
+		Entity:      AuditEntity,
+		ID:          accessRequest.ID,
+		UserID:      accessRequest.UserID,
+		NamespaceID: accessRequest.NamespaceID,
+		ObjectID:    accessRequest.ObjectID,
+		RoleID:      accessRequest.RoleID,
+		State:       string(accessRequest.State),
+	}
+	if !accessRequest.ExpiresAt.IsZero() {
+		logData.ExpiresAt = accessRequest.ExpiresAt.Format(time.RFC3339)
+	}
+	return logData
+}
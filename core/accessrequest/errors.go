@@ -0,0 +1,14 @@
+package accessrequest
+
+import "errors"
+
+var (
+	ErrNotExist       = errors.New("access request doesn't exist")
+	ErrInvalidID      = errors.New("access request id is invalid")
+	ErrInvalidDetail  = errors.New("invalid access request detail")
+	ErrConflict       = errors.New("a pending access request of the role already exist")
+	ErrNotPending     = errors.New("access request is already reviewed")
+	ErrSelfReview     = errors.New("access requests can't be reviewed by their requester")
+	ErrAlreadyGranted = errors.New("user already has the role")
+	ErrLogActivity    = errors.New("error while logging activity")
+)
@@ -0,0 +1,386 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	accessrequest "github.com/goto/shield/core/accessrequest"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+type Repository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repository) EXPECT() *Repository_Expecter {
+	return &Repository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, accessRequest
+func (_m *Repository) Create(ctx context.Context, accessRequest accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, accessRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.AccessRequest) (accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, accessRequest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.AccessRequest) accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, accessRequest)
+	} else {
+		r0 = ret.Get(0).(accessrequest.AccessRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accessrequest.AccessRequest) error); ok {
+		r1 = rf(ctx, accessRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessRequest accessrequest.AccessRequest
+func (_e *Repository_Expecter) Create(ctx interface{}, accessRequest interface{}) *Repository_Create_Call {
+	return &Repository_Create_Call{Call: _e.mock.On("Create", ctx, accessRequest)}
+}
+
+func (_c *Repository_Create_Call) Run(run func(ctx context.Context, accessRequest accessrequest.AccessRequest)) *Repository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accessrequest.AccessRequest))
+	})
+	return _c
+}
+
+func (_c *Repository_Create_Call) Return(_a0 accessrequest.AccessRequest, _a1 error) *Repository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_Create_Call) RunAndReturn(run func(context.Context, accessrequest.AccessRequest) (accessrequest.AccessRequest, error)) *Repository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Expire provides a mock function with given fields: ctx, id
+func (_m *Repository) Expire(ctx context.Context, id string) (accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expire")
+	}
+
+	var r0 accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(accessrequest.AccessRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_Expire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expire'
+type Repository_Expire_Call struct {
+	*mock.Call
+}
+
+// Expire is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) Expire(ctx interface{}, id interface{}) *Repository_Expire_Call {
+	return &Repository_Expire_Call{Call: _e.mock.On("Expire", ctx, id)}
+}
+
+func (_c *Repository_Expire_Call) Run(run func(ctx context.Context, id string)) *Repository_Expire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_Expire_Call) Return(_a0 accessrequest.AccessRequest, _a1 error) *Repository_Expire_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_Expire_Call) RunAndReturn(run func(context.Context, string) (accessrequest.AccessRequest, error)) *Repository_Expire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetByID(ctx context.Context, id string) (accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(accessrequest.AccessRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type Repository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) GetByID(ctx interface{}, id interface{}) *Repository_GetByID_Call {
+	return &Repository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *Repository_GetByID_Call) Run(run func(ctx context.Context, id string)) *Repository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) Return(_a0 accessrequest.AccessRequest, _a1 error) *Repository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) RunAndReturn(run func(context.Context, string) (accessrequest.AccessRequest, error)) *Repository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, flt
+func (_m *Repository) List(ctx context.Context, flt accessrequest.Filter) ([]accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.Filter) ([]accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.Filter) []accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accessrequest.AccessRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accessrequest.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type Repository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt accessrequest.Filter
+func (_e *Repository_Expecter) List(ctx interface{}, flt interface{}) *Repository_List_Call {
+	return &Repository_List_Call{Call: _e.mock.On("List", ctx, flt)}
+}
+
+func (_c *Repository_List_Call) Run(run func(ctx context.Context, flt accessrequest.Filter)) *Repository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accessrequest.Filter))
+	})
+	return _c
+}
+
+func (_c *Repository_List_Call) Return(_a0 []accessrequest.AccessRequest, _a1 error) *Repository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_List_Call) RunAndReturn(run func(context.Context, accessrequest.Filter) ([]accessrequest.AccessRequest, error)) *Repository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpired provides a mock function with given fields: ctx, at
+func (_m *Repository) ListExpired(ctx context.Context, at time.Time) ([]accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpired")
+	}
+
+	var r0 []accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accessrequest.AccessRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_ListExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpired'
+type Repository_ListExpired_Call struct {
+	*mock.Call
+}
+
+// ListExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - at time.Time
+func (_e *Repository_Expecter) ListExpired(ctx interface{}, at interface{}) *Repository_ListExpired_Call {
+	return &Repository_ListExpired_Call{Call: _e.mock.On("ListExpired", ctx, at)}
+}
+
+func (_c *Repository_ListExpired_Call) Run(run func(ctx context.Context, at time.Time)) *Repository_ListExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repository_ListExpired_Call) Return(_a0 []accessrequest.AccessRequest, _a1 error) *Repository_ListExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_ListExpired_Call) RunAndReturn(run func(context.Context, time.Time) ([]accessrequest.AccessRequest, error)) *Repository_ListExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, review
+func (_m *Repository) Review(ctx context.Context, review accessrequest.Review) (accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, review)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.Review) (accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, review)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.Review) accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, review)
+	} else {
+		r0 = ret.Get(0).(accessrequest.AccessRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accessrequest.Review) error); ok {
+		r1 = rf(ctx, review)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type Repository_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - review accessrequest.Review
+func (_e *Repository_Expecter) Review(ctx interface{}, review interface{}) *Repository_Review_Call {
+	return &Repository_Review_Call{Call: _e.mock.On("Review", ctx, review)}
+}
+
+func (_c *Repository_Review_Call) Run(run func(ctx context.Context, review accessrequest.Review)) *Repository_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accessrequest.Review))
+	})
+	return _c
+}
+
+func (_c *Repository_Review_Call) Return(_a0 accessrequest.AccessRequest, _a1 error) *Repository_Review_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_Review_Call) RunAndReturn(run func(context.Context, accessrequest.Review) (accessrequest.AccessRequest, error)) *Repository_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,394 @@
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/role"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/uuid"
+)
+
+const (
+	auditKeyAccessRequestCreate  = "accessrequest.create"
+	auditKeyAccessRequestApprove = "accessrequest.approve"
+	auditKeyAccessRequestReject  = "accessrequest.reject"
+	auditKeyAccessRequestExpire  = "accessrequest.expire"
+)
+
+type UserService interface {
+	FetchCurrentUser(ctx context.Context) (user.User, error)
+}
+
+type RelationService interface {
+	Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
+	DeleteV2(ctx context.Context, rel relation.RelationV2) error
+	GetRelationByFields(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
+	CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
+}
+
+type RoleService interface {
+	Get(ctx context.Context, id string) (role.Role, error)
+}
+
+type ResourceService interface {
+	Get(ctx context.Context, id string) (resource.Resource, error)
+}
+
+type ActivityService interface {
+	Log(ctx context.Context, action string, actor activity.Actor, data any) error
+}
+
+type Service struct {
+	logger          log.Logger
+	repository      Repository
+	relationService RelationService
+	roleService     RoleService
+	resourceService ResourceService
+	userService     UserService
+	activityService ActivityService
+}
+
+func NewService(logger log.Logger, repository Repository, relationService RelationService, roleService RoleService,
+	resourceService ResourceService, userService UserService, activityService ActivityService,
+) *Service {
+	return &Service{
+		logger:          logger,
+		repository:      repository,
+		relationService: relationService,
+		roleService:     roleService,
+		resourceService: resourceService,
+		userService:     userService,
+		activityService: activityService,
+	}
+}
+
+// Create requests a role on a resource, project or group for the current user
+func (s Service) Create(ctx context.Context, accessRequest AccessRequest) (AccessRequest, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	if err := s.validateTarget(ctx, accessRequest); err != nil {
+		return AccessRequest{}, err
+	}
+	if err := s.checkNotGranted(ctx, currentUser.ID, accessRequest); err != nil {
+		return AccessRequest{}, err
+	}
+
+	newAccessRequest, err := s.repository.Create(ctx, AccessRequest{
+		UserID:      currentUser.ID,
+		NamespaceID: accessRequest.NamespaceID,
+		ObjectID:    accessRequest.ObjectID,
+		RoleID:      accessRequest.RoleID,
+		Reason:      strings.TrimSpace(accessRequest.Reason),
+	})
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		accessRequestLogData := newAccessRequest.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyAccessRequestCreate, actor, accessRequestLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return newAccessRequest, nil
+}
+
+// List returns the access requests of the resource, project or group of the
+// filter, which the current user has to be able to edit. Without a target the
+// access requests of the current user are returned.
+func (s Service) List(ctx context.Context, flt Filter) ([]AccessRequest, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return []AccessRequest{}, err
+	}
+
+	if flt.State != "" && !flt.State.IsValid() {
+		return []AccessRequest{}, fmt.Errorf("%w: unknown state %s", ErrInvalidDetail, flt.State)
+	}
+	if flt.ObjectID == "" {
+		return s.repository.List(ctx, Filter{UserID: currentUser.ID, State: flt.State})
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, flt.NamespaceID, flt.ObjectID); err != nil {
+		return []AccessRequest{}, err
+	}
+	return s.repository.List(ctx, Filter{NamespaceID: flt.NamespaceID, ObjectID: flt.ObjectID, State: flt.State})
+}
+
+// Approve grants the requested role, a non zero expiresAt grants it until then.
+// The current user has to be able to edit the target of the request.
+func (s Service) Approve(ctx context.Context, id string, expiresAt time.Time, comment string) (AccessRequest, error) {
+	currentUser, accessRequest, err := s.authorizeReview(ctx, id)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return AccessRequest{}, fmt.Errorf("%w: expiry has to be in the future", ErrInvalidDetail)
+	}
+	// a role granted for a limited time would revoke an existing grant on expiry
+	if err := s.checkNotGranted(ctx, accessRequest.UserID, accessRequest); err != nil {
+		return AccessRequest{}, err
+	}
+
+	createdRelation, err := s.relationService.Create(ctx, roleRelation(accessRequest))
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	approvedAccessRequest, err := s.repository.Review(ctx, Review{
+		ID:         accessRequest.ID,
+		State:      StateApproved,
+		ReviewedBy: currentUser.ID,
+		Comment:    strings.TrimSpace(comment),
+		ExpiresAt:  expiresAt,
+		RelationID: createdRelation.ID,
+	})
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		accessRequestLogData := approvedAccessRequest.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyAccessRequestApprove, actor, accessRequestLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return approvedAccessRequest, nil
+}
+
+// Reject rejects the request, the current user has to be able to edit the
+// target of the request
+func (s Service) Reject(ctx context.Context, id, comment string) (AccessRequest, error) {
+	currentUser, accessRequest, err := s.authorizeReview(ctx, id)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	rejectedAccessRequest, err := s.repository.Review(ctx, Review{
+		ID:         accessRequest.ID,
+		State:      StateRejected,
+		ReviewedBy: currentUser.ID,
+		Comment:    strings.TrimSpace(comment),
+	})
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		accessRequestLogData := rejectedAccessRequest.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyAccessRequestReject, actor, accessRequestLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return rejectedAccessRequest, nil
+}
+
+// ExpireGrants revokes the roles of approved requests whose expiry has passed
+func (s Service) ExpireGrants(ctx context.Context) error {
+	expiredAccessRequests, err := s.repository.ListExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, accessRequest := range expiredAccessRequests {
+		if err := s.revokeGrant(ctx, accessRequest); err != nil {
+			s.logger.Error(fmt.Sprintf("error while revoking the role of access request %s: %s", accessRequest.ID, err.Error()))
+			continue
+		}
+
+		expiredAccessRequest, err := s.repository.Expire(ctx, accessRequest.ID)
+		if err != nil {
+			if !errors.Is(err, ErrNotPending) {
+				s.logger.Error(fmt.Sprintf("error while expiring access request %s: %s", accessRequest.ID, err.Error()))
+			}
+			continue
+		}
+
+		// the reviewer set the expiry, so the expiry is logged on their behalf
+		go func() {
+			ctx := context.WithoutCancel(ctx)
+			accessRequestLogData := expiredAccessRequest.ToLogData()
+			actor := activity.Actor{ID: expiredAccessRequest.ReviewedBy}
+			if err := s.activityService.Log(ctx, auditKeyAccessRequestExpire, actor, accessRequestLogData); err != nil {
+				s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+			}
+		}()
+	}
+
+	return nil
+}
+
+// RunGrantExpiry expires grants every interval until the ctx is done
+func (s Service) RunGrantExpiry(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultExpiryCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.ExpireGrants(ctx); err != nil {
+			s.logger.Error(fmt.Sprintf("error while expiring access request grants: %s", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// revokeGrant removes the role granted by the approval of the request. The role
+// is left as it is if it was granted again since the approval, it may also
+// already be removed by hand or by another replica.
+func (s Service) revokeGrant(ctx context.Context, accessRequest AccessRequest) error {
+	fetchedRelation, err := s.relationService.GetRelationByFields(ctx, roleRelation(accessRequest))
+	if err != nil {
+		if errors.Is(err, relation.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	if fetchedRelation.ID != accessRequest.RelationID || fetchedRelation.UpdatedAt.After(accessRequest.ReviewedAt) {
+		return nil
+	}
+
+	if err := s.relationService.DeleteV2(ctx, roleRelation(accessRequest)); err != nil && !errors.Is(err, relation.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// authorizeReview returns the pending request if the current user can review it
+func (s Service) authorizeReview(ctx context.Context, id string) (user.User, AccessRequest, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return user.User{}, AccessRequest{}, err
+	}
+
+	if !uuid.IsValid(id) {
+		return user.User{}, AccessRequest{}, ErrInvalidID
+	}
+	accessRequest, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return user.User{}, AccessRequest{}, err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, accessRequest.NamespaceID, accessRequest.ObjectID); err != nil {
+		return user.User{}, AccessRequest{}, err
+	}
+	if accessRequest.State != StatePending {
+		return user.User{}, AccessRequest{}, ErrNotPending
+	}
+	if accessRequest.UserID == currentUser.ID {
+		return user.User{}, AccessRequest{}, ErrSelfReview
+	}
+	return currentUser, accessRequest, nil
+}
+
+// validateTarget checks the role can be granted to users on the resource,
+// project or group. Custom roles of an organization can only be requested on
+// its own resources.
+func (s Service) validateTarget(ctx context.Context, accessRequest AccessRequest) error {
+	if !uuid.IsValid(accessRequest.ObjectID) {
+		return fmt.Errorf("%w: invalid object id", ErrInvalidDetail)
+	}
+
+	isResource := !namespace.IsSystemNamespaceID(accessRequest.NamespaceID)
+	if !isResource && accessRequest.NamespaceID != schema.ProjectNamespace && accessRequest.NamespaceID != schema.GroupNamespace {
+		return fmt.Errorf("%w: access can only be requested to resources, projects and groups", ErrInvalidDetail)
+	}
+
+	fetchedRole, err := s.roleService.Get(ctx, schema.GetRoleID(accessRequest.NamespaceID, accessRequest.RoleID))
+	if err != nil {
+		if errors.Is(err, role.ErrNotExist) {
+			return fmt.Errorf("%w: role %s doesn't exist in %s", ErrInvalidDetail, accessRequest.RoleID, accessRequest.NamespaceID)
+		}
+		return err
+	}
+	if !slices.Contains(fetchedRole.Types, schema.UserPrincipal) {
+		return fmt.Errorf("%w: role %s can't be granted to users", ErrInvalidDetail, accessRequest.RoleID)
+	}
+
+	if !isResource {
+		return nil
+	}
+	res, err := s.resourceService.Get(ctx, accessRequest.ObjectID)
+	if err != nil {
+		if errors.Is(err, resource.ErrNotExist) {
+			return fmt.Errorf("%w: resource doesn't exist", ErrInvalidDetail)
+		}
+		return err
+	}
+	if res.NamespaceID != accessRequest.NamespaceID || (fetchedRole.OrgID != "" && fetchedRole.OrgID != res.OrganizationID) {
+		return fmt.Errorf("%w: role %s can't be granted on the resource", ErrInvalidDetail, accessRequest.RoleID)
+	}
+	return nil
+}
+
+func (s Service) checkNotGranted(ctx context.Context, userID string, accessRequest AccessRequest) error {
+	rel := roleRelation(accessRequest)
+	rel.Subject.ID = userID
+	_, err := s.relationService.GetRelationByFields(ctx, rel)
+	switch {
+	case err == nil:
+		return ErrAlreadyGranted
+	case errors.Is(err, relation.ErrNotExist):
+		return nil
+	default:
+		return err
+	}
+}
+
+func (s Service) checkEditPermission(ctx context.Context, usr user.User, namespaceID, objectID string) error {
+	permission, err := s.relationService.CheckPermission(ctx, usr, namespace.Namespace{ID: namespaceID},
+		objectID, action.Action{ID: schema.EditPermission})
+	if err != nil {
+		return err
+	}
+	if !permission {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+func roleRelation(accessRequest AccessRequest) relation.RelationV2 {
+	return relation.RelationV2{
+		Object: relation.Object{
+			ID:          accessRequest.ObjectID,
+			NamespaceID: accessRequest.NamespaceID,
+		},
+		Subject: relation.Subject{
+			ID:        accessRequest.UserID,
+			Namespace: schema.UserPrincipal,
+			RoleID:    accessRequest.RoleID,
+		},
+	}
+}
@@ -0,0 +1,513 @@
+package accessrequest_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/accessrequest"
+	"github.com/goto/shield/core/accessrequest/mocks"
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/role"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	errorsPkg "github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	testLogger = logger.InitLogger(logger.Config{
+		Level:  "info",
+		Format: "json",
+	})
+	testRequester = user.User{
+		ID:    "2e1b1e9c-5d5f-4d4a-8c4e-3f2a1b0c9d04",
+		Email: "jane.doe@gotocompany.com",
+	}
+	testReviewer = user.User{
+		ID:    "9f256f86-31a3-11ec-8d3d-0242ac130003",
+		Email: "john.doe@gotocompany.com",
+	}
+	testAccessRequest = accessrequest.AccessRequest{
+		ID:          "0a1c2b7e-4b5e-4e1e-9c2a-1b6f5c6b7d01",
+		UserID:      testRequester.ID,
+		NamespaceID: schema.ProjectNamespace,
+		ObjectID:    "5fd3fbc4-4b1f-4b8d-a0c8-6c7d1f8a7c02",
+		RoleID:      schema.ViewerRole,
+		Reason:      "debugging an incident",
+		State:       accessrequest.StatePending,
+	}
+	testViewerRole = role.Role{
+		ID:          schema.GetRoleID(schema.ProjectNamespace, schema.ViewerRole),
+		Name:        schema.ViewerRole,
+		Types:       []string{schema.UserPrincipal, schema.GroupPrincipal},
+		NamespaceID: schema.ProjectNamespace,
+	}
+	testRelationID        = "c3a8f1e2-6b7d-4e9f-8a1b-2c3d4e5f6a07"
+	testRequesterRelation = relation.RelationV2{
+		Object: relation.Object{
+			ID:          testAccessRequest.ObjectID,
+			NamespaceID: testAccessRequest.NamespaceID,
+		},
+		Subject: relation.Subject{
+			ID:        testRequester.ID,
+			Namespace: schema.UserPrincipal,
+			RoleID:    testAccessRequest.RoleID,
+		},
+	}
+)
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	const resourceNamespace = "entropy/firehose"
+
+	tests := []struct {
+		name          string
+		accessRequest accessrequest.AccessRequest
+		setup         func(roleService *mocks.RoleService, resourceService *mocks.ResourceService, relationService *mocks.RelationService)
+		wantErr       error
+	}{
+		{
+			name: "should request a role of a project",
+			accessRequest: accessrequest.AccessRequest{
+				NamespaceID: testAccessRequest.NamespaceID,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      testAccessRequest.RoleID,
+				Reason:      " debugging an incident ",
+			},
+			setup: func(roleService *mocks.RoleService, resourceService *mocks.ResourceService, relationService *mocks.RelationService) {
+				roleService.EXPECT().Get(mock.Anything, testViewerRole.ID).Return(testViewerRole, nil)
+				relationService.EXPECT().GetRelationByFields(mock.Anything, testRequesterRelation).Return(relation.RelationV2{}, relation.ErrNotExist)
+			},
+		},
+		{
+			name: "should request a role of a resource",
+			accessRequest: accessrequest.AccessRequest{
+				NamespaceID: resourceNamespace,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      schema.ViewerRole,
+			},
+			setup: func(roleService *mocks.RoleService, resourceService *mocks.ResourceService, relationService *mocks.RelationService) {
+				roleService.EXPECT().Get(mock.Anything, schema.GetRoleID(resourceNamespace, schema.ViewerRole)).
+					Return(role.Role{Types: []string{schema.UserPrincipal}}, nil)
+				resourceService.EXPECT().Get(mock.Anything, testAccessRequest.ObjectID).
+					Return(resource.Resource{Idxa: testAccessRequest.ObjectID, NamespaceID: resourceNamespace}, nil)
+				relationService.EXPECT().GetRelationByFields(mock.Anything, mock.AnythingOfType("relation.RelationV2")).
+					Return(relation.RelationV2{}, relation.ErrNotExist)
+			},
+		},
+		{
+			name: "should not request a role of an organization",
+			accessRequest: accessrequest.AccessRequest{
+				NamespaceID: schema.OrganizationNamespace,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      schema.ViewerRole,
+			},
+			wantErr: accessrequest.ErrInvalidDetail,
+		},
+		{
+			name: "should not request a role which doesn't exist",
+			accessRequest: accessrequest.AccessRequest{
+				NamespaceID: testAccessRequest.NamespaceID,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      "superuser",
+			},
+			setup: func(roleService *mocks.RoleService, resourceService *mocks.ResourceService, relationService *mocks.RelationService) {
+				roleService.EXPECT().Get(mock.Anything, schema.GetRoleID(testAccessRequest.NamespaceID, "superuser")).Return(role.Role{}, role.ErrNotExist)
+			},
+			wantErr: accessrequest.ErrInvalidDetail,
+		},
+		{
+			name: "should not request a role which can't be granted to users",
+			accessRequest: accessrequest.AccessRequest{
+				NamespaceID: testAccessRequest.NamespaceID,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      testAccessRequest.RoleID,
+			},
+			setup: func(roleService *mocks.RoleService, resourceService *mocks.ResourceService, relationService *mocks.RelationService) {
+				roleService.EXPECT().Get(mock.Anything, testViewerRole.ID).Return(role.Role{Types: []string{schema.GroupPrincipal}}, nil)
+			},
+			wantErr: accessrequest.ErrInvalidDetail,
+		},
+		{
+			name: "should not request a custom role of another organization",
+			accessRequest: accessrequest.AccessRequest{
+				NamespaceID: resourceNamespace,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      "auditor",
+			},
+			setup: func(roleService *mocks.RoleService, resourceService *mocks.ResourceService, relationService *mocks.RelationService) {
+				roleService.EXPECT().Get(mock.Anything, schema.GetRoleID(resourceNamespace, "auditor")).
+					Return(role.Role{Types: []string{schema.UserPrincipal}, OrgID: "e8a4b2c1-7d6e-4f3a-9b8c-1d2e3f4a5b6c"}, nil)
+				resourceService.EXPECT().Get(mock.Anything, testAccessRequest.ObjectID).
+					Return(resource.Resource{Idxa: testAccessRequest.ObjectID, NamespaceID: resourceNamespace, OrganizationID: "b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e"}, nil)
+			},
+			wantErr: accessrequest.ErrInvalidDetail,
+		},
+		{
+			name: "should not request a role the user already has",
+			accessRequest: accessrequest.AccessRequest{
+				NamespaceID: testAccessRequest.NamespaceID,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      testAccessRequest.RoleID,
+			},
+			setup: func(roleService *mocks.RoleService, resourceService *mocks.ResourceService, relationService *mocks.RelationService) {
+				roleService.EXPECT().Get(mock.Anything, testViewerRole.ID).Return(testViewerRole, nil)
+				relationService.EXPECT().GetRelationByFields(mock.Anything, testRequesterRelation).Return(testRequesterRelation, nil)
+			},
+			wantErr: accessrequest.ErrAlreadyGranted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			roleService := &mocks.RoleService{}
+			resourceService := &mocks.ResourceService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testRequester, nil)
+			repository.EXPECT().Create(mock.Anything, mock.AnythingOfType("accessrequest.AccessRequest")).
+				RunAndReturn(func(ctx context.Context, ar accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+					ar.ID = testAccessRequest.ID
+					ar.State = accessrequest.StatePending
+					return ar, nil
+				}).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			if tt.setup != nil {
+				tt.setup(roleService, resourceService, relationService)
+			}
+			s := accessrequest.NewService(testLogger, repository, relationService, roleService, resourceService, userService, activityService)
+
+			got, err := s.Create(context.Background(), tt.accessRequest)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testRequester.ID, got.UserID)
+			assert.Equal(t, accessrequest.StatePending, got.State)
+			assert.Equal(t, strings.TrimSpace(tt.accessRequest.Reason), got.Reason)
+			roleService.AssertExpectations(t)
+			resourceService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should list the access requests of the current user without a target", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &mocks.Repository{}
+		userService := &mocks.UserService{}
+		userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testRequester, nil)
+		repository.EXPECT().List(mock.Anything, accessrequest.Filter{UserID: testRequester.ID, State: accessrequest.StatePending}).
+			Return([]accessrequest.AccessRequest{testAccessRequest}, nil)
+		s := accessrequest.NewService(testLogger, repository, &mocks.RelationService{}, &mocks.RoleService{}, &mocks.ResourceService{},
+			userService, &mocks.ActivityService{})
+
+		got, err := s.List(context.Background(), accessrequest.Filter{State: accessrequest.StatePending})
+		assert.NoError(t, err)
+		assert.Equal(t, []accessrequest.AccessRequest{testAccessRequest}, got)
+	})
+
+	t.Run("should not list the access requests of a target without its edit permission", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &mocks.Repository{}
+		relationService := &mocks.RelationService{}
+		userService := &mocks.UserService{}
+		userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testRequester, nil)
+		relationService.EXPECT().CheckPermission(mock.Anything, testRequester, namespace.Namespace{ID: testAccessRequest.NamespaceID},
+			testAccessRequest.ObjectID, action.Action{ID: schema.EditPermission}).Return(false, nil)
+		s := accessrequest.NewService(testLogger, repository, relationService, &mocks.RoleService{}, &mocks.ResourceService{},
+			userService, &mocks.ActivityService{})
+
+		_, err := s.List(context.Background(), accessrequest.Filter{NamespaceID: testAccessRequest.NamespaceID, ObjectID: testAccessRequest.ObjectID})
+		assert.ErrorIs(t, err, errorsPkg.ErrForbidden)
+		repository.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not list the access requests of an unknown state", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &mocks.Repository{}
+		userService := &mocks.UserService{}
+		userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testRequester, nil)
+		s := accessrequest.NewService(testLogger, repository, &mocks.RelationService{}, &mocks.RoleService{}, &mocks.ResourceService{},
+			userService, &mocks.ActivityService{})
+
+		_, err := s.List(context.Background(), accessrequest.Filter{State: "granted"})
+		assert.ErrorIs(t, err, accessrequest.ErrInvalidDetail)
+		repository.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_Approve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		currentUser   user.User
+		accessRequest accessrequest.AccessRequest
+		allowed       bool
+		granted       bool
+		expiresAt     time.Time
+		wantErr       error
+	}{
+		{
+			name:          "should grant the requested role",
+			currentUser:   testReviewer,
+			accessRequest: testAccessRequest,
+			allowed:       true,
+		},
+		{
+			name:          "should grant the requested role until the expiry",
+			currentUser:   testReviewer,
+			accessRequest: testAccessRequest,
+			allowed:       true,
+			expiresAt:     time.Now().Add(time.Hour),
+		},
+		{
+			name:          "should not approve without the edit permission of the target",
+			currentUser:   testReviewer,
+			accessRequest: testAccessRequest,
+			allowed:       false,
+			wantErr:       errorsPkg.ErrForbidden,
+		},
+		{
+			name:          "should not approve a request of the current user",
+			currentUser:   testRequester,
+			accessRequest: testAccessRequest,
+			allowed:       true,
+			wantErr:       accessrequest.ErrSelfReview,
+		},
+		{
+			name:        "should not approve a rejected request",
+			currentUser: testReviewer,
+			accessRequest: accessrequest.AccessRequest{
+				ID:          testAccessRequest.ID,
+				UserID:      testAccessRequest.UserID,
+				NamespaceID: testAccessRequest.NamespaceID,
+				ObjectID:    testAccessRequest.ObjectID,
+				RoleID:      testAccessRequest.RoleID,
+				State:       accessrequest.StateRejected,
+			},
+			allowed: true,
+			wantErr: accessrequest.ErrNotPending,
+		},
+		{
+			name:          "should not approve with an expiry in the past",
+			currentUser:   testReviewer,
+			accessRequest: testAccessRequest,
+			allowed:       true,
+			expiresAt:     time.Now().Add(-time.Minute),
+			wantErr:       accessrequest.ErrInvalidDetail,
+		},
+		{
+			name:          "should not approve a role the user already has",
+			currentUser:   testReviewer,
+			accessRequest: testAccessRequest,
+			allowed:       true,
+			granted:       true,
+			wantErr:       accessrequest.ErrAlreadyGranted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(tt.currentUser, nil)
+			repository.EXPECT().GetByID(mock.Anything, testAccessRequest.ID).Return(tt.accessRequest, nil)
+			relationService.EXPECT().CheckPermission(mock.Anything, tt.currentUser, namespace.Namespace{ID: testAccessRequest.NamespaceID},
+				testAccessRequest.ObjectID, action.Action{ID: schema.EditPermission}).Return(tt.allowed, nil)
+			if tt.granted {
+				relationService.EXPECT().GetRelationByFields(mock.Anything, testRequesterRelation).Return(testRequesterRelation, nil).Maybe()
+			} else {
+				relationService.EXPECT().GetRelationByFields(mock.Anything, testRequesterRelation).Return(relation.RelationV2{}, relation.ErrNotExist).Maybe()
+			}
+			createdRelation := testRequesterRelation
+			createdRelation.ID = testRelationID
+			relationService.EXPECT().Create(mock.Anything, testRequesterRelation).Return(createdRelation, nil).Maybe()
+			repository.EXPECT().Review(mock.Anything, accessrequest.Review{
+				ID:         testAccessRequest.ID,
+				State:      accessrequest.StateApproved,
+				ReviewedBy: testReviewer.ID,
+				Comment:    "approved for the incident",
+				ExpiresAt:  tt.expiresAt,
+				RelationID: testRelationID,
+			}).Return(accessrequest.AccessRequest{ID: testAccessRequest.ID, State: accessrequest.StateApproved, ExpiresAt: tt.expiresAt}, nil).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := accessrequest.NewService(testLogger, repository, relationService, &mocks.RoleService{}, &mocks.ResourceService{},
+				userService, activityService)
+
+			got, err := s.Approve(context.Background(), testAccessRequest.ID, tt.expiresAt, " approved for the incident ")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				relationService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				repository.AssertNotCalled(t, "Review", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, accessrequest.StateApproved, got.State)
+			relationService.AssertExpectations(t)
+			repository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Reject(t *testing.T) {
+	t.Parallel()
+
+	repository := &mocks.Repository{}
+	relationService := &mocks.RelationService{}
+	userService := &mocks.UserService{}
+	activityService := &mocks.ActivityService{}
+	userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testReviewer, nil)
+	repository.EXPECT().GetByID(mock.Anything, testAccessRequest.ID).Return(testAccessRequest, nil)
+	relationService.EXPECT().CheckPermission(mock.Anything, testReviewer, namespace.Namespace{ID: testAccessRequest.NamespaceID},
+		testAccessRequest.ObjectID, action.Action{ID: schema.EditPermission}).Return(true, nil)
+	repository.EXPECT().Review(mock.Anything, accessrequest.Review{
+		ID:         testAccessRequest.ID,
+		State:      accessrequest.StateRejected,
+		ReviewedBy: testReviewer.ID,
+		Comment:    "not needed",
+	}).Return(accessrequest.AccessRequest{ID: testAccessRequest.ID, State: accessrequest.StateRejected}, nil)
+	activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	s := accessrequest.NewService(testLogger, repository, relationService, &mocks.RoleService{}, &mocks.ResourceService{},
+		userService, activityService)
+
+	got, err := s.Reject(context.Background(), testAccessRequest.ID, "not needed")
+	assert.NoError(t, err)
+	assert.Equal(t, accessrequest.StateRejected, got.State)
+	relationService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_ExpireGrants(t *testing.T) {
+	t.Parallel()
+
+	reviewedAt := time.Now().Add(-time.Hour)
+	approvedAccessRequest := accessrequest.AccessRequest{
+		ID:          testAccessRequest.ID,
+		UserID:      testAccessRequest.UserID,
+		NamespaceID: testAccessRequest.NamespaceID,
+		ObjectID:    testAccessRequest.ObjectID,
+		RoleID:      testAccessRequest.RoleID,
+		State:       accessrequest.StateApproved,
+		ReviewedBy:  testReviewer.ID,
+		ReviewedAt:  reviewedAt,
+		ExpiresAt:   time.Now().Add(-time.Minute),
+		RelationID:  testRelationID,
+	}
+	failingAccessRequest := approvedAccessRequest
+	failingAccessRequest.ID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+	failingAccessRequest.UserID = testReviewer.ID
+	failingRelation := testRequesterRelation
+	failingRelation.Subject.ID = testReviewer.ID
+
+	grantedRelation := testRequesterRelation
+	grantedRelation.ID = testRelationID
+	grantedRelation.UpdatedAt = reviewedAt.Add(-time.Second)
+	grantedAgainRelation := grantedRelation
+	grantedAgainRelation.UpdatedAt = reviewedAt.Add(time.Minute)
+	recreatedRelation := grantedRelation
+	recreatedRelation.ID = "5b0f4c1d-2e3a-4b5c-9d6e-7f8a9b0c1d08"
+
+	tests := []struct {
+		name            string
+		fetchedRelation relation.RelationV2
+		getErr          error
+		revoked         bool
+		deleteErr       error
+		expireErr       error
+	}{
+		{
+			name:            "should revoke the role and expire the request",
+			fetchedRelation: grantedRelation,
+			revoked:         true,
+		},
+		{
+			name:   "should expire the request if the role is already removed",
+			getErr: relation.ErrNotExist,
+		},
+		{
+			name:            "should expire the request if the role is removed while revoking it",
+			fetchedRelation: grantedRelation,
+			revoked:         true,
+			deleteErr:       relation.ErrNotExist,
+		},
+		{
+			name:            "should keep the role if it is granted again since the approval",
+			fetchedRelation: grantedAgainRelation,
+		},
+		{
+			name:            "should keep the role if it is removed and granted again since the approval",
+			fetchedRelation: recreatedRelation,
+		},
+		{
+			name:            "should skip the request if it is expired by another replica",
+			fetchedRelation: grantedRelation,
+			revoked:         true,
+			expireErr:       accessrequest.ErrNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			activityService := &mocks.ActivityService{}
+			repository.EXPECT().ListExpired(mock.Anything, mock.AnythingOfType("time.Time")).
+				Return([]accessrequest.AccessRequest{failingAccessRequest, approvedAccessRequest}, nil)
+			// a request whose role can't be revoked is retried on the next run
+			failingGrantedRelation := failingRelation
+			failingGrantedRelation.ID = testRelationID
+			relationService.EXPECT().GetRelationByFields(mock.Anything, failingRelation).Return(failingGrantedRelation, nil)
+			relationService.EXPECT().DeleteV2(mock.Anything, failingRelation).Return(errors.New("spicedb is down"))
+			relationService.EXPECT().GetRelationByFields(mock.Anything, testRequesterRelation).Return(tt.fetchedRelation, tt.getErr)
+			if tt.revoked {
+				relationService.EXPECT().DeleteV2(mock.Anything, testRequesterRelation).Return(tt.deleteErr)
+			}
+			expiredAccessRequest := approvedAccessRequest
+			expiredAccessRequest.State = accessrequest.StateExpired
+			repository.EXPECT().Expire(mock.Anything, approvedAccessRequest.ID).Return(expiredAccessRequest, tt.expireErr)
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := accessrequest.NewService(testLogger, repository, relationService, &mocks.RoleService{}, &mocks.ResourceService{},
+				&mocks.UserService{}, activityService)
+
+			err := s.ExpireGrants(context.Background())
+			assert.NoError(t, err)
+			repository.AssertNotCalled(t, "Expire", mock.Anything, failingAccessRequest.ID)
+			if !tt.revoked {
+				relationService.AssertNotCalled(t, "DeleteV2", mock.Anything, testRequesterRelation)
+			}
+			relationService.AssertExpectations(t)
+			repository.AssertExpectations(t)
+		})
+	}
+}
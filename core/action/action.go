@@ -12,6 +12,7 @@ type Repository interface {
 	Upsert(ctx context.Context, action Action) (Action, error)
 	List(ctx context.Context) ([]Action, error)
 	Update(ctx context.Context, action Action) (Action, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type Action struct {
@@ -12,6 +12,7 @@ import (
 const (
 	auditKeyActionUpsert = "action.upsert"
 	auditKeyActionUpdate = "action.update"
+	auditKeyActionDelete = "action.delete"
 )
 
 type UserService interface {
@@ -95,3 +96,30 @@ func (s Service) Update(ctx context.Context, id string, action Action) (Action,
 
 	return updatedAction, nil
 }
+
+func (s Service) Delete(ctx context.Context, id string) error {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	deletedAction, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actionLogData := deletedAction.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyActionDelete, actor, actionLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return nil
+}
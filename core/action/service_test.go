@@ -195,3 +195,55 @@ func TestService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Delete(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		setup  func(ar *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService)
+		ErrStr string
+	}{
+		{
+			name: "should return error if context has no user information",
+			id:   mockAction.ID,
+			setup: func(ar *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService) {
+				us.EXPECT().FetchCurrentUser(mock.AnythingOfType("context.todoCtx")).Return(user.User{}, errors.New("some error"))
+			},
+			ErrStr: "some error",
+		},
+		{
+			name: "should return error if action does not exist",
+			id:   mockAction.ID,
+			setup: func(ar *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService) {
+				us.EXPECT().FetchCurrentUser(mock.AnythingOfType("context.todoCtx")).Return(mockUser, nil)
+				ar.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), mockAction.ID).Return(action.Action{}, action.ErrNotExist)
+			},
+			ErrStr: action.ErrNotExist.Error(),
+		},
+		{
+			name: "should not return error if succeed",
+			id:   mockAction.ID,
+			setup: func(ar *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService) {
+				us.EXPECT().FetchCurrentUser(mock.AnythingOfType("context.todoCtx")).Return(mockUser, nil)
+				ar.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), mockAction.ID).Return(mockAction, nil)
+				ar.EXPECT().Delete(mock.AnythingOfType("context.todoCtx"), mockAction.ID).Return(nil)
+				as.EXPECT().Log(mock.AnythingOfType("context.withoutCancelCtx"), mock.AnythingOfType("string"), mock.AnythingOfType("activity.Actor"), mock.AnythingOfType("action.LogData")).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ar := new(mocks.Repository)
+			as := new(mocks.ActivityService)
+			us := new(mocks.UserService)
+			tt.setup(ar, us, as)
+			s := action.NewService(log.NewNoop(), ar, us, as)
+			err := s.Delete(context.TODO(), tt.id)
+			if err != nil {
+				if err.Error() != tt.ErrStr {
+					t.Fatalf("got error %s, expected was %s", err.Error(), tt.ErrStr)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package activity
+
+import "context"
+
+type contextImpersonatorKey struct{}
+
+// SetContextWithImpersonator marks the requests of the ctx as run by the
+// impersonator on behalf of the current user
+func SetContextWithImpersonator(ctx context.Context, impersonator Actor) context.Context {
+	return context.WithValue(ctx, contextImpersonatorKey{}, impersonator)
+}
+
+func GetImpersonatorFromContext(ctx context.Context) (Actor, bool) {
+	impersonator, ok := ctx.Value(contextImpersonatorKey{}).(Actor)
+	return impersonator, ok
+}
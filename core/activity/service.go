@@ -35,6 +35,11 @@ func (s Service) Log(ctx context.Context, action string, actor Actor, data any)
 		"app_version": s.appConfig.Version,
 		"email":       actor.Email,
 	}
+	// the actor is the impersonated user, the impersonator ran the request
+	if impersonator, ok := GetImpersonatorFromContext(ctx); ok {
+		metadata["impersonator"] = impersonator.ID
+		metadata["impersonator_email"] = impersonator.Email
+	}
 
 	log := &audit.Log{
 		Timestamp: time.Now(),
@@ -0,0 +1,12 @@
+package invitation
+
+import "errors"
+
+var (
+	ErrNotExist      = errors.New("invitation doesn't exist")
+	ErrInvalidID     = errors.New("invitation id is invalid")
+	ErrInvalidDetail = errors.New("invalid invitation detail")
+	ErrNotPending    = errors.New("invitation is already accepted, revoked or expired")
+	ErrEmailMismatch = errors.New("invitation is for another email")
+	ErrLogActivity   = errors.New("error while logging activity")
+)
@@ -0,0 +1,78 @@
+package invitation
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	AuditEntity = "invitation"
+
+	// DefaultExpiry is used when no expiry is configured
+	DefaultExpiry = 7 * 24 * time.Hour
+)
+
+type Repository interface {
+	Create(ctx context.Context, invitation Invitation) (Invitation, error)
+	GetByID(ctx context.Context, id string) (Invitation, error)
+	List(ctx context.Context, flt Filter) ([]Invitation, error)
+	Revoke(ctx context.Context, id string) (Invitation, error)
+	Accept(ctx context.Context, id string) (Invitation, error)
+}
+
+// Notifier delivers invitations to the invited email, e.g. by mail
+type Notifier interface {
+	Notify(ctx context.Context, invitation Invitation) error
+}
+
+// Invitation invites the owner of an email to join an organization or a group
+// with a role, the user is created when the invitation is accepted if needed
+type Invitation struct {
+	ID    string
+	Email string
+	// NamespaceID is either the organization or the group namespace
+	NamespaceID string
+	ObjectID    string
+	RoleID      string
+	InvitedBy   string
+	ExpiresAt   time.Time
+	// AcceptedAt is zero for invitations which aren't accepted
+	AcceptedAt time.Time
+	// RevokedAt is zero for invitations which aren't revoked
+	RevokedAt time.Time
+	CreatedAt time.Time
+}
+
+// Filter lists the pending invitations of a target or of an email
+type Filter struct {
+	NamespaceID string
+	ObjectID    string
+	Email       string
+}
+
+// IsPending returns whether the invitation can still be accepted at the given time
+func (i Invitation) IsPending(at time.Time) bool {
+	return i.AcceptedAt.IsZero() && i.RevokedAt.IsZero() && at.Before(i.ExpiresAt)
+}
+
+type LogData struct {
+	Entity      string `mapstructure:"entity"`
+	ID          string `mapstructure:"id"`
+	Email       string `mapstructure:"email"`
+	NamespaceID string `mapstructure:"namespace_id"`
+	ObjectID    string `mapstructure:"object_id"`
+	RoleID      string `mapstructure:"role_id"`
+	ExpiresAt   string `mapstructure:"expires_at"`
+}
+
+func (invitation Invitation) ToLogData() LogData {
+	return LogData{
+		Entity:      AuditEntity,
+		ID:          invitation.ID,
+		Email:       invitation.Email,
+		NamespaceID: invitation.NamespaceID,
+		ObjectID:    invitation.ObjectID,
+		RoleID:      invitation.RoleID,
+		ExpiresAt:   invitation.ExpiresAt.Format(time.RFC3339),
+	}
+}
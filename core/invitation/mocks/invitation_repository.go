@@ -0,0 +1,324 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	invitation "github.com/goto/shield/core/invitation"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+type Repository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repository) EXPECT() *Repository_Expecter {
+	return &Repository_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: ctx, id
+func (_m *Repository) Accept(ctx context.Context, id string) (invitation.Invitation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (invitation.Invitation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) invitation.Invitation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(invitation.Invitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type Repository_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) Accept(ctx interface{}, id interface{}) *Repository_Accept_Call {
+	return &Repository_Accept_Call{Call: _e.mock.On("Accept", ctx, id)}
+}
+
+func (_c *Repository_Accept_Call) Run(run func(ctx context.Context, id string)) *Repository_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_Accept_Call) Return(_a0 invitation.Invitation, _a1 error) *Repository_Accept_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_Accept_Call) RunAndReturn(run func(context.Context, string) (invitation.Invitation, error)) *Repository_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *Repository) Create(ctx context.Context, _a1 invitation.Invitation) (invitation.Invitation, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Invitation) (invitation.Invitation, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Invitation) invitation.Invitation); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(invitation.Invitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, invitation.Invitation) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 invitation.Invitation
+func (_e *Repository_Expecter) Create(ctx interface{}, _a1 interface{}) *Repository_Create_Call {
+	return &Repository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *Repository_Create_Call) Run(run func(ctx context.Context, _a1 invitation.Invitation)) *Repository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(invitation.Invitation))
+	})
+	return _c
+}
+
+func (_c *Repository_Create_Call) Return(_a0 invitation.Invitation, _a1 error) *Repository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_Create_Call) RunAndReturn(run func(context.Context, invitation.Invitation) (invitation.Invitation, error)) *Repository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetByID(ctx context.Context, id string) (invitation.Invitation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (invitation.Invitation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) invitation.Invitation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(invitation.Invitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type Repository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) GetByID(ctx interface{}, id interface{}) *Repository_GetByID_Call {
+	return &Repository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *Repository_GetByID_Call) Run(run func(ctx context.Context, id string)) *Repository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) Return(_a0 invitation.Invitation, _a1 error) *Repository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) RunAndReturn(run func(context.Context, string) (invitation.Invitation, error)) *Repository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, flt
+func (_m *Repository) List(ctx context.Context, flt invitation.Filter) ([]invitation.Invitation, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Filter) ([]invitation.Invitation, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Filter) []invitation.Invitation); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]invitation.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, invitation.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type Repository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt invitation.Filter
+func (_e *Repository_Expecter) List(ctx interface{}, flt interface{}) *Repository_List_Call {
+	return &Repository_List_Call{Call: _e.mock.On("List", ctx, flt)}
+}
+
+func (_c *Repository_List_Call) Run(run func(ctx context.Context, flt invitation.Filter)) *Repository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(invitation.Filter))
+	})
+	return _c
+}
+
+func (_c *Repository_List_Call) Return(_a0 []invitation.Invitation, _a1 error) *Repository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_List_Call) RunAndReturn(run func(context.Context, invitation.Filter) ([]invitation.Invitation, error)) *Repository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, id
+func (_m *Repository) Revoke(ctx context.Context, id string) (invitation.Invitation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (invitation.Invitation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) invitation.Invitation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(invitation.Invitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type Repository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) Revoke(ctx interface{}, id interface{}) *Repository_Revoke_Call {
+	return &Repository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *Repository_Revoke_Call) Run(run func(ctx context.Context, id string)) *Repository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_Revoke_Call) Return(_a0 invitation.Invitation, _a1 error) *Repository_Revoke_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_Revoke_Call) RunAndReturn(run func(context.Context, string) (invitation.Invitation, error)) *Repository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,161 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	action "github.com/goto/shield/core/action"
+
+	mock "github.com/stretchr/testify/mock"
+
+	namespace "github.com/goto/shield/core/namespace"
+
+	relation "github.com/goto/shield/core/relation"
+
+	user "github.com/goto/shield/core/user"
+)
+
+// RelationService is an autogenerated mock type for the RelationService type
+type RelationService struct {
+	mock.Mock
+}
+
+type RelationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RelationService) EXPECT() *RelationService_Expecter {
+	return &RelationService_Expecter{mock: &_m.Mock}
+}
+
+// CheckPermission provides a mock function with given fields: ctx, usr, resourceNS, resourceIdxa, _a4
+func (_m *RelationService) CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, _a4 action.Action) (bool, error) {
+	ret := _m.Called(ctx, usr, resourceNS, resourceIdxa, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPermission")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.User, namespace.Namespace, string, action.Action) (bool, error)); ok {
+		return rf(ctx, usr, resourceNS, resourceIdxa, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, user.User, namespace.Namespace, string, action.Action) bool); ok {
+		r0 = rf(ctx, usr, resourceNS, resourceIdxa, _a4)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, user.User, namespace.Namespace, string, action.Action) error); ok {
+		r1 = rf(ctx, usr, resourceNS, resourceIdxa, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RelationService_CheckPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPermission'
+type RelationService_CheckPermission_Call struct {
+	*mock.Call
+}
+
+// CheckPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usr user.User
+//   - resourceNS namespace.Namespace
+//   - resourceIdxa string
+//   - _a4 action.Action
+func (_e *RelationService_Expecter) CheckPermission(ctx interface{}, usr interface{}, resourceNS interface{}, resourceIdxa interface{}, _a4 interface{}) *RelationService_CheckPermission_Call {
+	return &RelationService_CheckPermission_Call{Call: _e.mock.On("CheckPermission", ctx, usr, resourceNS, resourceIdxa, _a4)}
+}
+
+func (_c *RelationService_CheckPermission_Call) Run(run func(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, _a4 action.Action)) *RelationService_CheckPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.User), args[2].(namespace.Namespace), args[3].(string), args[4].(action.Action))
+	})
+	return _c
+}
+
+func (_c *RelationService_CheckPermission_Call) Return(_a0 bool, _a1 error) *RelationService_CheckPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RelationService_CheckPermission_Call) RunAndReturn(run func(context.Context, user.User, namespace.Namespace, string, action.Action) (bool, error)) *RelationService_CheckPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, rel
+func (_m *RelationService) Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error) {
+	ret := _m.Called(ctx, rel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 relation.RelationV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, relation.RelationV2) (relation.RelationV2, error)); ok {
+		return rf(ctx, rel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, relation.RelationV2) relation.RelationV2); ok {
+		r0 = rf(ctx, rel)
+	} else {
+		r0 = ret.Get(0).(relation.RelationV2)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, relation.RelationV2) error); ok {
+		r1 = rf(ctx, rel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RelationService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type RelationService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rel relation.RelationV2
+func (_e *RelationService_Expecter) Create(ctx interface{}, rel interface{}) *RelationService_Create_Call {
+	return &RelationService_Create_Call{Call: _e.mock.On("Create", ctx, rel)}
+}
+
+func (_c *RelationService_Create_Call) Run(run func(ctx context.Context, rel relation.RelationV2)) *RelationService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(relation.RelationV2))
+	})
+	return _c
+}
+
+func (_c *RelationService_Create_Call) Return(_a0 relation.RelationV2, _a1 error) *RelationService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RelationService_Create_Call) RunAndReturn(run func(context.Context, relation.RelationV2) (relation.RelationV2, error)) *RelationService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRelationService creates a new instance of RelationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRelationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RelationService {
+	mock := &RelationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
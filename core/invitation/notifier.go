@@ -0,0 +1,25 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+)
+
+// LogNotifier only logs invitations, it is used until a notifier which
+// delivers them to the invited email is configured
+type LogNotifier struct {
+	logger log.Logger
+}
+
+func NewLogNotifier(logger log.Logger) *LogNotifier {
+	return &LogNotifier{
+		logger: logger,
+	}
+}
+
+func (n LogNotifier) Notify(ctx context.Context, invitation Invitation) error {
+	n.logger.Info("invitation created", "id", invitation.ID, "email", invitation.Email,
+		"namespace_id", invitation.NamespaceID, "object_id", invitation.ObjectID, "role_id", invitation.RoleID)
+	return nil
+}
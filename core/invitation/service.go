@@ -0,0 +1,265 @@
+package invitation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/uuid"
+)
+
+const (
+	auditKeyInvitationCreate = "invitation.create"
+	auditKeyInvitationRevoke = "invitation.revoke"
+	auditKeyInvitationAccept = "invitation.accept"
+)
+
+type UserService interface {
+	FetchCurrentUser(ctx context.Context) (user.User, error)
+	GetByEmail(ctx context.Context, email string) (user.User, error)
+	Create(ctx context.Context, user user.User) (user.User, error)
+}
+
+type RelationService interface {
+	Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
+	CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
+}
+
+type ActivityService interface {
+	Log(ctx context.Context, action string, actor activity.Actor, data any) error
+}
+
+type Service struct {
+	logger          log.Logger
+	repository      Repository
+	relationService RelationService
+	userService     UserService
+	activityService ActivityService
+	notifier        Notifier
+	expiry          time.Duration
+}
+
+func NewService(logger log.Logger, repository Repository, relationService RelationService, userService UserService,
+	activityService ActivityService, notifier Notifier, expiry time.Duration,
+) *Service {
+	if expiry <= 0 {
+		expiry = DefaultExpiry
+	}
+	return &Service{
+		logger:          logger,
+		repository:      repository,
+		relationService: relationService,
+		userService:     userService,
+		activityService: activityService,
+		notifier:        notifier,
+		expiry:          expiry,
+	}
+}
+
+// Create invites the email to the organization or group with the role, the
+// current user has to be able to edit the organization or group
+func (s Service) Create(ctx context.Context, invitation Invitation) (Invitation, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	invitation.Email = strings.TrimSpace(invitation.Email)
+	if invitation.Email == "" {
+		return Invitation{}, fmt.Errorf("%w: email is required", ErrInvalidDetail)
+	}
+	if err := validateTarget(invitation.NamespaceID, invitation.ObjectID); err != nil {
+		return Invitation{}, err
+	}
+	if !slices.Contains(schema.PreDefinedSystemNamespaceConfig[invitation.NamespaceID].Roles[invitation.RoleID], schema.UserPrincipal) {
+		return Invitation{}, fmt.Errorf("%w: role %s can't be granted to users of %s", ErrInvalidDetail, invitation.RoleID, invitation.NamespaceID)
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, invitation.NamespaceID, invitation.ObjectID); err != nil {
+		return Invitation{}, err
+	}
+
+	invitation.InvitedBy = currentUser.ID
+	invitation.ExpiresAt = time.Now().Add(s.expiry)
+	newInvitation, err := s.repository.Create(ctx, invitation)
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	// the invitation can be listed and shared by other means if it isn't delivered
+	if err := s.notifier.Notify(ctx, newInvitation); err != nil {
+		s.logger.Error(fmt.Sprintf("error while notifying invitation %s: %s", newInvitation.ID, err.Error()))
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		invitationLogData := newInvitation.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyInvitationCreate, actor, invitationLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return newInvitation, nil
+}
+
+// List returns the pending invitations of the organization or group of the
+// filter, which the current user has to be able to edit. Without a target the
+// pending invitations of the current user are returned.
+func (s Service) List(ctx context.Context, flt Filter) ([]Invitation, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return []Invitation{}, err
+	}
+
+	if flt.ObjectID == "" {
+		return s.repository.List(ctx, Filter{Email: currentUser.Email})
+	}
+
+	if err := validateTarget(flt.NamespaceID, flt.ObjectID); err != nil {
+		return []Invitation{}, err
+	}
+	if err := s.checkEditPermission(ctx, currentUser, flt.NamespaceID, flt.ObjectID); err != nil {
+		return []Invitation{}, err
+	}
+	return s.repository.List(ctx, Filter{NamespaceID: flt.NamespaceID, ObjectID: flt.ObjectID})
+}
+
+// Revoke revokes a pending invitation, the current user has to be able to
+// edit the organization or group of the invitation
+func (s Service) Revoke(ctx context.Context, id string) error {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := s.get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, invitation.NamespaceID, invitation.ObjectID); err != nil {
+		return err
+	}
+	if !invitation.IsPending(time.Now()) {
+		return ErrNotPending
+	}
+
+	revokedInvitation, err := s.repository.Revoke(ctx, invitation.ID)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		invitationLogData := revokedInvitation.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyInvitationRevoke, actor, invitationLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return nil
+}
+
+// Accept accepts a pending invitation of the current email, the user is
+// created if it doesn't exist yet and is granted the role of the invitation
+func (s Service) Accept(ctx context.Context, id string) (Invitation, error) {
+	email, ok := user.GetEmailFromContext(ctx)
+	if !ok || strings.TrimSpace(email) == "" {
+		return Invitation{}, user.ErrMissingEmail
+	}
+
+	invitation, err := s.get(ctx, id)
+	if err != nil {
+		return Invitation{}, err
+	}
+	if !strings.EqualFold(invitation.Email, email) {
+		return Invitation{}, ErrEmailMismatch
+	}
+	if !invitation.IsPending(time.Now()) {
+		return Invitation{}, ErrNotPending
+	}
+
+	currentUser, err := s.userService.GetByEmail(ctx, email)
+	if err != nil {
+		if !errors.Is(err, user.ErrNotExist) {
+			return Invitation{}, err
+		}
+		if currentUser, err = s.userService.Create(ctx, user.User{Name: email, Email: email}); err != nil {
+			return Invitation{}, err
+		}
+	}
+
+	// relations are upserted, so the role is granted before the invitation is
+	// marked accepted and a failed acceptance can be retried
+	if _, err := s.relationService.Create(ctx, relation.RelationV2{
+		Object: relation.Object{
+			ID:          invitation.ObjectID,
+			NamespaceID: invitation.NamespaceID,
+		},
+		Subject: relation.Subject{
+			ID:        currentUser.ID,
+			Namespace: schema.UserPrincipal,
+			RoleID:    invitation.RoleID,
+		},
+	}); err != nil {
+		return Invitation{}, err
+	}
+
+	acceptedInvitation, err := s.repository.Accept(ctx, invitation.ID)
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		invitationLogData := acceptedInvitation.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyInvitationAccept, actor, invitationLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return acceptedInvitation, nil
+}
+
+func (s Service) get(ctx context.Context, id string) (Invitation, error) {
+	if !uuid.IsValid(id) {
+		return Invitation{}, ErrInvalidID
+	}
+	return s.repository.GetByID(ctx, id)
+}
+
+func (s Service) checkEditPermission(ctx context.Context, usr user.User, namespaceID, objectID string) error {
+	permission, err := s.relationService.CheckPermission(ctx, usr, namespace.Namespace{ID: namespaceID},
+		objectID, action.Action{ID: schema.EditPermission})
+	if err != nil {
+		return err
+	}
+	if !permission {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+// validateTarget checks the invitation is to an organization or a group
+func validateTarget(namespaceID, objectID string) error {
+	if namespaceID != schema.OrganizationNamespace && namespaceID != schema.GroupNamespace {
+		return fmt.Errorf("%w: invitations are only to organizations and groups", ErrInvalidDetail)
+	}
+	if !uuid.IsValid(objectID) {
+		return fmt.Errorf("%w: invalid %s id", ErrInvalidDetail, namespaceID)
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package invitation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/invitation"
+	"github.com/goto/shield/core/invitation/mocks"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	errorsPkg "github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	testLogger = logger.InitLogger(logger.Config{
+		Level:  "info",
+		Format: "json",
+	})
+	testUser = user.User{
+		ID:    "9f256f86-31a3-11ec-8d3d-0242ac130003",
+		Email: "john.doe@gotocompany.com",
+	}
+	testInvitee = user.User{
+		ID:    "2e1b1e9c-5d5f-4d4a-8c4e-3f2a1b0c9d04",
+		Email: "jane.doe@gotocompany.com",
+	}
+	testInvitation = invitation.Invitation{
+		ID:          "0a1c2b7e-4b5e-4e1e-9c2a-1b6f5c6b7d01",
+		Email:       testInvitee.Email,
+		NamespaceID: schema.GroupNamespace,
+		ObjectID:    "5fd3fbc4-4b1f-4b8d-a0c8-6c7d1f8a7c02",
+		RoleID:      schema.MemberRole,
+		InvitedBy:   testUser.ID,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+)
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		invitation invitation.Invitation
+		allowed    bool
+		notifyErr  error
+		wantErr    error
+	}{
+		{
+			name: "should create an invitation expiring after the configured expiry",
+			invitation: invitation.Invitation{
+				Email:       testInvitee.Email,
+				NamespaceID: testInvitation.NamespaceID,
+				ObjectID:    testInvitation.ObjectID,
+				RoleID:      schema.MemberRole,
+			},
+			allowed: true,
+		},
+		{
+			name: "should create an invitation even if it can't be delivered",
+			invitation: invitation.Invitation{
+				Email:       testInvitee.Email,
+				NamespaceID: testInvitation.NamespaceID,
+				ObjectID:    testInvitation.ObjectID,
+				RoleID:      schema.MemberRole,
+			},
+			allowed:   true,
+			notifyErr: errors.New("smtp is down"),
+		},
+		{
+			name: "should not create an invitation without the edit permission of the target",
+			invitation: invitation.Invitation{
+				Email:       testInvitee.Email,
+				NamespaceID: testInvitation.NamespaceID,
+				ObjectID:    testInvitation.ObjectID,
+				RoleID:      schema.MemberRole,
+			},
+			allowed: false,
+			wantErr: errorsPkg.ErrForbidden,
+		},
+		{
+			name: "should not create an invitation with a role of another namespace",
+			invitation: invitation.Invitation{
+				Email:       testInvitee.Email,
+				NamespaceID: testInvitation.NamespaceID,
+				ObjectID:    testInvitation.ObjectID,
+				RoleID:      schema.OwnerRole,
+			},
+			allowed: true,
+			wantErr: invitation.ErrInvalidDetail,
+		},
+		{
+			name: "should not create an invitation to a project",
+			invitation: invitation.Invitation{
+				Email:       testInvitee.Email,
+				NamespaceID: schema.ProjectNamespace,
+				ObjectID:    testInvitation.ObjectID,
+				RoleID:      schema.OwnerRole,
+			},
+			allowed: true,
+			wantErr: invitation.ErrInvalidDetail,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			notifier := &mocks.Notifier{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			relationService.EXPECT().CheckPermission(mock.Anything, testUser, namespace.Namespace{ID: tt.invitation.NamespaceID},
+				tt.invitation.ObjectID, action.Action{ID: schema.EditPermission}).Return(tt.allowed, nil).Maybe()
+			repository.EXPECT().Create(mock.Anything, mock.AnythingOfType("invitation.Invitation")).
+				RunAndReturn(func(ctx context.Context, inv invitation.Invitation) (invitation.Invitation, error) {
+					inv.ID = testInvitation.ID
+					return inv, nil
+				}).Maybe()
+			notifier.EXPECT().Notify(mock.Anything, mock.AnythingOfType("invitation.Invitation")).Return(tt.notifyErr).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := invitation.NewService(testLogger, repository, relationService, userService, activityService, notifier, 2*time.Hour)
+
+			got, err := s.Create(context.Background(), tt.invitation)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testUser.ID, got.InvitedBy)
+			assert.WithinDuration(t, time.Now().Add(2*time.Hour), got.ExpiresAt, time.Minute)
+			notifier.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should list the invitations of the current user without a target", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &mocks.Repository{}
+		userService := &mocks.UserService{}
+		userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testInvitee, nil)
+		repository.EXPECT().List(mock.Anything, invitation.Filter{Email: testInvitee.Email}).Return([]invitation.Invitation{testInvitation}, nil)
+		s := invitation.NewService(testLogger, repository, &mocks.RelationService{}, userService, &mocks.ActivityService{}, &mocks.Notifier{}, 0)
+
+		got, err := s.List(context.Background(), invitation.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, []invitation.Invitation{testInvitation}, got)
+	})
+
+	t.Run("should not list the invitations of a target without its edit permission", func(t *testing.T) {
+		t.Parallel()
+
+		repository := &mocks.Repository{}
+		relationService := &mocks.RelationService{}
+		userService := &mocks.UserService{}
+		userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testInvitee, nil)
+		relationService.EXPECT().CheckPermission(mock.Anything, testInvitee, namespace.Namespace{ID: testInvitation.NamespaceID},
+			testInvitation.ObjectID, action.Action{ID: schema.EditPermission}).Return(false, nil)
+		s := invitation.NewService(testLogger, repository, relationService, userService, &mocks.ActivityService{}, &mocks.Notifier{}, 0)
+
+		_, err := s.List(context.Background(), invitation.Filter{NamespaceID: testInvitation.NamespaceID, ObjectID: testInvitation.ObjectID})
+		assert.ErrorIs(t, err, errorsPkg.ErrForbidden)
+	})
+}
+
+func TestService_Revoke(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		invitation invitation.Invitation
+		allowed    bool
+		wantErr    error
+	}{
+		{
+			name:       "should revoke a pending invitation",
+			invitation: testInvitation,
+			allowed:    true,
+		},
+		{
+			name:       "should not revoke an invitation without the edit permission of the target",
+			invitation: testInvitation,
+			allowed:    false,
+			wantErr:    errorsPkg.ErrForbidden,
+		},
+		{
+			name: "should not revoke an accepted invitation",
+			invitation: invitation.Invitation{
+				ID:          testInvitation.ID,
+				NamespaceID: testInvitation.NamespaceID,
+				ObjectID:    testInvitation.ObjectID,
+				ExpiresAt:   testInvitation.ExpiresAt,
+				AcceptedAt:  time.Now(),
+			},
+			allowed: true,
+			wantErr: invitation.ErrNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			repository.EXPECT().GetByID(mock.Anything, testInvitation.ID).Return(tt.invitation, nil)
+			relationService.EXPECT().CheckPermission(mock.Anything, testUser, namespace.Namespace{ID: testInvitation.NamespaceID},
+				testInvitation.ObjectID, action.Action{ID: schema.EditPermission}).Return(tt.allowed, nil)
+			repository.EXPECT().Revoke(mock.Anything, testInvitation.ID).Return(tt.invitation, nil).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := invitation.NewService(testLogger, repository, relationService, userService, activityService, &mocks.Notifier{}, 0)
+
+			err := s.Revoke(context.Background(), testInvitation.ID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repository.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			repository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Accept(t *testing.T) {
+	t.Parallel()
+
+	inviteeRelation := relation.RelationV2{
+		Object: relation.Object{
+			ID:          testInvitation.ObjectID,
+			NamespaceID: testInvitation.NamespaceID,
+		},
+		Subject: relation.Subject{
+			ID:        testInvitee.ID,
+			Namespace: schema.UserPrincipal,
+			RoleID:    testInvitation.RoleID,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		email      string
+		invitation invitation.Invitation
+		setup      func(userService *mocks.UserService, relationService *mocks.RelationService)
+		wantErr    error
+	}{
+		{
+			name:       "should grant the role of the invitation to an existing user",
+			email:      testInvitee.Email,
+			invitation: testInvitation,
+			setup: func(userService *mocks.UserService, relationService *mocks.RelationService) {
+				userService.EXPECT().GetByEmail(mock.Anything, testInvitee.Email).Return(testInvitee, nil)
+				relationService.EXPECT().Create(mock.Anything, inviteeRelation).Return(inviteeRelation, nil)
+			},
+		},
+		{
+			name:       "should create the user of the invitation if it doesn't exist",
+			email:      testInvitee.Email,
+			invitation: testInvitation,
+			setup: func(userService *mocks.UserService, relationService *mocks.RelationService) {
+				userService.EXPECT().GetByEmail(mock.Anything, testInvitee.Email).Return(user.User{}, user.ErrNotExist)
+				userService.EXPECT().Create(mock.Anything, user.User{Name: testInvitee.Email, Email: testInvitee.Email}).Return(testInvitee, nil)
+				relationService.EXPECT().Create(mock.Anything, inviteeRelation).Return(inviteeRelation, nil)
+			},
+		},
+		{
+			name:       "should not accept an invitation of another email",
+			email:      testUser.Email,
+			invitation: testInvitation,
+			wantErr:    invitation.ErrEmailMismatch,
+		},
+		{
+			name:  "should not accept an expired invitation",
+			email: testInvitee.Email,
+			invitation: invitation.Invitation{
+				ID:        testInvitation.ID,
+				Email:     testInvitee.Email,
+				ExpiresAt: time.Now().Add(-time.Minute),
+			},
+			wantErr: invitation.ErrNotPending,
+		},
+		{
+			name:       "should not accept an invitation without an email",
+			invitation: testInvitation,
+			wantErr:    user.ErrMissingEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			repository.EXPECT().GetByID(mock.Anything, testInvitation.ID).Return(tt.invitation, nil).Maybe()
+			repository.EXPECT().Accept(mock.Anything, testInvitation.ID).Return(tt.invitation, nil).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			if tt.setup != nil {
+				tt.setup(userService, relationService)
+			}
+			s := invitation.NewService(testLogger, repository, relationService, userService, activityService, &mocks.Notifier{}, 0)
+
+			ctx := context.Background()
+			if tt.email != "" {
+				ctx = user.SetContextWithEmail(ctx, tt.email)
+			}
+			_, err := s.Accept(ctx, testInvitation.ID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repository.AssertNotCalled(t, "Accept", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			userService.AssertExpectations(t)
+			relationService.AssertExpectations(t)
+			repository.AssertExpectations(t)
+		})
+	}
+}
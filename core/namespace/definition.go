@@ -1,6 +1,6 @@
 package namespace
 
-var systemIdsDefinition = []string{DefinitionTeam.ID, DefinitionUser.ID, DefinitionOrg.ID, DefinitionProject.ID}
+var systemIdsDefinition = []string{DefinitionTeam.ID, DefinitionUser.ID, DefinitionServiceAccount.ID, DefinitionPlatform.ID, DefinitionOrg.ID, DefinitionProject.ID}
 
 var DefinitionOrg = Namespace{
 	ID:   "shield/organization",
@@ -21,3 +21,13 @@ var DefinitionUser = Namespace{
 	ID:   "shield/user",
 	Name: "User",
 }
+
+var DefinitionServiceAccount = Namespace{
+	ID:   "shield/serviceaccount",
+	Name: "Service Account",
+}
+
+var DefinitionPlatform = Namespace{
+	ID:   "shield/platform",
+	Name: "Platform",
+}
@@ -9,4 +9,6 @@ var (
 	ErrConflict      = errors.New("org already exist")
 	ErrInvalidDetail = errors.New("invalid org detail")
 	ErrLogActivity   = errors.New("error while logging activity")
+	ErrNotAdmin      = errors.New("user is not an admin of the org")
+	ErrLastAdmin     = errors.New("cannot remove the last admin of the org")
 )
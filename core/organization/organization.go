@@ -44,3 +44,21 @@ func (organization Organization) ToLogData() LogData {
 		Slug:   organization.Slug,
 	}
 }
+
+type AdminLogData struct {
+	Entity  string `mapstructure:"entity"`
+	ID      string `mapstructure:"id"`
+	Name    string `mapstructure:"name"`
+	Slug    string `mapstructure:"slug"`
+	AdminID string `mapstructure:"admin_id"`
+}
+
+func (organization Organization) ToAdminLogData(adminID string) AdminLogData {
+	return AdminLogData{
+		Entity:  AuditEntity,
+		ID:      organization.ID,
+		Name:    organization.Name,
+		Slug:    organization.Slug,
+		AdminID: adminID,
+	}
+}
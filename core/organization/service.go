@@ -9,19 +9,24 @@ import (
 	"github.com/goto/shield/core/activity"
 	"github.com/goto/shield/core/namespace"
 	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
 	"github.com/goto/shield/pkg/uuid"
 )
 
 const (
-	auditKeyOrganizationCreate = "organization.create"
-	auditKeyOrganizationUpdate = "organization.update"
+	auditKeyOrganizationCreate      = "organization.create"
+	auditKeyOrganizationUpdate      = "organization.update"
+	auditKeyOrganizationAdminAdd    = "organization.admin.add"
+	auditKeyOrganizationAdminRemove = "organization.admin.remove"
 )
 
 type RelationService interface {
 	Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
 	Delete(ctx context.Context, rel relation.Relation) error
+	DeleteV2(ctx context.Context, rel relation.RelationV2) error
 	CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
 }
 
@@ -31,6 +36,11 @@ type UserService interface {
 	GetByIDs(ctx context.Context, userIDs []string) ([]user.User, error)
 }
 
+type RoleService interface {
+	UpsertOrganizationRole(ctx context.Context, toUpsert role.Role) (role.Role, error)
+	DeleteOrganizationRole(ctx context.Context, orgID, roleID string) error
+}
+
 type ActivityService interface {
 	Log(ctx context.Context, action string, actor activity.Actor, data any) error
 }
@@ -40,15 +50,17 @@ type Service struct {
 	repository      Repository
 	relationService RelationService
 	userService     UserService
+	roleService     RoleService
 	activityService ActivityService
 }
 
-func NewService(logger log.Logger, repository Repository, relationService RelationService, userService UserService, activityService ActivityService) *Service {
+func NewService(logger log.Logger, repository Repository, relationService RelationService, userService UserService, roleService RoleService, activityService ActivityService) *Service {
 	return &Service{
 		logger:          logger,
 		repository:      repository,
 		relationService: relationService,
 		userService:     userService,
+		roleService:     roleService,
 		activityService: activityService,
 	}
 }
@@ -138,21 +150,166 @@ func (s Service) ListAdmins(ctx context.Context, idOrSlug string) ([]user.User,
 	return s.repository.ListAdminsByOrgID(ctx, org.ID)
 }
 
+func (s Service) AddAdmins(ctx context.Context, idOrSlug string, userIds []string) ([]user.User, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	org, err := s.Get(ctx, idOrSlug)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, org); err != nil {
+		return []user.User{}, err
+	}
+
+	users, err := s.userService.GetByIDs(ctx, userIds)
+	if err != nil {
+		return []user.User{}, err
+	}
+	if len(users) != len(userIds) {
+		return []user.User{}, user.ErrNotExist
+	}
+
+	for _, usr := range users {
+		if err := s.addAdminToOrg(ctx, usr, org); err != nil {
+			return []user.User{}, err
+		}
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		for _, usr := range users {
+			if err := s.activityService.Log(ctx, auditKeyOrganizationAdminAdd, actor, org.ToAdminLogData(usr.ID)); err != nil {
+				s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+			}
+		}
+	}()
+
+	return s.repository.ListAdminsByOrgID(ctx, org.ID)
+}
+
+func (s Service) RemoveAdmin(ctx context.Context, idOrSlug string, userId string) ([]user.User, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	org, err := s.Get(ctx, idOrSlug)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, org); err != nil {
+		return []user.User{}, err
+	}
+
+	admins, err := s.repository.ListAdminsByOrgID(ctx, org.ID)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	isAdmin := false
+	for _, admin := range admins {
+		if admin.ID == userId {
+			isAdmin = true
+			break
+		}
+	}
+	if !isAdmin {
+		return []user.User{}, ErrNotAdmin
+	}
+	if len(admins) == 1 {
+		return []user.User{}, ErrLastAdmin
+	}
+
+	if err := s.relationService.DeleteV2(ctx, adminRelation(org, userId)); err != nil {
+		return []user.User{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyOrganizationAdminRemove, actor, org.ToAdminLogData(userId)); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return s.repository.ListAdminsByOrgID(ctx, org.ID)
+}
+
+// CreateRole creates or updates a custom role of the org composed of
+// permissions of a namespace
+func (s Service) CreateRole(ctx context.Context, idOrSlug string, toCreate role.Role) (role.Role, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return role.Role{}, err
+	}
+
+	org, err := s.Get(ctx, idOrSlug)
+	if err != nil {
+		return role.Role{}, err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, org); err != nil {
+		return role.Role{}, err
+	}
+
+	toCreate.OrgID = org.ID
+	return s.roleService.UpsertOrganizationRole(ctx, toCreate)
+}
+
+// DeleteRole deletes a custom role of the org along with its relations
+func (s Service) DeleteRole(ctx context.Context, idOrSlug string, roleID string) error {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	org, err := s.Get(ctx, idOrSlug)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, org); err != nil {
+		return err
+	}
+
+	return s.roleService.DeleteOrganizationRole(ctx, org.ID, roleID)
+}
+
+func (s Service) checkEditPermission(ctx context.Context, usr user.User, org Organization) error {
+	permission, err := s.relationService.CheckPermission(ctx, usr, namespace.Namespace{ID: schema.OrganizationNamespace},
+		org.ID, action.Action{ID: schema.EditPermission})
+	if err != nil {
+		return err
+	}
+	if !permission {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
 func (s Service) addAdminToOrg(ctx context.Context, user user.User, org Organization) error {
-	rel := relation.RelationV2{
+	if _, err := s.relationService.Create(ctx, adminRelation(org, user.ID)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func adminRelation(org Organization, userID string) relation.RelationV2 {
+	return relation.RelationV2{
 		Object: relation.Object{
 			ID:          org.ID,
 			NamespaceID: schema.OrganizationNamespace,
 		},
 		Subject: relation.Subject{
-			ID:        user.ID,
+			ID:        userID,
 			Namespace: schema.UserPrincipal,
 			RoleID:    schema.OwnerRole,
 		},
 	}
-
-	if _, err := s.relationService.Create(ctx, rel); err != nil {
-		return err
-	}
-	return nil
 }
@@ -0,0 +1,10 @@
+package platform
+
+import "errors"
+
+var (
+	ErrNotAdmin         = errors.New("user is not a platform admin")
+	ErrNotImpersonator  = errors.New("user is not an impersonator")
+	ErrImpersonateAdmin = errors.New("platform admins can't be impersonated")
+	ErrLogActivity      = errors.New("error while logging activity")
+)
@@ -0,0 +1,66 @@
+package platform
+
+import (
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/internal/schema"
+)
+
+const (
+	AuditEntity = "platform"
+
+	// ActAsHeader carries the email of the user an impersonator runs the
+	// request as, both in the proxy and the API
+	ActAsHeader = "X-Shield-Act-As"
+)
+
+type AdminLogData struct {
+	Entity  string `mapstructure:"entity"`
+	AdminID string `mapstructure:"admin_id"`
+}
+
+func ToAdminLogData(adminID string) AdminLogData {
+	return AdminLogData{
+		Entity:  AuditEntity,
+		AdminID: adminID,
+	}
+}
+
+type ImpersonatorLogData struct {
+	Entity         string `mapstructure:"entity"`
+	ImpersonatorID string `mapstructure:"impersonator_id"`
+}
+
+func ToImpersonatorLogData(impersonatorID string) ImpersonatorLogData {
+	return ImpersonatorLogData{
+		Entity:         AuditEntity,
+		ImpersonatorID: impersonatorID,
+	}
+}
+
+type ImpersonationLogData struct {
+	Entity    string `mapstructure:"entity"`
+	UserID    string `mapstructure:"user_id"`
+	UserEmail string `mapstructure:"user_email"`
+}
+
+func ToImpersonationLogData(userID, userEmail string) ImpersonationLogData {
+	return ImpersonationLogData{
+		Entity:    AuditEntity,
+		UserID:    userID,
+		UserEmail: userEmail,
+	}
+}
+
+func roleRelation(userID, roleID string) relation.RelationV2 {
+	return relation.RelationV2{
+		Object: relation.Object{
+			ID:          schema.PlatformID,
+			NamespaceID: schema.PlatformNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        userID,
+			Namespace: schema.UserPrincipal,
+			RoleID:    roleID,
+		},
+	}
+}
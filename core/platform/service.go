@@ -0,0 +1,196 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
+)
+
+const (
+	auditKeyPlatformAdminAdd           = "platform.admin.add"
+	auditKeyPlatformAdminRemove        = "platform.admin.remove"
+	auditKeyPlatformImpersonatorAdd    = "platform.impersonator.add"
+	auditKeyPlatformImpersonatorRemove = "platform.impersonator.remove"
+	auditKeyPlatformImpersonate        = "platform.impersonate"
+)
+
+type RelationService interface {
+	Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
+	DeleteV2(ctx context.Context, rel relation.RelationV2) error
+	CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
+}
+
+type UserService interface {
+	FetchCurrentUser(ctx context.Context) (user.User, error)
+	GetByID(ctx context.Context, id string) (user.User, error)
+	GetByEmail(ctx context.Context, email string) (user.User, error)
+}
+
+type ActivityService interface {
+	Log(ctx context.Context, action string, actor activity.Actor, data any) error
+}
+
+type Service struct {
+	logger          log.Logger
+	relationService RelationService
+	userService     UserService
+	activityService ActivityService
+}
+
+func NewService(logger log.Logger, relationService RelationService, userService UserService, activityService ActivityService) *Service {
+	return &Service{
+		logger:          logger,
+		relationService: relationService,
+		userService:     userService,
+		activityService: activityService,
+	}
+}
+
+// CheckAdmin returns errors.ErrForbidden if the current user is not a
+// platform admin
+func (s Service) CheckAdmin(ctx context.Context) error {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.checkPermission(ctx, currentUser, schema.AdministerPermission)
+}
+
+// AddAdmin grants the platform admin role to the user, only platform admins
+// can grant it
+func (s Service) AddAdmin(ctx context.Context, userID string) (user.User, error) {
+	return s.addRole(ctx, userID, schema.AdminRole, auditKeyPlatformAdminAdd, ToAdminLogData(userID))
+}
+
+// RemoveAdmin revokes the platform admin role of the user, the default system
+// user gets it back on the next migration of the resources config
+func (s Service) RemoveAdmin(ctx context.Context, userID string) error {
+	return s.removeRole(ctx, userID, schema.AdminRole, ErrNotAdmin, auditKeyPlatformAdminRemove, ToAdminLogData(userID))
+}
+
+// AddImpersonator grants the user the role to run requests as other users,
+// only platform admins can grant it
+func (s Service) AddImpersonator(ctx context.Context, userID string) (user.User, error) {
+	return s.addRole(ctx, userID, schema.ImpersonatorRole, auditKeyPlatformImpersonatorAdd, ToImpersonatorLogData(userID))
+}
+
+func (s Service) RemoveImpersonator(ctx context.Context, userID string) error {
+	return s.removeRole(ctx, userID, schema.ImpersonatorRole, ErrNotImpersonator, auditKeyPlatformImpersonatorRemove, ToImpersonatorLogData(userID))
+}
+
+// Impersonate returns the current user and the user with the email, if the
+// current user is allowed to run requests as them. Platform admins can't be
+// impersonated.
+func (s Service) Impersonate(ctx context.Context, email string) (user.User, user.User, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return user.User{}, user.User{}, err
+	}
+
+	if err := s.checkPermission(ctx, currentUser, schema.ImpersonatePermission); err != nil {
+		return user.User{}, user.User{}, err
+	}
+
+	impersonatedUser, err := s.userService.GetByEmail(ctx, email)
+	if err != nil {
+		return user.User{}, user.User{}, err
+	}
+
+	isAdmin, err := s.relationService.CheckPermission(ctx, impersonatedUser, namespace.Namespace{ID: schema.PlatformNamespace},
+		schema.PlatformID, action.Action{ID: schema.AdministerPermission})
+	if err != nil {
+		return user.User{}, user.User{}, err
+	}
+	if isAdmin {
+		return user.User{}, user.User{}, ErrImpersonateAdmin
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyPlatformImpersonate, actor, ToImpersonationLogData(impersonatedUser.ID, impersonatedUser.Email)); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return currentUser, impersonatedUser, nil
+}
+
+func (s Service) addRole(ctx context.Context, userID, roleID, auditKey string, logData any) (user.User, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	if err := s.checkPermission(ctx, currentUser, schema.AdministerPermission); err != nil {
+		return user.User{}, err
+	}
+
+	usr, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	if _, err := s.relationService.Create(ctx, roleRelation(usr.ID, roleID)); err != nil {
+		return user.User{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKey, actor, logData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return usr, nil
+}
+
+func (s Service) removeRole(ctx context.Context, userID, roleID string, errNotMember error, auditKey string, logData any) error {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkPermission(ctx, currentUser, schema.AdministerPermission); err != nil {
+		return err
+	}
+
+	if err := s.relationService.DeleteV2(ctx, roleRelation(userID, roleID)); err != nil {
+		if errors.Is(err, relation.ErrNotExist) {
+			return errNotMember
+		}
+		return err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKey, actor, logData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return nil
+}
+
+func (s Service) checkPermission(ctx context.Context, usr user.User, permission string) error {
+	allowed, err := s.relationService.CheckPermission(ctx, usr, namespace.Namespace{ID: schema.PlatformNamespace},
+		schema.PlatformID, action.Action{ID: permission})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errors.ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package platform_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/platform"
+	"github.com/goto/shield/core/platform/mocks"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	errorsPkg "github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	testLogger = logger.InitLogger(logger.Config{
+		Level:  "info",
+		Format: "json",
+	})
+	testUser = user.User{
+		ID:    "9f256f86-31a3-11ec-8d3d-0242ac130003",
+		Email: "john.doe@gotocompany.com",
+	}
+	testAdminID = "2e73f4a2-3b1c-4d6e-9a0f-8c1b2d3e4f05"
+)
+
+func expectAdministerPermission(relationService *mocks.RelationService, allowed bool) {
+	relationService.EXPECT().CheckPermission(mock.Anything, testUser, namespace.Namespace{ID: schema.PlatformNamespace},
+		schema.PlatformID, action.Action{ID: schema.AdministerPermission}).Return(allowed, nil)
+}
+
+func TestService_CheckAdmin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		allowed bool
+		wantErr error
+	}{
+		{
+			name:    "should allow platform admins",
+			allowed: true,
+		},
+		{
+			name:    "should forbid users without the administer permission",
+			allowed: false,
+			wantErr: errorsPkg.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			expectAdministerPermission(relationService, tt.allowed)
+			s := platform.NewService(testLogger, relationService, userService, &mocks.ActivityService{})
+
+			err := s.CheckAdmin(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_AddAdmin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		allowed bool
+		wantErr error
+	}{
+		{
+			name:    "should grant the admin role on the platform",
+			allowed: true,
+		},
+		{
+			name:    "should not grant the admin role if current user isn't a platform admin",
+			allowed: false,
+			wantErr: errorsPkg.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			expectAdministerPermission(relationService, tt.allowed)
+			userService.EXPECT().GetByID(mock.Anything, testAdminID).Return(user.User{ID: testAdminID}, nil).Maybe()
+			relationService.EXPECT().Create(mock.Anything, relation.RelationV2{
+				Object:  relation.Object{ID: schema.PlatformID, NamespaceID: schema.PlatformNamespace},
+				Subject: relation.Subject{ID: testAdminID, Namespace: schema.UserPrincipal, RoleID: schema.AdminRole},
+			}).Return(relation.RelationV2{}, nil).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := platform.NewService(testLogger, relationService, userService, activityService)
+
+			got, err := s.AddAdmin(context.Background(), testAdminID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				relationService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testAdminID, got.ID)
+			relationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RemoveAdmin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		allowed   bool
+		deleteErr error
+		wantErr   error
+	}{
+		{
+			name:    "should revoke the admin role on the platform",
+			allowed: true,
+		},
+		{
+			name:      "should return not admin error if user isn't a platform admin",
+			allowed:   true,
+			deleteErr: relation.ErrNotExist,
+			wantErr:   platform.ErrNotAdmin,
+		},
+		{
+			name:    "should not revoke the admin role if current user isn't a platform admin",
+			allowed: false,
+			wantErr: errorsPkg.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			expectAdministerPermission(relationService, tt.allowed)
+			relationService.EXPECT().DeleteV2(mock.Anything, mock.AnythingOfType("relation.RelationV2")).Return(tt.deleteErr).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := platform.NewService(testLogger, relationService, userService, activityService)
+
+			err := s.RemoveAdmin(context.Background(), testAdminID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_Impersonate(t *testing.T) {
+	t.Parallel()
+
+	impersonatedUser := user.User{
+		ID:    "5b0f8c9e-7a6d-4c3b-8e2f-1a0b9c8d7e06",
+		Email: "jane.doe@gotocompany.com",
+	}
+
+	tests := []struct {
+		name             string
+		allowed          bool
+		impersonateAdmin bool
+		wantErr          error
+	}{
+		{
+			name:    "should return both users if current user can impersonate",
+			allowed: true,
+		},
+		{
+			name:    "should not impersonate without the impersonate permission",
+			allowed: false,
+			wantErr: errorsPkg.ErrForbidden,
+		},
+		{
+			name:             "should not impersonate platform admins",
+			allowed:          true,
+			impersonateAdmin: true,
+			wantErr:          platform.ErrImpersonateAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			relationService.EXPECT().CheckPermission(mock.Anything, testUser, namespace.Namespace{ID: schema.PlatformNamespace},
+				schema.PlatformID, action.Action{ID: schema.ImpersonatePermission}).Return(tt.allowed, nil)
+			userService.EXPECT().GetByEmail(mock.Anything, impersonatedUser.Email).Return(impersonatedUser, nil).Maybe()
+			relationService.EXPECT().CheckPermission(mock.Anything, impersonatedUser, namespace.Namespace{ID: schema.PlatformNamespace},
+				schema.PlatformID, action.Action{ID: schema.AdministerPermission}).Return(tt.impersonateAdmin, nil).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := platform.NewService(testLogger, relationService, userService, activityService)
+
+			impersonator, got, err := s.Impersonate(context.Background(), impersonatedUser.Email)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testUser, impersonator)
+			assert.Equal(t, impersonatedUser, got)
+		})
+	}
+}
@@ -11,6 +11,7 @@ type Repository interface {
 	List(ctx context.Context, filter Filters) ([]Policy, error)
 	Upsert(ctx context.Context, pol *Policy) (string, error)
 	Update(ctx context.Context, pol *Policy) (string, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type AuthzRepository interface {
@@ -12,6 +12,7 @@ import (
 const (
 	auditKeyPolicyUpsert = "policy.upsert"
 	auditKeyPolicyUpdate = "policy.update"
+	auditKeyPolicyDelete = "policy.delete"
 )
 
 type UserService interface {
@@ -103,3 +104,30 @@ func (s Service) Update(ctx context.Context, pol *Policy) ([]Policy, error) {
 
 	return policies, err
 }
+
+func (s Service) Delete(ctx context.Context, id string) error {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	deletedPolicy, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		policyLogData := deletedPolicy.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyPolicyDelete, actor, policyLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return nil
+}
@@ -9,4 +9,6 @@ var (
 	ErrConflict      = errors.New("project already exist")
 	ErrInvalidDetail = errors.New("invalid project detail")
 	ErrLogActivity   = errors.New("error while logging activity")
+	ErrNotAdmin      = errors.New("user is not an admin of the project")
+	ErrLastAdmin     = errors.New("cannot remove the last admin of the project")
 )
@@ -48,3 +48,21 @@ func (project Project) ToLogData() LogData {
 		OrganizationID: project.Organization.ID,
 	}
 }
+
+type AdminLogData struct {
+	Entity  string `mapstructure:"entity"`
+	ID      string `mapstructure:"id"`
+	Name    string `mapstructure:"name"`
+	Slug    string `mapstructure:"slug"`
+	AdminID string `mapstructure:"admin_id"`
+}
+
+func (project Project) ToAdminLogData(adminID string) AdminLogData {
+	return AdminLogData{
+		Entity:  AuditEntity,
+		ID:      project.ID,
+		Name:    project.Name,
+		Slug:    project.Slug,
+		AdminID: adminID,
+	}
+}
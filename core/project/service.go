@@ -12,17 +12,21 @@ import (
 	"github.com/goto/shield/core/relation"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
 	"github.com/goto/shield/pkg/uuid"
 )
 
 const (
-	auditKeyProjectCreate = "project.create"
-	auditKeyProjectUpdate = "project.update"
+	auditKeyProjectCreate      = "project.create"
+	auditKeyProjectUpdate      = "project.update"
+	auditKeyProjectAdminAdd    = "project.admin.add"
+	auditKeyProjectAdminRemove = "project.admin.remove"
 )
 
 type RelationService interface {
 	Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
 	Delete(ctx context.Context, rel relation.Relation) error
+	DeleteV2(ctx context.Context, rel relation.RelationV2) error
 	CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
 }
 
@@ -125,8 +129,45 @@ func (s Service) Update(ctx context.Context, prj Project) (Project, error) {
 }
 
 func (s Service) AddAdmins(ctx context.Context, idOrSlug string, userIds []string) ([]user.User, error) {
-	// TODO(discussion): can be done with create relations
-	return []user.User{}, nil
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	prj, err := s.Get(ctx, idOrSlug)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, prj); err != nil {
+		return []user.User{}, err
+	}
+
+	users, err := s.userService.GetByIDs(ctx, userIds)
+	if err != nil {
+		return []user.User{}, err
+	}
+	if len(users) != len(userIds) {
+		return []user.User{}, user.ErrNotExist
+	}
+
+	for _, usr := range users {
+		if _, err := s.relationService.Create(ctx, adminRelation(prj, usr.ID)); err != nil {
+			return []user.User{}, err
+		}
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		for _, usr := range users {
+			if err := s.activityService.Log(ctx, auditKeyProjectAdminAdd, actor, prj.ToAdminLogData(usr.ID)); err != nil {
+				s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+			}
+		}
+	}()
+
+	return s.repository.ListAdmins(ctx, prj.ID)
 }
 
 func (s Service) ListAdmins(ctx context.Context, id string) ([]user.User, error) {
@@ -134,8 +175,78 @@ func (s Service) ListAdmins(ctx context.Context, id string) ([]user.User, error)
 }
 
 func (s Service) RemoveAdmin(ctx context.Context, idOrSlug string, userId string) ([]user.User, error) {
-	// TODO(discussion): can be done with delete relations
-	return []user.User{}, nil
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	prj, err := s.Get(ctx, idOrSlug)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, prj); err != nil {
+		return []user.User{}, err
+	}
+
+	admins, err := s.repository.ListAdmins(ctx, prj.ID)
+	if err != nil {
+		return []user.User{}, err
+	}
+
+	isAdmin := false
+	for _, admin := range admins {
+		if admin.ID == userId {
+			isAdmin = true
+			break
+		}
+	}
+	if !isAdmin {
+		return []user.User{}, ErrNotAdmin
+	}
+	if len(admins) == 1 {
+		return []user.User{}, ErrLastAdmin
+	}
+
+	if err := s.relationService.DeleteV2(ctx, adminRelation(prj, userId)); err != nil {
+		return []user.User{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyProjectAdminRemove, actor, prj.ToAdminLogData(userId)); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return s.repository.ListAdmins(ctx, prj.ID)
+}
+
+func (s Service) checkEditPermission(ctx context.Context, usr user.User, prj Project) error {
+	permission, err := s.relationService.CheckPermission(ctx, usr, namespace.Namespace{ID: schema.ProjectNamespace},
+		prj.ID, action.Action{ID: schema.EditPermission})
+	if err != nil {
+		return err
+	}
+	if !permission {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+func adminRelation(prj Project, userID string) relation.RelationV2 {
+	return relation.RelationV2{
+		Object: relation.Object{
+			ID:          prj.ID,
+			NamespaceID: schema.ProjectNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        userID,
+			Namespace: schema.UserPrincipal,
+			RoleID:    schema.OwnerRole,
+		},
+	}
 }
 
 func (s Service) addProjectToOrg(ctx context.Context, prj Project, org organization.Organization) error {
@@ -147,6 +147,16 @@ func (s Service) CheckPermission(ctx context.Context, usr user.User, resourceNS
 	}, action)
 }
 
+// CheckServiceAccountPermission checks the permission of a service account on the resource
+func (s Service) CheckServiceAccountPermission(ctx context.Context, serviceAccountID string, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error) {
+	return s.authzRepository.Check(ctx, Relation{
+		ObjectNamespace:  resourceNS,
+		ObjectID:         resourceIdxa,
+		SubjectID:        serviceAccountID,
+		SubjectNamespace: namespace.DefinitionServiceAccount,
+	}, action)
+}
+
 func (s Service) CheckIsPublic(ctx context.Context, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error) {
 	return s.authzRepository.CheckIsPublic(ctx, Relation{
 		ObjectNamespace:  resourceNS,
@@ -491,6 +491,24 @@ func TestService_CheckPermission(t *testing.T) {
 	}
 }
 
+func TestService_CheckServiceAccountPermission(t *testing.T) {
+	t.Parallel()
+
+	serviceAccountRelation := testRelation
+	serviceAccountRelation.SubjectNamespace = namespace.DefinitionServiceAccount
+
+	repository := &mocks.Repository{}
+	authzRepository := &mocks.AuthzRepository{}
+	userService := &mocks.UserService{}
+	activityService := &mocks.ActivityService{}
+	authzRepository.EXPECT().Check(mock.Anything, serviceAccountRelation, testAction).Return(true, nil)
+	svc := relation.NewService(testLogger, repository, authzRepository, userService, activityService)
+
+	got, err := svc.CheckServiceAccountPermission(context.TODO(), serviceAccountRelation.SubjectID, serviceAccountRelation.ObjectNamespace, serviceAccountRelation.ObjectID, testAction)
+	assert.NoError(t, err)
+	assert.True(t, got)
+}
+
 func TestService_BulkCheckPermission(t *testing.T) {
 	t.Parallel()
 
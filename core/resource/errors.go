@@ -9,6 +9,7 @@ var (
 	ErrInvalidURN               = errors.New("resource urn is invalid")
 	ErrConflict                 = errors.New("resource already exist")
 	ErrInvalidDetail            = errors.New("invalid resource detail")
+	ErrInvalidParent            = errors.New("parent resource is invalid")
 	ErrLogActivity              = errors.New("error while logging activity")
 	ErrUpsertConfigNotSupported = errors.New("upsert resource config is currently not supported")
 	ErrMarshal                  = errors.New("error while marshalling resource config")
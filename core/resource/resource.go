@@ -22,6 +22,7 @@ type Repository interface {
 	List(ctx context.Context, flt Filter) ([]Resource, error)
 	Update(ctx context.Context, id string, resource Resource) (Resource, error)
 	GetByNamespace(ctx context.Context, name string, ns string) (Resource, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type Resource struct {
@@ -32,6 +33,7 @@ type Resource struct {
 	OrganizationID string
 	NamespaceID    string
 	UserID         string
+	ParentID       string
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -78,6 +80,7 @@ type LogData struct {
 	ProjectID      string `mapstructure:"project_id"`
 	NamespaceID    string `mapstructure:"namespace_id"`
 	UserID         string `mapstructure:"user_id"`
+	ParentID       string `mapstructure:"parent_id"`
 }
 
 func (resource Resource) ToLogData() LogData {
@@ -89,5 +92,26 @@ func (resource Resource) ToLogData() LogData {
 		ProjectID:      resource.ProjectID,
 		NamespaceID:    resource.NamespaceID,
 		UserID:         resource.UserID,
+		ParentID:       resource.ParentID,
+	}
+}
+
+// VisibilityLogData records a permission of a resource or project made public
+// or private
+type VisibilityLogData struct {
+	Entity      string `mapstructure:"entity"`
+	NamespaceID string `mapstructure:"namespace_id"`
+	ObjectID    string `mapstructure:"object_id"`
+	Permission  string `mapstructure:"permission"`
+	Public      bool   `mapstructure:"public"`
+}
+
+func (resource Resource) ToVisibilityLogData(permission string, public bool) VisibilityLogData {
+	return VisibilityLogData{
+		Entity:      AuditEntity,
+		NamespaceID: resource.NamespaceID,
+		ObjectID:    resource.Idxa,
+		Permission:  permission,
+		Public:      public,
 	}
 }
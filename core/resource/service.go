@@ -15,8 +15,10 @@ import (
 	"github.com/goto/shield/core/policy"
 	"github.com/goto/shield/core/project"
 	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
 	"github.com/goto/shield/pkg/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -26,13 +28,20 @@ const (
 	auditKeyResourceCreate = "resource.create"
 	auditKeyResourceUpdate = "resource.update"
 
+	auditKeyResourceVisibilityUpdate = "resource.visibility.update"
+	auditKeyProjectVisibilityUpdate  = "project.visibility.update"
+
 	userNamespace = schema.UserPrincipal
+	userWildcard  = "*"
 )
 
 type RelationService interface {
 	Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
 	Delete(ctx context.Context, rel relation.Relation) error
+	DeleteV2(ctx context.Context, rel relation.RelationV2) error
 	CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
+	CheckServiceAccountPermission(ctx context.Context, serviceAccountID string, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
+	CheckIsPublic(ctx context.Context, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
 	BulkCheckPermission(ctx context.Context, rels []relation.Relation, acts []action.Action) ([]relation.Permission, error)
 	DeleteSubjectRelations(ctx context.Context, resourceType, optionalResourceID string) error
 	LookupResources(ctx context.Context, resourceType, permission, subjectType, subjectID string) ([]string, error)
@@ -69,6 +78,10 @@ type NamespaceService interface {
 
 type SchemaService interface {
 	UpsertConfig(ctx context.Context, name string, config string) (schema.Config, error)
+	PlanConfig(ctx context.Context, name string, config string) (schema.Plan, error)
+	ListSchemaVersions(ctx context.Context) ([]schema.SchemaVersion, error)
+	PublicRole(ctx context.Context, namespaceID, permission string) (string, error)
+	ParentType(ctx context.Context, namespaceID string) (string, error)
 }
 
 type Service struct {
@@ -137,6 +150,18 @@ func (s Service) Upsert(ctx context.Context, res Resource) (Resource, error) {
 		return Resource{}, err
 	}
 
+	parent, err := s.getParent(ctx, currentUser, res.NamespaceID, res.ParentID, fetchedProject.ID)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	// only a resource created by this upsert is deleted if its relations can't be written
+	_, err = s.repository.GetByURN(ctx, urn)
+	isNew := errors.Is(err, ErrNotExist)
+	if err != nil && !isNew {
+		return Resource{}, err
+	}
+
 	userId := res.UserID
 	if strings.TrimSpace(userId) == "" {
 		userId = currentUser.ID
@@ -149,20 +174,16 @@ func (s Service) Upsert(ctx context.Context, res Resource) (Resource, error) {
 		ProjectID:      fetchedProject.ID,
 		NamespaceID:    res.NamespaceID,
 		UserID:         userId,
+		ParentID:       res.ParentID,
 	})
 	if err != nil {
 		return Resource{}, err
 	}
 
-	if err = s.relationService.DeleteSubjectRelations(ctx, newResource.NamespaceID, newResource.Idxa); err != nil {
-		return Resource{}, err
-	}
-
-	if err = s.AddProjectToResource(ctx, project.Project{ID: newResource.ProjectID}, newResource); err != nil {
-		return Resource{}, err
-	}
-
-	if err = s.AddOrgToResource(ctx, organization.Organization{ID: newResource.OrganizationID}, newResource); err != nil {
+	if err = s.addResourceRelations(ctx, newResource, parent); err != nil {
+		if isNew {
+			s.deleteNewResource(ctx, newResource, parent)
+		}
 		return Resource{}, err
 	}
 
@@ -195,6 +216,11 @@ func (s Service) Create(ctx context.Context, res Resource) (Resource, error) {
 		return Resource{}, err
 	}
 
+	parent, err := s.getParent(ctx, currentUser, res.NamespaceID, res.ParentID, fetchedProject.ID)
+	if err != nil {
+		return Resource{}, err
+	}
+
 	userId := res.UserID
 	if strings.TrimSpace(userId) == "" {
 		userId = currentUser.ID
@@ -207,20 +233,14 @@ func (s Service) Create(ctx context.Context, res Resource) (Resource, error) {
 		ProjectID:      fetchedProject.ID,
 		NamespaceID:    res.NamespaceID,
 		UserID:         userId,
+		ParentID:       res.ParentID,
 	})
 	if err != nil {
 		return Resource{}, err
 	}
 
-	if err = s.relationService.DeleteSubjectRelations(ctx, newResource.NamespaceID, newResource.Idxa); err != nil {
-		return Resource{}, err
-	}
-
-	if err = s.AddProjectToResource(ctx, project.Project{ID: newResource.ProjectID}, newResource); err != nil {
-		return Resource{}, err
-	}
-
-	if err = s.AddOrgToResource(ctx, organization.Organization{ID: newResource.OrganizationID}, newResource); err != nil {
+	if err = s.addResourceRelations(ctx, newResource, parent); err != nil {
+		s.deleteNewResource(ctx, newResource, parent)
 		return Resource{}, err
 	}
 
@@ -271,98 +291,357 @@ func (s Service) Update(ctx context.Context, id string, resource Resource) (Reso
 	return updatedResource, nil
 }
 
+// addResourceRelations replaces the relations of the resource with the ones to
+// its project, organization and parent
+func (s Service) addResourceRelations(ctx context.Context, res Resource, parent Resource) error {
+	if err := s.relationService.DeleteSubjectRelations(ctx, res.NamespaceID, res.Idxa); err != nil {
+		return err
+	}
+
+	if err := s.AddProjectToResource(ctx, project.Project{ID: res.ProjectID}, res); err != nil {
+		return err
+	}
+
+	if err := s.AddOrgToResource(ctx, organization.Organization{ID: res.OrganizationID}, res); err != nil {
+		return err
+	}
+
+	if parent.Idxa != "" {
+		if err := s.AddParentToResource(ctx, parent, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteNewResource deletes a resource whose relations couldn't be written
+// along with the relations which were written, failures are only logged as
+// the error of writing the relations is returned to the caller
+func (s Service) deleteNewResource(ctx context.Context, res Resource, parent Resource) {
+	rels := []relation.RelationV2{projectRelation(res.ProjectID, res), orgRelation(res.OrganizationID, res)}
+	if parent.Idxa != "" {
+		rels = append(rels, parentRelation(parent, res))
+	}
+	for _, rel := range rels {
+		if err := s.relationService.DeleteV2(ctx, rel); err != nil && !errors.Is(err, relation.ErrNotExist) {
+			s.logger.Error(fmt.Sprintf("error while deleting the relations of resource %s: %s", res.Idxa, err.Error()))
+		}
+	}
+
+	if err := s.repository.Delete(ctx, res.Idxa); err != nil {
+		s.logger.Error(fmt.Sprintf("error while deleting resource %s: %s", res.Idxa, err.Error()))
+	}
+}
+
 func (s Service) AddProjectToResource(ctx context.Context, project project.Project, res Resource) error {
-	rel := relation.RelationV2{
+	if _, err := s.relationService.Create(ctx, projectRelation(project.ID, res)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s Service) AddOrgToResource(ctx context.Context, org organization.Organization, res Resource) error {
+	if _, err := s.relationService.Create(ctx, orgRelation(org.ID, res)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddParentToResource lets the resource inherit the permissions of its parent
+func (s Service) AddParentToResource(ctx context.Context, parent Resource, res Resource) error {
+	if _, err := s.relationService.Create(ctx, parentRelation(parent, res)); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidParent, err.Error())
+	}
+	return nil
+}
+
+func projectRelation(projectID string, res Resource) relation.RelationV2 {
+	return relation.RelationV2{
 		Object: relation.Object{
 			ID:          res.Idxa,
 			NamespaceID: res.NamespaceID,
 		},
 		Subject: relation.Subject{
 			RoleID:    schema.ProjectRelationName,
-			ID:        project.ID,
+			ID:        projectID,
 			Namespace: schema.ProjectNamespace,
 		},
 	}
-
-	if _, err := s.relationService.Create(ctx, rel); err != nil {
-		return err
-	}
-
-	return nil
 }
 
-func (s Service) AddOrgToResource(ctx context.Context, org organization.Organization, res Resource) error {
-	rel := relation.RelationV2{
+func orgRelation(orgID string, res Resource) relation.RelationV2 {
+	return relation.RelationV2{
 		Object: relation.Object{
 			ID:          res.Idxa,
 			NamespaceID: res.NamespaceID,
 		},
 		Subject: relation.Subject{
 			RoleID:    schema.OrganizationRelationName,
-			ID:        org.ID,
+			ID:        orgID,
 			Namespace: schema.OrganizationNamespace,
 		},
 	}
+}
+
+func parentRelation(parent Resource, res Resource) relation.RelationV2 {
+	return relation.RelationV2{
+		Object: relation.Object{
+			ID:          res.Idxa,
+			NamespaceID: res.NamespaceID,
+		},
+		Subject: relation.Subject{
+			RoleID:    schema.ParentRelationName,
+			ID:        parent.Idxa,
+			Namespace: parent.NamespaceID,
+		},
+	}
+}
+
+// SetVisibility makes the permission of the resource public or private, a
+// public permission is granted to every user including unauthenticated ones
+func (s Service) SetVisibility(ctx context.Context, id string, permission string, public bool) (Resource, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	res, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	if err := s.setVisibility(ctx, currentUser, res.NamespaceID, res.Idxa, permission, public); err != nil {
+		return Resource{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		logData := res.ToVisibilityLogData(permission, public)
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyResourceVisibilityUpdate, actor, logData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return res, nil
+}
+
+// SetProjectVisibility makes the permission of the project public or private,
+// resources of a public project aren't public unless they inherit the permission
+// from the project
+func (s Service) SetProjectVisibility(ctx context.Context, idOrSlug string, permission string, public bool) (project.Project, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return project.Project{}, err
+	}
+
+	prj, err := s.projectService.Get(ctx, idOrSlug)
+	if err != nil {
+		return project.Project{}, err
+	}
+
+	if err := s.setVisibility(ctx, currentUser, schema.ProjectNamespace, prj.ID, permission, public); err != nil {
+		return project.Project{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		logData := VisibilityLogData{
+			Entity:      project.AuditEntity,
+			NamespaceID: schema.ProjectNamespace,
+			ObjectID:    prj.ID,
+			Permission:  permission,
+			Public:      public,
+		}
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyProjectVisibilityUpdate, actor, logData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return prj, nil
+}
+
+// setVisibility relates the user wildcard with the object through the role
+// which grants the permission to every user, or removes that relation
+func (s Service) setVisibility(ctx context.Context, currentUser user.User, namespaceID, objectID, permission string, public bool) error {
+	allowed, err := s.relationService.CheckPermission(ctx, currentUser, namespace.Namespace{ID: namespaceID}, objectID, action.Action{ID: schema.EditPermission})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errors.ErrForbidden
+	}
+
+	roleName, err := s.schemaService.PublicRole(ctx, namespaceID, permission)
+	if err != nil {
+		return err
+	}
+
+	rel := relation.RelationV2{
+		Object: relation.Object{
+			ID:          objectID,
+			NamespaceID: namespaceID,
+		},
+		Subject: relation.Subject{
+			RoleID:    roleName,
+			ID:        userWildcard,
+			Namespace: userNamespace,
+		},
+	}
 
-	if _, err := s.relationService.Create(ctx, rel); err != nil {
+	if public {
+		_, err = s.relationService.Create(ctx, rel)
+		return err
+	}
+
+	// a permission which isn't public is already private
+	if err = s.relationService.DeleteV2(ctx, rel); err != nil && !errors.Is(err, relation.ErrNotExist) {
 		return err
 	}
 	return nil
 }
 
+// getParent fetches the parent of a new resource, which has to be of the parent type
+// configured for the namespace and in the same project, and editable by the current
+// user. It is checked before the resource is stored, as the authz engine would
+// reject the parent relation after.
+func (s Service) getParent(ctx context.Context, currentUser user.User, namespaceID string, parentID string, projectID string) (Resource, error) {
+	if strings.TrimSpace(parentID) == "" {
+		return Resource{}, nil
+	}
+
+	parentType, err := s.schemaService.ParentType(ctx, namespaceID)
+	if err != nil {
+		return Resource{}, err
+	}
+	if parentType == "" {
+		return Resource{}, fmt.Errorf("%w: %s doesn't have a parent type", ErrInvalidParent, namespaceID)
+	}
+
+	parent, err := s.repository.GetByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) || errors.Is(err, ErrInvalidUUID) {
+			return Resource{}, ErrInvalidParent
+		}
+		return Resource{}, err
+	}
+
+	if parent.NamespaceID != parentType {
+		return Resource{}, fmt.Errorf("%w: parent of %s has to be a %s", ErrInvalidParent, namespaceID, parentType)
+	}
+	if parent.ProjectID != projectID {
+		return Resource{}, fmt.Errorf("%w: parent belongs to another project", ErrInvalidParent)
+	}
+
+	// resources inherit the permissions of their parent, so only its editors can add children
+	permitted, err := s.relationService.CheckPermission(ctx, currentUser, namespace.Namespace{ID: parent.NamespaceID},
+		parent.Idxa, action.Action{ID: schema.EditPermission})
+	if err != nil {
+		return Resource{}, err
+	}
+	if !permitted {
+		return Resource{}, fmt.Errorf("%w: parent can't be edited by the current user", errors.ErrForbidden)
+	}
+	return parent, nil
+}
+
 // TODO(krkvrm): Separate Authz for Resources & System Namespaces
+// CheckAuthz checks the permission of the current user, or of the service account
+// of requests authenticated with a service account key
 func (s Service) CheckAuthz(ctx context.Context, res Resource, act action.Action) (bool, error) {
-	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	serviceAccount, isServiceAccount := serviceaccount.GetFromContext(ctx)
+
+	var currentUser user.User
+	if !isServiceAccount {
+		var err error
+		currentUser, err = s.userService.FetchCurrentUser(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	fetchedResource, err := s.fetchAuthzResource(ctx, res)
 	if err != nil {
 		return false, err
 	}
+	fetchedResourceNS := namespace.Namespace{ID: fetchedResource.NamespaceID}
+	if isServiceAccount {
+		return s.relationService.CheckServiceAccountPermission(ctx, serviceAccount.ID, fetchedResourceNS, fetchedResource.Idxa, act)
+	}
+	return s.relationService.CheckPermission(ctx, currentUser, fetchedResourceNS, fetchedResource.Idxa, act)
+}
 
-	isSystemNS := namespace.IsSystemNamespaceID(res.NamespaceID)
-	fetchedResource := res
+// CheckIsPublic checks whether the permission of the resource is granted to
+// every user, it doesn't require a signed in user
+func (s Service) CheckIsPublic(ctx context.Context, res Resource, act action.Action) (bool, error) {
+	fetchedResource, err := s.fetchAuthzResource(ctx, res)
+	if err != nil {
+		return false, err
+	}
+	fetchedResourceNS := namespace.Namespace{ID: fetchedResource.NamespaceID}
+	return s.relationService.CheckIsPublic(ctx, fetchedResourceNS, fetchedResource.Idxa, act)
+}
 
-	if isSystemNS {
-		if !uuid.IsValid(res.Name) {
-			switch res.NamespaceID {
-			case namespace.DefinitionProject.ID:
-				project, err := s.projectService.Get(ctx, res.Name)
-				if err != nil {
-					return false, err
-				}
-				res.Name = project.ID
-			case namespace.DefinitionOrg.ID:
-				organization, err := s.organizationService.Get(ctx, res.Name)
-				if err != nil {
-					return false, err
-				}
-				res.Name = organization.ID
-			case namespace.DefinitionTeam.ID:
-				group, err := s.groupService.GetBySlug(ctx, res.Name)
-				if err != nil {
-					return false, err
-				}
-				res.Name = group.ID
-			}
-		}
-		fetchedResource.Idxa = res.Name
-	} else {
-		fetchedResource, err = s.repository.GetByNamespace(ctx, res.Name, res.NamespaceID)
+// fetchAuthzResource resolves the id of the resource in the authz engine, the
+// name is either the id or slug of an entity of a system namespace or the name
+// or id of a resource
+func (s Service) fetchAuthzResource(ctx context.Context, res Resource) (Resource, error) {
+	if !namespace.IsSystemNamespaceID(res.NamespaceID) {
+		fetchedResource, err := s.repository.GetByNamespace(ctx, res.Name, res.NamespaceID)
 		if err != nil {
 			fetchedResource, err = s.repository.GetByID(ctx, res.Name)
 			if err != nil {
-				return false, ErrNotExist
+				return Resource{}, ErrNotExist
 			}
 		}
+		return fetchedResource, nil
 	}
-	fetchedResourceNS := namespace.Namespace{ID: fetchedResource.NamespaceID}
-	return s.relationService.CheckPermission(ctx, currentUser, fetchedResourceNS, fetchedResource.Idxa, act)
+
+	fetchedResource := res
+	if !uuid.IsValid(res.Name) {
+		switch res.NamespaceID {
+		case namespace.DefinitionProject.ID:
+			project, err := s.projectService.Get(ctx, res.Name)
+			if err != nil {
+				return Resource{}, err
+			}
+			res.Name = project.ID
+		case namespace.DefinitionOrg.ID:
+			organization, err := s.organizationService.Get(ctx, res.Name)
+			if err != nil {
+				return Resource{}, err
+			}
+			res.Name = organization.ID
+		case namespace.DefinitionTeam.ID:
+			group, err := s.groupService.GetBySlug(ctx, res.Name)
+			if err != nil {
+				return Resource{}, err
+			}
+			res.Name = group.ID
+		}
+	}
+	fetchedResource.Idxa = res.Name
+	return fetchedResource, nil
 }
 
 func (s Service) BulkCheckAuthz(ctx context.Context, resources []Resource, actions []action.Action) ([]relation.Permission, error) {
-	currentUser, err := s.userService.FetchCurrentUser(ctx)
-	if err != nil {
-		return []relation.Permission{}, err
+	// the subject is the service account of requests authenticated with its key
+	var subjectID string
+	subjectNamespace := namespace.DefinitionUser
+	if serviceAccount, ok := serviceaccount.GetFromContext(ctx); ok {
+		subjectID = serviceAccount.ID
+		subjectNamespace = namespace.DefinitionServiceAccount
+	} else {
+		currentUser, err := s.userService.FetchCurrentUser(ctx)
+		if err != nil {
+			return []relation.Permission{}, err
+		}
+		subjectID = currentUser.ID
 	}
 
+	var err error
 	var relations []relation.Relation
 	for _, res := range resources {
 		isSystemNS := namespace.IsSystemNamespaceID(res.NamespaceID)
@@ -404,8 +683,8 @@ func (s Service) BulkCheckAuthz(ctx context.Context, resources []Resource, actio
 		fetchedResourceNS := namespace.Namespace{ID: fetchedResource.NamespaceID}
 
 		relations = append(relations, relation.Relation{
-			SubjectID:        currentUser.ID,
-			SubjectNamespace: namespace.DefinitionUser,
+			SubjectID:        subjectID,
+			SubjectNamespace: subjectNamespace,
 			ObjectID:         fetchedResource.Idxa,
 			ObjectNamespace:  fetchedResourceNS,
 		})
@@ -588,3 +867,11 @@ func (s Service) listUserResources(ctx context.Context, resourceType string, use
 func (s Service) UpsertConfig(ctx context.Context, name string, config string) (schema.Config, error) {
 	return s.schemaService.UpsertConfig(ctx, name, config)
 }
+
+func (s Service) PlanConfig(ctx context.Context, name string, config string) (schema.Plan, error) {
+	return s.schemaService.PlanConfig(ctx, name, config)
+}
+
+func (s Service) ListSchemaVersions(ctx context.Context) ([]schema.SchemaVersion, error) {
+	return s.schemaService.ListSchemaVersions(ctx)
+}
@@ -22,6 +22,53 @@ func (_m *Repository) EXPECT() *Repository_Expecter {
 	return &Repository_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Repository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Repository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) Delete(ctx interface{}, id interface{}) *Repository_Delete_Call {
+	return &Repository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *Repository_Delete_Call) Run(run func(ctx context.Context, id string)) *Repository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_Delete_Call) Return(_a0 error) *Repository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *Repository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *Repository) Get(ctx context.Context, id string) (role.Role, error) {
 	ret := _m.Called(ctx, id)
@@ -79,9 +126,9 @@ func (_c *Repository_Get_Call) RunAndReturn(run func(context.Context, string) (r
 	return _c
 }
 
-// List provides a mock function with given fields: ctx
-func (_m *Repository) List(ctx context.Context) ([]role.Role, error) {
-	ret := _m.Called(ctx)
+// List provides a mock function with given fields: ctx, flt
+func (_m *Repository) List(ctx context.Context, flt role.Filter) ([]role.Role, error) {
+	ret := _m.Called(ctx, flt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
@@ -89,19 +136,19 @@ func (_m *Repository) List(ctx context.Context) ([]role.Role, error) {
 
 	var r0 []role.Role
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]role.Role, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, role.Filter) ([]role.Role, error)); ok {
+		return rf(ctx, flt)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []role.Role); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, role.Filter) []role.Role); ok {
+		r0 = rf(ctx, flt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]role.Role)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, role.Filter) error); ok {
+		r1 = rf(ctx, flt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,13 +163,14 @@ type Repository_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *Repository_Expecter) List(ctx interface{}) *Repository_List_Call {
-	return &Repository_List_Call{Call: _e.mock.On("List", ctx)}
+//   - flt role.Filter
+func (_e *Repository_Expecter) List(ctx interface{}, flt interface{}) *Repository_List_Call {
+	return &Repository_List_Call{Call: _e.mock.On("List", ctx, flt)}
 }
 
-func (_c *Repository_List_Call) Run(run func(ctx context.Context)) *Repository_List_Call {
+func (_c *Repository_List_Call) Run(run func(ctx context.Context, flt role.Filter)) *Repository_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(role.Filter))
 	})
 	return _c
 }
@@ -132,7 +180,7 @@ func (_c *Repository_List_Call) Return(_a0 []role.Role, _a1 error) *Repository_L
 	return _c
 }
 
-func (_c *Repository_List_Call) RunAndReturn(run func(context.Context) ([]role.Role, error)) *Repository_List_Call {
+func (_c *Repository_List_Call) RunAndReturn(run func(context.Context, role.Filter) ([]role.Role, error)) *Repository_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
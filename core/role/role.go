@@ -14,9 +14,10 @@ const AuditEntity = "role"
 
 type Repository interface {
 	Get(ctx context.Context, id string) (Role, error)
-	List(ctx context.Context) ([]Role, error)
+	List(ctx context.Context, flt Filter) ([]Role, error)
 	Upsert(ctx context.Context, role Role) (string, error)
 	Update(ctx context.Context, toUpdate Role) (string, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type Role struct {
@@ -24,17 +25,27 @@ type Role struct {
 	Name        string
 	Types       []string
 	NamespaceID string
+	// OrgID is set for custom roles of an organization, which are composed of
+	// existing Permissions of the namespace instead of coming from the resources config
+	OrgID       string
+	Permissions []string
 	Metadata    metadata.Metadata
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+type Filter struct {
+	OrgID string
+}
+
 type LogData struct {
 	Entity      string   `mapstructure:"entity"`
 	ID          string   `mapstructure:"id"`
 	Name        string   `mapstructure:"name"`
 	Types       []string `mapstructure:"types"`
 	NamespaceID string   `mapstructure:"namespace_id"`
+	OrgID       string   `mapstructure:"org_id"`
+	Permissions []string `mapstructure:"permissions"`
 }
 
 func (role Role) ToLogData() LogData {
@@ -44,6 +55,8 @@ func (role Role) ToLogData() LogData {
 		Name:        role.Name,
 		Types:       role.Types,
 		NamespaceID: role.NamespaceID,
+		OrgID:       role.OrgID,
+		Permissions: role.Permissions,
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 const (
 	auditKeyRoleUpsert = "role.upsert"
 	auditKeyRoleUpdate = "role.update"
+	auditKeyRoleDelete = "role.delete"
 )
 
 type UserService interface {
@@ -70,8 +71,8 @@ func (s Service) Get(ctx context.Context, id string) (Role, error) {
 	return s.repository.Get(ctx, id)
 }
 
-func (s Service) List(ctx context.Context) ([]Role, error) {
-	return s.repository.List(ctx)
+func (s Service) List(ctx context.Context, flt Filter) ([]Role, error) {
+	return s.repository.List(ctx, flt)
 }
 
 func (s Service) Update(ctx context.Context, toUpdate Role) (Role, error) {
@@ -101,3 +102,30 @@ func (s Service) Update(ctx context.Context, toUpdate Role) (Role, error) {
 
 	return updatedRole, nil
 }
+
+func (s Service) Delete(ctx context.Context, id string) error {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	deletedRole, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		roleLogData := deletedRole.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyRoleDelete, actor, roleLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return nil
+}
@@ -134,7 +134,7 @@ func TestService_List(t *testing.T) {
 			name: "should call repository if service being called",
 			id:   mockRole.ID,
 			setup: func(rr *mocks.Repository) {
-				rr.EXPECT().List(mock.AnythingOfType("context.todoCtx")).Return([]role.Role{mockRole}, nil)
+				rr.EXPECT().List(mock.AnythingOfType("context.todoCtx"), role.Filter{}).Return([]role.Role{mockRole}, nil)
 			},
 			want: []role.Role{mockRole},
 		},
@@ -144,7 +144,7 @@ func TestService_List(t *testing.T) {
 			rr := new(mocks.Repository)
 			tt.setup(rr)
 			s := role.NewService(log.NewNoop(), rr, nil, nil)
-			got, err := s.List(context.TODO())
+			got, err := s.List(context.TODO(), role.Filter{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.List() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -218,3 +218,55 @@ func TestService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Delete(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		setup  func(rr *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService)
+		ErrStr string
+	}{
+		{
+			name: "should return error if context has no user information",
+			id:   mockRole.ID,
+			setup: func(rr *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService) {
+				us.EXPECT().FetchCurrentUser(mock.AnythingOfType("context.todoCtx")).Return(user.User{}, errors.New("some error"))
+			},
+			ErrStr: "some error",
+		},
+		{
+			name: "should return error if role does not exist",
+			id:   mockRole.ID,
+			setup: func(rr *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService) {
+				us.EXPECT().FetchCurrentUser(mock.AnythingOfType("context.todoCtx")).Return(mockUser, nil)
+				rr.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), mockRole.ID).Return(role.Role{}, role.ErrNotExist)
+			},
+			ErrStr: role.ErrNotExist.Error(),
+		},
+		{
+			name: "should not return error if succeed",
+			id:   mockRole.ID,
+			setup: func(rr *mocks.Repository, us *mocks.UserService, as *mocks.ActivityService) {
+				us.EXPECT().FetchCurrentUser(mock.AnythingOfType("context.todoCtx")).Return(mockUser, nil)
+				rr.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), mockRole.ID).Return(mockRole, nil)
+				rr.EXPECT().Delete(mock.AnythingOfType("context.todoCtx"), mockRole.ID).Return(nil)
+				as.EXPECT().Log(mock.AnythingOfType("context.withoutCancelCtx"), mock.AnythingOfType("string"), mock.AnythingOfType("activity.Actor"), mock.AnythingOfType("role.LogData")).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := new(mocks.Repository)
+			as := new(mocks.ActivityService)
+			us := new(mocks.UserService)
+			tt.setup(rr, us, as)
+			s := role.NewService(log.NewNoop(), rr, us, as)
+			err := s.Delete(context.TODO(), tt.id)
+			if err != nil {
+				if err.Error() != tt.ErrStr {
+					t.Fatalf("got error %s, expected was %s", err.Error(), tt.ErrStr)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"gopkg.in/yaml.v2"
 )
 
@@ -18,9 +20,61 @@ type Backend struct {
 	Methods   []string   `yaml:"methods"`
 	Frontends []Frontend `yaml:"frontends"`
 	Prefix    string     `yaml:"prefix"`
+
+	// Timeout for a single request to the backend, e.g. 30s
+	Timeout        time.Duration  `yaml:"timeout"`
+	Retry          Retry          `yaml:"retry"`
+	CircuitBreaker CircuitBreaker `yaml:"circuit_breaker"`
+
+	// Targets load balances requests across weighted targets, used instead of Target
+	Targets     []Target    `yaml:"targets"`
+	HealthCheck HealthCheck `yaml:"health_check"`
+	Ejection    Ejection    `yaml:"ejection"`
+	// Sticky routes requests of the same user to the same target
+	Sticky bool `yaml:"sticky"`
+}
+
+type Target struct {
+	URL    string `yaml:"url"`
+	Weight int    `yaml:"weight"`
+}
+
+type HealthCheck struct {
+	// Path on every target which should respond with a 2xx status, empty disables active health checks
+	Path     string        `yaml:"path"`
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+	// UnhealthyThreshold of consecutive failed checks to mark a target unhealthy
+	UnhealthyThreshold int `yaml:"unhealthy_threshold"`
+}
+
+type Ejection struct {
+	// Errors is the number of consecutive failed requests to eject a target, 0 disables it
+	Errors int `yaml:"errors"`
+	// Duration a target stays ejected
+	Duration time.Duration `yaml:"duration"`
+}
+
+type Retry struct {
+	// Attempts is the number of retries for idempotent requests
+	Attempts int `yaml:"attempts"`
+	// Backoff before the first retry, doubled on every retry
+	Backoff time.Duration `yaml:"backoff"`
+	// GRPCMethods which are safe to retry as /package.Service/Method, or * for
+	// all of them. gRPC requests aren't retried otherwise as they are all POSTs.
+	GRPCMethods []string `yaml:"grpc_methods"`
+}
+
+type CircuitBreaker struct {
+	// Threshold of consecutive failures to open the circuit, 0 disables it
+	Threshold int `yaml:"threshold"`
+	// Cooldown is the duration the circuit stays open before a trial request
+	Cooldown time.Duration `yaml:"cooldown"`
 }
 
 type Frontend struct {
+	// Name identifies the rule in error responses
+	Name        string       `yaml:"name"`
 	Action      string       `yaml:"action"`
 	Path        string       `yaml:"path"`
 	Method      string       `yaml:"method"`
@@ -31,6 +85,9 @@ type Frontend struct {
 type Middleware struct {
 	Name   string                 `yaml:"name"`
 	Config map[string]interface{} `yaml:"config"`
+	// Mode is "enforce" by default, "shadow" records the decision of the
+	// middleware without denying requests
+	Mode string `yaml:"mode"`
 }
 
 type Hook struct {
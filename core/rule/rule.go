@@ -32,9 +32,22 @@ type Rule struct {
 	Hooks       HookSpecs       `yaml:"hooks"`
 }
 
+const (
+	// MiddlewareModeEnforce denies requests not allowed by the middleware
+	MiddlewareModeEnforce = "enforce"
+	// MiddlewareModeShadow only records the decision of the middleware and
+	// passes on every request, to validate a rule before enforcing it
+	MiddlewareModeShadow = "shadow"
+)
+
 type MiddlewareSpec struct {
 	Name   string                 `yaml:"name"`
 	Config map[string]interface{} `yaml:"config"`
+	Mode   string                 `yaml:"mode"`
+}
+
+func (m MiddlewareSpec) IsShadow() bool {
+	return m.Mode == MiddlewareModeShadow
 }
 
 type MiddlewareSpecs []MiddlewareSpec
@@ -48,6 +61,14 @@ func (m MiddlewareSpecs) Get(name string) (MiddlewareSpec, bool) {
 	return MiddlewareSpec{}, false
 }
 
+// Name of the rule, the path of its frontend if it isn't named
+func (r Rule) Name() string {
+	if r.Frontend.Name != "" {
+		return r.Frontend.Name
+	}
+	return r.Frontend.URL
+}
+
 type HookSpec struct {
 	Name   string                 `yaml:"name"`
 	Config map[string]interface{} `yaml:"config"`
@@ -65,6 +86,7 @@ func (m HookSpecs) Get(name string) (HookSpec, bool) {
 }
 
 type Frontend struct {
+	Name  string         `yaml:"name"`
 	URL   string         `yaml:"url"`
 	URLRx *regexp.Regexp `yaml:"-"`
 
@@ -75,18 +97,64 @@ type Backend struct {
 	URL       string `yaml:"url"`
 	Namespace string `yaml:"namespace"`
 	Prefix    string `yaml:"prefix"`
+
+	Timeout        time.Duration  `yaml:"timeout"`
+	Retry          Retry          `yaml:"retry"`
+	CircuitBreaker CircuitBreaker `yaml:"circuit_breaker"`
+
+	Targets     []Target    `yaml:"targets"`
+	HealthCheck HealthCheck `yaml:"health_check"`
+	Ejection    Ejection    `yaml:"ejection"`
+	Sticky      bool        `yaml:"sticky"`
+}
+
+type Target struct {
+	URL    string `yaml:"url"`
+	Weight int    `yaml:"weight"`
+}
+
+type HealthCheck struct {
+	Path               string        `yaml:"path"`
+	Interval           time.Duration `yaml:"interval"`
+	Timeout            time.Duration `yaml:"timeout"`
+	UnhealthyThreshold int           `yaml:"unhealthy_threshold"`
+}
+
+type Ejection struct {
+	Errors   int           `yaml:"errors"`
+	Duration time.Duration `yaml:"duration"`
+}
+
+type Retry struct {
+	Attempts    int           `yaml:"attempts"`
+	Backoff     time.Duration `yaml:"backoff"`
+	GRPCMethods []string      `yaml:"grpc_methods"`
+}
+
+type CircuitBreaker struct {
+	Threshold int           `yaml:"threshold"`
+	Cooldown  time.Duration `yaml:"cooldown"`
 }
 
 func YamlRulesetToRuleset(YamlRuleset config.Ruleset) Ruleset {
 	targetRuleSet := Ruleset{}
 	for _, theRule := range YamlRuleset.Rules {
 		for _, backend := range theRule.Backends {
+			var targets []Target
+			for _, target := range backend.Targets {
+				targets = append(targets, Target{
+					URL:    target.URL,
+					Weight: target.Weight,
+				})
+			}
+
 			for _, frontend := range backend.Frontends {
 				middlewares := MiddlewareSpecs{}
 				for _, middleware := range frontend.Middlewares {
 					middlewares = append(middlewares, MiddlewareSpec{
 						Name:   middleware.Name,
 						Config: middleware.Config,
+						Mode:   middleware.Mode,
 					})
 				}
 
@@ -100,10 +168,37 @@ func YamlRulesetToRuleset(YamlRuleset config.Ruleset) Ruleset {
 
 				targetRuleSet.Rules = append(targetRuleSet.Rules, Rule{
 					Frontend: Frontend{
+						Name:   frontend.Name,
 						URL:    frontend.Path,
 						Method: frontend.Method,
 					},
-					Backend:     Backend{URL: backend.Target, Namespace: backend.Name, Prefix: backend.Prefix},
+					Backend: Backend{
+						URL:       backend.Target,
+						Namespace: backend.Name,
+						Prefix:    backend.Prefix,
+						Timeout:   backend.Timeout,
+						Retry: Retry{
+							Attempts:    backend.Retry.Attempts,
+							Backoff:     backend.Retry.Backoff,
+							GRPCMethods: backend.Retry.GRPCMethods,
+						},
+						CircuitBreaker: CircuitBreaker{
+							Threshold: backend.CircuitBreaker.Threshold,
+							Cooldown:  backend.CircuitBreaker.Cooldown,
+						},
+						Targets: targets,
+						HealthCheck: HealthCheck{
+							Path:               backend.HealthCheck.Path,
+							Interval:           backend.HealthCheck.Interval,
+							Timeout:            backend.HealthCheck.Timeout,
+							UnhealthyThreshold: backend.HealthCheck.UnhealthyThreshold,
+						},
+						Ejection: Ejection{
+							Errors:   backend.Ejection.Errors,
+							Duration: backend.Ejection.Duration,
+						},
+						Sticky: backend.Sticky,
+					},
 					Middlewares: middlewares,
 					Hooks:       hooks,
 				})
@@ -0,0 +1,14 @@
+package serviceaccount
+
+import "context"
+
+type contextServiceAccountKey struct{}
+
+func SetContextWithServiceAccount(ctx context.Context, serviceAccount ServiceAccount) context.Context {
+	return context.WithValue(ctx, contextServiceAccountKey{}, serviceAccount)
+}
+
+func GetFromContext(ctx context.Context) (ServiceAccount, bool) {
+	serviceAccount, ok := ctx.Value(contextServiceAccountKey{}).(ServiceAccount)
+	return serviceAccount, ok
+}
@@ -0,0 +1,14 @@
+package serviceaccount
+
+import "errors"
+
+var (
+	ErrNotExist      = errors.New("service account doesn't exist")
+	ErrInvalidID     = errors.New("service account id is invalid")
+	ErrInvalidDetail = errors.New("invalid service account detail")
+	ErrConflict      = errors.New("service account already exist")
+	ErrKeyNotExist   = errors.New("service account key doesn't exist")
+	ErrInvalidKey    = errors.New("service account key is invalid")
+	ErrKeyRevoked    = errors.New("service account key is already revoked")
+	ErrLogActivity   = errors.New("error while logging activity")
+)
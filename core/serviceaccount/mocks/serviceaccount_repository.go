@@ -0,0 +1,500 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	serviceaccount "github.com/goto/shield/core/serviceaccount"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+type Repository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repository) EXPECT() *Repository_Expecter {
+	return &Repository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, serviceAccount
+func (_m *Repository) Create(ctx context.Context, serviceAccount serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error) {
+	ret := _m.Called(ctx, serviceAccount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 serviceaccount.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error)); ok {
+		return rf(ctx, serviceAccount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.ServiceAccount) serviceaccount.ServiceAccount); ok {
+		r0 = rf(ctx, serviceAccount)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, serviceaccount.ServiceAccount) error); ok {
+		r1 = rf(ctx, serviceAccount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccount serviceaccount.ServiceAccount
+func (_e *Repository_Expecter) Create(ctx interface{}, serviceAccount interface{}) *Repository_Create_Call {
+	return &Repository_Create_Call{Call: _e.mock.On("Create", ctx, serviceAccount)}
+}
+
+func (_c *Repository_Create_Call) Run(run func(ctx context.Context, serviceAccount serviceaccount.ServiceAccount)) *Repository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(serviceaccount.ServiceAccount))
+	})
+	return _c
+}
+
+func (_c *Repository_Create_Call) Return(_a0 serviceaccount.ServiceAccount, _a1 error) *Repository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_Create_Call) RunAndReturn(run func(context.Context, serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error)) *Repository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateKey provides a mock function with given fields: ctx, key
+func (_m *Repository) CreateKey(ctx context.Context, key serviceaccount.Key) (serviceaccount.Key, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKey")
+	}
+
+	var r0 serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.Key) (serviceaccount.Key, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.Key) serviceaccount.Key); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.Key)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, serviceaccount.Key) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_CreateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKey'
+type Repository_CreateKey_Call struct {
+	*mock.Call
+}
+
+// CreateKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key serviceaccount.Key
+func (_e *Repository_Expecter) CreateKey(ctx interface{}, key interface{}) *Repository_CreateKey_Call {
+	return &Repository_CreateKey_Call{Call: _e.mock.On("CreateKey", ctx, key)}
+}
+
+func (_c *Repository_CreateKey_Call) Run(run func(ctx context.Context, key serviceaccount.Key)) *Repository_CreateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(serviceaccount.Key))
+	})
+	return _c
+}
+
+func (_c *Repository_CreateKey_Call) Return(_a0 serviceaccount.Key, _a1 error) *Repository_CreateKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_CreateKey_Call) RunAndReturn(run func(context.Context, serviceaccount.Key) (serviceaccount.Key, error)) *Repository_CreateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetByID(ctx context.Context, id string) (serviceaccount.ServiceAccount, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 serviceaccount.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (serviceaccount.ServiceAccount, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) serviceaccount.ServiceAccount); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type Repository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) GetByID(ctx interface{}, id interface{}) *Repository_GetByID_Call {
+	return &Repository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *Repository_GetByID_Call) Run(run func(ctx context.Context, id string)) *Repository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) Return(_a0 serviceaccount.ServiceAccount, _a1 error) *Repository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) RunAndReturn(run func(context.Context, string) (serviceaccount.ServiceAccount, error)) *Repository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKey provides a mock function with given fields: ctx, id
+func (_m *Repository) GetKey(ctx context.Context, id string) (serviceaccount.Key, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKey")
+	}
+
+	var r0 serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (serviceaccount.Key, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) serviceaccount.Key); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.Key)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_GetKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKey'
+type Repository_GetKey_Call struct {
+	*mock.Call
+}
+
+// GetKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) GetKey(ctx interface{}, id interface{}) *Repository_GetKey_Call {
+	return &Repository_GetKey_Call{Call: _e.mock.On("GetKey", ctx, id)}
+}
+
+func (_c *Repository_GetKey_Call) Run(run func(ctx context.Context, id string)) *Repository_GetKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_GetKey_Call) Return(_a0 serviceaccount.Key, _a1 error) *Repository_GetKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_GetKey_Call) RunAndReturn(run func(context.Context, string) (serviceaccount.Key, error)) *Repository_GetKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, flt
+func (_m *Repository) List(ctx context.Context, flt serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []serviceaccount.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.Filter) []serviceaccount.ServiceAccount); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]serviceaccount.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, serviceaccount.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type Repository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt serviceaccount.Filter
+func (_e *Repository_Expecter) List(ctx interface{}, flt interface{}) *Repository_List_Call {
+	return &Repository_List_Call{Call: _e.mock.On("List", ctx, flt)}
+}
+
+func (_c *Repository_List_Call) Run(run func(ctx context.Context, flt serviceaccount.Filter)) *Repository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(serviceaccount.Filter))
+	})
+	return _c
+}
+
+func (_c *Repository_List_Call) Return(_a0 []serviceaccount.ServiceAccount, _a1 error) *Repository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_List_Call) RunAndReturn(run func(context.Context, serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error)) *Repository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKeys provides a mock function with given fields: ctx, serviceAccountID
+func (_m *Repository) ListKeys(ctx context.Context, serviceAccountID string) ([]serviceaccount.Key, error) {
+	ret := _m.Called(ctx, serviceAccountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKeys")
+	}
+
+	var r0 []serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]serviceaccount.Key, error)); ok {
+		return rf(ctx, serviceAccountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []serviceaccount.Key); ok {
+		r0 = rf(ctx, serviceAccountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]serviceaccount.Key)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceAccountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_ListKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKeys'
+type Repository_ListKeys_Call struct {
+	*mock.Call
+}
+
+// ListKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+func (_e *Repository_Expecter) ListKeys(ctx interface{}, serviceAccountID interface{}) *Repository_ListKeys_Call {
+	return &Repository_ListKeys_Call{Call: _e.mock.On("ListKeys", ctx, serviceAccountID)}
+}
+
+func (_c *Repository_ListKeys_Call) Run(run func(ctx context.Context, serviceAccountID string)) *Repository_ListKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_ListKeys_Call) Return(_a0 []serviceaccount.Key, _a1 error) *Repository_ListKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_ListKeys_Call) RunAndReturn(run func(context.Context, string) ([]serviceaccount.Key, error)) *Repository_ListKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeKey provides a mock function with given fields: ctx, id
+func (_m *Repository) RevokeKey(ctx context.Context, id string) (serviceaccount.Key, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeKey")
+	}
+
+	var r0 serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (serviceaccount.Key, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) serviceaccount.Key); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.Key)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_RevokeKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeKey'
+type Repository_RevokeKey_Call struct {
+	*mock.Call
+}
+
+// RevokeKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) RevokeKey(ctx interface{}, id interface{}) *Repository_RevokeKey_Call {
+	return &Repository_RevokeKey_Call{Call: _e.mock.On("RevokeKey", ctx, id)}
+}
+
+func (_c *Repository_RevokeKey_Call) Run(run func(ctx context.Context, id string)) *Repository_RevokeKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_RevokeKey_Call) Return(_a0 serviceaccount.Key, _a1 error) *Repository_RevokeKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_RevokeKey_Call) RunAndReturn(run func(context.Context, string) (serviceaccount.Key, error)) *Repository_RevokeKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateKeyExpiry provides a mock function with given fields: ctx, id, expiresAt
+func (_m *Repository) UpdateKeyExpiry(ctx context.Context, id string, expiresAt time.Time) (serviceaccount.Key, error) {
+	ret := _m.Called(ctx, id, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKeyExpiry")
+	}
+
+	var r0 serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (serviceaccount.Key, error)); ok {
+		return rf(ctx, id, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) serviceaccount.Key); ok {
+		r0 = rf(ctx, id, expiresAt)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.Key)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, id, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_UpdateKeyExpiry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateKeyExpiry'
+type Repository_UpdateKeyExpiry_Call struct {
+	*mock.Call
+}
+
+// UpdateKeyExpiry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - expiresAt time.Time
+func (_e *Repository_Expecter) UpdateKeyExpiry(ctx interface{}, id interface{}, expiresAt interface{}) *Repository_UpdateKeyExpiry_Call {
+	return &Repository_UpdateKeyExpiry_Call{Call: _e.mock.On("UpdateKeyExpiry", ctx, id, expiresAt)}
+}
+
+func (_c *Repository_UpdateKeyExpiry_Call) Run(run func(ctx context.Context, id string, expiresAt time.Time)) *Repository_UpdateKeyExpiry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateKeyExpiry_Call) Return(_a0 serviceaccount.Key, _a1 error) *Repository_UpdateKeyExpiry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_UpdateKeyExpiry_Call) RunAndReturn(run func(context.Context, string, time.Time) (serviceaccount.Key, error)) *Repository_UpdateKeyExpiry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,305 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/uuid"
+)
+
+const (
+	auditKeyServiceAccountCreate    = "serviceaccount.create"
+	auditKeyServiceAccountKeyCreate = "serviceaccount.key.create"
+	auditKeyServiceAccountKeyRotate = "serviceaccount.key.rotate"
+	auditKeyServiceAccountKeyRevoke = "serviceaccount.key.revoke"
+)
+
+type UserService interface {
+	FetchCurrentUser(ctx context.Context) (user.User, error)
+}
+
+type RelationService interface {
+	CheckPermission(ctx context.Context, usr user.User, resourceNS namespace.Namespace, resourceIdxa string, action action.Action) (bool, error)
+}
+
+type ActivityService interface {
+	Log(ctx context.Context, action string, actor activity.Actor, data any) error
+}
+
+type Service struct {
+	logger          log.Logger
+	repository      Repository
+	relationService RelationService
+	userService     UserService
+	activityService ActivityService
+}
+
+func NewService(logger log.Logger, repository Repository, relationService RelationService, userService UserService, activityService ActivityService) *Service {
+	return &Service{
+		logger:          logger,
+		repository:      repository,
+		relationService: relationService,
+		userService:     userService,
+		activityService: activityService,
+	}
+}
+
+// Create creates a service account in an organization, the current user
+// has to be able to edit the organization
+func (s Service) Create(ctx context.Context, serviceAccount ServiceAccount) (ServiceAccount, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+
+	if strings.TrimSpace(serviceAccount.Name) == "" || !uuid.IsValid(serviceAccount.OrganizationID) {
+		return ServiceAccount{}, ErrInvalidDetail
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, serviceAccount.OrganizationID); err != nil {
+		return ServiceAccount{}, err
+	}
+
+	newServiceAccount, err := s.repository.Create(ctx, serviceAccount)
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		serviceAccountLogData := newServiceAccount.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyServiceAccountCreate, actor, serviceAccountLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return newServiceAccount, nil
+}
+
+func (s Service) Get(ctx context.Context, id string) (ServiceAccount, error) {
+	if !uuid.IsValid(id) {
+		return ServiceAccount{}, ErrInvalidID
+	}
+	return s.repository.GetByID(ctx, id)
+}
+
+func (s Service) List(ctx context.Context, flt Filter) ([]ServiceAccount, error) {
+	return s.repository.List(ctx, flt)
+}
+
+// CreateKey issues a new key of the service account, a zero expiresAt creates
+// a key which never expires. The secret of the key is only returned here.
+func (s Service) CreateKey(ctx context.Context, serviceAccountID string, expiresAt time.Time) (Key, error) {
+	currentUser, serviceAccount, err := s.authorizeKeyManagement(ctx, serviceAccountID)
+	if err != nil {
+		return Key{}, err
+	}
+
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return Key{}, fmt.Errorf("%w: expiry has to be in the future", ErrInvalidDetail)
+	}
+
+	newKey, err := s.createKey(ctx, serviceAccount.ID, expiresAt)
+	if err != nil {
+		return Key{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		keyLogData := newKey.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyServiceAccountKeyCreate, actor, keyLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return newKey, nil
+}
+
+func (s Service) ListKeys(ctx context.Context, serviceAccountID string) ([]Key, error) {
+	serviceAccount, err := s.Get(ctx, serviceAccountID)
+	if err != nil {
+		return []Key{}, err
+	}
+	return s.repository.ListKeys(ctx, serviceAccount.ID)
+}
+
+// RotateKey issues a key replacing the given one, the replaced key keeps
+// working for the grace period so clients can switch over. The new key is
+// valid for as long as the replaced key was.
+func (s Service) RotateKey(ctx context.Context, serviceAccountID, keyID string, gracePeriod time.Duration) (Key, error) {
+	currentUser, serviceAccount, err := s.authorizeKeyManagement(ctx, serviceAccountID)
+	if err != nil {
+		return Key{}, err
+	}
+
+	oldKey, err := s.getKey(ctx, serviceAccount.ID, keyID)
+	if err != nil {
+		return Key{}, err
+	}
+	if !oldKey.RevokedAt.IsZero() {
+		return Key{}, ErrKeyRevoked
+	}
+
+	now := time.Now()
+	var expiresAt time.Time
+	if !oldKey.ExpiresAt.IsZero() {
+		expiresAt = now.Add(oldKey.ExpiresAt.Sub(oldKey.CreatedAt))
+	}
+
+	newKey, err := s.createKey(ctx, serviceAccount.ID, expiresAt)
+	if err != nil {
+		return Key{}, err
+	}
+
+	if gracePeriod <= 0 {
+		_, err = s.repository.RevokeKey(ctx, oldKey.ID)
+	} else if graceExpiry := now.Add(gracePeriod); oldKey.ExpiresAt.IsZero() || graceExpiry.Before(oldKey.ExpiresAt) {
+		_, err = s.repository.UpdateKeyExpiry(ctx, oldKey.ID, graceExpiry)
+	}
+	if err != nil {
+		return Key{}, err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		keyLogData := newKey.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyServiceAccountKeyRotate, actor, keyLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return newKey, nil
+}
+
+func (s Service) RevokeKey(ctx context.Context, serviceAccountID, keyID string) error {
+	currentUser, serviceAccount, err := s.authorizeKeyManagement(ctx, serviceAccountID)
+	if err != nil {
+		return err
+	}
+
+	key, err := s.getKey(ctx, serviceAccount.ID, keyID)
+	if err != nil {
+		return err
+	}
+	if !key.RevokedAt.IsZero() {
+		return ErrKeyRevoked
+	}
+
+	revokedKey, err := s.repository.RevokeKey(ctx, key.ID)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		keyLogData := revokedKey.ToLogData()
+		actor := activity.Actor{ID: currentUser.ID, Email: currentUser.Email}
+		if err := s.activityService.Log(ctx, auditKeyServiceAccountKeyRevoke, actor, keyLogData); err != nil {
+			s.logger.Error(fmt.Sprintf("%s: %s", ErrLogActivity.Error(), err.Error()))
+		}
+	}()
+
+	return nil
+}
+
+// Authenticate returns the service account of an active key, see Key.Token
+// for the format of the token
+func (s Service) Authenticate(ctx context.Context, token string) (ServiceAccount, error) {
+	keyID, secret, err := ParseToken(token)
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+	if !uuid.IsValid(keyID) {
+		return ServiceAccount{}, ErrInvalidKey
+	}
+
+	key, err := s.repository.GetKey(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotExist) {
+			return ServiceAccount{}, ErrInvalidKey
+		}
+		return ServiceAccount{}, err
+	}
+
+	if !verifySecret(secret, key.Hash) || !key.IsActive(time.Now()) {
+		return ServiceAccount{}, ErrInvalidKey
+	}
+
+	return s.repository.GetByID(ctx, key.ServiceAccountID)
+}
+
+func (s Service) createKey(ctx context.Context, serviceAccountID string, expiresAt time.Time) (Key, error) {
+	secret, hash, err := generateSecret()
+	if err != nil {
+		return Key{}, err
+	}
+
+	newKey, err := s.repository.CreateKey(ctx, Key{
+		ServiceAccountID: serviceAccountID,
+		Hash:             hash,
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		return Key{}, err
+	}
+	newKey.Secret = secret
+	return newKey, nil
+}
+
+func (s Service) getKey(ctx context.Context, serviceAccountID, keyID string) (Key, error) {
+	if !uuid.IsValid(keyID) {
+		return Key{}, ErrKeyNotExist
+	}
+
+	key, err := s.repository.GetKey(ctx, keyID)
+	if err != nil {
+		return Key{}, err
+	}
+	if key.ServiceAccountID != serviceAccountID {
+		return Key{}, ErrKeyNotExist
+	}
+	return key, nil
+}
+
+// authorizeKeyManagement checks the current user is able to edit the
+// organization of the service account
+func (s Service) authorizeKeyManagement(ctx context.Context, serviceAccountID string) (user.User, ServiceAccount, error) {
+	currentUser, err := s.userService.FetchCurrentUser(ctx)
+	if err != nil {
+		return user.User{}, ServiceAccount{}, err
+	}
+
+	serviceAccount, err := s.Get(ctx, serviceAccountID)
+	if err != nil {
+		return user.User{}, ServiceAccount{}, err
+	}
+
+	if err := s.checkEditPermission(ctx, currentUser, serviceAccount.OrganizationID); err != nil {
+		return user.User{}, ServiceAccount{}, err
+	}
+	return currentUser, serviceAccount, nil
+}
+
+func (s Service) checkEditPermission(ctx context.Context, usr user.User, orgID string) error {
+	permission, err := s.relationService.CheckPermission(ctx, usr, namespace.Namespace{ID: schema.OrganizationNamespace},
+		orgID, action.Action{ID: schema.EditPermission})
+	if err != nil {
+		return err
+	}
+	if !permission {
+		return errors.ErrForbidden
+	}
+	return nil
+}
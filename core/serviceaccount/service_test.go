@@ -0,0 +1,289 @@
+package serviceaccount_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/serviceaccount/mocks"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	errorsPkg "github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	testLogger = logger.InitLogger(logger.Config{
+		Level:  "info",
+		Format: "json",
+	})
+	testUser = user.User{
+		ID:    "9f256f86-31a3-11ec-8d3d-0242ac130003",
+		Email: "john.doe@gotocompany.com",
+	}
+	testServiceAccount = serviceaccount.ServiceAccount{
+		ID:             "0a1c2b7e-4b5e-4e1e-9c2a-1b6f5c6b7d01",
+		Name:           "deployer",
+		OrganizationID: "5fd3fbc4-4b1f-4b8d-a0c8-6c7d1f8a7c02",
+	}
+	testKeyID  = "7b3f0f0e-6d7b-4e0a-8d44-2a4a3a1c9d03"
+	testSecret = "c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0"
+)
+
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestService_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	token := serviceaccount.Key{ID: testKeyID, Secret: testSecret}.Token()
+
+	tests := []struct {
+		name    string
+		token   string
+		key     serviceaccount.Key
+		keyErr  error
+		want    serviceaccount.ServiceAccount
+		wantErr error
+	}{
+		{
+			name:  "should return the service account of an active key",
+			token: token,
+			key: serviceaccount.Key{
+				ID:               testKeyID,
+				ServiceAccountID: testServiceAccount.ID,
+				Hash:             hash(testSecret),
+			},
+			want: testServiceAccount,
+		},
+		{
+			name:  "should not authenticate an expired key",
+			token: token,
+			key: serviceaccount.Key{
+				ID:               testKeyID,
+				ServiceAccountID: testServiceAccount.ID,
+				Hash:             hash(testSecret),
+				ExpiresAt:        time.Now().Add(-time.Minute),
+			},
+			wantErr: serviceaccount.ErrInvalidKey,
+		},
+		{
+			name:  "should not authenticate a revoked key",
+			token: token,
+			key: serviceaccount.Key{
+				ID:               testKeyID,
+				ServiceAccountID: testServiceAccount.ID,
+				Hash:             hash(testSecret),
+				RevokedAt:        time.Now().Add(-time.Minute),
+			},
+			wantErr: serviceaccount.ErrInvalidKey,
+		},
+		{
+			name:  "should not authenticate a key with a wrong secret",
+			token: token,
+			key: serviceaccount.Key{
+				ID:               testKeyID,
+				ServiceAccountID: testServiceAccount.ID,
+				Hash:             hash("wrong"),
+			},
+			wantErr: serviceaccount.ErrInvalidKey,
+		},
+		{
+			name:    "should not authenticate a key which doesn't exist",
+			token:   token,
+			keyErr:  serviceaccount.ErrKeyNotExist,
+			wantErr: serviceaccount.ErrInvalidKey,
+		},
+		{
+			name:    "should not authenticate a malformed token",
+			token:   "shk_" + testKeyID,
+			wantErr: serviceaccount.ErrInvalidKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			repository.EXPECT().GetKey(mock.Anything, testKeyID).Return(tt.key, tt.keyErr).Maybe()
+			repository.EXPECT().GetByID(mock.Anything, testServiceAccount.ID).Return(testServiceAccount, nil).Maybe()
+			s := serviceaccount.NewService(testLogger, repository, &mocks.RelationService{}, &mocks.UserService{}, &mocks.ActivityService{})
+
+			got, err := s.Authenticate(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_CreateKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		allowed   bool
+		wantErr   error
+	}{
+		{
+			name:    "should create a key which can be used as a token",
+			allowed: true,
+		},
+		{
+			name:    "should not create a key without the edit permission of the organization",
+			allowed: false,
+			wantErr: errorsPkg.ErrForbidden,
+		},
+		{
+			name:      "should not create a key which has already expired",
+			expiresAt: time.Now().Add(-time.Hour),
+			allowed:   true,
+			wantErr:   serviceaccount.ErrInvalidDetail,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			repository.EXPECT().GetByID(mock.Anything, testServiceAccount.ID).Return(testServiceAccount, nil)
+			relationService.EXPECT().CheckPermission(mock.Anything, testUser, namespace.Namespace{ID: schema.OrganizationNamespace},
+				testServiceAccount.OrganizationID, action.Action{ID: schema.EditPermission}).Return(tt.allowed, nil)
+			repository.EXPECT().CreateKey(mock.Anything, mock.AnythingOfType("serviceaccount.Key")).
+				RunAndReturn(func(ctx context.Context, key serviceaccount.Key) (serviceaccount.Key, error) {
+					key.ID = testKeyID
+					return key, nil
+				}).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			s := serviceaccount.NewService(testLogger, repository, relationService, userService, activityService)
+
+			got, err := s.CreateKey(context.Background(), testServiceAccount.ID, tt.expiresAt)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEmpty(t, got.Secret)
+
+			// the secret of the token matches the stored hash
+			id, secret, err := serviceaccount.ParseToken(got.Token())
+			assert.NoError(t, err)
+			assert.Equal(t, testKeyID, id)
+			assert.Equal(t, hash(secret), got.Hash)
+		})
+	}
+}
+
+func TestService_RotateKey(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now().Add(-time.Hour)
+	activeKey := serviceaccount.Key{
+		ID:               testKeyID,
+		ServiceAccountID: testServiceAccount.ID,
+		ExpiresAt:        createdAt.Add(24 * time.Hour),
+		CreatedAt:        createdAt,
+	}
+
+	tests := []struct {
+		name        string
+		key         serviceaccount.Key
+		gracePeriod time.Duration
+		setup       func(repository *mocks.Repository)
+		wantErr     error
+	}{
+		{
+			name:        "should shorten the expiry of the replaced key to the grace period",
+			key:         activeKey,
+			gracePeriod: time.Hour,
+			setup: func(repository *mocks.Repository) {
+				repository.EXPECT().UpdateKeyExpiry(mock.Anything, testKeyID, mock.MatchedBy(func(expiresAt time.Time) bool {
+					return expiresAt.Before(activeKey.ExpiresAt)
+				})).Return(activeKey, nil)
+			},
+		},
+		{
+			name: "should revoke the replaced key without a grace period",
+			key:  activeKey,
+			setup: func(repository *mocks.Repository) {
+				repository.EXPECT().RevokeKey(mock.Anything, testKeyID).Return(activeKey, nil)
+			},
+		},
+		{
+			name: "should not rotate a revoked key",
+			key: serviceaccount.Key{
+				ID:               testKeyID,
+				ServiceAccountID: testServiceAccount.ID,
+				RevokedAt:        createdAt,
+			},
+			wantErr: serviceaccount.ErrKeyRevoked,
+		},
+		{
+			name: "should not rotate a key of another service account",
+			key: serviceaccount.Key{
+				ID:               testKeyID,
+				ServiceAccountID: "other",
+			},
+			wantErr: serviceaccount.ErrKeyNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &mocks.Repository{}
+			relationService := &mocks.RelationService{}
+			userService := &mocks.UserService{}
+			activityService := &mocks.ActivityService{}
+			userService.EXPECT().FetchCurrentUser(mock.Anything).Return(testUser, nil)
+			repository.EXPECT().GetByID(mock.Anything, testServiceAccount.ID).Return(testServiceAccount, nil)
+			relationService.EXPECT().CheckPermission(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			repository.EXPECT().GetKey(mock.Anything, testKeyID).Return(tt.key, nil)
+			repository.EXPECT().CreateKey(mock.Anything, mock.AnythingOfType("serviceaccount.Key")).
+				RunAndReturn(func(ctx context.Context, key serviceaccount.Key) (serviceaccount.Key, error) {
+					key.ID = "new-key-id"
+					return key, nil
+				}).Maybe()
+			activityService.EXPECT().Log(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			if tt.setup != nil {
+				tt.setup(repository)
+			}
+			s := serviceaccount.NewService(testLogger, repository, relationService, userService, activityService)
+
+			got, err := s.RotateKey(context.Background(), testServiceAccount.ID, testKeyID, tt.gracePeriod)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "new-key-id", got.ID)
+			// the new key is valid for as long as the replaced key was
+			assert.WithinDuration(t, time.Now().Add(24*time.Hour), got.ExpiresAt, time.Minute)
+			repository.AssertExpectations(t)
+		})
+	}
+}
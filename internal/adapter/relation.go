@@ -71,6 +71,9 @@ func (a Relation) TransformRelation(ctx context.Context, rlt relation.RelationV2
 		}
 		rel.Subject.Namespace = schema.GroupPrincipal
 		rel.Subject.ID = groupID
+	} else if rel.Subject.Namespace == "serviceaccount" {
+		// service accounts are only referred to by their ID
+		rel.Subject.Namespace = schema.ServiceAccountPrincipal
 	}
 
 	// Group
@@ -1,35 +1,43 @@
 package api
 
 import (
+	"github.com/goto/shield/core/accessrequest"
 	"github.com/goto/shield/core/action"
 	"github.com/goto/shield/core/activity"
 	"github.com/goto/shield/core/group"
+	"github.com/goto/shield/core/invitation"
 	"github.com/goto/shield/core/namespace"
 	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/platform"
 	"github.com/goto/shield/core/policy"
 	"github.com/goto/shield/core/project"
 	"github.com/goto/shield/core/relation"
 	"github.com/goto/shield/core/resource"
 	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/servicedata"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/adapter"
 )
 
 type Deps struct {
-	OrgService         *organization.Service
-	ProjectService     *project.Service
-	GroupService       *group.Service
-	RoleService        *role.Service
-	PolicyService      *policy.Service
-	UserService        *user.Service
-	NamespaceService   *namespace.Service
-	ActionService      *action.Service
-	RelationService    *relation.Service
-	RelationAdapter    *adapter.Relation
-	ResourceService    *resource.Service
-	RuleService        *rule.Service
-	ActivityService    *activity.Service
-	ServiceDataService *servicedata.Service
+	OrgService            *organization.Service
+	ProjectService        *project.Service
+	GroupService          *group.Service
+	RoleService           *role.Service
+	PolicyService         *policy.Service
+	UserService           *user.Service
+	NamespaceService      *namespace.Service
+	ActionService         *action.Service
+	RelationService       *relation.Service
+	RelationAdapter       *adapter.Relation
+	ResourceService       *resource.Service
+	RuleService           *rule.Service
+	ActivityService       *activity.Service
+	ServiceDataService    *servicedata.Service
+	ServiceAccountService *serviceaccount.Service
+	PlatformService       *platform.Service
+	InvitationService     *invitation.Service
+	AccessRequestService  *accessrequest.Service
 }
@@ -0,0 +1,175 @@
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/shield/core/accessrequest"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/pkg/errors"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AccessRequestService interface {
+	Create(ctx context.Context, accessRequest accessrequest.AccessRequest) (accessrequest.AccessRequest, error)
+	List(ctx context.Context, flt accessrequest.Filter) ([]accessrequest.AccessRequest, error)
+	Approve(ctx context.Context, id string, expiresAt time.Time, comment string) (accessrequest.AccessRequest, error)
+	Reject(ctx context.Context, id, comment string) (accessrequest.AccessRequest, error)
+}
+
+var (
+	grpcAccessRequestNotFoundErr       = status.Errorf(codes.NotFound, "access request doesn't exist")
+	grpcAccessRequestNotPendingErr     = status.Errorf(codes.FailedPrecondition, accessrequest.ErrNotPending.Error())
+	grpcAccessRequestSelfReviewErr     = status.Errorf(codes.PermissionDenied, accessrequest.ErrSelfReview.Error())
+	grpcAccessRequestAlreadyGrantedErr = status.Errorf(codes.AlreadyExists, accessrequest.ErrAlreadyGranted.Error())
+)
+
+func (h Handler) ListAccessRequests(ctx context.Context, request *shieldv1beta1.ListAccessRequestsRequest) (*shieldv1beta1.ListAccessRequestsResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	// the namespace and id of the object are given together
+	if (request.GetObjectNamespace() == "") != (request.GetObjectId() == "") {
+		return nil, grpcBadBodyError
+	}
+
+	accessRequestList, err := h.accessRequestService.List(ctx, accessrequest.Filter{
+		NamespaceID: request.GetObjectNamespace(),
+		ObjectID:    request.GetObjectId(),
+		State:       accessrequest.State(request.GetState()),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, accessrequest.ErrInvalidDetail):
+			return nil, grpcBadBodyError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	var accessRequests []*shieldv1beta1.AccessRequest
+	for _, ar := range accessRequestList {
+		accessRequests = append(accessRequests, transformAccessRequestToPB(ar))
+	}
+
+	return &shieldv1beta1.ListAccessRequestsResponse{AccessRequests: accessRequests}, nil
+}
+
+func (h Handler) CreateAccessRequest(ctx context.Context, request *shieldv1beta1.CreateAccessRequestRequest) (*shieldv1beta1.CreateAccessRequestResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if request.GetBody() == nil {
+		return nil, grpcBadBodyError
+	}
+
+	newAccessRequest, err := h.accessRequestService.Create(ctx, accessrequest.AccessRequest{
+		NamespaceID: request.GetBody().GetObjectNamespace(),
+		ObjectID:    request.GetBody().GetObjectId(),
+		RoleID:      request.GetBody().GetRole(),
+		Reason:      request.GetBody().GetReason(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, accessrequest.ErrInvalidDetail):
+			return nil, grpcBadBodyError
+		case errors.Is(err, accessrequest.ErrConflict):
+			return nil, grpcConflictError
+		case errors.Is(err, accessrequest.ErrAlreadyGranted):
+			return nil, grpcAccessRequestAlreadyGrantedErr
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.CreateAccessRequestResponse{AccessRequest: transformAccessRequestToPB(newAccessRequest)}, nil
+}
+
+func (h Handler) ApproveAccessRequest(ctx context.Context, request *shieldv1beta1.ApproveAccessRequestRequest) (*shieldv1beta1.ApproveAccessRequestResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	var expiresAt time.Time
+	if request.GetExpiresAt() != nil {
+		expiresAt = request.GetExpiresAt().AsTime()
+	}
+
+	approvedAccessRequest, err := h.accessRequestService.Approve(ctx, request.GetId(), expiresAt, request.GetComment())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, accessRequestReviewErr(err)
+	}
+
+	return &shieldv1beta1.ApproveAccessRequestResponse{AccessRequest: transformAccessRequestToPB(approvedAccessRequest)}, nil
+}
+
+func (h Handler) RejectAccessRequest(ctx context.Context, request *shieldv1beta1.RejectAccessRequestRequest) (*shieldv1beta1.RejectAccessRequestResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	rejectedAccessRequest, err := h.accessRequestService.Reject(ctx, request.GetId(), request.GetComment())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, accessRequestReviewErr(err)
+	}
+
+	return &shieldv1beta1.RejectAccessRequestResponse{AccessRequest: transformAccessRequestToPB(rejectedAccessRequest)}, nil
+}
+
+// accessRequestReviewErr maps the errors of approving or rejecting an access request
+func accessRequestReviewErr(err error) error {
+	switch {
+	case errors.Is(err, accessrequest.ErrNotExist),
+		errors.Is(err, accessrequest.ErrInvalidID):
+		return grpcAccessRequestNotFoundErr
+	case errors.Is(err, accessrequest.ErrNotPending):
+		return grpcAccessRequestNotPendingErr
+	case errors.Is(err, accessrequest.ErrSelfReview):
+		return grpcAccessRequestSelfReviewErr
+	case errors.Is(err, accessrequest.ErrAlreadyGranted):
+		return grpcAccessRequestAlreadyGrantedErr
+	case errors.Is(err, accessrequest.ErrInvalidDetail):
+		return grpcBadBodyError
+	case errors.Is(err, errors.ErrForbidden):
+		return grpcPermissionDenied
+	case errors.Is(err, user.ErrInvalidEmail),
+		errors.Is(err, user.ErrMissingEmail):
+		return grpcUnauthenticated
+	default:
+		return grpcInternalServerError
+	}
+}
+
+func transformAccessRequestToPB(ar accessrequest.AccessRequest) *shieldv1beta1.AccessRequest {
+	accessRequestPB := &shieldv1beta1.AccessRequest{
+		Id:              ar.ID,
+		UserId:          ar.UserID,
+		ObjectNamespace: ar.NamespaceID,
+		ObjectId:        ar.ObjectID,
+		Role:            ar.RoleID,
+		Reason:          ar.Reason,
+		State:           string(ar.State),
+		ReviewedBy:      ar.ReviewedBy,
+		ReviewComment:   ar.ReviewComment,
+		CreatedAt:       timestamppb.New(ar.CreatedAt),
+		UpdatedAt:       timestamppb.New(ar.UpdatedAt),
+	}
+	if !ar.ReviewedAt.IsZero() {
+		accessRequestPB.ReviewedAt = timestamppb.New(ar.ReviewedAt)
+	}
+	if !ar.ExpiresAt.IsZero() {
+		accessRequestPB.ExpiresAt = timestamppb.New(ar.ExpiresAt)
+	}
+	return accessRequestPB
+}
@@ -0,0 +1,311 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/accessrequest"
+	"github.com/goto/shield/internal/api/v1beta1/mocks"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/uuid"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testAccessRequestExpiry = time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC)
+	testAccessRequest       = accessrequest.AccessRequest{
+		ID:          uuid.NewString(),
+		UserID:      uuid.NewString(),
+		NamespaceID: schema.ProjectNamespace,
+		ObjectID:    uuid.NewString(),
+		RoleID:      schema.ViewerRole,
+		Reason:      "debugging an incident",
+		State:       accessrequest.StatePending,
+	}
+	testAccessRequestPB = &shieldv1beta1.AccessRequest{
+		Id:              testAccessRequest.ID,
+		UserId:          testAccessRequest.UserID,
+		ObjectNamespace: testAccessRequest.NamespaceID,
+		ObjectId:        testAccessRequest.ObjectID,
+		Role:            testAccessRequest.RoleID,
+		Reason:          testAccessRequest.Reason,
+		State:           string(accessrequest.StatePending),
+		CreatedAt:       timestamppb.New(time.Time{}),
+		UpdatedAt:       timestamppb.New(time.Time{}),
+	}
+)
+
+func TestHandler_CreateAccessRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AccessRequestService)
+		request *shieldv1beta1.CreateAccessRequestRequest
+		want    *shieldv1beta1.CreateAccessRequestResponse
+		wantErr error
+	}{
+		{
+			name:    "should return bad body error if body is empty",
+			request: &shieldv1beta1.CreateAccessRequestRequest{},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return conflict error if the role is already requested",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Create(mock.AnythingOfType("context.todoCtx"), mock.AnythingOfType("accessrequest.AccessRequest")).
+					Return(accessrequest.AccessRequest{}, accessrequest.ErrConflict)
+			},
+			request: &shieldv1beta1.CreateAccessRequestRequest{Body: &shieldv1beta1.AccessRequestRequestBody{
+				ObjectNamespace: testAccessRequest.NamespaceID,
+				ObjectId:        testAccessRequest.ObjectID,
+				Role:            testAccessRequest.RoleID,
+			}},
+			want:    nil,
+			wantErr: grpcConflictError,
+		},
+		{
+			name: "should return already exists error if the user has the role",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Create(mock.AnythingOfType("context.todoCtx"), mock.AnythingOfType("accessrequest.AccessRequest")).
+					Return(accessrequest.AccessRequest{}, accessrequest.ErrAlreadyGranted)
+			},
+			request: &shieldv1beta1.CreateAccessRequestRequest{Body: &shieldv1beta1.AccessRequestRequestBody{
+				ObjectNamespace: testAccessRequest.NamespaceID,
+				ObjectId:        testAccessRequest.ObjectID,
+				Role:            testAccessRequest.RoleID,
+			}},
+			want:    nil,
+			wantErr: grpcAccessRequestAlreadyGrantedErr,
+		},
+		{
+			name: "should return the new access request if no error",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Create(mock.AnythingOfType("context.todoCtx"), accessrequest.AccessRequest{
+					NamespaceID: testAccessRequest.NamespaceID,
+					ObjectID:    testAccessRequest.ObjectID,
+					RoleID:      testAccessRequest.RoleID,
+					Reason:      testAccessRequest.Reason,
+				}).Return(testAccessRequest, nil)
+			},
+			request: &shieldv1beta1.CreateAccessRequestRequest{Body: &shieldv1beta1.AccessRequestRequestBody{
+				ObjectNamespace: testAccessRequest.NamespaceID,
+				ObjectId:        testAccessRequest.ObjectID,
+				Role:            testAccessRequest.RoleID,
+				Reason:          testAccessRequest.Reason,
+			}},
+			want:    &shieldv1beta1.CreateAccessRequestResponse{AccessRequest: testAccessRequestPB},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccessRequestService := new(mocks.AccessRequestService)
+			if tt.setup != nil {
+				tt.setup(mockAccessRequestService)
+			}
+			mockDep := Handler{accessRequestService: mockAccessRequestService}
+			resp, err := mockDep.CreateAccessRequest(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_ListAccessRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AccessRequestService)
+		request *shieldv1beta1.ListAccessRequestsRequest
+		want    *shieldv1beta1.ListAccessRequestsResponse
+		wantErr error
+	}{
+		{
+			name:    "should return bad body error if only the object id is given",
+			request: &shieldv1beta1.ListAccessRequestsRequest{ObjectId: testAccessRequest.ObjectID},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return the access requests of the current user without an object",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().List(mock.AnythingOfType("context.todoCtx"), accessrequest.Filter{State: accessrequest.StatePending}).
+					Return([]accessrequest.AccessRequest{testAccessRequest}, nil)
+			},
+			request: &shieldv1beta1.ListAccessRequestsRequest{State: string(accessrequest.StatePending)},
+			want:    &shieldv1beta1.ListAccessRequestsResponse{AccessRequests: []*shieldv1beta1.AccessRequest{testAccessRequestPB}},
+			wantErr: nil,
+		},
+		{
+			name: "should return permission denied error if current user can't edit the object",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().List(mock.AnythingOfType("context.todoCtx"), accessrequest.Filter{
+					NamespaceID: testAccessRequest.NamespaceID,
+					ObjectID:    testAccessRequest.ObjectID,
+				}).Return(nil, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.ListAccessRequestsRequest{
+				ObjectNamespace: testAccessRequest.NamespaceID,
+				ObjectId:        testAccessRequest.ObjectID,
+			},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccessRequestService := new(mocks.AccessRequestService)
+			if tt.setup != nil {
+				tt.setup(mockAccessRequestService)
+			}
+			mockDep := Handler{accessRequestService: mockAccessRequestService}
+			resp, err := mockDep.ListAccessRequests(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_ApproveAccessRequest(t *testing.T) {
+	approvedAccessRequest := testAccessRequest
+	approvedAccessRequest.State = accessrequest.StateApproved
+	approvedAccessRequest.ExpiresAt = testAccessRequestExpiry
+
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AccessRequestService)
+		request *shieldv1beta1.ApproveAccessRequestRequest
+		want    *shieldv1beta1.ApproveAccessRequestResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if the access request doesn't exist",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Approve(mock.AnythingOfType("context.todoCtx"), testAccessRequest.ID, time.Time{}, "").
+					Return(accessrequest.AccessRequest{}, accessrequest.ErrNotExist)
+			},
+			request: &shieldv1beta1.ApproveAccessRequestRequest{Id: testAccessRequest.ID},
+			want:    nil,
+			wantErr: grpcAccessRequestNotFoundErr,
+		},
+		{
+			name: "should return failed precondition error if the access request is already reviewed",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Approve(mock.AnythingOfType("context.todoCtx"), testAccessRequest.ID, time.Time{}, "").
+					Return(accessrequest.AccessRequest{}, accessrequest.ErrNotPending)
+			},
+			request: &shieldv1beta1.ApproveAccessRequestRequest{Id: testAccessRequest.ID},
+			want:    nil,
+			wantErr: grpcAccessRequestNotPendingErr,
+		},
+		{
+			name: "should return permission denied error if the requester approves their own request",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Approve(mock.AnythingOfType("context.todoCtx"), testAccessRequest.ID, time.Time{}, "").
+					Return(accessrequest.AccessRequest{}, accessrequest.ErrSelfReview)
+			},
+			request: &shieldv1beta1.ApproveAccessRequestRequest{Id: testAccessRequest.ID},
+			want:    nil,
+			wantErr: grpcAccessRequestSelfReviewErr,
+		},
+		{
+			name: "should return the approved access request with its expiry if no error",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Approve(mock.AnythingOfType("context.todoCtx"), testAccessRequest.ID, testAccessRequestExpiry, "for the incident").
+					Return(approvedAccessRequest, nil)
+			},
+			request: &shieldv1beta1.ApproveAccessRequestRequest{
+				Id:        testAccessRequest.ID,
+				ExpiresAt: timestamppb.New(testAccessRequestExpiry),
+				Comment:   "for the incident",
+			},
+			want: &shieldv1beta1.ApproveAccessRequestResponse{AccessRequest: &shieldv1beta1.AccessRequest{
+				Id:              testAccessRequest.ID,
+				UserId:          testAccessRequest.UserID,
+				ObjectNamespace: testAccessRequest.NamespaceID,
+				ObjectId:        testAccessRequest.ObjectID,
+				Role:            testAccessRequest.RoleID,
+				Reason:          testAccessRequest.Reason,
+				State:           string(accessrequest.StateApproved),
+				ExpiresAt:       timestamppb.New(testAccessRequestExpiry),
+				CreatedAt:       timestamppb.New(time.Time{}),
+				UpdatedAt:       timestamppb.New(time.Time{}),
+			}},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccessRequestService := new(mocks.AccessRequestService)
+			if tt.setup != nil {
+				tt.setup(mockAccessRequestService)
+			}
+			mockDep := Handler{accessRequestService: mockAccessRequestService}
+			resp, err := mockDep.ApproveAccessRequest(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RejectAccessRequest(t *testing.T) {
+	rejectedAccessRequest := testAccessRequest
+	rejectedAccessRequest.State = accessrequest.StateRejected
+	rejectedAccessRequest.ReviewComment = "not needed"
+
+	tests := []struct {
+		name    string
+		setup   func(as *mocks.AccessRequestService)
+		request *shieldv1beta1.RejectAccessRequestRequest
+		want    *shieldv1beta1.RejectAccessRequestResponse
+		wantErr error
+	}{
+		{
+			name: "should return permission denied error if current user can't edit the object",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Reject(mock.AnythingOfType("context.todoCtx"), testAccessRequest.ID, "").
+					Return(accessrequest.AccessRequest{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.RejectAccessRequestRequest{Id: testAccessRequest.ID},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return the rejected access request if no error",
+			setup: func(as *mocks.AccessRequestService) {
+				as.EXPECT().Reject(mock.AnythingOfType("context.todoCtx"), testAccessRequest.ID, "not needed").
+					Return(rejectedAccessRequest, nil)
+			},
+			request: &shieldv1beta1.RejectAccessRequestRequest{Id: testAccessRequest.ID, Comment: "not needed"},
+			want: &shieldv1beta1.RejectAccessRequestResponse{AccessRequest: &shieldv1beta1.AccessRequest{
+				Id:              testAccessRequest.ID,
+				UserId:          testAccessRequest.UserID,
+				ObjectNamespace: testAccessRequest.NamespaceID,
+				ObjectId:        testAccessRequest.ObjectID,
+				Role:            testAccessRequest.RoleID,
+				Reason:          testAccessRequest.Reason,
+				State:           string(accessrequest.StateRejected),
+				ReviewComment:   "not needed",
+				CreatedAt:       timestamppb.New(time.Time{}),
+				UpdatedAt:       timestamppb.New(time.Time{}),
+			}},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccessRequestService := new(mocks.AccessRequestService)
+			if tt.setup != nil {
+				tt.setup(mockAccessRequestService)
+			}
+			mockDep := Handler{accessRequestService: mockAccessRequestService}
+			resp, err := mockDep.RejectAccessRequest(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/shield/core/invitation"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type InvitationService interface {
+	Create(ctx context.Context, invitation invitation.Invitation) (invitation.Invitation, error)
+	List(ctx context.Context, flt invitation.Filter) ([]invitation.Invitation, error)
+	Revoke(ctx context.Context, id string) error
+	Accept(ctx context.Context, id string) (invitation.Invitation, error)
+}
+
+var (
+	grpcInvitationNotFoundErr   = status.Errorf(codes.NotFound, "invitation doesn't exist")
+	grpcInvitationNotPendingErr = status.Errorf(codes.FailedPrecondition, invitation.ErrNotPending.Error())
+)
+
+func (h Handler) ListInvitations(ctx context.Context, request *shieldv1beta1.ListInvitationsRequest) (*shieldv1beta1.ListInvitationsResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	var flt invitation.Filter
+	if request.GetOrgId() != "" || request.GetGroupId() != "" {
+		namespaceID, objectID, ok := invitationTarget(request.GetOrgId(), request.GetGroupId())
+		if !ok {
+			return nil, grpcBadBodyError
+		}
+		flt = invitation.Filter{NamespaceID: namespaceID, ObjectID: objectID}
+	}
+
+	invitationList, err := h.invitationService.List(ctx, flt)
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, invitation.ErrInvalidDetail):
+			return nil, grpcBadBodyError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	var invitations []*shieldv1beta1.Invitation
+	for _, inv := range invitationList {
+		invitations = append(invitations, transformInvitationToPB(inv))
+	}
+
+	return &shieldv1beta1.ListInvitationsResponse{Invitations: invitations}, nil
+}
+
+func (h Handler) CreateInvitation(ctx context.Context, request *shieldv1beta1.CreateInvitationRequest) (*shieldv1beta1.CreateInvitationResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if request.GetBody() == nil {
+		return nil, grpcBadBodyError
+	}
+
+	namespaceID, objectID, ok := invitationTarget(request.GetBody().GetOrgId(), request.GetBody().GetGroupId())
+	if !ok {
+		return nil, grpcBadBodyError
+	}
+
+	newInvitation, err := h.invitationService.Create(ctx, invitation.Invitation{
+		Email:       request.GetBody().GetEmail(),
+		NamespaceID: namespaceID,
+		ObjectID:    objectID,
+		RoleID:      request.GetBody().GetRole(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, invitation.ErrInvalidDetail):
+			return nil, grpcBadBodyError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.CreateInvitationResponse{Invitation: transformInvitationToPB(newInvitation)}, nil
+}
+
+func (h Handler) RevokeInvitation(ctx context.Context, request *shieldv1beta1.RevokeInvitationRequest) (*shieldv1beta1.RevokeInvitationResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if err := h.invitationService.Revoke(ctx, request.GetId()); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, invitation.ErrNotExist),
+			errors.Is(err, invitation.ErrInvalidID):
+			return nil, grpcInvitationNotFoundErr
+		case errors.Is(err, invitation.ErrNotPending):
+			return nil, grpcInvitationNotPendingErr
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.RevokeInvitationResponse{}, nil
+}
+
+func (h Handler) AcceptInvitation(ctx context.Context, request *shieldv1beta1.AcceptInvitationRequest) (*shieldv1beta1.AcceptInvitationResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	acceptedInvitation, err := h.invitationService.Accept(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, invitation.ErrNotExist),
+			errors.Is(err, invitation.ErrInvalidID):
+			return nil, grpcInvitationNotFoundErr
+		case errors.Is(err, invitation.ErrNotPending):
+			return nil, grpcInvitationNotPendingErr
+		case errors.Is(err, invitation.ErrEmailMismatch):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.AcceptInvitationResponse{Invitation: transformInvitationToPB(acceptedInvitation)}, nil
+}
+
+// invitationTarget returns the namespace and id of the organization or group
+// an invitation is to, exactly one of them has to be given
+func invitationTarget(orgID, groupID string) (string, string, bool) {
+	switch {
+	case orgID != "" && groupID == "":
+		return schema.OrganizationNamespace, orgID, true
+	case groupID != "" && orgID == "":
+		return schema.GroupNamespace, groupID, true
+	default:
+		return "", "", false
+	}
+}
+
+func transformInvitationToPB(inv invitation.Invitation) *shieldv1beta1.Invitation {
+	invitationPB := &shieldv1beta1.Invitation{
+		Id:        inv.ID,
+		Email:     inv.Email,
+		Role:      inv.RoleID,
+		InvitedBy: inv.InvitedBy,
+		ExpiresAt: timestamppb.New(inv.ExpiresAt),
+		CreatedAt: timestamppb.New(inv.CreatedAt),
+	}
+	switch inv.NamespaceID {
+	case schema.OrganizationNamespace:
+		invitationPB.OrgId = inv.ObjectID
+	case schema.GroupNamespace:
+		invitationPB.GroupId = inv.ObjectID
+	}
+	if !inv.AcceptedAt.IsZero() {
+		invitationPB.AcceptedAt = timestamppb.New(inv.AcceptedAt)
+	}
+	if !inv.RevokedAt.IsZero() {
+		invitationPB.RevokedAt = timestamppb.New(inv.RevokedAt)
+	}
+	return invitationPB
+}
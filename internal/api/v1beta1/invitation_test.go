@@ -0,0 +1,281 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/invitation"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/api/v1beta1/mocks"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/uuid"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testInvitationExpiry = time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC)
+	testInvitation       = invitation.Invitation{
+		ID:          uuid.NewString(),
+		Email:       "jane.doe@gotocompany.com",
+		NamespaceID: schema.GroupNamespace,
+		ObjectID:    uuid.NewString(),
+		RoleID:      schema.MemberRole,
+		InvitedBy:   uuid.NewString(),
+		ExpiresAt:   testInvitationExpiry,
+	}
+	testInvitationPB = &shieldv1beta1.Invitation{
+		Id:        testInvitation.ID,
+		Email:     testInvitation.Email,
+		GroupId:   testInvitation.ObjectID,
+		Role:      testInvitation.RoleID,
+		InvitedBy: testInvitation.InvitedBy,
+		ExpiresAt: timestamppb.New(testInvitationExpiry),
+		CreatedAt: timestamppb.New(time.Time{}),
+	}
+)
+
+func TestHandler_CreateInvitation(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(is *mocks.InvitationService)
+		request *shieldv1beta1.CreateInvitationRequest
+		want    *shieldv1beta1.CreateInvitationResponse
+		wantErr error
+	}{
+		{
+			name: "should return bad body error if both org and group are given",
+			request: &shieldv1beta1.CreateInvitationRequest{Body: &shieldv1beta1.InvitationRequestBody{
+				Email:   testInvitation.Email,
+				OrgId:   uuid.NewString(),
+				GroupId: testInvitation.ObjectID,
+				Role:    testInvitation.RoleID,
+			}},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return permission denied error if current user can't edit the group",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Create(mock.AnythingOfType("context.todoCtx"), invitation.Invitation{
+					Email:       testInvitation.Email,
+					NamespaceID: schema.GroupNamespace,
+					ObjectID:    testInvitation.ObjectID,
+					RoleID:      testInvitation.RoleID,
+				}).Return(invitation.Invitation{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.CreateInvitationRequest{Body: &shieldv1beta1.InvitationRequestBody{
+				Email:   testInvitation.Email,
+				GroupId: testInvitation.ObjectID,
+				Role:    testInvitation.RoleID,
+			}},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return bad body error if the role can't be granted to users",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Create(mock.AnythingOfType("context.todoCtx"), mock.AnythingOfType("invitation.Invitation")).
+					Return(invitation.Invitation{}, invitation.ErrInvalidDetail)
+			},
+			request: &shieldv1beta1.CreateInvitationRequest{Body: &shieldv1beta1.InvitationRequestBody{
+				Email:   testInvitation.Email,
+				GroupId: testInvitation.ObjectID,
+				Role:    schema.OwnerRole,
+			}},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return the new invitation if no error",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Create(mock.AnythingOfType("context.todoCtx"), invitation.Invitation{
+					Email:       testInvitation.Email,
+					NamespaceID: schema.GroupNamespace,
+					ObjectID:    testInvitation.ObjectID,
+					RoleID:      testInvitation.RoleID,
+				}).Return(testInvitation, nil)
+			},
+			request: &shieldv1beta1.CreateInvitationRequest{Body: &shieldv1beta1.InvitationRequestBody{
+				Email:   testInvitation.Email,
+				GroupId: testInvitation.ObjectID,
+				Role:    testInvitation.RoleID,
+			}},
+			want:    &shieldv1beta1.CreateInvitationResponse{Invitation: testInvitationPB},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockInvitationService := new(mocks.InvitationService)
+			if tt.setup != nil {
+				tt.setup(mockInvitationService)
+			}
+			mockDep := Handler{invitationService: mockInvitationService}
+			resp, err := mockDep.CreateInvitation(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_ListInvitations(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(is *mocks.InvitationService)
+		request *shieldv1beta1.ListInvitationsRequest
+		want    *shieldv1beta1.ListInvitationsResponse
+		wantErr error
+	}{
+		{
+			name: "should return the invitations of the current user without a target",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().List(mock.AnythingOfType("context.todoCtx"), invitation.Filter{}).Return([]invitation.Invitation{testInvitation}, nil)
+			},
+			request: &shieldv1beta1.ListInvitationsRequest{},
+			want:    &shieldv1beta1.ListInvitationsResponse{Invitations: []*shieldv1beta1.Invitation{testInvitationPB}},
+			wantErr: nil,
+		},
+		{
+			name: "should return permission denied error if current user can't edit the group",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().List(mock.AnythingOfType("context.todoCtx"), invitation.Filter{
+					NamespaceID: schema.GroupNamespace,
+					ObjectID:    testInvitation.ObjectID,
+				}).Return(nil, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.ListInvitationsRequest{GroupId: testInvitation.ObjectID},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockInvitationService := new(mocks.InvitationService)
+			if tt.setup != nil {
+				tt.setup(mockInvitationService)
+			}
+			mockDep := Handler{invitationService: mockInvitationService}
+			resp, err := mockDep.ListInvitations(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RevokeInvitation(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(is *mocks.InvitationService)
+		request *shieldv1beta1.RevokeInvitationRequest
+		want    *shieldv1beta1.RevokeInvitationResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if invitation doesn't exist",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Revoke(mock.AnythingOfType("context.todoCtx"), testInvitation.ID).Return(invitation.ErrNotExist)
+			},
+			request: &shieldv1beta1.RevokeInvitationRequest{Id: testInvitation.ID},
+			want:    nil,
+			wantErr: grpcInvitationNotFoundErr,
+		},
+		{
+			name: "should return failed precondition error if invitation isn't pending",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Revoke(mock.AnythingOfType("context.todoCtx"), testInvitation.ID).Return(invitation.ErrNotPending)
+			},
+			request: &shieldv1beta1.RevokeInvitationRequest{Id: testInvitation.ID},
+			want:    nil,
+			wantErr: grpcInvitationNotPendingErr,
+		},
+		{
+			name: "should revoke the invitation if no error",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Revoke(mock.AnythingOfType("context.todoCtx"), testInvitation.ID).Return(nil)
+			},
+			request: &shieldv1beta1.RevokeInvitationRequest{Id: testInvitation.ID},
+			want:    &shieldv1beta1.RevokeInvitationResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockInvitationService := new(mocks.InvitationService)
+			if tt.setup != nil {
+				tt.setup(mockInvitationService)
+			}
+			mockDep := Handler{invitationService: mockInvitationService}
+			resp, err := mockDep.RevokeInvitation(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_AcceptInvitation(t *testing.T) {
+	acceptedAt := time.Date(2024, time.October, 22, 0, 0, 0, 0, time.UTC)
+	acceptedInvitation := testInvitation
+	acceptedInvitation.AcceptedAt = acceptedAt
+
+	tests := []struct {
+		name    string
+		setup   func(is *mocks.InvitationService)
+		request *shieldv1beta1.AcceptInvitationRequest
+		want    *shieldv1beta1.AcceptInvitationResponse
+		wantErr error
+	}{
+		{
+			name: "should return unauthenticated error if email is missing",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Accept(mock.AnythingOfType("context.todoCtx"), testInvitation.ID).Return(invitation.Invitation{}, user.ErrMissingEmail)
+			},
+			request: &shieldv1beta1.AcceptInvitationRequest{Id: testInvitation.ID},
+			want:    nil,
+			wantErr: grpcUnauthenticated,
+		},
+		{
+			name: "should return permission denied error if invitation is for another email",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Accept(mock.AnythingOfType("context.todoCtx"), testInvitation.ID).Return(invitation.Invitation{}, invitation.ErrEmailMismatch)
+			},
+			request: &shieldv1beta1.AcceptInvitationRequest{Id: testInvitation.ID},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return the accepted invitation if no error",
+			setup: func(is *mocks.InvitationService) {
+				is.EXPECT().Accept(mock.AnythingOfType("context.todoCtx"), testInvitation.ID).Return(acceptedInvitation, nil)
+			},
+			request: &shieldv1beta1.AcceptInvitationRequest{Id: testInvitation.ID},
+			want: &shieldv1beta1.AcceptInvitationResponse{Invitation: &shieldv1beta1.Invitation{
+				Id:         testInvitation.ID,
+				Email:      testInvitation.Email,
+				GroupId:    testInvitation.ObjectID,
+				Role:       testInvitation.RoleID,
+				InvitedBy:  testInvitation.InvitedBy,
+				ExpiresAt:  timestamppb.New(testInvitationExpiry),
+				AcceptedAt: timestamppb.New(acceptedAt),
+				CreatedAt:  timestamppb.New(time.Time{}),
+			}},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockInvitationService := new(mocks.InvitationService)
+			if tt.setup != nil {
+				tt.setup(mockInvitationService)
+			}
+			mockDep := Handler{invitationService: mockInvitationService}
+			resp, err := mockDep.AcceptInvitation(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	accessrequest "github.com/goto/shield/core/accessrequest"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AccessRequestService is an autogenerated mock type for the AccessRequestService type
+type AccessRequestService struct {
+	mock.Mock
+}
+
+type AccessRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AccessRequestService) EXPECT() *AccessRequestService_Expecter {
+	return &AccessRequestService_Expecter{mock: &_m.Mock}
+}
+
+// Approve provides a mock function with given fields: ctx, id, expiresAt, comment
+func (_m *AccessRequestService) Approve(ctx context.Context, id string, expiresAt time.Time, comment string) (accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, id, expiresAt, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Approve")
+	}
+
+	var r0 accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string) (accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, id, expiresAt, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string) accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, id, expiresAt, comment)
+	} else {
+		r0 = ret.Get(0).(accessrequest.AccessRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, string) error); ok {
+		r1 = rf(ctx, id, expiresAt, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessRequestService_Approve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Approve'
+type AccessRequestService_Approve_Call struct {
+	*mock.Call
+}
+
+// Approve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - expiresAt time.Time
+//   - comment string
+func (_e *AccessRequestService_Expecter) Approve(ctx interface{}, id interface{}, expiresAt interface{}, comment interface{}) *AccessRequestService_Approve_Call {
+	return &AccessRequestService_Approve_Call{Call: _e.mock.On("Approve", ctx, id, expiresAt, comment)}
+}
+
+func (_c *AccessRequestService_Approve_Call) Run(run func(ctx context.Context, id string, expiresAt time.Time, comment string)) *AccessRequestService_Approve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AccessRequestService_Approve_Call) Return(_a0 accessrequest.AccessRequest, _a1 error) *AccessRequestService_Approve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessRequestService_Approve_Call) RunAndReturn(run func(context.Context, string, time.Time, string) (accessrequest.AccessRequest, error)) *AccessRequestService_Approve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, accessRequest
+func (_m *AccessRequestService) Create(ctx context.Context, accessRequest accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, accessRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.AccessRequest) (accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, accessRequest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.AccessRequest) accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, accessRequest)
+	} else {
+		r0 = ret.Get(0).(accessrequest.AccessRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accessrequest.AccessRequest) error); ok {
+		r1 = rf(ctx, accessRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessRequestService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AccessRequestService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessRequest accessrequest.AccessRequest
+func (_e *AccessRequestService_Expecter) Create(ctx interface{}, accessRequest interface{}) *AccessRequestService_Create_Call {
+	return &AccessRequestService_Create_Call{Call: _e.mock.On("Create", ctx, accessRequest)}
+}
+
+func (_c *AccessRequestService_Create_Call) Run(run func(ctx context.Context, accessRequest accessrequest.AccessRequest)) *AccessRequestService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accessrequest.AccessRequest))
+	})
+	return _c
+}
+
+func (_c *AccessRequestService_Create_Call) Return(_a0 accessrequest.AccessRequest, _a1 error) *AccessRequestService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessRequestService_Create_Call) RunAndReturn(run func(context.Context, accessrequest.AccessRequest) (accessrequest.AccessRequest, error)) *AccessRequestService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, flt
+func (_m *AccessRequestService) List(ctx context.Context, flt accessrequest.Filter) ([]accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.Filter) ([]accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accessrequest.Filter) []accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accessrequest.AccessRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accessrequest.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessRequestService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type AccessRequestService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt accessrequest.Filter
+func (_e *AccessRequestService_Expecter) List(ctx interface{}, flt interface{}) *AccessRequestService_List_Call {
+	return &AccessRequestService_List_Call{Call: _e.mock.On("List", ctx, flt)}
+}
+
+func (_c *AccessRequestService_List_Call) Run(run func(ctx context.Context, flt accessrequest.Filter)) *AccessRequestService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accessrequest.Filter))
+	})
+	return _c
+}
+
+func (_c *AccessRequestService_List_Call) Return(_a0 []accessrequest.AccessRequest, _a1 error) *AccessRequestService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessRequestService_List_Call) RunAndReturn(run func(context.Context, accessrequest.Filter) ([]accessrequest.AccessRequest, error)) *AccessRequestService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reject provides a mock function with given fields: ctx, id, comment
+func (_m *AccessRequestService) Reject(ctx context.Context, id string, comment string) (accessrequest.AccessRequest, error) {
+	ret := _m.Called(ctx, id, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reject")
+	}
+
+	var r0 accessrequest.AccessRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (accessrequest.AccessRequest, error)); ok {
+		return rf(ctx, id, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) accessrequest.AccessRequest); ok {
+		r0 = rf(ctx, id, comment)
+	} else {
+		r0 = ret.Get(0).(accessrequest.AccessRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccessRequestService_Reject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reject'
+type AccessRequestService_Reject_Call struct {
+	*mock.Call
+}
+
+// Reject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - comment string
+func (_e *AccessRequestService_Expecter) Reject(ctx interface{}, id interface{}, comment interface{}) *AccessRequestService_Reject_Call {
+	return &AccessRequestService_Reject_Call{Call: _e.mock.On("Reject", ctx, id, comment)}
+}
+
+func (_c *AccessRequestService_Reject_Call) Run(run func(ctx context.Context, id string, comment string)) *AccessRequestService_Reject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AccessRequestService_Reject_Call) Return(_a0 accessrequest.AccessRequest, _a1 error) *AccessRequestService_Reject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccessRequestService_Reject_Call) RunAndReturn(run func(context.Context, string, string) (accessrequest.AccessRequest, error)) *AccessRequestService_Reject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAccessRequestService creates a new instance of AccessRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAccessRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AccessRequestService {
+	mock := &AccessRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
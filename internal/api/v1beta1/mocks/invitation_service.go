@@ -0,0 +1,257 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	invitation "github.com/goto/shield/core/invitation"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InvitationService is an autogenerated mock type for the InvitationService type
+type InvitationService struct {
+	mock.Mock
+}
+
+type InvitationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InvitationService) EXPECT() *InvitationService_Expecter {
+	return &InvitationService_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function with given fields: ctx, id
+func (_m *InvitationService) Accept(ctx context.Context, id string) (invitation.Invitation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (invitation.Invitation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) invitation.Invitation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(invitation.Invitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type InvitationService_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *InvitationService_Expecter) Accept(ctx interface{}, id interface{}) *InvitationService_Accept_Call {
+	return &InvitationService_Accept_Call{Call: _e.mock.On("Accept", ctx, id)}
+}
+
+func (_c *InvitationService_Accept_Call) Run(run func(ctx context.Context, id string)) *InvitationService_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationService_Accept_Call) Return(_a0 invitation.Invitation, _a1 error) *InvitationService_Accept_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_Accept_Call) RunAndReturn(run func(context.Context, string) (invitation.Invitation, error)) *InvitationService_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *InvitationService) Create(ctx context.Context, _a1 invitation.Invitation) (invitation.Invitation, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Invitation) (invitation.Invitation, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Invitation) invitation.Invitation); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(invitation.Invitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, invitation.Invitation) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type InvitationService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 invitation.Invitation
+func (_e *InvitationService_Expecter) Create(ctx interface{}, _a1 interface{}) *InvitationService_Create_Call {
+	return &InvitationService_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *InvitationService_Create_Call) Run(run func(ctx context.Context, _a1 invitation.Invitation)) *InvitationService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(invitation.Invitation))
+	})
+	return _c
+}
+
+func (_c *InvitationService_Create_Call) Return(_a0 invitation.Invitation, _a1 error) *InvitationService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_Create_Call) RunAndReturn(run func(context.Context, invitation.Invitation) (invitation.Invitation, error)) *InvitationService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, flt
+func (_m *InvitationService) List(ctx context.Context, flt invitation.Filter) ([]invitation.Invitation, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []invitation.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Filter) ([]invitation.Invitation, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, invitation.Filter) []invitation.Invitation); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]invitation.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, invitation.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvitationService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type InvitationService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt invitation.Filter
+func (_e *InvitationService_Expecter) List(ctx interface{}, flt interface{}) *InvitationService_List_Call {
+	return &InvitationService_List_Call{Call: _e.mock.On("List", ctx, flt)}
+}
+
+func (_c *InvitationService_List_Call) Run(run func(ctx context.Context, flt invitation.Filter)) *InvitationService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(invitation.Filter))
+	})
+	return _c
+}
+
+func (_c *InvitationService_List_Call) Return(_a0 []invitation.Invitation, _a1 error) *InvitationService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvitationService_List_Call) RunAndReturn(run func(context.Context, invitation.Filter) ([]invitation.Invitation, error)) *InvitationService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, id
+func (_m *InvitationService) Revoke(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InvitationService_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type InvitationService_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *InvitationService_Expecter) Revoke(ctx interface{}, id interface{}) *InvitationService_Revoke_Call {
+	return &InvitationService_Revoke_Call{Call: _e.mock.On("Revoke", ctx, id)}
+}
+
+func (_c *InvitationService_Revoke_Call) Run(run func(ctx context.Context, id string)) *InvitationService_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InvitationService_Revoke_Call) Return(_a0 error) *InvitationService_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvitationService_Revoke_Call) RunAndReturn(run func(context.Context, string) error) *InvitationService_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInvitationService creates a new instance of InvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvitationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvitationService {
+	mock := &InvitationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,8 @@ import (
 	organization "github.com/goto/shield/core/organization"
 	mock "github.com/stretchr/testify/mock"
 
+	role "github.com/goto/shield/core/role"
+
 	user "github.com/goto/shield/core/user"
 )
 
@@ -24,6 +26,66 @@ func (_m *OrganizationService) EXPECT() *OrganizationService_Expecter {
 	return &OrganizationService_Expecter{mock: &_m.Mock}
 }
 
+// AddAdmins provides a mock function with given fields: ctx, idOrSlug, userIds
+func (_m *OrganizationService) AddAdmins(ctx context.Context, idOrSlug string, userIds []string) ([]user.User, error) {
+	ret := _m.Called(ctx, idOrSlug, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAdmins")
+	}
+
+	var r0 []user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]user.User, error)); ok {
+		return rf(ctx, idOrSlug, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []user.User); ok {
+		r0 = rf(ctx, idOrSlug, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, idOrSlug, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_AddAdmins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAdmins'
+type OrganizationService_AddAdmins_Call struct {
+	*mock.Call
+}
+
+// AddAdmins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idOrSlug string
+//   - userIds []string
+func (_e *OrganizationService_Expecter) AddAdmins(ctx interface{}, idOrSlug interface{}, userIds interface{}) *OrganizationService_AddAdmins_Call {
+	return &OrganizationService_AddAdmins_Call{Call: _e.mock.On("AddAdmins", ctx, idOrSlug, userIds)}
+}
+
+func (_c *OrganizationService_AddAdmins_Call) Run(run func(ctx context.Context, idOrSlug string, userIds []string)) *OrganizationService_AddAdmins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_AddAdmins_Call) Return(_a0 []user.User, _a1 error) *OrganizationService_AddAdmins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_AddAdmins_Call) RunAndReturn(run func(context.Context, string, []string) ([]user.User, error)) *OrganizationService_AddAdmins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, org
 func (_m *OrganizationService) Create(ctx context.Context, org organization.Organization) (organization.Organization, error) {
 	ret := _m.Called(ctx, org)
@@ -81,6 +143,112 @@ func (_c *OrganizationService_Create_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// CreateRole provides a mock function with given fields: ctx, idOrSlug, toCreate
+func (_m *OrganizationService) CreateRole(ctx context.Context, idOrSlug string, toCreate role.Role) (role.Role, error) {
+	ret := _m.Called(ctx, idOrSlug, toCreate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 role.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, role.Role) (role.Role, error)); ok {
+		return rf(ctx, idOrSlug, toCreate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, role.Role) role.Role); ok {
+		r0 = rf(ctx, idOrSlug, toCreate)
+	} else {
+		r0 = ret.Get(0).(role.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, role.Role) error); ok {
+		r1 = rf(ctx, idOrSlug, toCreate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type OrganizationService_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idOrSlug string
+//   - toCreate role.Role
+func (_e *OrganizationService_Expecter) CreateRole(ctx interface{}, idOrSlug interface{}, toCreate interface{}) *OrganizationService_CreateRole_Call {
+	return &OrganizationService_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, idOrSlug, toCreate)}
+}
+
+func (_c *OrganizationService_CreateRole_Call) Run(run func(ctx context.Context, idOrSlug string, toCreate role.Role)) *OrganizationService_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(role.Role))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_CreateRole_Call) Return(_a0 role.Role, _a1 error) *OrganizationService_CreateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_CreateRole_Call) RunAndReturn(run func(context.Context, string, role.Role) (role.Role, error)) *OrganizationService_CreateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function with given fields: ctx, idOrSlug, roleID
+func (_m *OrganizationService) DeleteRole(ctx context.Context, idOrSlug string, roleID string) error {
+	ret := _m.Called(ctx, idOrSlug, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, idOrSlug, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrganizationService_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type OrganizationService_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idOrSlug string
+//   - roleID string
+func (_e *OrganizationService_Expecter) DeleteRole(ctx interface{}, idOrSlug interface{}, roleID interface{}) *OrganizationService_DeleteRole_Call {
+	return &OrganizationService_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, idOrSlug, roleID)}
+}
+
+func (_c *OrganizationService_DeleteRole_Call) Run(run func(ctx context.Context, idOrSlug string, roleID string)) *OrganizationService_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_DeleteRole_Call) Return(_a0 error) *OrganizationService_DeleteRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrganizationService_DeleteRole_Call) RunAndReturn(run func(context.Context, string, string) error) *OrganizationService_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, idOrSlug
 func (_m *OrganizationService) Get(ctx context.Context, idOrSlug string) (organization.Organization, error) {
 	ret := _m.Called(ctx, idOrSlug)
@@ -255,6 +423,66 @@ func (_c *OrganizationService_ListAdmins_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RemoveAdmin provides a mock function with given fields: ctx, idOrSlug, userId
+func (_m *OrganizationService) RemoveAdmin(ctx context.Context, idOrSlug string, userId string) ([]user.User, error) {
+	ret := _m.Called(ctx, idOrSlug, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAdmin")
+	}
+
+	var r0 []user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]user.User, error)); ok {
+		return rf(ctx, idOrSlug, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []user.User); ok {
+		r0 = rf(ctx, idOrSlug, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, idOrSlug, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationService_RemoveAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAdmin'
+type OrganizationService_RemoveAdmin_Call struct {
+	*mock.Call
+}
+
+// RemoveAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idOrSlug string
+//   - userId string
+func (_e *OrganizationService_Expecter) RemoveAdmin(ctx interface{}, idOrSlug interface{}, userId interface{}) *OrganizationService_RemoveAdmin_Call {
+	return &OrganizationService_RemoveAdmin_Call{Call: _e.mock.On("RemoveAdmin", ctx, idOrSlug, userId)}
+}
+
+func (_c *OrganizationService_RemoveAdmin_Call) Run(run func(ctx context.Context, idOrSlug string, userId string)) *OrganizationService_RemoveAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrganizationService_RemoveAdmin_Call) Return(_a0 []user.User, _a1 error) *OrganizationService_RemoveAdmin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganizationService_RemoveAdmin_Call) RunAndReturn(run func(context.Context, string, string) ([]user.User, error)) *OrganizationService_RemoveAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, toUpdate
 func (_m *OrganizationService) Update(ctx context.Context, toUpdate organization.Organization) (organization.Organization, error) {
 	ret := _m.Called(ctx, toUpdate)
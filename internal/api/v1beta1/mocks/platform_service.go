@@ -0,0 +1,245 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	user "github.com/goto/shield/core/user"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PlatformService is an autogenerated mock type for the PlatformService type
+type PlatformService struct {
+	mock.Mock
+}
+
+type PlatformService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PlatformService) EXPECT() *PlatformService_Expecter {
+	return &PlatformService_Expecter{mock: &_m.Mock}
+}
+
+// AddAdmin provides a mock function with given fields: ctx, userID
+func (_m *PlatformService) AddAdmin(ctx context.Context, userID string) (user.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAdmin")
+	}
+
+	var r0 user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(user.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlatformService_AddAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAdmin'
+type PlatformService_AddAdmin_Call struct {
+	*mock.Call
+}
+
+// AddAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *PlatformService_Expecter) AddAdmin(ctx interface{}, userID interface{}) *PlatformService_AddAdmin_Call {
+	return &PlatformService_AddAdmin_Call{Call: _e.mock.On("AddAdmin", ctx, userID)}
+}
+
+func (_c *PlatformService_AddAdmin_Call) Run(run func(ctx context.Context, userID string)) *PlatformService_AddAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PlatformService_AddAdmin_Call) Return(_a0 user.User, _a1 error) *PlatformService_AddAdmin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlatformService_AddAdmin_Call) RunAndReturn(run func(context.Context, string) (user.User, error)) *PlatformService_AddAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddImpersonator provides a mock function with given fields: ctx, userID
+func (_m *PlatformService) AddImpersonator(ctx context.Context, userID string) (user.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddImpersonator")
+	}
+
+	var r0 user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(user.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlatformService_AddImpersonator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddImpersonator'
+type PlatformService_AddImpersonator_Call struct {
+	*mock.Call
+}
+
+// AddImpersonator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *PlatformService_Expecter) AddImpersonator(ctx interface{}, userID interface{}) *PlatformService_AddImpersonator_Call {
+	return &PlatformService_AddImpersonator_Call{Call: _e.mock.On("AddImpersonator", ctx, userID)}
+}
+
+func (_c *PlatformService_AddImpersonator_Call) Run(run func(ctx context.Context, userID string)) *PlatformService_AddImpersonator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PlatformService_AddImpersonator_Call) Return(_a0 user.User, _a1 error) *PlatformService_AddImpersonator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlatformService_AddImpersonator_Call) RunAndReturn(run func(context.Context, string) (user.User, error)) *PlatformService_AddImpersonator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAdmin provides a mock function with given fields: ctx, userID
+func (_m *PlatformService) RemoveAdmin(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAdmin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PlatformService_RemoveAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAdmin'
+type PlatformService_RemoveAdmin_Call struct {
+	*mock.Call
+}
+
+// RemoveAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *PlatformService_Expecter) RemoveAdmin(ctx interface{}, userID interface{}) *PlatformService_RemoveAdmin_Call {
+	return &PlatformService_RemoveAdmin_Call{Call: _e.mock.On("RemoveAdmin", ctx, userID)}
+}
+
+func (_c *PlatformService_RemoveAdmin_Call) Run(run func(ctx context.Context, userID string)) *PlatformService_RemoveAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PlatformService_RemoveAdmin_Call) Return(_a0 error) *PlatformService_RemoveAdmin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PlatformService_RemoveAdmin_Call) RunAndReturn(run func(context.Context, string) error) *PlatformService_RemoveAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveImpersonator provides a mock function with given fields: ctx, userID
+func (_m *PlatformService) RemoveImpersonator(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveImpersonator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PlatformService_RemoveImpersonator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveImpersonator'
+type PlatformService_RemoveImpersonator_Call struct {
+	*mock.Call
+}
+
+// RemoveImpersonator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *PlatformService_Expecter) RemoveImpersonator(ctx interface{}, userID interface{}) *PlatformService_RemoveImpersonator_Call {
+	return &PlatformService_RemoveImpersonator_Call{Call: _e.mock.On("RemoveImpersonator", ctx, userID)}
+}
+
+func (_c *PlatformService_RemoveImpersonator_Call) Run(run func(ctx context.Context, userID string)) *PlatformService_RemoveImpersonator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PlatformService_RemoveImpersonator_Call) Return(_a0 error) *PlatformService_RemoveImpersonator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PlatformService_RemoveImpersonator_Call) RunAndReturn(run func(context.Context, string) error) *PlatformService_RemoveImpersonator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPlatformService creates a new instance of PlatformService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlatformService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PlatformService {
+	mock := &PlatformService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,6 +9,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	project "github.com/goto/shield/core/project"
+
 	relation "github.com/goto/shield/core/relation"
 
 	resource "github.com/goto/shield/core/resource"
@@ -322,6 +324,64 @@ func (_c *ResourceService_ListAllUserResources_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ListSchemaVersions provides a mock function with given fields: ctx
+func (_m *ResourceService) ListSchemaVersions(ctx context.Context) ([]schema.SchemaVersion, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSchemaVersions")
+	}
+
+	var r0 []schema.SchemaVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schema.SchemaVersion, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schema.SchemaVersion); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.SchemaVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListSchemaVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSchemaVersions'
+type ResourceService_ListSchemaVersions_Call struct {
+	*mock.Call
+}
+
+// ListSchemaVersions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ResourceService_Expecter) ListSchemaVersions(ctx interface{}) *ResourceService_ListSchemaVersions_Call {
+	return &ResourceService_ListSchemaVersions_Call{Call: _e.mock.On("ListSchemaVersions", ctx)}
+}
+
+func (_c *ResourceService_ListSchemaVersions_Call) Run(run func(ctx context.Context)) *ResourceService_ListSchemaVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListSchemaVersions_Call) Return(_a0 []schema.SchemaVersion, _a1 error) *ResourceService_ListSchemaVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListSchemaVersions_Call) RunAndReturn(run func(context.Context) ([]schema.SchemaVersion, error)) *ResourceService_ListSchemaVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserResourcesByType provides a mock function with given fields: ctx, userID, resourceType, permissions
 func (_m *ResourceService) ListUserResourcesByType(ctx context.Context, userID string, resourceType string, permissions []string) (map[string][]string, error) {
 	ret := _m.Called(ctx, userID, resourceType, permissions)
@@ -383,6 +443,182 @@ func (_c *ResourceService_ListUserResourcesByType_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// PlanConfig provides a mock function with given fields: ctx, name, config
+func (_m *ResourceService) PlanConfig(ctx context.Context, name string, config string) (schema.Plan, error) {
+	ret := _m.Called(ctx, name, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanConfig")
+	}
+
+	var r0 schema.Plan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (schema.Plan, error)); ok {
+		return rf(ctx, name, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) schema.Plan); ok {
+		r0 = rf(ctx, name, config)
+	} else {
+		r0 = ret.Get(0).(schema.Plan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_PlanConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanConfig'
+type ResourceService_PlanConfig_Call struct {
+	*mock.Call
+}
+
+// PlanConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - config string
+func (_e *ResourceService_Expecter) PlanConfig(ctx interface{}, name interface{}, config interface{}) *ResourceService_PlanConfig_Call {
+	return &ResourceService_PlanConfig_Call{Call: _e.mock.On("PlanConfig", ctx, name, config)}
+}
+
+func (_c *ResourceService_PlanConfig_Call) Run(run func(ctx context.Context, name string, config string)) *ResourceService_PlanConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_PlanConfig_Call) Return(_a0 schema.Plan, _a1 error) *ResourceService_PlanConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_PlanConfig_Call) RunAndReturn(run func(context.Context, string, string) (schema.Plan, error)) *ResourceService_PlanConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProjectVisibility provides a mock function with given fields: ctx, idOrSlug, permission, public
+func (_m *ResourceService) SetProjectVisibility(ctx context.Context, idOrSlug string, permission string, public bool) (project.Project, error) {
+	ret := _m.Called(ctx, idOrSlug, permission, public)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProjectVisibility")
+	}
+
+	var r0 project.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (project.Project, error)); ok {
+		return rf(ctx, idOrSlug, permission, public)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) project.Project); ok {
+		r0 = rf(ctx, idOrSlug, permission, public)
+	} else {
+		r0 = ret.Get(0).(project.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, idOrSlug, permission, public)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_SetProjectVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProjectVisibility'
+type ResourceService_SetProjectVisibility_Call struct {
+	*mock.Call
+}
+
+// SetProjectVisibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idOrSlug string
+//   - permission string
+//   - public bool
+func (_e *ResourceService_Expecter) SetProjectVisibility(ctx interface{}, idOrSlug interface{}, permission interface{}, public interface{}) *ResourceService_SetProjectVisibility_Call {
+	return &ResourceService_SetProjectVisibility_Call{Call: _e.mock.On("SetProjectVisibility", ctx, idOrSlug, permission, public)}
+}
+
+func (_c *ResourceService_SetProjectVisibility_Call) Run(run func(ctx context.Context, idOrSlug string, permission string, public bool)) *ResourceService_SetProjectVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ResourceService_SetProjectVisibility_Call) Return(_a0 project.Project, _a1 error) *ResourceService_SetProjectVisibility_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_SetProjectVisibility_Call) RunAndReturn(run func(context.Context, string, string, bool) (project.Project, error)) *ResourceService_SetProjectVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetVisibility provides a mock function with given fields: ctx, id, permission, public
+func (_m *ResourceService) SetVisibility(ctx context.Context, id string, permission string, public bool) (resource.Resource, error) {
+	ret := _m.Called(ctx, id, permission, public)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetVisibility")
+	}
+
+	var r0 resource.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (resource.Resource, error)); ok {
+		return rf(ctx, id, permission, public)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) resource.Resource); ok {
+		r0 = rf(ctx, id, permission, public)
+	} else {
+		r0 = ret.Get(0).(resource.Resource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, id, permission, public)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_SetVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVisibility'
+type ResourceService_SetVisibility_Call struct {
+	*mock.Call
+}
+
+// SetVisibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - permission string
+//   - public bool
+func (_e *ResourceService_Expecter) SetVisibility(ctx interface{}, id interface{}, permission interface{}, public interface{}) *ResourceService_SetVisibility_Call {
+	return &ResourceService_SetVisibility_Call{Call: _e.mock.On("SetVisibility", ctx, id, permission, public)}
+}
+
+func (_c *ResourceService_SetVisibility_Call) Run(run func(ctx context.Context, id string, permission string, public bool)) *ResourceService_SetVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ResourceService_SetVisibility_Call) Return(_a0 resource.Resource, _a1 error) *ResourceService_SetVisibility_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_SetVisibility_Call) RunAndReturn(run func(context.Context, string, string, bool) (resource.Resource, error)) *ResourceService_SetVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, id, _a2
 func (_m *ResourceService) Update(ctx context.Context, id string, _a2 resource.Resource) (resource.Resource, error) {
 	ret := _m.Called(ctx, id, _a2)
@@ -79,9 +79,9 @@ func (_c *RoleService_Get_Call) RunAndReturn(run func(context.Context, string) (
 	return _c
 }
 
-// List provides a mock function with given fields: ctx
-func (_m *RoleService) List(ctx context.Context) ([]role.Role, error) {
-	ret := _m.Called(ctx)
+// List provides a mock function with given fields: ctx, flt
+func (_m *RoleService) List(ctx context.Context, flt role.Filter) ([]role.Role, error) {
+	ret := _m.Called(ctx, flt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
@@ -89,19 +89,19 @@ func (_m *RoleService) List(ctx context.Context) ([]role.Role, error) {
 
 	var r0 []role.Role
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]role.Role, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, role.Filter) ([]role.Role, error)); ok {
+		return rf(ctx, flt)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []role.Role); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, role.Filter) []role.Role); ok {
+		r0 = rf(ctx, flt)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]role.Role)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, role.Filter) error); ok {
+		r1 = rf(ctx, flt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,13 +116,14 @@ type RoleService_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *RoleService_Expecter) List(ctx interface{}) *RoleService_List_Call {
-	return &RoleService_List_Call{Call: _e.mock.On("List", ctx)}
+//   - flt role.Filter
+func (_e *RoleService_Expecter) List(ctx interface{}, flt interface{}) *RoleService_List_Call {
+	return &RoleService_List_Call{Call: _e.mock.On("List", ctx, flt)}
 }
 
-func (_c *RoleService_List_Call) Run(run func(ctx context.Context)) *RoleService_List_Call {
+func (_c *RoleService_List_Call) Run(run func(ctx context.Context, flt role.Filter)) *RoleService_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(role.Filter))
 	})
 	return _c
 }
@@ -132,7 +133,7 @@ func (_c *RoleService_List_Call) Return(_a0 []role.Role, _a1 error) *RoleService
 	return _c
 }
 
-func (_c *RoleService_List_Call) RunAndReturn(run func(context.Context) ([]role.Role, error)) *RoleService_List_Call {
+func (_c *RoleService_List_Call) RunAndReturn(run func(context.Context, role.Filter) ([]role.Role, error)) *RoleService_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
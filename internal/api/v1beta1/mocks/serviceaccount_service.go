@@ -0,0 +1,436 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	serviceaccount "github.com/goto/shield/core/serviceaccount"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ServiceAccountService is an autogenerated mock type for the ServiceAccountService type
+type ServiceAccountService struct {
+	mock.Mock
+}
+
+type ServiceAccountService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAccountService) EXPECT() *ServiceAccountService_Expecter {
+	return &ServiceAccountService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, serviceAccount
+func (_m *ServiceAccountService) Create(ctx context.Context, serviceAccount serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error) {
+	ret := _m.Called(ctx, serviceAccount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 serviceaccount.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error)); ok {
+		return rf(ctx, serviceAccount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.ServiceAccount) serviceaccount.ServiceAccount); ok {
+		r0 = rf(ctx, serviceAccount)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, serviceaccount.ServiceAccount) error); ok {
+		r1 = rf(ctx, serviceAccount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ServiceAccountService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccount serviceaccount.ServiceAccount
+func (_e *ServiceAccountService_Expecter) Create(ctx interface{}, serviceAccount interface{}) *ServiceAccountService_Create_Call {
+	return &ServiceAccountService_Create_Call{Call: _e.mock.On("Create", ctx, serviceAccount)}
+}
+
+func (_c *ServiceAccountService_Create_Call) Run(run func(ctx context.Context, serviceAccount serviceaccount.ServiceAccount)) *ServiceAccountService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(serviceaccount.ServiceAccount))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_Create_Call) Return(_a0 serviceaccount.ServiceAccount, _a1 error) *ServiceAccountService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_Create_Call) RunAndReturn(run func(context.Context, serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error)) *ServiceAccountService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateKey provides a mock function with given fields: ctx, serviceAccountID, expiresAt
+func (_m *ServiceAccountService) CreateKey(ctx context.Context, serviceAccountID string, expiresAt time.Time) (serviceaccount.Key, error) {
+	ret := _m.Called(ctx, serviceAccountID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKey")
+	}
+
+	var r0 serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (serviceaccount.Key, error)); ok {
+		return rf(ctx, serviceAccountID, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) serviceaccount.Key); ok {
+		r0 = rf(ctx, serviceAccountID, expiresAt)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.Key)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, serviceAccountID, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_CreateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKey'
+type ServiceAccountService_CreateKey_Call struct {
+	*mock.Call
+}
+
+// CreateKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+//   - expiresAt time.Time
+func (_e *ServiceAccountService_Expecter) CreateKey(ctx interface{}, serviceAccountID interface{}, expiresAt interface{}) *ServiceAccountService_CreateKey_Call {
+	return &ServiceAccountService_CreateKey_Call{Call: _e.mock.On("CreateKey", ctx, serviceAccountID, expiresAt)}
+}
+
+func (_c *ServiceAccountService_CreateKey_Call) Run(run func(ctx context.Context, serviceAccountID string, expiresAt time.Time)) *ServiceAccountService_CreateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_CreateKey_Call) Return(_a0 serviceaccount.Key, _a1 error) *ServiceAccountService_CreateKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_CreateKey_Call) RunAndReturn(run func(context.Context, string, time.Time) (serviceaccount.Key, error)) *ServiceAccountService_CreateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ServiceAccountService) Get(ctx context.Context, id string) (serviceaccount.ServiceAccount, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 serviceaccount.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (serviceaccount.ServiceAccount, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) serviceaccount.ServiceAccount); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ServiceAccountService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ServiceAccountService_Expecter) Get(ctx interface{}, id interface{}) *ServiceAccountService_Get_Call {
+	return &ServiceAccountService_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *ServiceAccountService_Get_Call) Run(run func(ctx context.Context, id string)) *ServiceAccountService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_Get_Call) Return(_a0 serviceaccount.ServiceAccount, _a1 error) *ServiceAccountService_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_Get_Call) RunAndReturn(run func(context.Context, string) (serviceaccount.ServiceAccount, error)) *ServiceAccountService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, flt
+func (_m *ServiceAccountService) List(ctx context.Context, flt serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []serviceaccount.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, serviceaccount.Filter) []serviceaccount.ServiceAccount); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]serviceaccount.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, serviceaccount.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ServiceAccountService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt serviceaccount.Filter
+func (_e *ServiceAccountService_Expecter) List(ctx interface{}, flt interface{}) *ServiceAccountService_List_Call {
+	return &ServiceAccountService_List_Call{Call: _e.mock.On("List", ctx, flt)}
+}
+
+func (_c *ServiceAccountService_List_Call) Run(run func(ctx context.Context, flt serviceaccount.Filter)) *ServiceAccountService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(serviceaccount.Filter))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_List_Call) Return(_a0 []serviceaccount.ServiceAccount, _a1 error) *ServiceAccountService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_List_Call) RunAndReturn(run func(context.Context, serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error)) *ServiceAccountService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKeys provides a mock function with given fields: ctx, serviceAccountID
+func (_m *ServiceAccountService) ListKeys(ctx context.Context, serviceAccountID string) ([]serviceaccount.Key, error) {
+	ret := _m.Called(ctx, serviceAccountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKeys")
+	}
+
+	var r0 []serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]serviceaccount.Key, error)); ok {
+		return rf(ctx, serviceAccountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []serviceaccount.Key); ok {
+		r0 = rf(ctx, serviceAccountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]serviceaccount.Key)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceAccountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_ListKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKeys'
+type ServiceAccountService_ListKeys_Call struct {
+	*mock.Call
+}
+
+// ListKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+func (_e *ServiceAccountService_Expecter) ListKeys(ctx interface{}, serviceAccountID interface{}) *ServiceAccountService_ListKeys_Call {
+	return &ServiceAccountService_ListKeys_Call{Call: _e.mock.On("ListKeys", ctx, serviceAccountID)}
+}
+
+func (_c *ServiceAccountService_ListKeys_Call) Run(run func(ctx context.Context, serviceAccountID string)) *ServiceAccountService_ListKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_ListKeys_Call) Return(_a0 []serviceaccount.Key, _a1 error) *ServiceAccountService_ListKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_ListKeys_Call) RunAndReturn(run func(context.Context, string) ([]serviceaccount.Key, error)) *ServiceAccountService_ListKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeKey provides a mock function with given fields: ctx, serviceAccountID, keyID
+func (_m *ServiceAccountService) RevokeKey(ctx context.Context, serviceAccountID string, keyID string) error {
+	ret := _m.Called(ctx, serviceAccountID, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, serviceAccountID, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountService_RevokeKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeKey'
+type ServiceAccountService_RevokeKey_Call struct {
+	*mock.Call
+}
+
+// RevokeKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+//   - keyID string
+func (_e *ServiceAccountService_Expecter) RevokeKey(ctx interface{}, serviceAccountID interface{}, keyID interface{}) *ServiceAccountService_RevokeKey_Call {
+	return &ServiceAccountService_RevokeKey_Call{Call: _e.mock.On("RevokeKey", ctx, serviceAccountID, keyID)}
+}
+
+func (_c *ServiceAccountService_RevokeKey_Call) Run(run func(ctx context.Context, serviceAccountID string, keyID string)) *ServiceAccountService_RevokeKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_RevokeKey_Call) Return(_a0 error) *ServiceAccountService_RevokeKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountService_RevokeKey_Call) RunAndReturn(run func(context.Context, string, string) error) *ServiceAccountService_RevokeKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateKey provides a mock function with given fields: ctx, serviceAccountID, keyID, gracePeriod
+func (_m *ServiceAccountService) RotateKey(ctx context.Context, serviceAccountID string, keyID string, gracePeriod time.Duration) (serviceaccount.Key, error) {
+	ret := _m.Called(ctx, serviceAccountID, keyID, gracePeriod)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateKey")
+	}
+
+	var r0 serviceaccount.Key
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (serviceaccount.Key, error)); ok {
+		return rf(ctx, serviceAccountID, keyID, gracePeriod)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) serviceaccount.Key); ok {
+		r0 = rf(ctx, serviceAccountID, keyID, gracePeriod)
+	} else {
+		r0 = ret.Get(0).(serviceaccount.Key)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, serviceAccountID, keyID, gracePeriod)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountService_RotateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateKey'
+type ServiceAccountService_RotateKey_Call struct {
+	*mock.Call
+}
+
+// RotateKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+//   - keyID string
+//   - gracePeriod time.Duration
+func (_e *ServiceAccountService_Expecter) RotateKey(ctx interface{}, serviceAccountID interface{}, keyID interface{}, gracePeriod interface{}) *ServiceAccountService_RotateKey_Call {
+	return &ServiceAccountService_RotateKey_Call{Call: _e.mock.On("RotateKey", ctx, serviceAccountID, keyID, gracePeriod)}
+}
+
+func (_c *ServiceAccountService_RotateKey_Call) Run(run func(ctx context.Context, serviceAccountID string, keyID string, gracePeriod time.Duration)) *ServiceAccountService_RotateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountService_RotateKey_Call) Return(_a0 serviceaccount.Key, _a1 error) *ServiceAccountService_RotateKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountService_RotateKey_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) (serviceaccount.Key, error)) *ServiceAccountService_RotateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAccountService creates a new instance of ServiceAccountService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccountService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccountService {
+	mock := &ServiceAccountService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -13,6 +13,7 @@ import (
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 
 	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/role"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,7 +22,11 @@ import (
 	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
 )
 
-var grpcOrgNotFoundErr = status.Errorf(codes.NotFound, "org doesn't exist")
+var (
+	grpcOrgNotFoundErr      = status.Errorf(codes.NotFound, "org doesn't exist")
+	grpcOrgAdminNotFoundErr = status.Errorf(codes.NotFound, organization.ErrNotAdmin.Error())
+	grpcOrgLastAdminErr     = status.Errorf(codes.FailedPrecondition, organization.ErrLastAdmin.Error())
+)
 
 type OrganizationService interface {
 	Get(ctx context.Context, idOrSlug string) (organization.Organization, error)
@@ -29,6 +34,10 @@ type OrganizationService interface {
 	List(ctx context.Context) ([]organization.Organization, error)
 	Update(ctx context.Context, toUpdate organization.Organization) (organization.Organization, error)
 	ListAdmins(ctx context.Context, id string) ([]user.User, error)
+	AddAdmins(ctx context.Context, idOrSlug string, userIds []string) ([]user.User, error)
+	RemoveAdmin(ctx context.Context, idOrSlug string, userId string) ([]user.User, error)
+	CreateRole(ctx context.Context, idOrSlug string, toCreate role.Role) (role.Role, error)
+	DeleteRole(ctx context.Context, idOrSlug string, roleID string) error
 }
 
 func (h Handler) ListOrganizations(ctx context.Context, request *shieldv1beta1.ListOrganizationsRequest) (*shieldv1beta1.ListOrganizationsResponse, error) {
@@ -217,6 +226,150 @@ func (h Handler) ListOrganizationAdmins(ctx context.Context, request *shieldv1be
 	return &shieldv1beta1.ListOrganizationAdminsResponse{Users: adminsPB}, nil
 }
 
+func (h Handler) AddOrganizationAdmins(ctx context.Context, request *shieldv1beta1.AddOrganizationAdminsRequest) (*shieldv1beta1.AddOrganizationAdminsResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if len(request.GetBody().GetUserIds()) == 0 {
+		return nil, grpcBadBodyError
+	}
+
+	admins, err := h.orgService.AddAdmins(ctx, request.GetId(), request.GetBody().GetUserIds())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrNotExist),
+			errors.Is(err, organization.ErrInvalidUUID),
+			errors.Is(err, organization.ErrInvalidID):
+			return nil, grpcOrgNotFoundErr
+		case errors.Is(err, user.ErrNotExist),
+			errors.Is(err, user.ErrInvalidUUID),
+			errors.Is(err, user.ErrInvalidID):
+			return nil, grpcUserNotFoundError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	adminsPB, err := transformAdminsToPB(admins)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.AddOrganizationAdminsResponse{Users: adminsPB}, nil
+}
+
+func (h Handler) RemoveOrganizationAdmin(ctx context.Context, request *shieldv1beta1.RemoveOrganizationAdminRequest) (*shieldv1beta1.RemoveOrganizationAdminResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	admins, err := h.orgService.RemoveAdmin(ctx, request.GetId(), request.GetUserId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrNotExist),
+			errors.Is(err, organization.ErrInvalidUUID),
+			errors.Is(err, organization.ErrInvalidID):
+			return nil, grpcOrgNotFoundErr
+		case errors.Is(err, organization.ErrNotAdmin):
+			return nil, grpcOrgAdminNotFoundErr
+		case errors.Is(err, organization.ErrLastAdmin):
+			return nil, grpcOrgLastAdminErr
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	adminsPB, err := transformAdminsToPB(admins)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.RemoveOrganizationAdminResponse{Users: adminsPB}, nil
+}
+
+func (h Handler) CreateOrganizationRole(ctx context.Context, request *shieldv1beta1.CreateOrganizationRoleRequest) (*shieldv1beta1.CreateOrganizationRoleResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	metaDataMap, err := metadata.Build(request.GetBody().GetMetadata().AsMap())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcBadBodyError
+	}
+
+	newRole, err := h.orgService.CreateRole(ctx, request.GetId(), role.Role{
+		Name:        request.GetBody().GetName(),
+		NamespaceID: request.GetBody().GetNamespaceId(),
+		Permissions: request.GetBody().GetPermissions(),
+		Metadata:    metaDataMap,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrNotExist),
+			errors.Is(err, organization.ErrInvalidUUID),
+			errors.Is(err, organization.ErrInvalidID):
+			return nil, grpcOrgNotFoundErr
+		case errors.Is(err, role.ErrInvalidDetail),
+			errors.Is(err, role.ErrInvalidID):
+			return nil, grpcBadBodyError
+		case errors.Is(err, role.ErrConflict):
+			return nil, grpcConflictError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	rolePB, err := transformRoleToPB(newRole)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.CreateOrganizationRoleResponse{Role: &rolePB}, nil
+}
+
+func (h Handler) DeleteOrganizationRole(ctx context.Context, request *shieldv1beta1.DeleteOrganizationRoleRequest) (*shieldv1beta1.DeleteOrganizationRoleResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if err := h.orgService.DeleteRole(ctx, request.GetId(), request.GetRoleId()); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrNotExist),
+			errors.Is(err, organization.ErrInvalidUUID),
+			errors.Is(err, organization.ErrInvalidID):
+			return nil, grpcOrgNotFoundErr
+		case errors.Is(err, role.ErrNotExist),
+			errors.Is(err, role.ErrInvalidID):
+			return nil, grpcRoleNotFoundErr
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.DeleteOrganizationRoleResponse{}, nil
+}
+
 func transformOrgToPB(org organization.Organization) (shieldv1beta1.Organization, error) {
 	metaData, err := org.Metadata.ToStructPB()
 	if err != nil {
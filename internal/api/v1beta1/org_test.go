@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/api/v1beta1/mocks"
 	"github.com/goto/shield/pkg/errors"
@@ -660,3 +661,262 @@ func TestHandler_ListOrganizationAdmins(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_AddOrganizationAdmins(t *testing.T) {
+	someOrgID := uuid.NewString()
+	someUserID := uuid.NewString()
+	tests := []struct {
+		name    string
+		setup   func(os *mocks.OrganizationService)
+		request *shieldv1beta1.AddOrganizationAdminsRequest
+		want    *shieldv1beta1.AddOrganizationAdminsResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if org does not exist",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().AddAdmins(mock.AnythingOfType("context.todoCtx"), someOrgID, []string{someUserID}).Return([]user.User{}, organization.ErrNotExist)
+			},
+			request: &shieldv1beta1.AddOrganizationAdminsRequest{
+				Id:   someOrgID,
+				Body: &shieldv1beta1.AddOrganizationAdminsRequestBody{UserIds: []string{someUserID}},
+			},
+			want:    nil,
+			wantErr: grpcOrgNotFoundErr,
+		},
+		{
+			name: "should return permission denied error if user cannot edit the org",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().AddAdmins(mock.AnythingOfType("context.todoCtx"), someOrgID, []string{someUserID}).Return([]user.User{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.AddOrganizationAdminsRequest{
+				Id:   someOrgID,
+				Body: &shieldv1beta1.AddOrganizationAdminsRequestBody{UserIds: []string{someUserID}},
+			},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return admins of the org if added",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().AddAdmins(mock.AnythingOfType("context.todoCtx"), someOrgID, []string{someUserID}).Return([]user.User{
+					{ID: someUserID, Name: "User 1", Email: "test@test.com"},
+				}, nil)
+			},
+			request: &shieldv1beta1.AddOrganizationAdminsRequest{
+				Id:   someOrgID,
+				Body: &shieldv1beta1.AddOrganizationAdminsRequestBody{UserIds: []string{someUserID}},
+			},
+			want: &shieldv1beta1.AddOrganizationAdminsResponse{
+				Users: []*shieldv1beta1.User{
+					{
+						Id:        someUserID,
+						Name:      "User 1",
+						Email:     "test@test.com",
+						Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{}},
+						CreatedAt: timestamppb.New(time.Time{}),
+						UpdatedAt: timestamppb.New(time.Time{}),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgSrv := new(mocks.OrganizationService)
+			ctx := context.TODO()
+			if tt.setup != nil {
+				tt.setup(mockOrgSrv)
+			}
+			mockDep := Handler{orgService: mockOrgSrv}
+			got, err := mockDep.AddOrganizationAdmins(ctx, tt.request)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RemoveOrganizationAdmin(t *testing.T) {
+	someOrgID := uuid.NewString()
+	someUserID := uuid.NewString()
+	tests := []struct {
+		name    string
+		setup   func(os *mocks.OrganizationService)
+		request *shieldv1beta1.RemoveOrganizationAdminRequest
+		want    *shieldv1beta1.RemoveOrganizationAdminResponse
+		wantErr error
+	}{
+		{
+			name: "should return failed precondition error if user is the last admin",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), someOrgID, someUserID).Return([]user.User{}, organization.ErrLastAdmin)
+			},
+			request: &shieldv1beta1.RemoveOrganizationAdminRequest{Id: someOrgID, UserId: someUserID},
+			want:    nil,
+			wantErr: grpcOrgLastAdminErr,
+		},
+		{
+			name: "should return not found error if user is not an admin",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), someOrgID, someUserID).Return([]user.User{}, organization.ErrNotAdmin)
+			},
+			request: &shieldv1beta1.RemoveOrganizationAdminRequest{Id: someOrgID, UserId: someUserID},
+			want:    nil,
+			wantErr: grpcOrgAdminNotFoundErr,
+		},
+		{
+			name: "should return remaining admins of the org if removed",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), someOrgID, someUserID).Return([]user.User{}, nil)
+			},
+			request: &shieldv1beta1.RemoveOrganizationAdminRequest{Id: someOrgID, UserId: someUserID},
+			want:    &shieldv1beta1.RemoveOrganizationAdminResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgSrv := new(mocks.OrganizationService)
+			ctx := context.TODO()
+			if tt.setup != nil {
+				tt.setup(mockOrgSrv)
+			}
+			mockDep := Handler{orgService: mockOrgSrv}
+			got, err := mockDep.RemoveOrganizationAdmin(ctx, tt.request)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_CreateOrganizationRole(t *testing.T) {
+	toCreate := role.Role{
+		Name:        "deployer",
+		NamespaceID: "entropy/firehose",
+		Permissions: []string{"view"},
+		Metadata:    metadata.Metadata{},
+	}
+	request := &shieldv1beta1.CreateOrganizationRoleRequest{
+		Id: testOrgID,
+		Body: &shieldv1beta1.OrganizationRoleRequestBody{
+			Name:        "deployer",
+			NamespaceId: "entropy/firehose",
+			Permissions: []string{"view"},
+		},
+	}
+	tests := []struct {
+		name    string
+		setup   func(os *mocks.OrganizationService)
+		request *shieldv1beta1.CreateOrganizationRoleRequest
+		want    *shieldv1beta1.CreateOrganizationRoleResponse
+		wantErr error
+	}{
+		{
+			name: "should return bad body error if role is invalid",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().CreateRole(mock.AnythingOfType("context.todoCtx"), testOrgID, toCreate).Return(role.Role{}, role.ErrInvalidDetail)
+			},
+			request: request,
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return permission denied error if user can't edit the org",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().CreateRole(mock.AnythingOfType("context.todoCtx"), testOrgID, toCreate).Return(role.Role{}, errors.ErrForbidden)
+			},
+			request: request,
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return the created role",
+			setup: func(os *mocks.OrganizationService) {
+				created := toCreate
+				created.ID = "entropy/firehose:org_9f25_deployer"
+				created.OrgID = testOrgID
+				created.Types = []string{"shield/user", "shield/group"}
+				os.EXPECT().CreateRole(mock.AnythingOfType("context.todoCtx"), testOrgID, toCreate).Return(created, nil)
+			},
+			request: request,
+			want: &shieldv1beta1.CreateOrganizationRoleResponse{Role: &shieldv1beta1.Role{
+				Id:          "entropy/firehose:org_9f25_deployer",
+				Name:        "deployer",
+				Types:       []string{"shield/user", "shield/group"},
+				NamespaceId: "entropy/firehose",
+				OrgId:       testOrgID,
+				Permissions: []string{"view"},
+				Metadata:    &structpb.Struct{Fields: map[string]*structpb.Value{}},
+				CreatedAt:   timestamppb.New(time.Time{}),
+				UpdatedAt:   timestamppb.New(time.Time{}),
+			}},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgSrv := new(mocks.OrganizationService)
+			ctx := context.TODO()
+			if tt.setup != nil {
+				tt.setup(mockOrgSrv)
+			}
+			mockDep := Handler{orgService: mockOrgSrv}
+			got, err := mockDep.CreateOrganizationRole(ctx, tt.request)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_DeleteOrganizationRole(t *testing.T) {
+	someRoleID := "entropy/firehose:org_9f25_deployer"
+	tests := []struct {
+		name    string
+		setup   func(os *mocks.OrganizationService)
+		request *shieldv1beta1.DeleteOrganizationRoleRequest
+		want    *shieldv1beta1.DeleteOrganizationRoleResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if role is not a custom role of the org",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().DeleteRole(mock.AnythingOfType("context.todoCtx"), testOrgID, someRoleID).Return(role.ErrNotExist)
+			},
+			request: &shieldv1beta1.DeleteOrganizationRoleRequest{Id: testOrgID, RoleId: someRoleID},
+			want:    nil,
+			wantErr: grpcRoleNotFoundErr,
+		},
+		{
+			name: "should return not found error if org doesn't exist",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().DeleteRole(mock.AnythingOfType("context.todoCtx"), testOrgID, someRoleID).Return(organization.ErrNotExist)
+			},
+			request: &shieldv1beta1.DeleteOrganizationRoleRequest{Id: testOrgID, RoleId: someRoleID},
+			want:    nil,
+			wantErr: grpcOrgNotFoundErr,
+		},
+		{
+			name: "should return empty response if role is deleted",
+			setup: func(os *mocks.OrganizationService) {
+				os.EXPECT().DeleteRole(mock.AnythingOfType("context.todoCtx"), testOrgID, someRoleID).Return(nil)
+			},
+			request: &shieldv1beta1.DeleteOrganizationRoleRequest{Id: testOrgID, RoleId: someRoleID},
+			want:    &shieldv1beta1.DeleteOrganizationRoleResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrgSrv := new(mocks.OrganizationService)
+			ctx := context.TODO()
+			if tt.setup != nil {
+				tt.setup(mockOrgSrv)
+			}
+			mockDep := Handler{orgService: mockOrgSrv}
+			got, err := mockDep.DeleteOrganizationRole(ctx, tt.request)
+			assert.EqualValues(t, tt.want, got)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/shield/core/platform"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/pkg/errors"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PlatformService interface {
+	AddAdmin(ctx context.Context, userID string) (user.User, error)
+	RemoveAdmin(ctx context.Context, userID string) error
+	AddImpersonator(ctx context.Context, userID string) (user.User, error)
+	RemoveImpersonator(ctx context.Context, userID string) error
+}
+
+var (
+	grpcPlatformAdminNotFoundErr        = status.Errorf(codes.NotFound, platform.ErrNotAdmin.Error())
+	grpcPlatformImpersonatorNotFoundErr = status.Errorf(codes.NotFound, platform.ErrNotImpersonator.Error())
+)
+
+func (h Handler) AddPlatformAdmin(ctx context.Context, request *shieldv1beta1.AddPlatformAdminRequest) (*shieldv1beta1.AddPlatformAdminResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if request.GetBody().GetUserId() == "" {
+		return nil, grpcBadBodyError
+	}
+
+	admin, err := h.platformService.AddAdmin(ctx, request.GetBody().GetUserId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, user.ErrNotExist),
+			errors.Is(err, user.ErrInvalidUUID),
+			errors.Is(err, user.ErrInvalidID):
+			return nil, grpcUserNotFoundError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	adminPB, err := transformUserToPB(admin)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.AddPlatformAdminResponse{User: &adminPB}, nil
+}
+
+func (h Handler) RemovePlatformAdmin(ctx context.Context, request *shieldv1beta1.RemovePlatformAdminRequest) (*shieldv1beta1.RemovePlatformAdminResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if err := h.platformService.RemoveAdmin(ctx, request.GetUserId()); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, platform.ErrNotAdmin):
+			return nil, grpcPlatformAdminNotFoundErr
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.RemovePlatformAdminResponse{}, nil
+}
+
+func (h Handler) AddPlatformImpersonator(ctx context.Context, request *shieldv1beta1.AddPlatformImpersonatorRequest) (*shieldv1beta1.AddPlatformImpersonatorResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if request.GetBody().GetUserId() == "" {
+		return nil, grpcBadBodyError
+	}
+
+	impersonator, err := h.platformService.AddImpersonator(ctx, request.GetBody().GetUserId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, user.ErrNotExist),
+			errors.Is(err, user.ErrInvalidUUID),
+			errors.Is(err, user.ErrInvalidID):
+			return nil, grpcUserNotFoundError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	impersonatorPB, err := transformUserToPB(impersonator)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.AddPlatformImpersonatorResponse{User: &impersonatorPB}, nil
+}
+
+func (h Handler) RemovePlatformImpersonator(ctx context.Context, request *shieldv1beta1.RemovePlatformImpersonatorRequest) (*shieldv1beta1.RemovePlatformImpersonatorResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if err := h.platformService.RemoveImpersonator(ctx, request.GetUserId()); err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, platform.ErrNotImpersonator):
+			return nil, grpcPlatformImpersonatorNotFoundErr
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.RemovePlatformImpersonatorResponse{}, nil
+}
@@ -0,0 +1,191 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/platform"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/api/v1beta1/mocks"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/metadata"
+	"github.com/goto/shield/pkg/uuid"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testPlatformAdminID = uuid.NewString()
+
+func TestHandler_AddPlatformAdmin(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(ps *mocks.PlatformService)
+		request *shieldv1beta1.AddPlatformAdminRequest
+		want    *shieldv1beta1.AddPlatformAdminResponse
+		wantErr error
+	}{
+		{
+			name:    "should return bad body error if user id is empty",
+			request: &shieldv1beta1.AddPlatformAdminRequest{Body: &shieldv1beta1.AddPlatformAdminRequestBody{}},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return permission denied error if current user isn't a platform admin",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().AddAdmin(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(user.User{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.AddPlatformAdminRequest{Body: &shieldv1beta1.AddPlatformAdminRequestBody{UserId: testPlatformAdminID}},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return user not found error if user doesn't exist",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().AddAdmin(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(user.User{}, user.ErrNotExist)
+			},
+			request: &shieldv1beta1.AddPlatformAdminRequest{Body: &shieldv1beta1.AddPlatformAdminRequestBody{UserId: testPlatformAdminID}},
+			want:    nil,
+			wantErr: grpcUserNotFoundError,
+		},
+		{
+			name: "should return the new platform admin if no error",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().AddAdmin(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(user.User{
+					ID:       testPlatformAdminID,
+					Name:     "Admin",
+					Email:    "admin@gotocompany.com",
+					Metadata: metadata.Metadata{},
+				}, nil)
+			},
+			request: &shieldv1beta1.AddPlatformAdminRequest{Body: &shieldv1beta1.AddPlatformAdminRequestBody{UserId: testPlatformAdminID}},
+			want: &shieldv1beta1.AddPlatformAdminResponse{
+				User: &shieldv1beta1.User{
+					Id:        testPlatformAdminID,
+					Name:      "Admin",
+					Email:     "admin@gotocompany.com",
+					Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{}},
+					CreatedAt: timestamppb.New(time.Time{}),
+					UpdatedAt: timestamppb.New(time.Time{}),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlatformService := new(mocks.PlatformService)
+			if tt.setup != nil {
+				tt.setup(mockPlatformService)
+			}
+			mockDep := Handler{platformService: mockPlatformService}
+			resp, err := mockDep.AddPlatformAdmin(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RemovePlatformAdmin(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(ps *mocks.PlatformService)
+		request *shieldv1beta1.RemovePlatformAdminRequest
+		want    *shieldv1beta1.RemovePlatformAdminResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if user isn't a platform admin",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(platform.ErrNotAdmin)
+			},
+			request: &shieldv1beta1.RemovePlatformAdminRequest{UserId: testPlatformAdminID},
+			want:    nil,
+			wantErr: grpcPlatformAdminNotFoundErr,
+		},
+		{
+			name: "should return unauthenticated error if auth email in context is empty",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(user.ErrMissingEmail)
+			},
+			request: &shieldv1beta1.RemovePlatformAdminRequest{UserId: testPlatformAdminID},
+			want:    nil,
+			wantErr: grpcUnauthenticated,
+		},
+		{
+			name: "should remove the platform admin if no error",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(nil)
+			},
+			request: &shieldv1beta1.RemovePlatformAdminRequest{UserId: testPlatformAdminID},
+			want:    &shieldv1beta1.RemovePlatformAdminResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlatformService := new(mocks.PlatformService)
+			if tt.setup != nil {
+				tt.setup(mockPlatformService)
+			}
+			mockDep := Handler{platformService: mockPlatformService}
+			resp, err := mockDep.RemovePlatformAdmin(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RemovePlatformImpersonator(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(ps *mocks.PlatformService)
+		request *shieldv1beta1.RemovePlatformImpersonatorRequest
+		want    *shieldv1beta1.RemovePlatformImpersonatorResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if user isn't an impersonator",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().RemoveImpersonator(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(platform.ErrNotImpersonator)
+			},
+			request: &shieldv1beta1.RemovePlatformImpersonatorRequest{UserId: testPlatformAdminID},
+			want:    nil,
+			wantErr: grpcPlatformImpersonatorNotFoundErr,
+		},
+		{
+			name: "should return permission denied error if current user isn't a platform admin",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().RemoveImpersonator(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.RemovePlatformImpersonatorRequest{UserId: testPlatformAdminID},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should remove the impersonator if no error",
+			setup: func(ps *mocks.PlatformService) {
+				ps.EXPECT().RemoveImpersonator(mock.AnythingOfType("context.todoCtx"), testPlatformAdminID).Return(nil)
+			},
+			request: &shieldv1beta1.RemovePlatformImpersonatorRequest{UserId: testPlatformAdminID},
+			want:    &shieldv1beta1.RemovePlatformImpersonatorResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlatformService := new(mocks.PlatformService)
+			if tt.setup != nil {
+				tt.setup(mockPlatformService)
+			}
+			mockDep := Handler{platformService: mockPlatformService}
+			resp, err := mockDep.RemovePlatformImpersonator(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
 	"github.com/goto/shield/pkg/errors"
 	"github.com/goto/shield/pkg/metadata"
 	"github.com/goto/shield/pkg/str"
@@ -22,7 +23,11 @@ import (
 	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
 )
 
-var grpcProjectNotFoundErr = status.Errorf(codes.NotFound, "project doesn't exist")
+var (
+	grpcProjectNotFoundErr      = status.Errorf(codes.NotFound, "project doesn't exist")
+	grpcProjectAdminNotFoundErr = status.Errorf(codes.NotFound, project.ErrNotAdmin.Error())
+	grpcProjectLastAdminErr     = status.Errorf(codes.FailedPrecondition, project.ErrLastAdmin.Error())
+)
 
 type ProjectService interface {
 	Get(ctx context.Context, idOrSlugd string) (project.Project, error)
@@ -30,6 +35,8 @@ type ProjectService interface {
 	List(ctx context.Context) ([]project.Project, error)
 	Update(ctx context.Context, toUpdate project.Project) (project.Project, error)
 	ListAdmins(ctx context.Context, id string) ([]user.User, error)
+	AddAdmins(ctx context.Context, idOrSlug string, userIds []string) ([]user.User, error)
+	RemoveAdmin(ctx context.Context, idOrSlug string, userId string) ([]user.User, error)
 }
 
 func (h Handler) ListProjects(
@@ -227,6 +234,136 @@ func (h Handler) ListProjectAdmins(
 	return &shieldv1beta1.ListProjectAdminsResponse{Users: transformedAdmins}, nil
 }
 
+func (h Handler) AddProjectAdmins(
+	ctx context.Context,
+	request *shieldv1beta1.AddProjectAdminsRequest,
+) (*shieldv1beta1.AddProjectAdminsResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if len(request.GetBody().GetUserIds()) == 0 {
+		return nil, grpcBadBodyError
+	}
+
+	admins, err := h.projectService.AddAdmins(ctx, request.GetId(), request.GetBody().GetUserIds())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, project.ErrNotExist),
+			errors.Is(err, project.ErrInvalidUUID),
+			errors.Is(err, project.ErrInvalidID):
+			return nil, grpcProjectNotFoundErr
+		case errors.Is(err, user.ErrNotExist),
+			errors.Is(err, user.ErrInvalidUUID),
+			errors.Is(err, user.ErrInvalidID):
+			return nil, grpcUserNotFoundError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	transformedAdmins, err := transformAdminsToPB(admins)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.AddProjectAdminsResponse{Users: transformedAdmins}, nil
+}
+
+func (h Handler) RemoveProjectAdmin(
+	ctx context.Context,
+	request *shieldv1beta1.RemoveProjectAdminRequest,
+) (*shieldv1beta1.RemoveProjectAdminResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	admins, err := h.projectService.RemoveAdmin(ctx, request.GetId(), request.GetUserId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, project.ErrNotExist),
+			errors.Is(err, project.ErrInvalidUUID),
+			errors.Is(err, project.ErrInvalidID):
+			return nil, grpcProjectNotFoundErr
+		case errors.Is(err, project.ErrNotAdmin):
+			return nil, grpcProjectAdminNotFoundErr
+		case errors.Is(err, project.ErrLastAdmin):
+			return nil, grpcProjectLastAdminErr
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	transformedAdmins, err := transformAdminsToPB(admins)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.RemoveProjectAdminResponse{Users: transformedAdmins}, nil
+}
+
+func (h Handler) SetProjectVisibility(
+	ctx context.Context,
+	request *shieldv1beta1.SetProjectVisibilityRequest,
+) (*shieldv1beta1.SetProjectVisibilityResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if request.GetBody() == nil || strings.TrimSpace(request.GetBody().GetPermission()) == "" {
+		return nil, grpcBadBodyError
+	}
+
+	prj, err := h.resourceService.SetProjectVisibility(ctx, request.GetId(), request.GetBody().GetPermission(), request.GetBody().GetPublic())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, project.ErrNotExist),
+			errors.Is(err, project.ErrInvalidUUID),
+			errors.Is(err, project.ErrInvalidID):
+			return nil, grpcProjectNotFoundErr
+		case errors.Is(err, schema.ErrPermissionNotPublic):
+			return nil, grpcBadBodyError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	projectPB, err := transformProjectToPB(prj)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.SetProjectVisibilityResponse{Project: &projectPB}, nil
+}
+
+func transformAdminsToPB(admins []user.User) ([]*shieldv1beta1.User, error) {
+	var transformedAdmins []*shieldv1beta1.User
+	for _, a := range admins {
+		u, err := transformUserToPB(a)
+		if err != nil {
+			return nil, err
+		}
+
+		transformedAdmins = append(transformedAdmins, &u)
+	}
+	return transformedAdmins, nil
+}
+
 func transformProjectToPB(prj project.Project) (shieldv1beta1.Project, error) {
 	metaData, err := prj.Metadata.ToStructPB()
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/goto/shield/core/project"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/api/v1beta1/mocks"
+	"github.com/goto/shield/internal/schema"
 	"github.com/goto/shield/pkg/errors"
 	"github.com/goto/shield/pkg/metadata"
 	"github.com/goto/shield/pkg/uuid"
@@ -731,3 +732,209 @@ func TestHandler_ListProjectAdmins(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_AddProjectAdmins(t *testing.T) {
+	testUserID := "9f256f86-31a3-11ec-8d3d-0242ac130003"
+	tests := []struct {
+		name    string
+		setup   func(ps *mocks.ProjectService)
+		request *shieldv1beta1.AddProjectAdminsRequest
+		want    *shieldv1beta1.AddProjectAdminsResponse
+		wantErr error
+	}{
+		{
+			name: "should return bad request error if user ids are empty",
+			request: &shieldv1beta1.AddProjectAdminsRequest{
+				Id:   testProjectID,
+				Body: &shieldv1beta1.AddProjectAdminsRequestBody{},
+			},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return permission denied error if user cannot edit the project",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().AddAdmins(mock.AnythingOfType("context.todoCtx"), testProjectID, []string{testUserID}).Return([]user.User{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.AddProjectAdminsRequest{
+				Id:   testProjectID,
+				Body: &shieldv1beta1.AddProjectAdminsRequestBody{UserIds: []string{testUserID}},
+			},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return not found error if user does not exist",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().AddAdmins(mock.AnythingOfType("context.todoCtx"), testProjectID, []string{testUserID}).Return([]user.User{}, user.ErrNotExist)
+			},
+			request: &shieldv1beta1.AddProjectAdminsRequest{
+				Id:   testProjectID,
+				Body: &shieldv1beta1.AddProjectAdminsRequestBody{UserIds: []string{testUserID}},
+			},
+			want:    nil,
+			wantErr: grpcUserNotFoundError,
+		},
+		{
+			name: "should return admins of the project if added",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().AddAdmins(mock.AnythingOfType("context.todoCtx"), testProjectID, []string{testUserID}).Return([]user.User{
+					{ID: testUserID, Name: "User 1", Email: "test@test.com"},
+				}, nil)
+			},
+			request: &shieldv1beta1.AddProjectAdminsRequest{
+				Id:   testProjectID,
+				Body: &shieldv1beta1.AddProjectAdminsRequestBody{UserIds: []string{testUserID}},
+			},
+			want: &shieldv1beta1.AddProjectAdminsResponse{
+				Users: []*shieldv1beta1.User{
+					{
+						Id:        testUserID,
+						Name:      "User 1",
+						Email:     "test@test.com",
+						Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{}},
+						CreatedAt: timestamppb.New(time.Time{}),
+						UpdatedAt: timestamppb.New(time.Time{}),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProjectSrv := new(mocks.ProjectService)
+			if tt.setup != nil {
+				tt.setup(mockProjectSrv)
+			}
+			mockDep := Handler{projectService: mockProjectSrv}
+			resp, err := mockDep.AddProjectAdmins(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RemoveProjectAdmin(t *testing.T) {
+	testUserID := "9f256f86-31a3-11ec-8d3d-0242ac130003"
+	tests := []struct {
+		name    string
+		setup   func(ps *mocks.ProjectService)
+		request *shieldv1beta1.RemoveProjectAdminRequest
+		want    *shieldv1beta1.RemoveProjectAdminResponse
+		wantErr error
+	}{
+		{
+			name: "should return failed precondition error if user is the last admin",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), testProjectID, testUserID).Return([]user.User{}, project.ErrLastAdmin)
+			},
+			request: &shieldv1beta1.RemoveProjectAdminRequest{Id: testProjectID, UserId: testUserID},
+			want:    nil,
+			wantErr: grpcProjectLastAdminErr,
+		},
+		{
+			name: "should return not found error if user is not an admin",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), testProjectID, testUserID).Return([]user.User{}, project.ErrNotAdmin)
+			},
+			request: &shieldv1beta1.RemoveProjectAdminRequest{Id: testProjectID, UserId: testUserID},
+			want:    nil,
+			wantErr: grpcProjectAdminNotFoundErr,
+		},
+		{
+			name: "should return permission denied error if user cannot edit the project",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), testProjectID, testUserID).Return([]user.User{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.RemoveProjectAdminRequest{Id: testProjectID, UserId: testUserID},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return remaining admins of the project if removed",
+			setup: func(ps *mocks.ProjectService) {
+				ps.EXPECT().RemoveAdmin(mock.AnythingOfType("context.todoCtx"), testProjectID, testUserID).Return([]user.User{}, nil)
+			},
+			request: &shieldv1beta1.RemoveProjectAdminRequest{Id: testProjectID, UserId: testUserID},
+			want:    &shieldv1beta1.RemoveProjectAdminResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProjectSrv := new(mocks.ProjectService)
+			if tt.setup != nil {
+				tt.setup(mockProjectSrv)
+			}
+			mockDep := Handler{projectService: mockProjectSrv}
+			resp, err := mockDep.RemoveProjectAdmin(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_SetProjectVisibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(rs *mocks.ResourceService)
+		request *shieldv1beta1.SetProjectVisibilityRequest
+		want    *shieldv1beta1.SetProjectVisibilityResponse
+		wantErr error
+	}{
+		{
+			name:    "should return bad body error if body is missing",
+			request: &shieldv1beta1.SetProjectVisibilityRequest{Id: testProjectID},
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return not found error if project doesn't exist",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().SetProjectVisibility(mock.AnythingOfType("context.todoCtx"), testProjectID, schema.ViewPermission, true).Return(project.Project{}, project.ErrNotExist)
+			},
+			request: &shieldv1beta1.SetProjectVisibilityRequest{Id: testProjectID, Body: &shieldv1beta1.VisibilityRequestBody{Permission: schema.ViewPermission, Public: true}},
+			wantErr: grpcProjectNotFoundErr,
+		},
+		{
+			name: "should return permission denied error if user cannot edit the project",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().SetProjectVisibility(mock.AnythingOfType("context.todoCtx"), testProjectID, schema.ViewPermission, true).Return(project.Project{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.SetProjectVisibilityRequest{Id: testProjectID, Body: &shieldv1beta1.VisibilityRequestBody{Permission: schema.ViewPermission, Public: true}},
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return the project if its visibility is set",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().SetProjectVisibility(mock.AnythingOfType("context.todoCtx"), testProjectID, schema.ViewPermission, false).Return(testProjectMap[testProjectID], nil)
+			},
+			request: &shieldv1beta1.SetProjectVisibilityRequest{Id: testProjectID, Body: &shieldv1beta1.VisibilityRequestBody{Permission: schema.ViewPermission}},
+			want: &shieldv1beta1.SetProjectVisibilityResponse{Project: &shieldv1beta1.Project{
+				Id:    testProjectID,
+				Name:  "Prj 1",
+				Slug:  "prj-1",
+				OrgId: testOrgID,
+				Metadata: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"email": structpb.NewStringValue("org1@org1.com"),
+					},
+				},
+				CreatedAt: timestamppb.New(time.Time{}),
+				UpdatedAt: timestamppb.New(time.Time{}),
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockResourceSrv := new(mocks.ResourceService)
+			if tt.setup != nil {
+				tt.setup(mockResourceSrv)
+			}
+			mockDep := Handler{resourceService: mockResourceSrv}
+			resp, err := mockDep.SetProjectVisibility(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
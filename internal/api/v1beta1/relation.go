@@ -10,6 +10,7 @@ import (
 	"github.com/goto/shield/core/group"
 	"github.com/goto/shield/core/namespace"
 	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/schema"
 
@@ -70,11 +71,18 @@ func (h Handler) CreateRelation(ctx context.Context, request *shieldv1beta1.Crea
 		return nil, grpcBadBodyError
 	}
 
+	// custom roles of an organization can only be granted on its own resources
+	roleOrgID, isOrganizationRole := schema.OrganizationOfRelation(request.GetBody().GetRoleName())
 	if !namespace.IsSystemNamespaceID(request.GetBody().GetObjectNamespace()) {
-		_, err := h.resourceService.Get(ctx, request.GetBody().GetObjectId())
+		res, err := h.resourceService.Get(ctx, request.GetBody().GetObjectId())
 		if err != nil {
 			return nil, status.Errorf(codes.NotFound, err.Error())
 		}
+		if isOrganizationRole && roleOrgID != res.OrganizationID {
+			return nil, grpcBadBodyError
+		}
+	} else if isOrganizationRole {
+		return nil, grpcBadBodyError
 	}
 
 	principal, subjectID := extractSubjectFromPrincipal(request.GetBody().GetSubject())
@@ -275,10 +283,13 @@ func (h Handler) verifyPrincipal(ctx context.Context, principal, subjectID strin
 		_, err = h.userService.Get(ctx, subjectID)
 	case strings.Split(schema.GroupPrincipal, "/")[1]:
 		_, err = h.groupService.Get(ctx, subjectID)
+	case strings.Split(schema.ServiceAccountPrincipal, "/")[1]:
+		_, err = h.serviceAccountService.Get(ctx, subjectID)
 	}
 	if err != nil {
 		switch {
-		case errors.Is(err, user.ErrNotExist), errors.Is(err, group.ErrNotExist):
+		case errors.Is(err, user.ErrNotExist), errors.Is(err, group.ErrNotExist),
+			errors.Is(err, serviceaccount.ErrNotExist), errors.Is(err, serviceaccount.ErrInvalidID):
 			return grpcBadBodyError
 		default:
 			return grpcInternalServerError
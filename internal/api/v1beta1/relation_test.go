@@ -145,6 +145,24 @@ func TestHandler_CreateRelation(t *testing.T) {
 			want:    nil,
 			wantErr: grpcInternalServerError,
 		},
+		{
+			name: "should return bad body error if role is a custom role of another org",
+			setup: func(rs *mocks.RelationService, res *mocks.ResourceService) {
+				res.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), testRelationV2.Object.ID).Return(resource.Resource{
+					OrganizationID: "9f256f86-31a3-11ec-8d3d-0242ac130003",
+				}, nil)
+			},
+			request: &shieldv1beta1.CreateRelationRequest{
+				Body: &shieldv1beta1.RelationRequestBody{
+					ObjectId:        testRelationV2.Object.ID,
+					ObjectNamespace: testRelationV2.Object.NamespaceID,
+					Subject:         generateSubject(testRelationV2.Subject.ID, testRelationV2.Subject.Namespace),
+					RoleName:        schema.OrganizationRoleRelation("4d7d7b3d-5b8a-4e3b-9d4c-6a2f1e0c8b9a", "deployer"),
+				},
+			},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
 		{
 			name:  "should return bad body error if object id is not uuid",
 			setup: func(rs *mocks.RelationService, res *mocks.ResourceService) {},
@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/schema"
+	errorsPkg "github.com/goto/shield/pkg/errors"
 	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"google.golang.org/grpc/codes"
@@ -32,9 +34,16 @@ type ResourceService interface {
 	ListUserResourcesByType(ctx context.Context, userID string, resourceType string, permissions []string) (resource.ResourcePermissions, error)
 	ListAllUserResources(ctx context.Context, userID string, resourceTypes []string, permissions []string) (map[string]resource.ResourcePermissions, error)
 	UpsertConfig(ctx context.Context, name string, config string) (schema.Config, error)
+	PlanConfig(ctx context.Context, name string, config string) (schema.Plan, error)
+	ListSchemaVersions(ctx context.Context) ([]schema.SchemaVersion, error)
+	SetVisibility(ctx context.Context, id string, permission string, public bool) (resource.Resource, error)
+	SetProjectVisibility(ctx context.Context, idOrSlug string, permission string, public bool) (project.Project, error)
 }
 
-var grpcResourceNotFoundErr = status.Errorf(codes.NotFound, "resource doesn't exist")
+var (
+	grpcResourceNotFoundErr       = status.Errorf(codes.NotFound, "resource doesn't exist")
+	grpcDestructiveMigrationError = status.Errorf(codes.FailedPrecondition, schema.ErrDestructiveMigration.Error())
+)
 
 func (h Handler) ListResources(ctx context.Context, request *shieldv1beta1.ListResourcesRequest) (*shieldv1beta1.ListResourcesResponse, error) {
 	logger := grpczap.Extract(ctx)
@@ -97,6 +106,7 @@ func (h Handler) CreateResource(ctx context.Context, request *shieldv1beta1.Crea
 		ProjectID:      request.GetBody().GetProjectId(),
 		NamespaceID:    request.GetBody().GetNamespaceId(),
 		Name:           request.GetBody().GetName(),
+		ParentID:       request.GetBody().GetParentId(),
 	})
 	if err != nil {
 		logger.Error(err.Error())
@@ -105,8 +115,11 @@ func (h Handler) CreateResource(ctx context.Context, request *shieldv1beta1.Crea
 			errors.Is(err, user.ErrMissingEmail):
 			return nil, grpcUnauthenticated
 		case errors.Is(err, resource.ErrInvalidUUID),
-			errors.Is(err, resource.ErrInvalidDetail):
+			errors.Is(err, resource.ErrInvalidDetail),
+			errors.Is(err, resource.ErrInvalidParent):
 			return nil, grpcBadBodyError
+		case errors.Is(err, errorsPkg.ErrForbidden):
+			return nil, grpcPermissionDenied
 		default:
 			return nil, grpcInternalServerError
 		}
@@ -233,6 +246,44 @@ func (h Handler) UpdateResource(ctx context.Context, request *shieldv1beta1.Upda
 	}, nil
 }
 
+func (h Handler) SetResourceVisibility(ctx context.Context, request *shieldv1beta1.SetResourceVisibilityRequest) (*shieldv1beta1.SetResourceVisibilityResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if request.GetBody() == nil || strings.TrimSpace(request.GetBody().GetPermission()) == "" {
+		return nil, grpcBadBodyError
+	}
+
+	res, err := h.resourceService.SetVisibility(ctx, request.GetId(), request.GetBody().GetPermission(), request.GetBody().GetPublic())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, resource.ErrNotExist),
+			errors.Is(err, resource.ErrInvalidUUID),
+			errors.Is(err, resource.ErrInvalidID):
+			return nil, grpcResourceNotFoundErr
+		case errors.Is(err, schema.ErrPermissionNotPublic):
+			return nil, grpcBadBodyError
+		case errors.Is(err, errorsPkg.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	resourcePB, err := transformResourceToPB(res)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.SetResourceVisibilityResponse{
+		Resource: &resourcePB,
+	}, nil
+}
+
 func (h Handler) ListAllUserResources(ctx context.Context, request *shieldv1beta1.ListAllUserResourcesRequest) (*shieldv1beta1.ListAllUserResourcesResponse, error) {
 	logger := grpczap.Extract(ctx)
 	resources, err := h.resourceService.ListAllUserResources(ctx, request.UserId, request.Types, request.Permissions)
@@ -318,6 +369,8 @@ func (h Handler) UpsertResourcesConfig(ctx context.Context, request *shieldv1bet
 		case errors.Is(err, resource.ErrInvalidDetail), errors.Is(err, role.ErrNotExist),
 			errors.Is(err, schema.ErrInvalidDetail):
 			return nil, grpcBadBodyError
+		case errors.Is(err, schema.ErrDestructiveMigration):
+			return nil, grpcDestructiveMigrationError
 		default:
 			return nil, grpcInternalServerError
 		}
@@ -327,6 +380,68 @@ func (h Handler) UpsertResourcesConfig(ctx context.Context, request *shieldv1bet
 	return resourceConfigToPB(rc), nil
 }
 
+func (h Handler) PlanResourcesConfig(ctx context.Context, request *shieldv1beta1.PlanResourcesConfigRequest) (*shieldv1beta1.PlanResourcesConfigResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	plan, err := h.resourceService.PlanConfig(ctx, request.GetName(), request.GetConfig())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, schema.ErrPlanNotSupported):
+			return nil, grpcUnsupportedError
+		case errors.Is(err, schema.ErrInvalidDetail), errors.Is(err, role.ErrNotExist):
+			return nil, grpcBadBodyError
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	changes := make([]*shieldv1beta1.ResourcesConfigChange, 0, len(plan.Changes))
+	for _, c := range plan.Changes {
+		changes = append(changes, &shieldv1beta1.ResourcesConfigChange{
+			Action:        string(c.Action),
+			Entity:        c.Entity,
+			Id:            c.ID,
+			Detail:        c.Detail,
+			LiveRelations: c.LiveRelations,
+			Destructive:   c.Destructive(),
+		})
+	}
+
+	return &shieldv1beta1.PlanResourcesConfigResponse{
+		Changes:     changes,
+		Destructive: plan.IsDestructive(),
+	}, nil
+}
+
+func (h Handler) ListSchemaVersions(ctx context.Context, request *shieldv1beta1.ListSchemaVersionsRequest) (*shieldv1beta1.ListSchemaVersionsResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	versions, err := h.resourceService.ListSchemaVersions(ctx)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	versionsPB := make([]*shieldv1beta1.SchemaVersion, 0, len(versions))
+	for _, v := range versions {
+		config, err := json.Marshal(v.Config)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, grpcInternalServerError
+		}
+
+		versionsPB = append(versionsPB, &shieldv1beta1.SchemaVersion{
+			Version:   v.Version,
+			Schema:    v.Schema,
+			Config:    string(config),
+			CreatedAt: timestamppb.New(v.CreatedAt),
+		})
+	}
+
+	return &shieldv1beta1.ListSchemaVersionsResponse{Versions: versionsPB}, nil
+}
+
 func resourceConfigToPB(from schema.Config) *shieldv1beta1.UpsertResourcesConfigResponse {
 	return &shieldv1beta1.UpsertResourcesConfigResponse{
 		Id:        from.ID,
@@ -355,6 +470,7 @@ func transformResourceToPB(from resource.Resource) (shieldv1beta1.Resource, erro
 		User: &shieldv1beta1.User{
 			Id: from.UserID,
 		},
+		ParentId:  from.ParentID,
 		CreatedAt: timestamppb.New(from.CreatedAt),
 		UpdatedAt: timestamppb.New(from.UpdatedAt),
 	}, nil
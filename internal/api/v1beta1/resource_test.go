@@ -15,6 +15,7 @@ import (
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/api/v1beta1/mocks"
 	"github.com/goto/shield/internal/schema"
+	errorsPkg "github.com/goto/shield/pkg/errors"
 	"github.com/goto/shield/pkg/uuid"
 	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
 	"github.com/stretchr/testify/assert"
@@ -274,6 +275,66 @@ func TestHandler_CreateResource(t *testing.T) {
 			want:    nil,
 			wantErr: grpcInternalServerError,
 		},
+		{
+			name: "should return bad request error if parent resource is invalid",
+			setup: func(ctx context.Context, rs *mocks.ResourceService, ps *mocks.ProjectService, rls *mocks.RelationService, _ *mocks.RelationTransformer) context.Context {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.valueCtx"), testResource.ProjectID).Return(project.Project{
+					ID: testResourceID,
+					Organization: organization.Organization{
+						ID: testResource.OrganizationID,
+					},
+				}, nil)
+
+				rs.EXPECT().Upsert(mock.AnythingOfType("*context.valueCtx"), resource.Resource{
+					Name:           testResource.Name,
+					ProjectID:      testResource.ProjectID,
+					OrganizationID: testResource.OrganizationID,
+					NamespaceID:    testResource.NamespaceID,
+					ParentID:       testResourceID,
+				}).Return(resource.Resource{}, resource.ErrInvalidParent)
+				return user.SetContextWithEmail(ctx, email)
+			},
+			request: &shieldv1beta1.CreateResourceRequest{
+				Body: &shieldv1beta1.ResourceRequestBody{
+					Name:        testResource.Name,
+					ProjectId:   testResource.ProjectID,
+					NamespaceId: testResource.NamespaceID,
+					ParentId:    testResourceID,
+				},
+			},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return permission denied error if the parent can't be edited by the current user",
+			setup: func(ctx context.Context, rs *mocks.ResourceService, ps *mocks.ProjectService, rls *mocks.RelationService, _ *mocks.RelationTransformer) context.Context {
+				ps.EXPECT().Get(mock.AnythingOfType("*context.valueCtx"), testResource.ProjectID).Return(project.Project{
+					ID: testResourceID,
+					Organization: organization.Organization{
+						ID: testResource.OrganizationID,
+					},
+				}, nil)
+
+				rs.EXPECT().Upsert(mock.AnythingOfType("*context.valueCtx"), resource.Resource{
+					Name:           testResource.Name,
+					ProjectID:      testResource.ProjectID,
+					OrganizationID: testResource.OrganizationID,
+					NamespaceID:    testResource.NamespaceID,
+					ParentID:       testResourceID,
+				}).Return(resource.Resource{}, errorsPkg.ErrForbidden)
+				return user.SetContextWithEmail(ctx, email)
+			},
+			request: &shieldv1beta1.CreateResourceRequest{
+				Body: &shieldv1beta1.ResourceRequestBody{
+					Name:        testResource.Name,
+					ProjectId:   testResource.ProjectID,
+					NamespaceId: testResource.NamespaceID,
+					ParentId:    testResourceID,
+				},
+			},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
 		{
 			name: "should return bad request error if field value not exist in foreign reference",
 			setup: func(ctx context.Context, rs *mocks.ResourceService, ps *mocks.ProjectService, rls *mocks.RelationService, _ *mocks.RelationTransformer) context.Context {
@@ -1056,6 +1117,19 @@ func TestHandler_UpsertResourcesConfig(t *testing.T) {
 			},
 			wantErr: grpcUnsupportedError,
 		},
+		{
+			name: "should return failed precondition error if the config removes roles still having relations",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().UpsertConfig(mock.AnythingOfType("context.todoCtx"),
+					"entropy", "entropy:\n  type: resource_group\n  resource_types:\n    - name: firehose").
+					Return(schema.Config{}, schema.ErrDestructiveMigration)
+			},
+			request: &shieldv1beta1.UpsertResourcesConfigRequest{
+				Name:   "entropy",
+				Config: "entropy:\n  type: resource_group\n  resource_types:\n    - name: firehose",
+			},
+			wantErr: grpcDestructiveMigrationError,
+		},
 		{
 			name: "should return internal error if service return unmarshal err",
 			setup: func(rs *mocks.ResourceService) {
@@ -1083,3 +1157,195 @@ func TestHandler_UpsertResourcesConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_PlanResourcesConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(rs *mocks.ResourceService)
+		request *shieldv1beta1.PlanResourcesConfigRequest
+		want    *shieldv1beta1.PlanResourcesConfigResponse
+		wantErr error
+	}{
+		{
+			name: "should return changes and flag destructive ones",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().PlanConfig(mock.AnythingOfType("context.todoCtx"),
+					"entropy", "entropy:\n  type: resource_group\n  resource_types:\n    - name: firehose").
+					Return(schema.Plan{Changes: []schema.Change{
+						{Action: schema.ChangeCreate, Entity: schema.EntityRole, ID: "entropy/firehose:viewer", Detail: "shield/user"},
+						{Action: schema.ChangeDelete, Entity: schema.EntityRole, ID: "entropy/firehose:editor", Detail: "shield/user", LiveRelations: 2},
+					}}, nil)
+			},
+			request: &shieldv1beta1.PlanResourcesConfigRequest{
+				Name:   "entropy",
+				Config: "entropy:\n  type: resource_group\n  resource_types:\n    - name: firehose",
+			},
+			want: &shieldv1beta1.PlanResourcesConfigResponse{
+				Changes: []*shieldv1beta1.ResourcesConfigChange{
+					{Action: "create", Entity: "role", Id: "entropy/firehose:viewer", Detail: "shield/user"},
+					{Action: "delete", Entity: "role", Id: "entropy/firehose:editor", Detail: "shield/user", LiveRelations: 2, Destructive: true},
+				},
+				Destructive: true,
+			},
+		},
+		{
+			name: "should return bad body error if config is invalid",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().PlanConfig(mock.AnythingOfType("context.todoCtx"), "entropy", "entropy").
+					Return(schema.Plan{}, schema.ErrInvalidDetail)
+			},
+			request: &shieldv1beta1.PlanResourcesConfigRequest{
+				Name:   "entropy",
+				Config: "entropy",
+			},
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return not supported error if config is not stored in postgres",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().PlanConfig(mock.AnythingOfType("context.todoCtx"),
+					"entropy", "entropy:\n  type: resource_group\n  resource_types:\n    - name: firehose").
+					Return(schema.Plan{}, schema.ErrPlanNotSupported)
+			},
+			request: &shieldv1beta1.PlanResourcesConfigRequest{
+				Name:   "entropy",
+				Config: "entropy:\n  type: resource_group\n  resource_types:\n    - name: firehose",
+			},
+			wantErr: grpcUnsupportedError,
+		},
+		{
+			name: "should return internal error if planning fails",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().PlanConfig(mock.AnythingOfType("context.todoCtx"), "", "").
+					Return(schema.Plan{}, schema.ErrMigration)
+			},
+			request: &shieldv1beta1.PlanResourcesConfigRequest{},
+			wantErr: grpcInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockResourceSrv := new(mocks.ResourceService)
+			if tt.setup != nil {
+				tt.setup(mockResourceSrv)
+			}
+			mockDep := Handler{resourceService: mockResourceSrv}
+			resp, err := mockDep.PlanResourcesConfig(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_ListSchemaVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(rs *mocks.ResourceService)
+		want    *shieldv1beta1.ListSchemaVersionsResponse
+		wantErr error
+	}{
+		{
+			name: "should return internal error if listing versions fails",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().ListSchemaVersions(mock.AnythingOfType("context.todoCtx")).Return(nil, errors.New("some error"))
+			},
+			wantErr: grpcInternalServerError,
+		},
+		{
+			name: "should return versions with their config",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().ListSchemaVersions(mock.AnythingOfType("context.todoCtx")).Return([]schema.SchemaVersion{
+					{
+						Version: 2,
+						Schema:  "definition entropy/firehose {}",
+						Config: schema.NamespaceConfigMapType{
+							"entropy/firehose": {Type: schema.ResourceGroupNamespace},
+						},
+					},
+				}, nil)
+			},
+			want: &shieldv1beta1.ListSchemaVersionsResponse{
+				Versions: []*shieldv1beta1.SchemaVersion{
+					{
+						Version:   2,
+						Schema:    "definition entropy/firehose {}",
+						Config:    `{"entropy/firehose":{"InheritedNamespaces":null,"Type":"resource_group_namespace","Roles":null,"Permissions":null}}`,
+						CreatedAt: timestamppb.New(time.Time{}),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockResourceSrv := new(mocks.ResourceService)
+			if tt.setup != nil {
+				tt.setup(mockResourceSrv)
+			}
+			mockDep := Handler{resourceService: mockResourceSrv}
+			resp, err := mockDep.ListSchemaVersions(context.TODO(), &shieldv1beta1.ListSchemaVersionsRequest{})
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_SetResourceVisibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(rs *mocks.ResourceService)
+		request *shieldv1beta1.SetResourceVisibilityRequest
+		want    *shieldv1beta1.SetResourceVisibilityResponse
+		wantErr error
+	}{
+		{
+			name:    "should return bad body error if permission is empty",
+			request: &shieldv1beta1.SetResourceVisibilityRequest{Id: testResourceID, Body: &shieldv1beta1.VisibilityRequestBody{Public: true}},
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return not found error if resource doesn't exist",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().SetVisibility(mock.AnythingOfType("context.todoCtx"), testResourceID, schema.ViewPermission, true).Return(resource.Resource{}, resource.ErrNotExist)
+			},
+			request: &shieldv1beta1.SetResourceVisibilityRequest{Id: testResourceID, Body: &shieldv1beta1.VisibilityRequestBody{Permission: schema.ViewPermission, Public: true}},
+			wantErr: grpcResourceNotFoundErr,
+		},
+		{
+			name: "should return bad body error if permission can't be made public",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().SetVisibility(mock.AnythingOfType("context.todoCtx"), testResourceID, schema.EditPermission, true).Return(resource.Resource{}, schema.ErrPermissionNotPublic)
+			},
+			request: &shieldv1beta1.SetResourceVisibilityRequest{Id: testResourceID, Body: &shieldv1beta1.VisibilityRequestBody{Permission: schema.EditPermission, Public: true}},
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return permission denied error if user cannot edit the resource",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().SetVisibility(mock.AnythingOfType("context.todoCtx"), testResourceID, schema.ViewPermission, false).Return(resource.Resource{}, errorsPkg.ErrForbidden)
+			},
+			request: &shieldv1beta1.SetResourceVisibilityRequest{Id: testResourceID, Body: &shieldv1beta1.VisibilityRequestBody{Permission: schema.ViewPermission}},
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return the resource if its visibility is set",
+			setup: func(rs *mocks.ResourceService) {
+				rs.EXPECT().SetVisibility(mock.AnythingOfType("context.todoCtx"), testResourceID, schema.ViewPermission, true).Return(testResource, nil)
+			},
+			request: &shieldv1beta1.SetResourceVisibilityRequest{Id: testResourceID, Body: &shieldv1beta1.VisibilityRequestBody{Permission: schema.ViewPermission, Public: true}},
+			want:    &shieldv1beta1.SetResourceVisibilityResponse{Resource: testResourcePB},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockResourceSrv := new(mocks.ResourceService)
+			if tt.setup != nil {
+				tt.setup(mockResourceSrv)
+			}
+			mockDep := Handler{resourceService: mockResourceSrv}
+			resp, err := mockDep.SetResourceVisibility(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
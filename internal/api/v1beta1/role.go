@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/organization"
 	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/pkg/metadata"
@@ -22,7 +23,7 @@ var grpcRoleNotFoundErr = status.Errorf(codes.NotFound, "role doesn't exist")
 type RoleService interface {
 	Get(ctx context.Context, id string) (role.Role, error)
 	Upsert(ctx context.Context, toUpsert role.Role) (role.Role, error)
-	List(ctx context.Context) ([]role.Role, error)
+	List(ctx context.Context, flt role.Filter) ([]role.Role, error)
 	Update(ctx context.Context, toUpdate role.Role) (role.Role, error)
 }
 
@@ -30,7 +31,24 @@ func (h Handler) ListRoles(ctx context.Context, request *shieldv1beta1.ListRoles
 	logger := grpczap.Extract(ctx)
 	var roles []*shieldv1beta1.Role
 
-	roleList, err := h.roleService.List(ctx)
+	var flt role.Filter
+	if request.GetOrgId() != "" {
+		org, err := h.orgService.Get(ctx, request.GetOrgId())
+		if err != nil {
+			logger.Error(err.Error())
+			switch {
+			case errors.Is(err, organization.ErrNotExist),
+				errors.Is(err, organization.ErrInvalidUUID),
+				errors.Is(err, organization.ErrInvalidID):
+				return nil, grpcOrgNotFoundErr
+			default:
+				return nil, grpcInternalServerError
+			}
+		}
+		flt.OrgID = org.ID
+	}
+
+	roleList, err := h.roleService.List(ctx, flt)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, grpcInternalServerError
@@ -166,15 +184,14 @@ func transformRoleToPB(from role.Role) (shieldv1beta1.Role, error) {
 	}
 
 	return shieldv1beta1.Role{
-		Id:    from.ID,
-		Name:  from.Name,
-		Types: from.Types,
-		// TODO(krtkvrm): use namespace id here instead of namespace as object
-		// Namespace: &namespace,
-		// Tags:      nil,
-		// Actions:   nil,
-		Metadata:  metaData,
-		CreatedAt: timestamppb.New(from.CreatedAt),
-		UpdatedAt: timestamppb.New(from.UpdatedAt),
+		Id:          from.ID,
+		Name:        from.Name,
+		Types:       from.Types,
+		NamespaceId: from.NamespaceID,
+		OrgId:       from.OrgID,
+		Permissions: from.Permissions,
+		Metadata:    metaData,
+		CreatedAt:   timestamppb.New(from.CreatedAt),
+		UpdatedAt:   timestamppb.New(from.UpdatedAt),
 	}, nil
 }
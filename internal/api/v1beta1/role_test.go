@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/organization"
 	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/internal/api/v1beta1/mocks"
 	"github.com/goto/shield/pkg/metadata"
@@ -38,34 +39,54 @@ var (
 func TestHandler_ListRoles(t *testing.T) {
 	tests := []struct {
 		name    string
-		setup   func(rs *mocks.RoleService)
+		setup   func(rs *mocks.RoleService, os *mocks.OrganizationService)
 		request *shieldv1beta1.ListRolesRequest
 		want    *shieldv1beta1.ListRolesResponse
 		wantErr error
 	}{
 		{
 			name: "should return internal error if role service return some error",
-			setup: func(rs *mocks.RoleService) {
-				rs.EXPECT().List(mock.AnythingOfType("context.todoCtx")).Return([]role.Role{}, errors.New("some error"))
+			setup: func(rs *mocks.RoleService, os *mocks.OrganizationService) {
+				rs.EXPECT().List(mock.AnythingOfType("context.todoCtx"), role.Filter{}).Return([]role.Role{}, errors.New("some error"))
 			},
 			want:    nil,
 			wantErr: grpcInternalServerError,
 		},
+		{
+			name: "should return not found error if org of the filter doesn't exist",
+			setup: func(rs *mocks.RoleService, os *mocks.OrganizationService) {
+				os.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), "org-1").Return(organization.Organization{}, organization.ErrNotExist)
+			},
+			request: &shieldv1beta1.ListRolesRequest{OrgId: "org-1"},
+			want:    nil,
+			wantErr: grpcOrgNotFoundErr,
+		},
+		{
+			name: "should list custom roles of the org",
+			setup: func(rs *mocks.RoleService, os *mocks.OrganizationService) {
+				os.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), "org-1").Return(testOrgMap[testOrgID], nil)
+				rs.EXPECT().List(mock.AnythingOfType("context.todoCtx"), role.Filter{OrgID: testOrgID}).Return([]role.Role{}, nil)
+			},
+			request: &shieldv1beta1.ListRolesRequest{OrgId: "org-1"},
+			want:    &shieldv1beta1.ListRolesResponse{},
+			wantErr: nil,
+		},
 		{
 			name: "should return success if role service return nil error",
-			setup: func(rs *mocks.RoleService) {
+			setup: func(rs *mocks.RoleService, os *mocks.OrganizationService) {
 				var testRolesList []role.Role
 				for _, rl := range testRoleMap {
 					testRolesList = append(testRolesList, rl)
 				}
-				rs.EXPECT().List(mock.AnythingOfType("context.todoCtx")).Return(testRolesList, nil)
+				rs.EXPECT().List(mock.AnythingOfType("context.todoCtx"), role.Filter{}).Return(testRolesList, nil)
 			},
 			want: &shieldv1beta1.ListRolesResponse{
 				Roles: []*shieldv1beta1.Role{
 					{
-						Id:    testRoleMap[testRoleID].ID,
-						Name:  testRoleMap[testRoleID].Name,
-						Types: testRoleMap[testRoleID].Types,
+						Id:          testRoleMap[testRoleID].ID,
+						Name:        testRoleMap[testRoleID].Name,
+						Types:       testRoleMap[testRoleID].Types,
+						NamespaceId: testRoleMap[testRoleID].NamespaceID,
 						Metadata: &structpb.Struct{
 							Fields: map[string]*structpb.Value{
 								"foo": structpb.NewStringValue("bar"),
@@ -82,10 +103,11 @@ func TestHandler_ListRoles(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRoleSrv := new(mocks.RoleService)
+			mockOrgSrv := new(mocks.OrganizationService)
 			if tt.setup != nil {
-				tt.setup(mockRoleSrv)
+				tt.setup(mockRoleSrv, mockOrgSrv)
 			}
-			mockDep := Handler{roleService: mockRoleSrv}
+			mockDep := Handler{roleService: mockRoleSrv, orgService: mockOrgSrv}
 			resp, err := mockDep.ListRoles(context.TODO(), tt.request)
 			assert.EqualValues(t, tt.want, resp)
 			assert.EqualValues(t, tt.wantErr, err)
@@ -230,9 +252,10 @@ func TestHandler_CreateRole(t *testing.T) {
 			},
 			want: &shieldv1beta1.CreateRoleResponse{
 				Role: &shieldv1beta1.Role{
-					Id:    testRoleMap[testRoleID].ID,
-					Name:  testRoleMap[testRoleID].Name,
-					Types: testRoleMap[testRoleID].Types,
+					Id:          testRoleMap[testRoleID].ID,
+					Name:        testRoleMap[testRoleID].Name,
+					Types:       testRoleMap[testRoleID].Types,
+					NamespaceId: testRoleMap[testRoleID].NamespaceID,
 					Metadata: &structpb.Struct{
 						Fields: map[string]*structpb.Value{
 							"foo": structpb.NewStringValue("bar"),
@@ -308,9 +331,10 @@ func TestHandler_GetRole(t *testing.T) {
 			},
 			want: &shieldv1beta1.GetRoleResponse{
 				Role: &shieldv1beta1.Role{
-					Id:    testRoleMap[testRoleID].ID,
-					Name:  testRoleMap[testRoleID].Name,
-					Types: testRoleMap[testRoleID].Types,
+					Id:          testRoleMap[testRoleID].ID,
+					Name:        testRoleMap[testRoleID].Name,
+					Types:       testRoleMap[testRoleID].Types,
+					NamespaceId: testRoleMap[testRoleID].NamespaceID,
 					Metadata: &structpb.Struct{
 						Fields: map[string]*structpb.Value{
 							"foo": structpb.NewStringValue("bar"),
@@ -0,0 +1,258 @@
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/metadata"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ServiceAccountService interface {
+	Create(ctx context.Context, serviceAccount serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error)
+	Get(ctx context.Context, id string) (serviceaccount.ServiceAccount, error)
+	List(ctx context.Context, flt serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error)
+	CreateKey(ctx context.Context, serviceAccountID string, expiresAt time.Time) (serviceaccount.Key, error)
+	ListKeys(ctx context.Context, serviceAccountID string) ([]serviceaccount.Key, error)
+	RotateKey(ctx context.Context, serviceAccountID, keyID string, gracePeriod time.Duration) (serviceaccount.Key, error)
+	RevokeKey(ctx context.Context, serviceAccountID, keyID string) error
+}
+
+var (
+	grpcServiceAccountNotFoundErr    = status.Errorf(codes.NotFound, "service account doesn't exist")
+	grpcServiceAccountKeyNotFoundErr = status.Errorf(codes.NotFound, "service account key doesn't exist")
+)
+
+func (h Handler) ListServiceAccounts(ctx context.Context, request *shieldv1beta1.ListServiceAccountsRequest) (*shieldv1beta1.ListServiceAccountsResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	serviceAccountList, err := h.serviceAccountService.List(ctx, serviceaccount.Filter{
+		OrganizationID: request.GetOrgId(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	var serviceAccounts []*shieldv1beta1.ServiceAccount
+	for _, sa := range serviceAccountList {
+		serviceAccountPB, err := transformServiceAccountToPB(sa)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, grpcInternalServerError
+		}
+		serviceAccounts = append(serviceAccounts, &serviceAccountPB)
+	}
+
+	return &shieldv1beta1.ListServiceAccountsResponse{ServiceAccounts: serviceAccounts}, nil
+}
+
+func (h Handler) CreateServiceAccount(ctx context.Context, request *shieldv1beta1.CreateServiceAccountRequest) (*shieldv1beta1.CreateServiceAccountResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if request.GetBody() == nil {
+		return nil, grpcBadBodyError
+	}
+
+	metaDataMap, err := metadata.Build(request.GetBody().GetMetadata().AsMap())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcBadBodyError
+	}
+
+	org, err := h.orgService.Get(ctx, request.GetBody().GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, organization.ErrNotExist),
+			errors.Is(err, organization.ErrInvalidUUID),
+			errors.Is(err, organization.ErrInvalidID):
+			return nil, grpcOrgNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	newServiceAccount, err := h.serviceAccountService.Create(ctx, serviceaccount.ServiceAccount{
+		Name:           request.GetBody().GetName(),
+		OrganizationID: org.ID,
+		Metadata:       metaDataMap,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, serviceaccount.ErrInvalidDetail):
+			return nil, grpcBadBodyError
+		case errors.Is(err, serviceaccount.ErrConflict):
+			return nil, grpcConflictError
+		case errors.Is(err, errors.ErrForbidden):
+			return nil, grpcPermissionDenied
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			return nil, grpcUnauthenticated
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	serviceAccountPB, err := transformServiceAccountToPB(newServiceAccount)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.CreateServiceAccountResponse{ServiceAccount: &serviceAccountPB}, nil
+}
+
+func (h Handler) GetServiceAccount(ctx context.Context, request *shieldv1beta1.GetServiceAccountRequest) (*shieldv1beta1.GetServiceAccountResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	fetchedServiceAccount, err := h.serviceAccountService.Get(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, serviceaccount.ErrNotExist),
+			errors.Is(err, serviceaccount.ErrInvalidID):
+			return nil, grpcServiceAccountNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	serviceAccountPB, err := transformServiceAccountToPB(fetchedServiceAccount)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.GetServiceAccountResponse{ServiceAccount: &serviceAccountPB}, nil
+}
+
+func (h Handler) ListServiceAccountKeys(ctx context.Context, request *shieldv1beta1.ListServiceAccountKeysRequest) (*shieldv1beta1.ListServiceAccountKeysResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	keyList, err := h.serviceAccountService.ListKeys(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, serviceaccount.ErrNotExist),
+			errors.Is(err, serviceaccount.ErrInvalidID):
+			return nil, grpcServiceAccountNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	var keys []*shieldv1beta1.ServiceAccountKey
+	for _, k := range keyList {
+		keys = append(keys, transformServiceAccountKeyToPB(k))
+	}
+
+	return &shieldv1beta1.ListServiceAccountKeysResponse{Keys: keys}, nil
+}
+
+func (h Handler) CreateServiceAccountKey(ctx context.Context, request *shieldv1beta1.CreateServiceAccountKeyRequest) (*shieldv1beta1.CreateServiceAccountKeyResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	var expiresAt time.Time
+	if request.GetExpiresAt() != nil {
+		expiresAt = request.GetExpiresAt().AsTime()
+	}
+
+	newKey, err := h.serviceAccountService.CreateKey(ctx, request.GetId(), expiresAt)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountKeyError(err)
+	}
+
+	return &shieldv1beta1.CreateServiceAccountKeyResponse{
+		Key:   transformServiceAccountKeyToPB(newKey),
+		Token: newKey.Token(),
+	}, nil
+}
+
+func (h Handler) RotateServiceAccountKey(ctx context.Context, request *shieldv1beta1.RotateServiceAccountKeyRequest) (*shieldv1beta1.RotateServiceAccountKeyResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	newKey, err := h.serviceAccountService.RotateKey(ctx, request.GetId(), request.GetKeyId(), request.GetGracePeriod().AsDuration())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountKeyError(err)
+	}
+
+	return &shieldv1beta1.RotateServiceAccountKeyResponse{
+		Key:   transformServiceAccountKeyToPB(newKey),
+		Token: newKey.Token(),
+	}, nil
+}
+
+func (h Handler) RevokeServiceAccountKey(ctx context.Context, request *shieldv1beta1.RevokeServiceAccountKeyRequest) (*shieldv1beta1.RevokeServiceAccountKeyResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if err := h.serviceAccountService.RevokeKey(ctx, request.GetId(), request.GetKeyId()); err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountKeyError(err)
+	}
+
+	return &shieldv1beta1.RevokeServiceAccountKeyResponse{}, nil
+}
+
+func serviceAccountKeyError(err error) error {
+	switch {
+	case errors.Is(err, serviceaccount.ErrNotExist),
+		errors.Is(err, serviceaccount.ErrInvalidID):
+		return grpcServiceAccountNotFoundErr
+	case errors.Is(err, serviceaccount.ErrKeyNotExist):
+		return grpcServiceAccountKeyNotFoundErr
+	case errors.Is(err, serviceaccount.ErrInvalidDetail),
+		errors.Is(err, serviceaccount.ErrKeyRevoked):
+		return grpcBadBodyError
+	case errors.Is(err, errors.ErrForbidden):
+		return grpcPermissionDenied
+	case errors.Is(err, user.ErrInvalidEmail),
+		errors.Is(err, user.ErrMissingEmail):
+		return grpcUnauthenticated
+	default:
+		return grpcInternalServerError
+	}
+}
+
+func transformServiceAccountToPB(serviceAccount serviceaccount.ServiceAccount) (shieldv1beta1.ServiceAccount, error) {
+	metaData, err := serviceAccount.Metadata.ToStructPB()
+	if err != nil {
+		return shieldv1beta1.ServiceAccount{}, err
+	}
+
+	return shieldv1beta1.ServiceAccount{
+		Id:        serviceAccount.ID,
+		Name:      serviceAccount.Name,
+		OrgId:     serviceAccount.OrganizationID,
+		Metadata:  metaData,
+		CreatedAt: timestamppb.New(serviceAccount.CreatedAt),
+		UpdatedAt: timestamppb.New(serviceAccount.UpdatedAt),
+	}, nil
+}
+
+func transformServiceAccountKeyToPB(key serviceaccount.Key) *shieldv1beta1.ServiceAccountKey {
+	keyPB := &shieldv1beta1.ServiceAccountKey{
+		Id:               key.ID,
+		ServiceAccountId: key.ServiceAccountID,
+		CreatedAt:        timestamppb.New(key.CreatedAt),
+	}
+	if !key.ExpiresAt.IsZero() {
+		keyPB.ExpiresAt = timestamppb.New(key.ExpiresAt)
+	}
+	if !key.RevokedAt.IsZero() {
+		keyPB.RevokedAt = timestamppb.New(key.RevokedAt)
+	}
+	return keyPB
+}
@@ -0,0 +1,214 @@
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/api/v1beta1/mocks"
+	"github.com/goto/shield/pkg/errors"
+	"github.com/goto/shield/pkg/metadata"
+	"github.com/goto/shield/pkg/uuid"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testServiceAccountID    = uuid.NewString()
+	testServiceAccountKeyID = uuid.NewString()
+	testServiceAccount      = serviceaccount.ServiceAccount{
+		ID:             testServiceAccountID,
+		Name:           "deployer",
+		OrganizationID: uuid.NewString(),
+		Metadata:       metadata.Metadata{},
+		CreatedAt:      time.Time{},
+		UpdatedAt:      time.Time{},
+	}
+	testServiceAccountKeyExpiry = time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+)
+
+func TestHandler_GetServiceAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(ss *mocks.ServiceAccountService)
+		request *shieldv1beta1.GetServiceAccountRequest
+		want    *shieldv1beta1.GetServiceAccountResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if service account doesn't exist",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), testServiceAccountID).Return(serviceaccount.ServiceAccount{}, serviceaccount.ErrNotExist)
+			},
+			request: &shieldv1beta1.GetServiceAccountRequest{Id: testServiceAccountID},
+			want:    nil,
+			wantErr: grpcServiceAccountNotFoundErr,
+		},
+		{
+			name: "should return not found error if service account id is invalid",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), "invalid").Return(serviceaccount.ServiceAccount{}, serviceaccount.ErrInvalidID)
+			},
+			request: &shieldv1beta1.GetServiceAccountRequest{Id: "invalid"},
+			want:    nil,
+			wantErr: grpcServiceAccountNotFoundErr,
+		},
+		{
+			name: "should return service account if no error",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().Get(mock.AnythingOfType("context.todoCtx"), testServiceAccountID).Return(testServiceAccount, nil)
+			},
+			request: &shieldv1beta1.GetServiceAccountRequest{Id: testServiceAccountID},
+			want: &shieldv1beta1.GetServiceAccountResponse{
+				ServiceAccount: &shieldv1beta1.ServiceAccount{
+					Id:        testServiceAccount.ID,
+					Name:      testServiceAccount.Name,
+					OrgId:     testServiceAccount.OrganizationID,
+					Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{}},
+					CreatedAt: timestamppb.New(time.Time{}),
+					UpdatedAt: timestamppb.New(time.Time{}),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiceAccountService := new(mocks.ServiceAccountService)
+			if tt.setup != nil {
+				tt.setup(mockServiceAccountService)
+			}
+			mockDep := Handler{serviceAccountService: mockServiceAccountService}
+			resp, err := mockDep.GetServiceAccount(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_CreateServiceAccountKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(ss *mocks.ServiceAccountService)
+		request *shieldv1beta1.CreateServiceAccountKeyRequest
+		want    *shieldv1beta1.CreateServiceAccountKeyResponse
+		wantErr error
+	}{
+		{
+			name: "should return unauthenticated error if auth email in context is empty",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().CreateKey(mock.AnythingOfType("context.todoCtx"), testServiceAccountID, time.Time{}).Return(serviceaccount.Key{}, user.ErrInvalidEmail)
+			},
+			request: &shieldv1beta1.CreateServiceAccountKeyRequest{Id: testServiceAccountID},
+			want:    nil,
+			wantErr: grpcUnauthenticated,
+		},
+		{
+			name: "should return permission denied error if user can't edit the organization",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().CreateKey(mock.AnythingOfType("context.todoCtx"), testServiceAccountID, time.Time{}).Return(serviceaccount.Key{}, errors.ErrForbidden)
+			},
+			request: &shieldv1beta1.CreateServiceAccountKeyRequest{Id: testServiceAccountID},
+			want:    nil,
+			wantErr: grpcPermissionDenied,
+		},
+		{
+			name: "should return bad body error if expiry is in the past",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().CreateKey(mock.AnythingOfType("context.todoCtx"), testServiceAccountID, time.Unix(0, 0).UTC()).Return(serviceaccount.Key{}, serviceaccount.ErrInvalidDetail)
+			},
+			request: &shieldv1beta1.CreateServiceAccountKeyRequest{Id: testServiceAccountID, ExpiresAt: timestamppb.New(time.Unix(0, 0))},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should return created key and its token if no error",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().CreateKey(mock.AnythingOfType("context.todoCtx"), testServiceAccountID, testServiceAccountKeyExpiry).Return(serviceaccount.Key{
+					ID:               testServiceAccountKeyID,
+					ServiceAccountID: testServiceAccountID,
+					Secret:           "secret",
+					ExpiresAt:        testServiceAccountKeyExpiry,
+				}, nil)
+			},
+			request: &shieldv1beta1.CreateServiceAccountKeyRequest{Id: testServiceAccountID, ExpiresAt: timestamppb.New(testServiceAccountKeyExpiry)},
+			want: &shieldv1beta1.CreateServiceAccountKeyResponse{
+				Key: &shieldv1beta1.ServiceAccountKey{
+					Id:               testServiceAccountKeyID,
+					ServiceAccountId: testServiceAccountID,
+					ExpiresAt:        timestamppb.New(testServiceAccountKeyExpiry),
+					CreatedAt:        timestamppb.New(time.Time{}),
+				},
+				Token: serviceaccount.KeyPrefix + testServiceAccountKeyID + ".secret",
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiceAccountService := new(mocks.ServiceAccountService)
+			if tt.setup != nil {
+				tt.setup(mockServiceAccountService)
+			}
+			mockDep := Handler{serviceAccountService: mockServiceAccountService}
+			resp, err := mockDep.CreateServiceAccountKey(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandler_RevokeServiceAccountKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(ss *mocks.ServiceAccountService)
+		request *shieldv1beta1.RevokeServiceAccountKeyRequest
+		want    *shieldv1beta1.RevokeServiceAccountKeyResponse
+		wantErr error
+	}{
+		{
+			name: "should return not found error if key doesn't exist",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().RevokeKey(mock.AnythingOfType("context.todoCtx"), testServiceAccountID, testServiceAccountKeyID).Return(serviceaccount.ErrKeyNotExist)
+			},
+			request: &shieldv1beta1.RevokeServiceAccountKeyRequest{Id: testServiceAccountID, KeyId: testServiceAccountKeyID},
+			want:    nil,
+			wantErr: grpcServiceAccountKeyNotFoundErr,
+		},
+		{
+			name: "should return bad body error if key is already revoked",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().RevokeKey(mock.AnythingOfType("context.todoCtx"), testServiceAccountID, testServiceAccountKeyID).Return(serviceaccount.ErrKeyRevoked)
+			},
+			request: &shieldv1beta1.RevokeServiceAccountKeyRequest{Id: testServiceAccountID, KeyId: testServiceAccountKeyID},
+			want:    nil,
+			wantErr: grpcBadBodyError,
+		},
+		{
+			name: "should revoke key if no error",
+			setup: func(ss *mocks.ServiceAccountService) {
+				ss.EXPECT().RevokeKey(mock.AnythingOfType("context.todoCtx"), testServiceAccountID, testServiceAccountKeyID).Return(nil)
+			},
+			request: &shieldv1beta1.RevokeServiceAccountKeyRequest{Id: testServiceAccountID, KeyId: testServiceAccountKeyID},
+			want:    &shieldv1beta1.RevokeServiceAccountKeyResponse{},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiceAccountService := new(mocks.ServiceAccountService)
+			if tt.setup != nil {
+				tt.setup(mockServiceAccountService)
+			}
+			mockDep := Handler{serviceAccountService: mockServiceAccountService}
+			resp, err := mockDep.RevokeServiceAccountKey(context.TODO(), tt.request)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.wantErr, err)
+		})
+	}
+}
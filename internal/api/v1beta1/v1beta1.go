@@ -22,42 +22,50 @@ type Handler struct {
 	shieldv1beta1.UnimplementedShieldServiceServer
 	shieldv1beta1.UnimplementedServiceDataServiceServer
 	shieldv1beta1.UnimplementedPublicServiceServer
-	orgService         OrganizationService
-	projectService     ProjectService
-	groupService       GroupService
-	roleService        RoleService
-	policyService      PolicyService
-	userService        UserService
-	namespaceService   NamespaceService
-	actionService      ActionService
-	relationService    RelationService
-	resourceService    ResourceService
-	ruleService        RuleService
-	activityService    ActivityService
-	serviceDataService ServiceDataService
-	relationAdapter    RelationTransformer
-	checkAPILimit      int
-	serviceDataConfig  ServiceDataConfig
+	orgService            OrganizationService
+	projectService        ProjectService
+	groupService          GroupService
+	roleService           RoleService
+	policyService         PolicyService
+	userService           UserService
+	namespaceService      NamespaceService
+	actionService         ActionService
+	relationService       RelationService
+	resourceService       ResourceService
+	ruleService           RuleService
+	activityService       ActivityService
+	serviceDataService    ServiceDataService
+	serviceAccountService ServiceAccountService
+	platformService       PlatformService
+	invitationService     InvitationService
+	accessRequestService  AccessRequestService
+	relationAdapter       RelationTransformer
+	checkAPILimit         int
+	serviceDataConfig     ServiceDataConfig
 }
 
 func Register(ctx context.Context, s *grpc.Server, deps api.Deps, checkAPILimit int, serviceDataConfig ServiceDataConfig) error {
 	handler := &Handler{
-		orgService:         deps.OrgService,
-		projectService:     deps.ProjectService,
-		groupService:       deps.GroupService,
-		roleService:        deps.RoleService,
-		policyService:      deps.PolicyService,
-		userService:        deps.UserService,
-		namespaceService:   deps.NamespaceService,
-		actionService:      deps.ActionService,
-		relationService:    deps.RelationService,
-		resourceService:    deps.ResourceService,
-		ruleService:        deps.RuleService,
-		activityService:    deps.ActivityService,
-		serviceDataService: deps.ServiceDataService,
-		relationAdapter:    deps.RelationAdapter,
-		checkAPILimit:      checkAPILimit,
-		serviceDataConfig:  serviceDataConfig,
+		orgService:            deps.OrgService,
+		projectService:        deps.ProjectService,
+		groupService:          deps.GroupService,
+		roleService:           deps.RoleService,
+		policyService:         deps.PolicyService,
+		userService:           deps.UserService,
+		namespaceService:      deps.NamespaceService,
+		actionService:         deps.ActionService,
+		relationService:       deps.RelationService,
+		resourceService:       deps.ResourceService,
+		ruleService:           deps.RuleService,
+		activityService:       deps.ActivityService,
+		serviceDataService:    deps.ServiceDataService,
+		serviceAccountService: deps.ServiceAccountService,
+		platformService:       deps.PlatformService,
+		invitationService:     deps.InvitationService,
+		accessRequestService:  deps.AccessRequestService,
+		relationAdapter:       deps.RelationAdapter,
+		checkAPILimit:         checkAPILimit,
+		serviceDataConfig:     serviceDataConfig,
 	}
 	s.RegisterService(&shieldv1beta1.ShieldService_ServiceDesc, handler)
 	s.RegisterService(&shieldv1beta1.ServiceDataService_ServiceDesc, handler)
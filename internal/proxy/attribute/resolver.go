@@ -0,0 +1,254 @@
+package attribute
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/goto/shield/pkg/body_extractor"
+	"github.com/goto/shield/pkg/httputil"
+)
+
+var (
+	ErrInvalidAttribute  = errors.New("invalid attribute")
+	ErrAttributeNotFound = errors.New("attribute not found")
+	ErrNotGRPCPayload    = errors.New("not a grpc payload")
+)
+
+type contextResolverKey struct{}
+
+// Resolver resolves attributes of a request or a response. The payload is read
+// and parsed once however many attributes are taken from it and resolved values
+// are kept, so middlewares and hooks of the same request share the work.
+type Resolver struct {
+	header     http.Header
+	query      url.Values
+	pathParams map[string]string
+	body       func() ([]byte, error)
+
+	mu          sync.Mutex
+	payload     []byte
+	payloadRead bool
+	payloadErr  error
+	grpcMessage []byte
+	grpcParsed  bool
+	grpcErr     error
+	values      map[string]interface{}
+}
+
+// FromRequest returns the resolver of the request kept in its context, one is
+// added to the context if the request doesn't have it yet
+func FromRequest(req *http.Request) *Resolver {
+	if r, ok := req.Context().Value(contextResolverKey{}).(*Resolver); ok {
+		return r
+	}
+
+	// the payload is kept in the context by rule matching, otherwise it is read
+	// now and put back so the request can still be proxied
+	body, ok := httputil.GetRequestBodyFromContext(req.Context())
+	var bodyErr error
+	if !ok && req.Body != nil {
+		body, bodyErr = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewBuffer(body))
+	}
+
+	r := newResolver(req.Header, queryOf(req.URL), req.Context(), func() ([]byte, error) { return body, bodyErr })
+	*req = *req.WithContext(context.WithValue(req.Context(), contextResolverKey{}, r))
+	return r
+}
+
+// FromResponse returns a resolver of the response headers and payload, query and
+// path params are taken from the request of the response
+func FromResponse(res *http.Response) *Resolver {
+	return newResolver(res.Header, queryOf(res.Request.URL), res.Request.Context(), func() ([]byte, error) {
+		if res.Body == nil {
+			return nil, nil
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		// put the payload back to be sent to the client
+		res.Body = io.NopCloser(bytes.NewBuffer(body))
+		return body, err
+	})
+}
+
+func newResolver(header http.Header, query url.Values, ctx context.Context, body func() ([]byte, error)) *Resolver {
+	pathParams, _ := httputil.GetPathParamsFromContext(ctx)
+	return &Resolver{
+		header:     header,
+		query:      query,
+		pathParams: pathParams,
+		body:       body,
+		values:     make(map[string]interface{}),
+	}
+}
+
+func queryOf(u *url.URL) url.Values {
+	if u == nil {
+		return url.Values{}
+	}
+	return u.Query()
+}
+
+// ResolveAll resolves the attributes by their names, JSON payload fields are
+// taken from the payload in a single pass
+func (r *Resolver) ResolveAll(attrs map[string]Attribute) (map[string]interface{}, error) {
+	var jsonKeys []string
+	for name, attr := range attrs {
+		if attr.Type != TypeJSONPayload {
+			continue
+		}
+		if attr.Key == "" {
+			return nil, fmt.Errorf("%w: %s: payload key is empty", ErrInvalidAttribute, name)
+		}
+		jsonKeys = append(jsonKeys, attr.Key)
+	}
+	if err := r.resolveJSON(jsonKeys); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(attrs))
+	for name, attr := range attrs {
+		value, err := r.Resolve(attr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+// Resolve returns the value of the attribute, composite attributes are returned
+// as is to be composed with the other attributes
+func (r *Resolver) Resolve(attr Attribute) (interface{}, error) {
+	switch attr.Type {
+	case TypeJSONPayload:
+		if attr.Key == "" {
+			return nil, fmt.Errorf("%w: payload key is empty", ErrInvalidAttribute)
+		}
+		if err := r.resolveJSON([]string{attr.Key}); err != nil {
+			return nil, err
+		}
+		return r.cached(attr), nil
+
+	case TypeGRPCPayload:
+		if !strings.HasPrefix(r.header.Get("Content-Type"), "application/grpc") {
+			return nil, ErrNotGRPCPayload
+		}
+		return r.resolveGRPC(attr)
+
+	case TypeHeader:
+		return required(attr, "header", func(key string) string { return r.header.Get(key) })
+
+	case TypeQuery:
+		return required(attr, "query", r.query.Get)
+
+	case TypePathParam:
+		return required(attr, "path param", func(key string) string { return r.pathParams[key] })
+
+	case TypeConstant, TypeComposite:
+		if attr.Value == "" {
+			return nil, fmt.Errorf("%w: %s value is empty", ErrInvalidAttribute, attr.Type)
+		}
+		return attr.Value, nil
+
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidAttribute, attr.Type)
+	}
+}
+
+func required(attr Attribute, kind string, get func(key string) string) (interface{}, error) {
+	if attr.Key == "" {
+		return nil, fmt.Errorf("%w: %s key is empty", ErrInvalidAttribute, kind)
+	}
+	value := get(attr.Key)
+	if value == "" {
+		return nil, fmt.Errorf("%w: %s %s is empty", ErrAttributeNotFound, kind, attr.Key)
+	}
+	return value, nil
+}
+
+// resolveJSON resolves the JSON payload fields which aren't resolved yet
+func (r *Resolver) resolveJSON(keys []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var missing []string
+	for _, key := range keys {
+		if _, ok := r.values[cacheKey(Attribute{Type: TypeJSONPayload, Key: key})]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	payload, err := r.readPayload()
+	if err != nil {
+		return err
+	}
+	fields, err := body_extractor.JSONPayloadHandler{}.ExtractMany(payload, missing...)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrAttributeNotFound, err.Error())
+	}
+	for key, value := range fields {
+		r.values[cacheKey(Attribute{Type: TypeJSONPayload, Key: key})] = value
+	}
+	return nil
+}
+
+func (r *Resolver) resolveGRPC(attr Attribute) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := cacheKey(attr)
+	if value, ok := r.values[key]; ok {
+		return value, nil
+	}
+
+	if !r.grpcParsed {
+		r.grpcParsed = true
+		payload, err := r.readPayload()
+		if err != nil {
+			r.grpcErr = err
+		} else {
+			r.grpcMessage, r.grpcErr = body_extractor.GRPCPayloadHandler{}.Message(payload)
+		}
+	}
+	if r.grpcErr != nil {
+		return nil, r.grpcErr
+	}
+
+	value, err := body_extractor.FieldFromProtoMessage(r.grpcMessage, attr.Index)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAttributeNotFound, err.Error())
+	}
+	r.values[key] = value
+	return value, nil
+}
+
+func (r *Resolver) cached(attr Attribute) interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.values[cacheKey(attr)]
+}
+
+// readPayload reads the payload once, the lock has to be held
+func (r *Resolver) readPayload() ([]byte, error) {
+	if !r.payloadRead {
+		r.payloadRead = true
+		r.payload, r.payloadErr = r.body()
+	}
+	return r.payload, r.payloadErr
+}
+
+func cacheKey(attr Attribute) string {
+	return strings.Join([]string{string(attr.Type), attr.Key, attr.Index}, "\x00")
+}
@@ -0,0 +1,185 @@
+package attribute
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	fixturesv1 "github.com/goto/shield/pkg/body_extractor/fixtures"
+	"github.com/goto/shield/pkg/httputil"
+)
+
+func TestResolveAll(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title      string
+		body       string
+		attributes map[string]Attribute
+		want       map[string]interface{}
+		err        error
+	}{
+		{
+			title: "should resolve attributes of every type",
+			body:  `{"project": {"id": "p1"}, "tags": ["a", "b"], "members": [{"id": "u1"}, {"id": "u2"}]}`,
+			attributes: map[string]Attribute{
+				"project":  {Type: TypeJSONPayload, Key: "project.id"},
+				"tags":     {Type: TypeJSONPayload, Key: "tags"},
+				"members":  {Type: TypeJSONPayload, Key: "members.#.id"},
+				"team":     {Type: TypeHeader, Key: "X-Team"},
+				"env":      {Type: TypeQuery, Key: "env"},
+				"resource": {Type: TypePathParam, Key: "name"},
+				"kind":     {Type: TypeConstant, Value: "firehose"},
+				"urn":      {Type: TypeComposite, Value: "${project}-${resource}"},
+			},
+			want: map[string]interface{}{
+				"project":  "p1",
+				"tags":     []interface{}{"a", "b"},
+				"members":  []interface{}{"u1", "u2"},
+				"team":     "t1",
+				"env":      "prod",
+				"resource": "r1",
+				"kind":     "firehose",
+				"urn":      "${project}-${resource}",
+			},
+		},
+		{
+			title:      "should return an error if a payload field is missing",
+			body:       `{"project": {"id": "p1"}}`,
+			attributes: map[string]Attribute{"resource": {Type: TypeJSONPayload, Key: "resource.id"}},
+			err:        ErrAttributeNotFound,
+		},
+		{
+			title:      "should return an error if a header is missing",
+			attributes: map[string]Attribute{"resource": {Type: TypeHeader, Key: "X-Resource"}},
+			err:        ErrAttributeNotFound,
+		},
+		{
+			title:      "should return an error if the key isn't configured",
+			attributes: map[string]Attribute{"resource": {Type: TypeJSONPayload}},
+			err:        ErrInvalidAttribute,
+		},
+		{
+			title:      "should return an error for an unknown type",
+			attributes: map[string]Attribute{"resource": {Type: "cookie", Key: "resource"}},
+			err:        ErrInvalidAttribute,
+		},
+		{
+			title:      "should return an error for a grpc attribute of a json request",
+			body:       `{}`,
+			attributes: map[string]Attribute{"resource": {Type: TypeGRPCPayload, Index: "1"}},
+			err:        ErrNotGRPCPayload,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/projects?env=prod", strings.NewReader(tt.body))
+			req.Header.Set("X-Team", "t1")
+			req = req.WithContext(httputil.SetContextWithPathParams(req.Context(), map[string]string{"name": "r1"}))
+
+			got, err := FromRequest(req).ResolveAll(tt.attributes)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.err == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should read the payload once and share the resolver through the request context", func(t *testing.T) {
+		t.Parallel()
+
+		body := &countingReader{r: strings.NewReader(`{"project": "p1", "resource": "r1"}`)}
+		req := httptest.NewRequest(http.MethodPost, "/", body)
+
+		resolver := FromRequest(req)
+		project, err := resolver.Resolve(Attribute{Type: TypeJSONPayload, Key: "project"})
+		assert.NoError(t, err)
+		assert.Equal(t, "p1", project)
+
+		assert.Same(t, resolver, FromRequest(req))
+		resource, err := FromRequest(req).Resolve(Attribute{Type: TypeJSONPayload, Key: "resource"})
+		assert.NoError(t, err)
+		assert.Equal(t, "r1", resource)
+		assert.Equal(t, 1, body.eofs)
+
+		// the payload is still there to be proxied
+		proxied, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"project": "p1", "resource": "r1"}`, string(proxied))
+	})
+
+	t.Run("should resolve fields of a grpc payload", func(t *testing.T) {
+		t.Parallel()
+
+		msg, err := proto.Marshal(&fixturesv1.NestedMessageL3{S1L3: "S1L3", L5: &fixturesv1.NestedMessageL5{S1L5: "SomeMessage"}})
+		assert.NoError(t, err)
+		frame := make([]byte, 5, 5+len(msg))
+		binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+		frame = append(frame, msg...)
+
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(frame))
+		req.Header.Set("Content-Type", "application/grpc")
+
+		got, err := FromRequest(req).ResolveAll(map[string]Attribute{
+			"root":   {Type: TypeGRPCPayload, Index: "1"},
+			"nested": {Type: TypeGRPCPayload, Index: "9.12"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"root":   "S1L3",
+			"nested": "SomeMessage",
+		}, got)
+	})
+}
+
+func TestFromResponse(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/?env=prod", nil)
+	res := &http.Response{
+		Header:  http.Header{"X-Resource": []string{"r1"}},
+		Body:    io.NopCloser(strings.NewReader(`{"id": "p1"}`)),
+		Request: req,
+	}
+
+	got, err := FromResponse(res).ResolveAll(map[string]Attribute{
+		"project":  {Type: TypeJSONPayload, Key: "id"},
+		"resource": {Type: TypeHeader, Key: "X-Resource"},
+		"env":      {Type: TypeQuery, Key: "env"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"project": "p1", "resource": "r1", "env": "prod"}, got)
+
+	// the payload is still there to be sent to the client
+	sent, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id": "p1"}`, string(sent))
+}
+
+type countingReader struct {
+	r    io.Reader
+	eofs int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if err == io.EOF {
+		c.eofs++
+	}
+	return n, err
+}
@@ -0,0 +1,281 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/shield/core/rule"
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultHealthCheckTimeout  = 2 * time.Second
+
+	// poolIdleTimeout is how long a pool is kept after its last request, pools
+	// of changed or removed rules are evicted once they are idle
+	poolIdleTimeout = 10 * time.Minute
+)
+
+var ctxTargetKey = struct{ name string }{"target"}
+
+// target is one of the upstreams of a backend, it is unavailable when
+// failing active health checks or ejected because of failed requests
+type target struct {
+	mu sync.Mutex
+
+	url    *url.URL
+	weight int
+
+	healthy      bool
+	checkFailure int
+
+	ejection     rule.Ejection
+	failures     int
+	ejectedUntil time.Time
+
+	now func() time.Time
+}
+
+func (t *target) available() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.healthy && !t.now().Before(t.ejectedUntil)
+}
+
+// record is used for passive health checking with the result of proxied requests
+func (t *target) record(success bool) {
+	if t.ejection.Errors <= 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if success {
+		t.failures = 0
+		return
+	}
+
+	t.failures++
+	if t.failures >= t.ejection.Errors {
+		t.failures = 0
+		t.ejectedUntil = t.now().Add(t.ejection.Duration)
+	}
+}
+
+func (t *target) recordCheck(success bool, threshold int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if success {
+		t.checkFailure = 0
+		t.healthy = true
+		return
+	}
+
+	t.checkFailure++
+	if t.checkFailure >= threshold {
+		t.healthy = false
+	}
+}
+
+// pool picks targets of a backend by their weights
+type pool struct {
+	targets []*target
+	sticky  bool
+}
+
+func newPool(backend rule.Backend) (*pool, error) {
+	p := &pool{sticky: backend.Sticky}
+	for _, t := range backend.Targets {
+		u, err := url.Parse(t.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		weight := t.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		p.targets = append(p.targets, &target{
+			url:      u,
+			weight:   weight,
+			healthy:  true,
+			ejection: backend.Ejection,
+			now:      time.Now,
+		})
+	}
+	return p, nil
+}
+
+// pick selects an available target, if every target is unavailable all of them
+// are considered instead of failing the request. A non empty key always maps to
+// the same target as long as the set of available targets does not change.
+func (p *pool) pick(key string) *target {
+	var candidates []*target
+	for _, t := range p.targets {
+		if t.available() {
+			candidates = append(candidates, t)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = p.targets
+	}
+
+	total := 0
+	for _, t := range candidates {
+		total += t.weight
+	}
+
+	var n int
+	if p.sticky && key != "" {
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		n = int(h.Sum32() % uint32(total))
+	} else {
+		n = rand.Intn(total)
+	}
+
+	for _, t := range candidates {
+		if n < t.weight {
+			return t
+		}
+		n -= t.weight
+	}
+	return candidates[len(candidates)-1]
+}
+
+func (p *pool) healthCheck(ctx context.Context, logger log.Logger, cfg rule.HealthCheck) {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultHealthCheckTimeout
+	}
+	threshold := cfg.UnhealthyThreshold
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	client := &http.Client{Timeout: timeout}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, t := range p.targets {
+			checkURL := *t.url
+			checkURL.Path = singleJoiningSlash(t.url.Path, cfg.Path)
+
+			ok := check(ctx, client, checkURL.String())
+			if !ok {
+				logger.Warn("proxy: health check failed", "target", t.url.String())
+			}
+			t.recordCheck(ok, threshold)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func check(ctx context.Context, client *http.Client, checkURL string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, checkURL, nil)
+	if err != nil {
+		return false
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer res.Body.Close()
+
+	return res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices
+}
+
+// balancer keeps a pool for every multi target backend by its name and the
+// configuration of its targets, so backends of different rules sharing a name
+// get their own pools. Active health checks of a pool run until the pool is
+// evicted after being idle or the context is done.
+type balancer struct {
+	ctx context.Context
+	log log.Logger
+
+	mu    sync.Mutex
+	pools map[string]*balancedPool
+
+	now func() time.Time
+}
+
+type balancedPool struct {
+	pool     *pool
+	lastUsed time.Time
+	cancel   context.CancelFunc
+}
+
+func newBalancer(ctx context.Context, log log.Logger) *balancer {
+	return &balancer{
+		ctx:   ctx,
+		log:   log,
+		pools: map[string]*balancedPool{},
+		now:   time.Now,
+	}
+}
+
+func (b *balancer) get(backend rule.Backend) (*pool, error) {
+	key := poolKey(backend)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.evictIdle(now)
+
+	if current, ok := b.pools[key]; ok {
+		current.lastUsed = now
+		return current.pool, nil
+	}
+
+	p, err := newPool(backend)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(b.ctx)
+	b.pools[key] = &balancedPool{pool: p, lastUsed: now, cancel: cancel}
+
+	if backend.HealthCheck.Path != "" {
+		go p.healthCheck(ctx, b.log, backend.HealthCheck)
+	}
+	return p, nil
+}
+
+// evictIdle removes the pools which were not used for poolIdleTimeout and
+// stops their health checks
+func (b *balancer) evictIdle(now time.Time) {
+	for key, bp := range b.pools {
+		if now.Sub(bp.lastUsed) > poolIdleTimeout {
+			bp.cancel()
+			delete(b.pools, key)
+		}
+	}
+}
+
+// poolKey identifies the pool of a backend by its name and the configuration
+// of its targets, a changed rule gets a new pool
+func poolKey(backend rule.Backend) string {
+	return fmt.Sprint(backend.Namespace, backend.Targets, backend.HealthCheck, backend.Ejection, backend.Sticky)
+}
@@ -0,0 +1,199 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/middleware"
+)
+
+func TestPool_Pick(t *testing.T) {
+	backend := rule.Backend{
+		Targets: []rule.Target{
+			{URL: "http://stable", Weight: 9},
+			{URL: "http://canary", Weight: 1},
+		},
+		Ejection: rule.Ejection{Errors: 2, Duration: time.Minute},
+	}
+
+	t.Run("should pick targets by weight", func(t *testing.T) {
+		p, err := newPool(backend)
+		assert.NoError(t, err)
+
+		picked := map[string]int{}
+		for i := 0; i < 1000; i++ {
+			picked[p.pick("").url.Host]++
+		}
+
+		assert.Greater(t, picked["stable"], picked["canary"])
+		assert.Greater(t, picked["canary"], 0)
+	})
+
+	t.Run("should pick the same target for the same key if sticky", func(t *testing.T) {
+		stickyBackend := backend
+		stickyBackend.Sticky = true
+		p, err := newPool(stickyBackend)
+		assert.NoError(t, err)
+
+		first := p.pick("2e73f4a2-3763-4dc6-a00f-e8d1e1c7a8e0")
+		for i := 0; i < 100; i++ {
+			assert.Same(t, first, p.pick("2e73f4a2-3763-4dc6-a00f-e8d1e1c7a8e0"))
+		}
+	})
+
+	t.Run("should not pick ejected targets until ejection is over", func(t *testing.T) {
+		p, err := newPool(backend)
+		assert.NoError(t, err)
+
+		now := time.Now()
+		for _, tgt := range p.targets {
+			tgt.now = func() time.Time { return now }
+		}
+
+		stable := p.targets[0]
+		stable.record(false)
+		assert.True(t, stable.available())
+		stable.record(false)
+		assert.False(t, stable.available())
+
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, "canary", p.pick("").url.Host)
+		}
+
+		now = now.Add(time.Minute)
+		assert.True(t, stable.available())
+	})
+
+	t.Run("should consider every target if none is available", func(t *testing.T) {
+		p, err := newPool(backend)
+		assert.NoError(t, err)
+		for _, tgt := range p.targets {
+			tgt.recordCheck(false, 1)
+		}
+
+		assert.NotNil(t, p.pick(""))
+	})
+}
+
+func TestPool_HealthCheck(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ping", r.URL.Path)
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := newBalancer(ctx, log.NewNoop())
+	p, err := b.get(rule.Backend{
+		Targets: []rule.Target{
+			{URL: healthy.URL},
+			{URL: unhealthy.URL},
+		},
+		HealthCheck: rule.HealthCheck{Path: "/ping", Interval: 10 * time.Millisecond},
+	})
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return p.targets[0].available() && !p.targets[1].available()
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBalancer_Get(t *testing.T) {
+	var checks int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&checks, 1)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	backend := rule.Backend{
+		Namespace:   "entropy",
+		Targets:     []rule.Target{{URL: srv.URL}},
+		HealthCheck: rule.HealthCheck{Path: "/ping", Interval: 10 * time.Millisecond},
+	}
+	otherBackend := rule.Backend{
+		Namespace: "entropy",
+		Targets:   []rule.Target{{URL: "http://entropy-other.io"}},
+	}
+
+	now := time.Now()
+	b := newBalancer(ctx, log.NewNoop())
+	b.now = func() time.Time { return now }
+
+	first, err := b.get(backend)
+	assert.NoError(t, err)
+	same, err := b.get(backend)
+	assert.NoError(t, err)
+	assert.Same(t, first, same)
+
+	// backends of different rules sharing a name get their own pools
+	other, err := b.get(otherBackend)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, other)
+	assert.Equal(t, "http://entropy-other.io", other.pick("").url.String())
+	again, err := b.get(backend)
+	assert.NoError(t, err)
+	assert.Same(t, first, again)
+	assert.Len(t, b.pools, 2)
+
+	// pools which are not used anymore are evicted and their health checks stopped
+	now = now.Add(poolIdleTimeout / 2)
+	_, err = b.get(otherBackend)
+	assert.NoError(t, err)
+	now = now.Add(poolIdleTimeout/2 + time.Second)
+	_, err = b.get(otherBackend)
+	assert.NoError(t, err)
+	assert.Len(t, b.pools, 1)
+
+	time.Sleep(20 * time.Millisecond)
+	stopped := atomic.LoadInt32(&checks)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped, atomic.LoadInt32(&checks))
+}
+
+func TestDirector_Direct(t *testing.T) {
+	director := NewDirector(context.Background(), log.NewNoop(), "X-Shield-Email", "X-Shield-User-Id")
+
+	t.Run("should direct to the single backend url", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://shield/api/firehoses", nil)
+		middleware.EnrichRule(req, &rule.Rule{Backend: rule.Backend{URL: "http://entropy/v1"}})
+
+		director.Direct(req)
+
+		assert.Equal(t, "entropy", req.URL.Host)
+		assert.Equal(t, "/v1/api/firehoses", req.URL.Path)
+	})
+
+	t.Run("should direct to one of the backend targets", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://shield/api/firehoses", nil)
+		req.Header.Set("X-Shield-User-Id", "2e73f4a2-3763-4dc6-a00f-e8d1e1c7a8e0")
+		middleware.EnrichRule(req, &rule.Rule{Backend: rule.Backend{
+			Namespace: "entropy",
+			Targets:   []rule.Target{{URL: "http://entropy-canary/v1"}},
+			Sticky:    true,
+		}})
+
+		director.Direct(req)
+
+		assert.Equal(t, "entropy-canary", req.URL.Host)
+		assert.Equal(t, "/v1/api/firehoses", req.URL.Path)
+		picked, ok := req.Context().Value(ctxTargetKey).(*target)
+		assert.True(t, ok)
+		assert.Equal(t, "entropy-canary", picked.url.Host)
+	})
+}
@@ -0,0 +1,179 @@
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/goto/shield/core/rule"
+)
+
+type breakerState int64
+
+const (
+	breakerClosed breakerState = iota
+	breakerHalfOpen
+	breakerOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerHalfOpen:
+		return "half_open"
+	case breakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker opens after a number of consecutive failures and rejects
+// requests until the cooldown elapses, then lets a single trial request through
+type circuitBreaker struct {
+	mu sync.Mutex
+
+	threshold int
+	cooldown  time.Duration
+
+	state    breakerState
+	failures int
+	openedAt time.Time
+	trialing bool
+
+	now func() time.Time
+}
+
+func newCircuitBreaker(cfg rule.CircuitBreaker) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: cfg.Threshold,
+		cooldown:  cfg.Cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow reports whether a request can be sent to the backend
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case breakerOpen:
+		if cb.now().Sub(cb.openedAt) < cb.cooldown {
+			return false
+		}
+		cb.state = breakerHalfOpen
+		cb.trialing = true
+		return true
+	case breakerHalfOpen:
+		if cb.trialing {
+			return false
+		}
+		cb.trialing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (cb *circuitBreaker) record(success bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.trialing = false
+	if success {
+		cb.state = breakerClosed
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.state == breakerHalfOpen || cb.failures >= cb.threshold {
+		cb.state = breakerOpen
+		cb.openedAt = cb.now()
+	}
+}
+
+func (cb *circuitBreaker) currentState() breakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+// circuitBreakers keeps a breaker for every backend which has one configured
+type circuitBreakers struct {
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+	names    map[string]string
+
+	metricCounterRejected metric.Int64Counter
+}
+
+func newCircuitBreakers() *circuitBreakers {
+	cbs := &circuitBreakers{
+		breakers: map[string]*circuitBreaker{},
+		names:    map[string]string{},
+	}
+
+	meter := otel.Meter("github.com/goto/shield/proxy")
+	var err error
+	cbs.metricCounterRejected, err = meter.Int64Counter("shield.proxy.circuit_breaker.rejected",
+		metric.WithDescription("requests rejected by an open circuit breaker"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	if _, err := meter.Int64ObservableGauge("shield.proxy.circuit_breaker.state",
+		metric.WithDescription("circuit breaker state per backend, 0 closed, 1 half open, 2 open"),
+		metric.WithInt64Callback(cbs.observe),
+	); err != nil {
+		otel.Handle(err)
+	}
+
+	return cbs
+}
+
+func (cbs *circuitBreakers) get(backend rule.Backend) *circuitBreaker {
+	if backend.CircuitBreaker.Threshold <= 0 {
+		return nil
+	}
+
+	key := backend.URL
+	if key == "" {
+		// backends with multiple targets share a breaker
+		key = backend.Namespace
+	}
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+
+	cb, ok := cbs.breakers[key]
+	if !ok {
+		cb = newCircuitBreaker(backend.CircuitBreaker)
+		cbs.breakers[key] = cb
+		cbs.names[key] = backend.Namespace
+	}
+	return cb
+}
+
+func (cbs *circuitBreakers) rejected(ctx context.Context, backend rule.Backend) {
+	cbs.metricCounterRejected.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("backend", backend.Namespace),
+		attribute.String("target", backend.URL),
+	))
+}
+
+func (cbs *circuitBreakers) observe(ctx context.Context, observer metric.Int64Observer) error {
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+
+	for key, cb := range cbs.breakers {
+		observer.Observe(int64(cb.currentState()), metric.WithAttributes(
+			attribute.String("backend", cbs.names[key]),
+			attribute.String("target", key),
+		))
+	}
+	return nil
+}
@@ -18,4 +18,8 @@ type Config struct {
 	// RulesPathSecret could be a env name, file path or actual value required
 	// to access RulesPath files
 	RulesPathSecret string `yaml:"ruleset_secret" mapstructure:"ruleset_secret"`
+
+	// ErrorVerbosity of the error responses of requests denied by middlewares,
+	// "minimal" responds with a generic message and "detailed" with the cause
+	ErrorVerbosity string `yaml:"error_verbosity" mapstructure:"error_verbosity" default:"minimal"`
 }
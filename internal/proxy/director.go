@@ -6,23 +6,37 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/goto/salt/log"
+
+	"github.com/goto/shield/core/rule"
 	"github.com/goto/shield/internal/proxy/middleware"
 	"github.com/goto/shield/pkg/httputil"
 )
 
 var ctxRequestErrorKey = struct{}{}
 
-type Director struct{}
+type Director struct {
+	balancer *balancer
+
+	identityProxyHeaderKey string
+	userIDHeaderKey        string
+}
 
-func NewDirector() *Director {
-	return &Director{}
+// NewDirector creates a director, health checks of backends with
+// multiple targets are stopped once the context is done
+func NewDirector(ctx context.Context, log log.Logger, identityProxyHeaderKey, userIDHeaderKey string) *Director {
+	return &Director{
+		balancer:               newBalancer(ctx, log),
+		identityProxyHeaderKey: identityProxyHeaderKey,
+		userIDHeaderKey:        userIDHeaderKey,
+	}
 }
 
 func (h Director) Direct(req *http.Request) {
 	matchedRule, _ := middleware.ExtractRule(req)
 
 	// update backend request to match rules
-	target, err := url.Parse(matchedRule.Backend.URL)
+	target, err := h.target(req, matchedRule.Backend)
 	if err != nil {
 		// backend is not configured properly
 		*req = *req.WithContext(context.WithValue(req.Context(), ctxRequestErrorKey, err))
@@ -48,6 +62,29 @@ func (h Director) Direct(req *http.Request) {
 	req.Header.Set("proxy-by", "shield")
 }
 
+// target picks the url of one of the backend targets, backends with a single
+// url are used as is
+func (h Director) target(req *http.Request, backend rule.Backend) (*url.URL, error) {
+	if len(backend.Targets) == 0 {
+		return url.Parse(backend.URL)
+	}
+
+	p, err := h.balancer.get(backend)
+	if err != nil {
+		return nil, err
+	}
+
+	// the user id is only available when set by authz middleware
+	key := req.Header.Get(h.userIDHeaderKey)
+	if key == "" {
+		key = req.Header.Get(h.identityProxyHeaderKey)
+	}
+
+	picked := p.pick(key)
+	*req = *req.WithContext(context.WithValue(req.Context(), ctxTargetKey, picked))
+	return picked.url, nil
+}
+
 func joinURLPath(a, b *url.URL) (path, rawpath string) {
 	if a.RawPath == "" && b.RawPath == "" {
 		return singleJoiningSlash(a.Path, b.Path), ""
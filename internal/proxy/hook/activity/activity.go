@@ -0,0 +1,116 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/internal/proxy/hook"
+)
+
+const (
+	auditKeyProxyRequest = "proxy.request"
+
+	AuditEntity = "proxy"
+)
+
+type ActivityService interface {
+	Log(ctx context.Context, action string, actor activity.Actor, data any) error
+}
+
+type Activity struct {
+	log log.Logger
+
+	// To go to next hook
+	next hook.Service
+
+	// To skip all the next hooks and just respond back
+	escape hook.Service
+
+	identityProxyHeaderKey string
+	userIDHeaderKey        string
+
+	activityService ActivityService
+}
+
+type Config struct {
+	// Action recorded in the activity, defaults to proxy.request
+	Action string `yaml:"action" mapstructure:"action"`
+}
+
+type LogData struct {
+	Entity     string `mapstructure:"entity"`
+	Method     string `mapstructure:"method"`
+	Host       string `mapstructure:"host"`
+	Path       string `mapstructure:"path"`
+	Namespace  string `mapstructure:"namespace"`
+	StatusCode string `mapstructure:"status_code"`
+}
+
+func New(log log.Logger, next, escape hook.Service, activityService ActivityService, identityProxyHeaderKey, userIDHeaderKey string) Activity {
+	return Activity{
+		log:                    log,
+		next:                   next,
+		escape:                 escape,
+		identityProxyHeaderKey: identityProxyHeaderKey,
+		userIDHeaderKey:        userIDHeaderKey,
+		activityService:        activityService,
+	}
+}
+
+func (a Activity) Info() hook.Info {
+	return hook.Info{
+		Name:        "activity",
+		Description: "hook to log an activity for the proxied request",
+	}
+}
+
+func (a Activity) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return a.escape.ServeHook(res, err)
+	}
+
+	hookSpec, ok := hook.ExtractHook(res.Request, a.Info().Name)
+	if !ok {
+		return a.next.ServeHook(res, nil)
+	}
+
+	config := Config{}
+	if err := mapstructure.Decode(hookSpec.Config, &config); err != nil {
+		a.log.Error("hook: failed to decode activity config", "config", hookSpec.Config)
+		return a.escape.ServeHook(res, err)
+	}
+
+	if config.Action == "" {
+		config.Action = auditKeyProxyRequest
+	}
+
+	logData := LogData{
+		Entity:     AuditEntity,
+		Method:     res.Request.Method,
+		Host:       res.Request.Host,
+		Path:       res.Request.URL.Path,
+		StatusCode: fmt.Sprint(res.StatusCode),
+	}
+	if rl, ok := hook.ExtractRule(res.Request); ok {
+		logData.Namespace = rl.Backend.Namespace
+	}
+
+	actor := activity.Actor{
+		ID:    res.Request.Header.Get(a.userIDHeaderKey),
+		Email: res.Request.Header.Get(a.identityProxyHeaderKey),
+	}
+
+	go func(ctx context.Context) {
+		ctx = context.WithoutCancel(ctx)
+		if err := a.activityService.Log(ctx, config.Action, actor, logData); err != nil {
+			a.log.Error(fmt.Sprintf("hook: failed to log activity: %s", err.Error()))
+		}
+	}(res.Request.Context())
+
+	return a.next.ServeHook(res, nil)
+}
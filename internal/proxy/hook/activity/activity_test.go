@@ -0,0 +1,72 @@
+package activity
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/hook"
+	"github.com/goto/shield/internal/proxy/hook/activity/mocks"
+	shieldlogger "github.com/goto/shield/pkg/logger"
+)
+
+func TestServeHook(t *testing.T) {
+	logger := shieldlogger.InitLogger(shieldlogger.Config{Level: "debug"})
+
+	t.Run("should log activity for the proxied request", func(t *testing.T) {
+		logged := make(chan struct{})
+		activityService := new(mocks.ActivityService)
+		activityService.EXPECT().Log(mock.Anything, "firehose.list", activity.Actor{
+			ID:    "user-id",
+			Email: "user@gotocompany.com",
+		}, LogData{
+			Entity:     AuditEntity,
+			Method:     http.MethodGet,
+			Host:       "localhost:8080",
+			Path:       "/firehoses",
+			Namespace:  "entropy",
+			StatusCode: "200",
+		}).Run(func(ctx context.Context, action string, actor activity.Actor, data interface{}) {
+			close(logged)
+		}).Return(nil)
+
+		a := New(logger, hook.Next(), hook.Escape(), activityService, "X-Shield-Email", "X-Shield-User-Id")
+
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost:8080/firehoses", nil)
+		req.Header.Set("X-Shield-Email", "user@gotocompany.com")
+		req.Header.Set("X-Shield-User-Id", "user-id")
+		req = req.WithContext(rule.WithContext(req.Context(), &rule.Rule{
+			Backend: rule.Backend{Namespace: "entropy"},
+			Hooks:   rule.HookSpecs{{Name: "activity", Config: map[string]interface{}{"action": "firehose.list"}}},
+		}))
+
+		res, err := a.ServeHook(&http.Response{Request: req, StatusCode: http.StatusOK}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		select {
+		case <-logged:
+		case <-time.After(time.Second):
+			t.Fatal("activity was not logged")
+		}
+	})
+
+	t.Run("should not log activity if hook is not configured", func(t *testing.T) {
+		activityService := new(mocks.ActivityService)
+		a := New(logger, hook.Next(), hook.Escape(), activityService, "X-Shield-Email", "X-Shield-User-Id")
+
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost:8080/firehoses", nil)
+		req = req.WithContext(rule.WithContext(req.Context(), &rule.Rule{}))
+
+		_, err := a.ServeHook(&http.Response{Request: req, StatusCode: http.StatusOK}, nil)
+
+		assert.Nil(t, err)
+		activityService.AssertNotCalled(t, "Log")
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/mitchellh/mapstructure"
 	"go.opentelemetry.io/otel"
@@ -17,11 +16,11 @@ import (
 	"github.com/goto/shield/core/project"
 	"github.com/goto/shield/core/relation"
 	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/user"
 	proxyattr "github.com/goto/shield/internal/proxy/attribute"
 	"github.com/goto/shield/internal/proxy/hook"
 	"github.com/goto/shield/internal/proxy/middleware"
-	"github.com/goto/shield/pkg/body_extractor"
 )
 
 type ResourceService interface {
@@ -106,9 +105,14 @@ func (a Authz) Info() hook.Info {
 }
 
 func (a Authz) ServeHook(res *http.Response, err error) (*http.Response, error) {
-	if err != nil || res.StatusCode >= 400 {
+	if err != nil {
 		return a.escape.ServeHook(res, err)
 	}
+	// nothing is created for failed requests, the remaining hooks still
+	// see the response to remap its status or record it
+	if res.StatusCode >= 400 {
+		return a.next.ServeHook(res, nil)
+	}
 
 	isResourceCreated := false
 	attributes := map[string]interface{}{}
@@ -149,87 +153,38 @@ func (a Authz) ServeHook(res *http.Response, err error) (*http.Response, error)
 	attributes["user"] = identityProxyHeaderValue
 	res.Request = res.Request.WithContext(user.SetContextWithEmail(res.Request.Context(), identityProxyHeaderValue))
 
+	// requests without a user are only let through by public permissions,
+	// there is no one to own what they create so nothing is registered
+	if _, isServiceAccount := serviceaccount.GetFromContext(res.Request.Context()); identityProxyHeaderValue == "" && !isServiceAccount {
+		a.log.Warn("hook: not creating resources of a request without a user", "path", res.Request.URL.Path)
+		return a.next.ServeHook(res, nil)
+	}
+
+	// attributes are taken from the response unless configured otherwise
+	requestAttributes := map[string]proxyattr.Attribute{}
+	responseAttributes := map[string]proxyattr.Attribute{}
 	for id, attr := range config.Attributes {
-		bdy, _ := middleware.ExtractRequestBody(res.Request)
-		bodySource := &res.Body
 		if attr.Source == string(proxyattr.SourceRequest) {
-			bodySource = &bdy
+			requestAttributes[id] = attr
+		} else {
+			responseAttributes[id] = attr
 		}
-
-		headerSource := &res.Header
-		if attr.Source == string(proxyattr.SourceRequest) {
-			headerSource = &res.Request.Header
+	}
+	for _, resolved := range []struct {
+		resolver   *proxyattr.Resolver
+		attributes map[string]proxyattr.Attribute
+	}{
+		{resolver: proxyattr.FromRequest(res.Request), attributes: requestAttributes},
+		{resolver: proxyattr.FromResponse(res), attributes: responseAttributes},
+	} {
+		values, err := resolved.resolver.ResolveAll(resolved.attributes)
+		if err != nil {
+			a.log.Error("middleware: failed to resolve attributes", "err", err)
+			return a.escape.ServeHook(res, err)
 		}
-
-		switch attr.Type {
-		case proxyattr.TypeGRPCPayload:
-			if !strings.HasPrefix(res.Header.Get("Content-Type"), "application/grpc") {
-				a.log.Error("middleware: not a grpc request", "attr", attr)
-				return a.escape.ServeHook(res, fmt.Errorf("invalid header for http request: %s", res.Header.Get("Content-Type")))
-			}
-
-			payloadField, err := body_extractor.GRPCPayloadHandler{}.Extract(bodySource, attr.Index)
-			if err != nil {
-				a.log.Error("middleware: failed to parse grpc payload", "err", err)
-				return a.escape.ServeHook(res, fmt.Errorf("unable to parse grpc payload"))
-			}
-			attributes[id] = payloadField
-
-			a.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-		case proxyattr.TypeJSONPayload:
-			if attr.Key == "" {
-				a.log.Error("middleware: payload key field empty")
-				return a.escape.ServeHook(res, fmt.Errorf("payload key field empty"))
-			}
-
-			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(bodySource, attr.Key)
-			if err != nil {
-				a.log.Error("middleware: failed to parse json payload", "err", err)
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-			attributes[id] = payloadField
-
-			a.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-		case proxyattr.TypeHeader:
-			if attr.Key == "" {
-				a.log.Error("middleware: header key field empty")
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-			headerAttr := headerSource.Get(attr.Key)
-			if headerAttr == "" {
-				a.log.Error(fmt.Sprintf("middleware: header %s is empty", attr.Key))
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-
-			attributes[id] = headerAttr
-			a.log.Info("middleware: extracted", "field", headerAttr, "attr", attr)
-
-		case proxyattr.TypeQuery:
-			if attr.Key == "" {
-				a.log.Error("middleware: query key field empty")
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-			queryAttr := res.Request.URL.Query().Get(attr.Key)
-			if queryAttr == "" {
-				a.log.Error(fmt.Sprintf("middleware: query %s is empty", attr.Key))
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-
-			attributes[id] = queryAttr
-			a.log.Info("middleware: extracted", "field", queryAttr, "attr", attr)
-
-		case proxyattr.TypeConstant, proxyattr.TypeComposite:
-			if attr.Value == "" {
-				a.log.Error("middleware:", string(attr.Type), "value empty")
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-
-			attributes[id] = attr.Value
-			a.log.Info("middleware: extracted", "key", res, "attr", attributes[id])
-
-		default:
-			a.log.Error("middleware: unknown attribute type", "attr", attr)
-			return a.escape.ServeHook(res, fmt.Errorf("unknown attribute type: %v", attr))
+		for id, value := range values {
+			attributes[id] = value
+			a.log.Info("middleware: extracted", "field", value, "attr", resolved.attributes[id])
 		}
 	}
 
@@ -143,6 +143,21 @@ func TestServeHook(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
 	})
 
+	t.Run("should pass error responses to the next hook instead of escaping", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "http://localhost:8080", nil)
+		response := &http.Response{
+			Request:    req,
+			Header:     http.Header{},
+			StatusCode: http.StatusNotFound,
+		}
+
+		pipelined := New(logger, hook.Next(), hook.Escape(), mockResourceService, mockRelationService, mockRelationTransformer, "X-Shield-Email")
+		res, err := pipelined.ServeHook(response, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
 	t.Run("should not change status code if rule is not set", func(t *testing.T) {
 		req, _ := http.NewRequest(http.MethodPost, "http://localhost:8080", nil)
 
@@ -208,11 +223,12 @@ func TestServeHook(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 	})
 
-	t.Run("should return InternalServerError if identityProxyHeaderKey not set", func(t *testing.T) {
+	t.Run("should pass on the response without creating resources if identityProxyHeaderKey not set", func(t *testing.T) {
 		req, _ := http.NewRequest(http.MethodPost, "http://localhost:8080", nil)
 		response := &http.Response{
-			Request: req,
-			Header:  http.Header{},
+			StatusCode: http.StatusOK,
+			Request:    req,
+			Header:     http.Header{},
 		}
 		rl := &rule.Rule{
 			Hooks: rule.HookSpecs{
@@ -229,7 +245,8 @@ func TestServeHook(t *testing.T) {
 		resp, err := a.ServeHook(response, nil)
 
 		assert.Nil(t, err)
-		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockResourceService.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
 	})
 
 	t.Run("should return InternalServerError if all attributes are not set", func(t *testing.T) {
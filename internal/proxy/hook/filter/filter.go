@@ -0,0 +1,337 @@
+package filter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/proxy/hook"
+)
+
+const defaultAction = "view"
+
+var (
+	ErrInvalidPath = errors.New("response path does not point to a json array")
+	ErrInvalidItem = errors.New("response item is not a json object")
+	ErrNotJSON     = errors.New("response to be filtered is not json")
+)
+
+type ResourceService interface {
+	CheckAuthz(ctx context.Context, resource resource.Resource, act action.Action) (bool, error)
+	BulkCheckAuthz(ctx context.Context, resources []resource.Resource, actions []action.Action) ([]relation.Permission, error)
+	CheckIsPublic(ctx context.Context, resource resource.Resource, act action.Action) (bool, error)
+}
+
+type Filter struct {
+	log log.Logger
+
+	// To go to next hook
+	next hook.Service
+
+	// To skip all the next hooks and just respond back
+	escape hook.Service
+
+	identityProxyHeaderKey string
+
+	// checkAPILimit is the maximum number of resources sent in a single bulk check
+	checkAPILimit int
+
+	resourceService ResourceService
+}
+
+type Config struct {
+	// Path is the dot separated path of the json array to be filtered,
+	// an empty path means the response body itself is the array
+	Path string `yaml:"path" mapstructure:"path"`
+
+	// ID is the dot separated path of the resource id inside every item
+	ID string `yaml:"id" mapstructure:"id"`
+
+	// Namespace of the resources, when empty it is derived from
+	// the backend namespace and ResourceType
+	Namespace    string `yaml:"namespace" mapstructure:"namespace"`
+	ResourceType string `yaml:"resource_type" mapstructure:"resource_type"`
+
+	// Action checked for every item, defaults to view
+	Action string `yaml:"action" mapstructure:"action"`
+}
+
+func New(log log.Logger, next, escape hook.Service, resourceService ResourceService, identityProxyHeaderKey string, checkAPILimit int) Filter {
+	return Filter{
+		log:                    log,
+		next:                   next,
+		escape:                 escape,
+		identityProxyHeaderKey: identityProxyHeaderKey,
+		checkAPILimit:          checkAPILimit,
+		resourceService:        resourceService,
+	}
+}
+
+func (f Filter) Info() hook.Info {
+	return hook.Info{
+		Name:        "filter",
+		Description: "hook to remove response items the user is not permitted to access",
+	}
+}
+
+func (f Filter) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return f.escape.ServeHook(res, err)
+	}
+	// error responses have no items to filter, the remaining hooks still
+	// see the response to remap its status or record it
+	if res.StatusCode >= 400 {
+		return f.next.ServeHook(res, nil)
+	}
+
+	ruleFromRequest, ok := hook.ExtractRule(res.Request)
+	if !ok {
+		return f.next.ServeHook(res, nil)
+	}
+
+	hookSpec, ok := hook.ExtractHook(res.Request, f.Info().Name)
+	if !ok {
+		return f.next.ServeHook(res, nil)
+	}
+
+	config := Config{}
+	if err := mapstructure.Decode(hookSpec.Config, &config); err != nil {
+		f.log.Error("hook: failed to decode filter config", "config", hookSpec.Config)
+		return f.escape.ServeHook(res, err)
+	}
+
+	if config.ID == "" {
+		return f.escape.ServeHook(res, fmt.Errorf("id path not defined in filter hook config"))
+	}
+
+	if config.Action == "" {
+		config.Action = defaultAction
+	}
+
+	if config.Namespace == "" {
+		if ruleFromRequest.Backend.Namespace == "" || config.ResourceType == "" {
+			return f.escape.ServeHook(res, fmt.Errorf("namespace or resource type not defined for filter hook"))
+		}
+		config.Namespace = namespace.CreateID(ruleFromRequest.Backend.Namespace, config.ResourceType)
+	}
+
+	// responses which can't be filtered are denied rather than passed through
+	if !strings.Contains(res.Header.Get("Content-Type"), "application/json") {
+		f.log.Error("hook: failed to filter non json response", "content_type", res.Header.Get("Content-Type"))
+		return f.escape.ServeHook(res, ErrNotJSON)
+	}
+
+	identityProxyHeaderValue := res.Request.Header.Get(f.identityProxyHeaderKey)
+	res.Request = res.Request.WithContext(user.SetContextWithEmail(res.Request.Context(), identityProxyHeaderValue))
+
+	// requests without a user only get the items which are public
+	_, isServiceAccount := serviceaccount.GetFromContext(res.Request.Context())
+	anonymous := identityProxyHeaderValue == "" && !isServiceAccount
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return f.escape.ServeHook(res, err)
+	}
+	res.Body.Close()
+
+	filtered, err := f.filterBody(res.Request.Context(), body, config, anonymous)
+	if err != nil {
+		f.log.Error("hook: failed to filter response", "err", err)
+		return f.escape.ServeHook(res, err)
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(filtered))
+	res.ContentLength = int64(len(filtered))
+	res.Header.Set("Content-Length", strconv.Itoa(len(filtered)))
+
+	return f.next.ServeHook(res, nil)
+}
+
+func (f Filter) filterBody(ctx context.Context, body []byte, config Config, anonymous bool) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var root interface{}
+	if err := decoder.Decode(&root); err != nil {
+		return nil, err
+	}
+
+	items, err := lookup(root, config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := items.([]interface{})
+	if !ok {
+		return nil, ErrInvalidPath
+	}
+
+	// items without an id can't be checked and aren't permitted
+	ids := make([]string, 0, len(list))
+	identified := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		id, err := lookup(item, config.ID)
+		if err != nil {
+			f.log.Warn("hook: dropping item without id from filtered response", "id", config.ID, "err", err)
+			continue
+		}
+		ids = append(ids, fmt.Sprint(id))
+		identified = append(identified, item)
+	}
+
+	allowed, err := f.checkPermissions(ctx, ids, config, anonymous)
+	if err != nil {
+		return nil, err
+	}
+
+	permitted := make([]interface{}, 0, len(identified))
+	for i, item := range identified {
+		if allowed[i] {
+			permitted = append(permitted, item)
+		}
+	}
+
+	root, err = replace(root, config.Path, permitted)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(root); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n")), nil
+}
+
+// checkPermissions runs bulk checks in batches of checkAPILimit and returns
+// the result for each id in the same order as the ids, without a user every
+// resource is checked for being public instead
+func (f Filter) checkPermissions(ctx context.Context, ids []string, config Config, anonymous bool) ([]bool, error) {
+	batchSize := f.checkAPILimit
+	if batchSize <= 0 {
+		batchSize = len(ids)
+	}
+
+	allowed := make([]bool, 0, len(ids))
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		var resources []resource.Resource
+		var actions []action.Action
+		for _, id := range ids[start:end] {
+			resources = append(resources, resource.Resource{
+				Name:        id,
+				NamespaceID: config.Namespace,
+			})
+			actions = append(actions, action.Action{ID: config.Action})
+		}
+
+		if anonymous {
+			batchAllowed, err := f.checkEach(ctx, resources, config, f.resourceService.CheckIsPublic)
+			if err != nil {
+				return nil, err
+			}
+			allowed = append(allowed, batchAllowed...)
+			continue
+		}
+
+		permissions, err := f.resourceService.BulkCheckAuthz(ctx, resources, actions)
+		if err != nil {
+			if !errors.Is(err, resource.ErrNotExist) {
+				return nil, err
+			}
+
+			// one of the items is not a registered resource,
+			// fall back to checking them one by one
+			batchAllowed, err := f.checkEach(ctx, resources, config, f.resourceService.CheckAuthz)
+			if err != nil {
+				return nil, err
+			}
+			allowed = append(allowed, batchAllowed...)
+			continue
+		}
+
+		if len(permissions) != len(resources) {
+			return nil, fmt.Errorf("bulk check returned %d results for %d resources", len(permissions), len(resources))
+		}
+
+		for _, p := range permissions {
+			allowed = append(allowed, p.Allowed)
+		}
+	}
+
+	return allowed, nil
+}
+
+// checkEach checks the resources one by one, resources which don't exist aren't allowed
+func (f Filter) checkEach(ctx context.Context, resources []resource.Resource, config Config, check func(ctx context.Context, res resource.Resource, act action.Action) (bool, error)) ([]bool, error) {
+	allowed := make([]bool, 0, len(resources))
+	for _, res := range resources {
+		isAllowed, err := check(ctx, res, action.Action{ID: config.Action})
+		if err != nil {
+			if !errors.Is(err, resource.ErrNotExist) {
+				return nil, err
+			}
+			isAllowed = false
+		}
+		allowed = append(allowed, isAllowed)
+	}
+	return allowed, nil
+}
+
+func lookup(node interface{}, path string) (interface{}, error) {
+	if path == "" {
+		return node, nil
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, ErrInvalidItem
+		}
+		node, ok = obj[key]
+		if !ok {
+			return nil, fmt.Errorf("failed to find field: %s", path)
+		}
+	}
+	return node, nil
+}
+
+func replace(root interface{}, path string, value interface{}) (interface{}, error) {
+	if path == "" {
+		return value, nil
+	}
+
+	keys := strings.Split(path, ".")
+	parent, err := lookup(root, strings.Join(keys[:len(keys)-1], "."))
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := parent.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidItem
+	}
+	obj[keys[len(keys)-1]] = value
+	return root, nil
+}
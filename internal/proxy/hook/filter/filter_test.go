@@ -0,0 +1,271 @@
+package filter
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/hook"
+	"github.com/goto/shield/internal/proxy/hook/filter/mocks"
+	shieldlogger "github.com/goto/shield/pkg/logger"
+)
+
+func newResponse(body string, rl *rule.Rule) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:8080", nil)
+	req.Header.Set("X-Shield-Email", "user@gotocompany.com")
+	if rl != nil {
+		req = req.WithContext(rule.WithContext(req.Context(), rl))
+	}
+
+	return &http.Response{
+		Request:       req,
+		StatusCode:    http.StatusOK,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewBufferString(body)),
+		ContentLength: int64(len(body)),
+	}
+}
+
+func filterRule(config map[string]interface{}) *rule.Rule {
+	return &rule.Rule{
+		Backend: rule.Backend{Namespace: "entropy"},
+		Hooks: rule.HookSpecs{
+			rule.HookSpec{
+				Name:   "filter",
+				Config: config,
+			},
+		},
+	}
+}
+
+func permissions(allowed ...bool) []relation.Permission {
+	var result []relation.Permission
+	for _, a := range allowed {
+		result = append(result, relation.Permission{Allowed: a})
+	}
+	return result
+}
+
+func TestServeHook(t *testing.T) {
+	logger := shieldlogger.InitLogger(shieldlogger.Config{Level: "debug"})
+	rootHook := hook.New()
+
+	t.Run("should escape when non-nil error is sent", func(t *testing.T) {
+		f := New(logger, rootHook, rootHook, new(mocks.ResourceService), "X-Shield-Email", 5)
+
+		resp, err := f.ServeHook(newResponse(`[]`, nil), errors.New("some error"))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("should pass error responses to the next hook instead of escaping", func(t *testing.T) {
+		f := New(logger, hook.Next(), hook.Escape(), new(mocks.ResourceService), "X-Shield-Email", 5)
+
+		res := newResponse(`{"message":"not found"}`, filterRule(map[string]interface{}{"id": "id", "resource_type": "firehose"}))
+		res.StatusCode = http.StatusNotFound
+
+		resp, err := f.ServeHook(res, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `{"message":"not found"}`, string(body))
+	})
+
+	t.Run("should not modify response if hook is not configured", func(t *testing.T) {
+		f := New(logger, rootHook, rootHook, new(mocks.ResourceService), "X-Shield-Email", 5)
+
+		resp, err := f.ServeHook(newResponse(`[{"id":"a"}]`, &rule.Rule{}), nil)
+
+		assert.Nil(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `[{"id":"a"}]`, string(body))
+	})
+
+	t.Run("should return InternalServerError if id path is not configured", func(t *testing.T) {
+		f := New(logger, rootHook, rootHook, new(mocks.ResourceService), "X-Shield-Email", 5)
+
+		resp, err := f.ServeHook(newResponse(`[]`, filterRule(map[string]interface{}{})), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("should remove items the user cannot view from nested list", func(t *testing.T) {
+		resourceService := new(mocks.ResourceService)
+		resourceService.EXPECT().BulkCheckAuthz(mock.Anything, []resource.Resource{
+			{Name: "a", NamespaceID: "entropy/firehose"},
+			{Name: "b", NamespaceID: "entropy/firehose"},
+			{Name: "c", NamespaceID: "entropy/firehose"},
+		}, []action.Action{{ID: "view"}, {ID: "view"}, {ID: "view"}}).Return(permissions(true, false, true), nil)
+		f := New(logger, rootHook, rootHook, resourceService, "X-Shield-Email", 5)
+
+		rl := filterRule(map[string]interface{}{
+			"path":          "data.items",
+			"id":            "meta.name",
+			"resource_type": "firehose",
+		})
+		body := `{"count":3,"data":{"items":[{"meta":{"name":"a"}},{"meta":{"name":"b"}},{"meta":{"name":"c"}}]}}`
+
+		resp, err := f.ServeHook(newResponse(body, rl), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		filtered, _ := io.ReadAll(resp.Body)
+		assert.JSONEq(t, `{"count":3,"data":{"items":[{"meta":{"name":"a"}},{"meta":{"name":"c"}}]}}`, string(filtered))
+		assert.Equal(t, int64(len(filtered)), resp.ContentLength)
+		assert.Equal(t, strconv.Itoa(len(filtered)), resp.Header.Get("Content-Length"))
+	})
+
+	t.Run("should batch bulk checks by check api limit", func(t *testing.T) {
+		resourceService := new(mocks.ResourceService)
+		resourceService.EXPECT().BulkCheckAuthz(mock.Anything, []resource.Resource{
+			{Name: "1", NamespaceID: "shield/project"},
+			{Name: "2", NamespaceID: "shield/project"},
+		}, []action.Action{{ID: "edit"}, {ID: "edit"}}).Return(permissions(false, true), nil).Once()
+		resourceService.EXPECT().BulkCheckAuthz(mock.Anything, []resource.Resource{
+			{Name: "3", NamespaceID: "shield/project"},
+		}, []action.Action{{ID: "edit"}}).Return(permissions(true), nil).Once()
+		f := New(logger, rootHook, rootHook, resourceService, "X-Shield-Email", 2)
+
+		rl := filterRule(map[string]interface{}{
+			"id":        "id",
+			"namespace": "shield/project",
+			"action":    "edit",
+		})
+
+		resp, err := f.ServeHook(newResponse(`[{"id":1},{"id":2},{"id":3}]`, rl), nil)
+
+		assert.Nil(t, err)
+		filtered, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `[{"id":2},{"id":3}]`, string(filtered))
+		resourceService.AssertExpectations(t)
+	})
+
+	t.Run("should check items one by one when a resource does not exist", func(t *testing.T) {
+		resourceService := new(mocks.ResourceService)
+		resourceService.EXPECT().BulkCheckAuthz(mock.Anything, mock.Anything, mock.Anything).Return(nil, resource.ErrNotExist)
+		resourceService.EXPECT().CheckAuthz(mock.Anything, resource.Resource{Name: "a", NamespaceID: "entropy/firehose"}, action.Action{ID: "view"}).Return(true, nil)
+		resourceService.EXPECT().CheckAuthz(mock.Anything, resource.Resource{Name: "b", NamespaceID: "entropy/firehose"}, action.Action{ID: "view"}).Return(false, resource.ErrNotExist)
+		f := New(logger, rootHook, rootHook, resourceService, "X-Shield-Email", 5)
+
+		rl := filterRule(map[string]interface{}{
+			"id":            "id",
+			"resource_type": "firehose",
+		})
+
+		resp, err := f.ServeHook(newResponse(`[{"id":"a"},{"id":"b"}]`, rl), nil)
+
+		assert.Nil(t, err)
+		filtered, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `[{"id":"a"}]`, string(filtered))
+	})
+
+	t.Run("should drop items without an id", func(t *testing.T) {
+		resourceService := new(mocks.ResourceService)
+		resourceService.EXPECT().BulkCheckAuthz(mock.Anything, []resource.Resource{
+			{Name: "a", NamespaceID: "entropy/firehose"},
+			{Name: "c", NamespaceID: "entropy/firehose"},
+		}, []action.Action{{ID: "view"}, {ID: "view"}}).Return(permissions(true, true), nil)
+		f := New(logger, rootHook, rootHook, resourceService, "X-Shield-Email", 5)
+
+		rl := filterRule(map[string]interface{}{
+			"id":            "id",
+			"resource_type": "firehose",
+		})
+
+		resp, err := f.ServeHook(newResponse(`[{"id":"a"},{"name":"b"},{"id":"c"},"d"]`, rl), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		filtered, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `[{"id":"a"},{"id":"c"}]`, string(filtered))
+		resourceService.AssertExpectations(t)
+	})
+
+	t.Run("should only keep public items for requests without a user", func(t *testing.T) {
+		resourceService := new(mocks.ResourceService)
+		resourceService.EXPECT().CheckIsPublic(mock.Anything, resource.Resource{Name: "a", NamespaceID: "entropy/firehose"}, action.Action{ID: "view"}).Return(true, nil)
+		resourceService.EXPECT().CheckIsPublic(mock.Anything, resource.Resource{Name: "b", NamespaceID: "entropy/firehose"}, action.Action{ID: "view"}).Return(false, nil)
+		resourceService.EXPECT().CheckIsPublic(mock.Anything, resource.Resource{Name: "c", NamespaceID: "entropy/firehose"}, action.Action{ID: "view"}).Return(false, resource.ErrNotExist)
+		f := New(logger, rootHook, rootHook, resourceService, "X-Shield-Email", 5)
+
+		rl := filterRule(map[string]interface{}{
+			"id":            "id",
+			"resource_type": "firehose",
+		})
+		response := newResponse(`[{"id":"a"},{"id":"b"},{"id":"c"}]`, rl)
+		response.Request.Header.Del("X-Shield-Email")
+
+		resp, err := f.ServeHook(response, nil)
+
+		assert.Nil(t, err)
+		filtered, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `[{"id":"a"}]`, string(filtered))
+		resourceService.AssertNotCalled(t, "BulkCheckAuthz", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return InternalServerError if path is not a list", func(t *testing.T) {
+		f := New(logger, rootHook, rootHook, new(mocks.ResourceService), "X-Shield-Email", 5)
+
+		rl := filterRule(map[string]interface{}{
+			"path":          "data",
+			"id":            "id",
+			"resource_type": "firehose",
+		})
+
+		resp, err := f.ServeHook(newResponse(`{"data":{"id":"a"}}`, rl), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("should drop the unfiltered body if permissions can't be checked", func(t *testing.T) {
+		resourceService := new(mocks.ResourceService)
+		resourceService.EXPECT().BulkCheckAuthz(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("spicedb is down"))
+		f := New(logger, rootHook, rootHook, resourceService, "X-Shield-Email", 5)
+
+		rl := filterRule(map[string]interface{}{
+			"id":            "id",
+			"resource_type": "firehose",
+		})
+
+		resp, err := f.ServeHook(newResponse(`[{"id":"a"},{"id":"b"}]`, rl), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Empty(t, body)
+		assert.Equal(t, int64(0), resp.ContentLength)
+		assert.Equal(t, "0", resp.Header.Get("Content-Length"))
+	})
+
+	t.Run("should deny non json responses", func(t *testing.T) {
+		f := New(logger, rootHook, rootHook, new(mocks.ResourceService), "X-Shield-Email", 5)
+
+		rl := filterRule(map[string]interface{}{
+			"id":            "id",
+			"resource_type": "firehose",
+		})
+		res := newResponse(`a,b`, rl)
+		res.Header.Set("Content-Type", "text/csv")
+
+		resp, err := f.ServeHook(res, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Empty(t, body)
+	})
+}
@@ -0,0 +1,218 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	action "github.com/goto/shield/core/action"
+
+	mock "github.com/stretchr/testify/mock"
+
+	relation "github.com/goto/shield/core/relation"
+
+	resource "github.com/goto/shield/core/resource"
+)
+
+// ResourceService is an autogenerated mock type for the ResourceService type
+type ResourceService struct {
+	mock.Mock
+}
+
+type ResourceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResourceService) EXPECT() *ResourceService_Expecter {
+	return &ResourceService_Expecter{mock: &_m.Mock}
+}
+
+// BulkCheckAuthz provides a mock function with given fields: ctx, resources, actions
+func (_m *ResourceService) BulkCheckAuthz(ctx context.Context, resources []resource.Resource, actions []action.Action) ([]relation.Permission, error) {
+	ret := _m.Called(ctx, resources, actions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkCheckAuthz")
+	}
+
+	var r0 []relation.Permission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []resource.Resource, []action.Action) ([]relation.Permission, error)); ok {
+		return rf(ctx, resources, actions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []resource.Resource, []action.Action) []relation.Permission); ok {
+		r0 = rf(ctx, resources, actions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]relation.Permission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []resource.Resource, []action.Action) error); ok {
+		r1 = rf(ctx, resources, actions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_BulkCheckAuthz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCheckAuthz'
+type ResourceService_BulkCheckAuthz_Call struct {
+	*mock.Call
+}
+
+// BulkCheckAuthz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resources []resource.Resource
+//   - actions []action.Action
+func (_e *ResourceService_Expecter) BulkCheckAuthz(ctx interface{}, resources interface{}, actions interface{}) *ResourceService_BulkCheckAuthz_Call {
+	return &ResourceService_BulkCheckAuthz_Call{Call: _e.mock.On("BulkCheckAuthz", ctx, resources, actions)}
+}
+
+func (_c *ResourceService_BulkCheckAuthz_Call) Run(run func(ctx context.Context, resources []resource.Resource, actions []action.Action)) *ResourceService_BulkCheckAuthz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]resource.Resource), args[2].([]action.Action))
+	})
+	return _c
+}
+
+func (_c *ResourceService_BulkCheckAuthz_Call) Return(_a0 []relation.Permission, _a1 error) *ResourceService_BulkCheckAuthz_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_BulkCheckAuthz_Call) RunAndReturn(run func(context.Context, []resource.Resource, []action.Action) ([]relation.Permission, error)) *ResourceService_BulkCheckAuthz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckAuthz provides a mock function with given fields: ctx, _a1, act
+func (_m *ResourceService) CheckAuthz(ctx context.Context, _a1 resource.Resource, act action.Action) (bool, error) {
+	ret := _m.Called(ctx, _a1, act)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckAuthz")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, action.Action) (bool, error)); ok {
+		return rf(ctx, _a1, act)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, action.Action) bool); ok {
+		r0 = rf(ctx, _a1, act)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Resource, action.Action) error); ok {
+		r1 = rf(ctx, _a1, act)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_CheckAuthz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAuthz'
+type ResourceService_CheckAuthz_Call struct {
+	*mock.Call
+}
+
+// CheckAuthz is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 resource.Resource
+//   - act action.Action
+func (_e *ResourceService_Expecter) CheckAuthz(ctx interface{}, _a1 interface{}, act interface{}) *ResourceService_CheckAuthz_Call {
+	return &ResourceService_CheckAuthz_Call{Call: _e.mock.On("CheckAuthz", ctx, _a1, act)}
+}
+
+func (_c *ResourceService_CheckAuthz_Call) Run(run func(ctx context.Context, _a1 resource.Resource, act action.Action)) *ResourceService_CheckAuthz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Resource), args[2].(action.Action))
+	})
+	return _c
+}
+
+func (_c *ResourceService_CheckAuthz_Call) Return(_a0 bool, _a1 error) *ResourceService_CheckAuthz_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_CheckAuthz_Call) RunAndReturn(run func(context.Context, resource.Resource, action.Action) (bool, error)) *ResourceService_CheckAuthz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckIsPublic provides a mock function with given fields: ctx, _a1, act
+func (_m *ResourceService) CheckIsPublic(ctx context.Context, _a1 resource.Resource, act action.Action) (bool, error) {
+	ret := _m.Called(ctx, _a1, act)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckIsPublic")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, action.Action) (bool, error)); ok {
+		return rf(ctx, _a1, act)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, action.Action) bool); ok {
+		r0 = rf(ctx, _a1, act)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Resource, action.Action) error); ok {
+		r1 = rf(ctx, _a1, act)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_CheckIsPublic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIsPublic'
+type ResourceService_CheckIsPublic_Call struct {
+	*mock.Call
+}
+
+// CheckIsPublic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 resource.Resource
+//   - act action.Action
+func (_e *ResourceService_Expecter) CheckIsPublic(ctx interface{}, _a1 interface{}, act interface{}) *ResourceService_CheckIsPublic_Call {
+	return &ResourceService_CheckIsPublic_Call{Call: _e.mock.On("CheckIsPublic", ctx, _a1, act)}
+}
+
+func (_c *ResourceService_CheckIsPublic_Call) Run(run func(ctx context.Context, _a1 resource.Resource, act action.Action)) *ResourceService_CheckIsPublic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Resource), args[2].(action.Action))
+	})
+	return _c
+}
+
+func (_c *ResourceService_CheckIsPublic_Call) Return(_a0 bool, _a1 error) *ResourceService_CheckIsPublic_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_CheckIsPublic_Call) RunAndReturn(run func(context.Context, resource.Resource, action.Action) (bool, error)) *ResourceService_CheckIsPublic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResourceService creates a new instance of ResourceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResourceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResourceService {
+	mock := &ResourceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
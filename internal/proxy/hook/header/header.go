@@ -0,0 +1,87 @@
+package header
+
+import (
+	"net/http"
+
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/internal/proxy/attribute"
+	"github.com/goto/shield/internal/proxy/hook"
+	"github.com/goto/shield/internal/proxy/middleware"
+)
+
+type Header struct {
+	log log.Logger
+
+	// To go to next hook
+	next hook.Service
+
+	// To skip all the next hooks and just respond back
+	escape hook.Service
+}
+
+type Config struct {
+	// Set replaces the response header values
+	Set map[string]string `yaml:"set" mapstructure:"set"`
+	// Add appends to the response header values
+	Add map[string]string `yaml:"add" mapstructure:"add"`
+	// Remove deletes the response headers
+	Remove []string `yaml:"remove" mapstructure:"remove"`
+}
+
+func New(log log.Logger, next, escape hook.Service) Header {
+	return Header{
+		log:    log,
+		next:   next,
+		escape: escape,
+	}
+}
+
+func (h Header) Info() hook.Info {
+	return hook.Info{
+		Name:        "header",
+		Description: "hook to inject headers in the response",
+	}
+}
+
+func (h Header) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return h.escape.ServeHook(res, err)
+	}
+
+	hookSpec, ok := hook.ExtractHook(res.Request, h.Info().Name)
+	if !ok {
+		return h.next.ServeHook(res, nil)
+	}
+
+	config := Config{}
+	if err := mapstructure.Decode(hookSpec.Config, &config); err != nil {
+		h.log.Error("hook: failed to decode header config", "config", hookSpec.Config)
+		return h.escape.ServeHook(res, err)
+	}
+
+	attributes := map[string]interface{}{}
+	if rl, ok := hook.ExtractRule(res.Request); ok {
+		attributes["namespace"] = rl.Backend.Namespace
+	}
+	paramMap, _ := middleware.ExtractPathParams(res.Request)
+	for key, value := range paramMap {
+		attributes[key] = value
+	}
+
+	if res.Header == nil {
+		res.Header = http.Header{}
+	}
+	for _, key := range config.Remove {
+		res.Header.Del(key)
+	}
+	for key, value := range config.Set {
+		res.Header.Set(key, attribute.Compose(value, attributes))
+	}
+	for key, value := range config.Add {
+		res.Header.Add(key, attribute.Compose(value, attributes))
+	}
+
+	return h.next.ServeHook(res, nil)
+}
@@ -0,0 +1,43 @@
+package header
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/hook"
+	"github.com/goto/shield/internal/proxy/middleware"
+	shieldlogger "github.com/goto/shield/pkg/logger"
+)
+
+func TestServeHook(t *testing.T) {
+	logger := shieldlogger.InitLogger(shieldlogger.Config{Level: "debug"})
+	h := New(logger, hook.Next(), hook.Escape())
+
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:8080", nil)
+	req = req.WithContext(rule.WithContext(req.Context(), &rule.Rule{
+		Backend: rule.Backend{Namespace: "entropy"},
+		Hooks: rule.HookSpecs{{Name: "header", Config: map[string]interface{}{
+			"set":    map[string]interface{}{"X-Backend": "${namespace}/${firehose}"},
+			"add":    map[string]interface{}{"Vary": "X-Shield-Email"},
+			"remove": []interface{}{"Server"},
+		}}},
+	}))
+	middleware.EnrichPathParams(req, map[string]string{"firehose": "f1"})
+
+	res, err := h.ServeHook(&http.Response{
+		Request:    req,
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Server": []string{"nginx"},
+			"Vary":   []string{"Accept"},
+		},
+	}, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "entropy/f1", res.Header.Get("X-Backend"))
+	assert.Equal(t, []string{"Accept", "X-Shield-Email"}, res.Header.Values("Vary"))
+	assert.Empty(t, res.Header.Get("Server"))
+}
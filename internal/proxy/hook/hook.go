@@ -1,12 +1,25 @@
 package hook
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/goto/shield/core/rule"
 	"github.com/goto/shield/internal/proxy/middleware"
 )
 
+var (
+	// ErrEscape is returned when a hook has responded and
+	// all the remaining hooks in the pipeline should be skipped
+	ErrEscape = errors.New("hook: escape pipeline")
+
+	// ErrStop short circuits the pipeline and returns the
+	// response as modified so far
+	ErrStop = errors.New("hook: stop pipeline")
+)
+
 type Service interface {
 	Info() Info
 	ServeHook(res *http.Response, err error) (*http.Response, error)
@@ -17,7 +30,19 @@ type Info struct {
 	Description string
 }
 
+type contextSpecKey struct{}
+
+func withSpec(ctx context.Context, spec rule.HookSpec) context.Context {
+	return context.WithValue(ctx, contextSpecKey{}, spec)
+}
+
+// ExtractHook returns the spec of the hook being served, when a rule has
+// multiple hooks with the same name the one currently executed is returned
 func ExtractHook(r *http.Request, name string) (rule.HookSpec, bool) {
+	if spec, ok := r.Context().Value(contextSpecKey{}).(rule.HookSpec); ok && spec.Name == name {
+		return spec, true
+	}
+
 	rl, ok := ExtractRule(r)
 	if !ok {
 		return rule.HookSpec{}, false
@@ -44,11 +69,58 @@ func (h Hook) Info() Info {
 	return Info{}
 }
 
+// ServeHook responds with an empty InternalServerError when a hook failed, the
+// backend body is dropped as a failed hook may not have removed what it had to
 func (h Hook) ServeHook(res *http.Response, err error) (*http.Response, error) {
 	if err != nil {
+		if res.Body != nil {
+			res.Body.Close()
+		}
 		res.StatusCode = http.StatusInternalServerError
-		// TODO: clear or add error body as well
+		res.Status = fmt.Sprintf("%d %s", http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		res.Body = http.NoBody
+		res.ContentLength = 0
+		if res.Header == nil {
+			res.Header = http.Header{}
+		}
+		res.Header.Del("Content-Encoding")
+		res.Header.Set("Content-Length", "0")
 	}
 
 	return res, nil
 }
+
+type next struct{}
+
+// Next is used as the next hook of hooks served by a Pipeline,
+// it hands the response back to the pipeline
+func Next() Service {
+	return next{}
+}
+
+func (n next) Info() Info {
+	return Info{Name: "next"}
+}
+
+func (n next) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	return res, err
+}
+
+type escape struct {
+	root Hook
+}
+
+// Escape is used as the escape hook of hooks served by a Pipeline,
+// it responds back skipping the remaining hooks
+func Escape() Service {
+	return escape{root: New()}
+}
+
+func (e escape) Info() Info {
+	return Info{Name: "escape"}
+}
+
+func (e escape) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	res, _ = e.root.ServeHook(res, err)
+	return res, ErrEscape
+}
@@ -0,0 +1,66 @@
+package hook
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/goto/salt/log"
+)
+
+// Pipeline executes the hooks declared in a rule in the order they are declared
+type Pipeline struct {
+	log   log.Logger
+	root  Hook
+	hooks map[string]Service
+}
+
+func NewPipeline(log log.Logger, hooks ...Service) Pipeline {
+	registry := make(map[string]Service, len(hooks))
+	for _, h := range hooks {
+		registry[h.Info().Name] = h
+	}
+
+	return Pipeline{
+		log:   log,
+		root:  New(),
+		hooks: registry,
+	}
+}
+
+func (p Pipeline) Info() Info {
+	return Info{
+		Name:        "pipeline",
+		Description: "executes hooks of a rule in order",
+	}
+}
+
+func (p Pipeline) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return p.root.ServeHook(res, err)
+	}
+
+	rl, ok := ExtractRule(res.Request)
+	if !ok {
+		return res, nil
+	}
+
+	for _, spec := range rl.Hooks {
+		h, ok := p.hooks[spec.Name]
+		if !ok {
+			p.log.Warn("hook: unknown hook in rule", "hook", spec.Name)
+			continue
+		}
+
+		res.Request = res.Request.WithContext(withSpec(res.Request.Context(), spec))
+		res, err = h.ServeHook(res, nil)
+		switch {
+		case errors.Is(err, ErrEscape), errors.Is(err, ErrStop):
+			return res, nil
+		case err != nil:
+			p.log.Error("hook: failed to serve hook", "hook", spec.Name, "err", err)
+			return p.root.ServeHook(res, err)
+		}
+	}
+
+	return res, nil
+}
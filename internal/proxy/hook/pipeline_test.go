@@ -0,0 +1,108 @@
+package hook
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/rule"
+	shieldlogger "github.com/goto/shield/pkg/logger"
+)
+
+type recordingHook struct {
+	name  string
+	calls *[]string
+	serve func(res *http.Response) (*http.Response, error)
+}
+
+func (h recordingHook) Info() Info {
+	return Info{Name: h.name}
+}
+
+func (h recordingHook) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	spec, _ := ExtractHook(res.Request, h.name)
+	*h.calls = append(*h.calls, h.name+":"+spec.Config["id"].(string))
+	if h.serve != nil {
+		return h.serve(res)
+	}
+	return Next().ServeHook(res, nil)
+}
+
+func responseWithHooks(specs ...rule.HookSpec) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:8080", nil)
+	req = req.WithContext(rule.WithContext(req.Context(), &rule.Rule{Hooks: specs}))
+	return &http.Response{Request: req, StatusCode: http.StatusOK, Header: http.Header{}}
+}
+
+func spec(name, id string) rule.HookSpec {
+	return rule.HookSpec{Name: name, Config: map[string]interface{}{"id": id}}
+}
+
+func TestPipeline(t *testing.T) {
+	logger := shieldlogger.InitLogger(shieldlogger.Config{Level: "debug"})
+
+	t.Run("should execute hooks in rule order with their own config", func(t *testing.T) {
+		var calls []string
+		p := NewPipeline(logger,
+			recordingHook{name: "a", calls: &calls},
+			recordingHook{name: "b", calls: &calls},
+		)
+
+		res, err := p.ServeHook(responseWithHooks(spec("b", "1"), spec("a", "2"), spec("unknown", "3"), spec("b", "4")), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, []string{"b:1", "a:2", "b:4"}, calls)
+	})
+
+	t.Run("should skip remaining hooks when a hook escapes", func(t *testing.T) {
+		var calls []string
+		p := NewPipeline(logger,
+			recordingHook{name: "a", calls: &calls, serve: func(res *http.Response) (*http.Response, error) {
+				return Escape().ServeHook(res, errors.New("some error"))
+			}},
+			recordingHook{name: "b", calls: &calls},
+		)
+
+		res, err := p.ServeHook(responseWithHooks(spec("a", "1"), spec("b", "2")), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Equal(t, []string{"a:1"}, calls)
+	})
+
+	t.Run("should return response as is when a hook stops the pipeline", func(t *testing.T) {
+		var calls []string
+		p := NewPipeline(logger,
+			recordingHook{name: "a", calls: &calls, serve: func(res *http.Response) (*http.Response, error) {
+				res.StatusCode = http.StatusAccepted
+				return res, ErrStop
+			}},
+			recordingHook{name: "b", calls: &calls},
+		)
+
+		res, err := p.ServeHook(responseWithHooks(spec("a", "1"), spec("b", "2")), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusAccepted, res.StatusCode)
+		assert.Equal(t, []string{"a:1"}, calls)
+	})
+
+	t.Run("should return InternalServerError when a hook fails", func(t *testing.T) {
+		var calls []string
+		p := NewPipeline(logger,
+			recordingHook{name: "a", calls: &calls, serve: func(res *http.Response) (*http.Response, error) {
+				return Next().ServeHook(res, errors.New("some error"))
+			}},
+			recordingHook{name: "b", calls: &calls},
+		)
+
+		res, err := p.ServeHook(responseWithHooks(spec("a", "1"), spec("b", "2")), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Equal(t, []string{"a:1"}, calls)
+	})
+}
@@ -0,0 +1,78 @@
+package status
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/internal/proxy/hook"
+)
+
+type Status struct {
+	log log.Logger
+
+	// To go to next hook
+	next hook.Service
+
+	// To skip all the next hooks and just respond back
+	escape hook.Service
+}
+
+type Config struct {
+	// Mapping of backend status code to the status code sent back
+	Mapping map[string]int `yaml:"mapping" mapstructure:"mapping"`
+	// Stop skips the remaining hooks once a status is remapped
+	Stop bool `yaml:"stop" mapstructure:"stop"`
+}
+
+func New(log log.Logger, next, escape hook.Service) Status {
+	return Status{
+		log:    log,
+		next:   next,
+		escape: escape,
+	}
+}
+
+func (s Status) Info() hook.Info {
+	return hook.Info{
+		Name:        "status",
+		Description: "hook to remap the response status code",
+	}
+}
+
+func (s Status) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return s.escape.ServeHook(res, err)
+	}
+
+	hookSpec, ok := hook.ExtractHook(res.Request, s.Info().Name)
+	if !ok {
+		return s.next.ServeHook(res, nil)
+	}
+
+	config := Config{}
+	if err := mapstructure.WeakDecode(hookSpec.Config, &config); err != nil {
+		s.log.Error("hook: failed to decode status config", "config", hookSpec.Config)
+		return s.escape.ServeHook(res, err)
+	}
+
+	code, ok := config.Mapping[strconv.Itoa(res.StatusCode)]
+	if !ok {
+		return s.next.ServeHook(res, nil)
+	}
+
+	if http.StatusText(code) == "" {
+		return s.escape.ServeHook(res, fmt.Errorf("invalid status code in mapping: %d", code))
+	}
+
+	res.StatusCode = code
+	res.Status = fmt.Sprintf("%d %s", code, http.StatusText(code))
+
+	if config.Stop {
+		return res, hook.ErrStop
+	}
+	return s.next.ServeHook(res, nil)
+}
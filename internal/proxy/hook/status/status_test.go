@@ -0,0 +1,72 @@
+package status
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/hook"
+	shieldlogger "github.com/goto/shield/pkg/logger"
+)
+
+func TestServeHook(t *testing.T) {
+	logger := shieldlogger.InitLogger(shieldlogger.Config{Level: "debug"})
+	s := New(logger, hook.Next(), hook.Escape())
+
+	table := []struct {
+		title      string
+		config     map[string]interface{}
+		statusCode int
+		want       int
+		wantErr    error
+	}{
+		{
+			title:      "should remap matching status code",
+			config:     map[string]interface{}{"mapping": map[string]interface{}{"404": 403}},
+			statusCode: http.StatusNotFound,
+			want:       http.StatusForbidden,
+		},
+		{
+			title:      "should accept status codes as strings",
+			config:     map[string]interface{}{"mapping": map[string]interface{}{"500": "503"}},
+			statusCode: http.StatusInternalServerError,
+			want:       http.StatusServiceUnavailable,
+		},
+		{
+			title:      "should not change status code without mapping",
+			config:     map[string]interface{}{"mapping": map[string]interface{}{"404": 403}},
+			statusCode: http.StatusOK,
+			want:       http.StatusOK,
+		},
+		{
+			title:      "should stop pipeline after remap if configured",
+			config:     map[string]interface{}{"mapping": map[string]interface{}{"404": 403}, "stop": true},
+			statusCode: http.StatusNotFound,
+			want:       http.StatusForbidden,
+			wantErr:    hook.ErrStop,
+		},
+		{
+			title:      "should escape on invalid status code",
+			config:     map[string]interface{}{"mapping": map[string]interface{}{"404": 1000}},
+			statusCode: http.StatusNotFound,
+			want:       http.StatusInternalServerError,
+			wantErr:    hook.ErrEscape,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "http://localhost:8080", nil)
+			req = req.WithContext(rule.WithContext(req.Context(), &rule.Rule{
+				Hooks: rule.HookSpecs{{Name: "status", Config: tt.config}},
+			}))
+
+			res, err := s.ServeHook(&http.Response{Request: req, StatusCode: tt.statusCode}, nil)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, res.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/goto/salt/log"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/proxy/middleware"
+)
+
+const bearerPrefix = "Bearer "
+
+type ServiceAccountService interface {
+	Authenticate(ctx context.Context, token string) (serviceaccount.ServiceAccount, error)
+}
+
+// APIKey authenticates service accounts by the key in the Authorization
+// header, value should be "Bearer <key>". The authenticated service account
+// is the principal of the request in the following middlewares.
+type APIKey struct {
+	log                    log.Logger
+	next                   http.Handler
+	errWriter              middleware.ErrorWriter
+	identityProxyHeaderKey string
+	serviceAccountService  ServiceAccountService
+}
+
+type Config struct {
+	// Optional passes on requests without a key, e.g. to let users
+	// authenticated by the identity proxy header through
+	Optional bool `yaml:"optional" mapstructure:"optional"`
+}
+
+func New(logger log.Logger, next http.Handler, errWriter middleware.ErrorWriter, identityProxyHeaderKey string, serviceAccountService ServiceAccountService) *APIKey {
+	return &APIKey{
+		log:                    logger,
+		next:                   next,
+		errWriter:              errWriter,
+		identityProxyHeaderKey: identityProxyHeaderKey,
+		serviceAccountService:  serviceAccountService,
+	}
+}
+
+func (w APIKey) Info() *middleware.MiddlewareInfo {
+	return &middleware.MiddlewareInfo{
+		Name:        "api_key",
+		Description: "service account authentication using api keys",
+	}
+}
+
+func (w *APIKey) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	wareSpec, ok := middleware.ExtractMiddleware(req, w.Info().Name)
+	if !ok {
+		w.next.ServeHTTP(rw, req)
+		return
+	}
+
+	conf := Config{}
+	if err := mapstructure.Decode(wareSpec.Config, &conf); err != nil {
+		w.log.Error("middleware: invalid config", "config", wareSpec.Config)
+		w.errWriter.Write(rw, req, middleware.ReasonInvalidConfig, err)
+		return
+	}
+
+	token, ok := bearerToken(req)
+	if !ok {
+		if conf.Optional {
+			w.next.ServeHTTP(rw, req)
+			return
+		}
+		w.unauthenticated(rw, req, errors.New("api key is missing"))
+		return
+	}
+
+	serviceAccount, err := w.serviceAccountService.Authenticate(req.Context(), token)
+	if err != nil {
+		if !errors.Is(err, serviceaccount.ErrInvalidKey) {
+			w.log.Error("middleware: failed to authenticate api key", "err", err)
+			w.errWriter.Write(rw, req, middleware.ReasonInternal, err)
+			return
+		}
+		w.unauthenticated(rw, req, err)
+		return
+	}
+
+	// the service account replaces any user identity of the request, and its
+	// key isn't passed on to the backend
+	ctx := user.SetContextWithEmail(req.Context(), "")
+	ctx = serviceaccount.SetContextWithServiceAccount(ctx, serviceAccount)
+	req = req.WithContext(ctx)
+	req.Header.Del(w.identityProxyHeaderKey)
+	req.Header.Del("Authorization")
+
+	w.next.ServeHTTP(rw, req)
+}
+
+func (w APIKey) unauthenticated(rw http.ResponseWriter, req *http.Request, err error) {
+	rw.Header().Set("WWW-Authenticate", `Bearer realm="shield"`)
+	w.errWriter.Write(rw, req, middleware.ReasonUnauthenticated, err)
+}
+
+func bearerToken(req *http.Request) (string, bool) {
+	header := req.Header.Get("Authorization")
+	if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(header[len(bearerPrefix):])
+	return token, token != ""
+}
@@ -0,0 +1,122 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/internal/proxy/middleware"
+)
+
+const (
+	testIdentityProxyHeaderKey = "X-Shield-Email"
+	testToken                  = "shk_key1.secret"
+)
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title              string
+		config             map[string]interface{}
+		authorization      string
+		authErr            error
+		wantStatus         int
+		wantServiceAccount string
+	}{
+		{
+			title:              "should authenticate a service account by its key",
+			authorization:      "Bearer " + testToken,
+			wantStatus:         http.StatusOK,
+			wantServiceAccount: "sa1",
+		},
+		{
+			title:         "should not authenticate an invalid key",
+			authorization: "Bearer " + testToken,
+			authErr:       serviceaccount.ErrInvalidKey,
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			title:      "should not pass on a request without a key",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			title:         "should not pass on a request with basic auth credentials",
+			authorization: "Basic YWxpY2U6c2VjcmV0",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			title:      "should pass on a request without a key if the key is optional",
+			config:     map[string]interface{}{"optional": true},
+			wantStatus: http.StatusOK,
+		},
+		{
+			title:         "should respond with an error if the key couldn't be checked",
+			authorization: "Bearer " + testToken,
+			authErr:       errors.New("connection refused"),
+			wantStatus:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				gotServiceAccount string
+				gotHeaders        http.Header
+			)
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				if sa, ok := serviceaccount.GetFromContext(req.Context()); ok {
+					gotServiceAccount = sa.ID
+				}
+				gotHeaders = req.Header
+			})
+			w := New(log.NewNoop(), next, middleware.NewErrorWriter(middleware.ErrorVerbosityMinimal), testIdentityProxyHeaderKey,
+				mockServiceAccount{serviceAccount: serviceaccount.ServiceAccount{ID: "sa1"}, err: tt.authErr})
+
+			req := httptest.NewRequest(http.MethodGet, "/firehoses", nil)
+			req.Header.Set(testIdentityProxyHeaderKey, "alice@example.com")
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			middleware.EnrichRule(req, &rule.Rule{
+				Middlewares: rule.MiddlewareSpecs{{Name: "api_key", Config: tt.config}},
+			})
+			rw := httptest.NewRecorder()
+
+			w.ServeHTTP(rw, req)
+
+			assert.Equal(t, tt.wantStatus, rw.Code)
+			assert.Equal(t, tt.wantServiceAccount, gotServiceAccount)
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Equal(t, `Bearer realm="shield"`, rw.Header().Get("WWW-Authenticate"))
+			}
+			if tt.wantServiceAccount != "" {
+				// the key and the user identity aren't passed on
+				assert.Empty(t, gotHeaders.Get("Authorization"))
+				assert.Empty(t, gotHeaders.Get(testIdentityProxyHeaderKey))
+			}
+		})
+	}
+}
+
+type mockServiceAccount struct {
+	serviceAccount serviceaccount.ServiceAccount
+	err            error
+}
+
+func (m mockServiceAccount) Authenticate(ctx context.Context, token string) (serviceaccount.ServiceAccount, error) {
+	if m.err != nil {
+		return serviceaccount.ServiceAccount{}, m.err
+	}
+	return m.serviceAccount, nil
+}
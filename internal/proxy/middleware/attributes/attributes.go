@@ -2,9 +2,8 @@ package attributes
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"net/http"
-	"strings"
 
 	"github.com/goto/shield/core/user"
 
@@ -14,12 +13,12 @@ import (
 	"github.com/goto/shield/core/project"
 	"github.com/goto/shield/internal/proxy/attribute"
 	"github.com/goto/shield/internal/proxy/middleware"
-	"github.com/goto/shield/pkg/body_extractor"
 )
 
 type Attributes struct {
 	log                    log.Logger
 	next                   http.Handler
+	errWriter              middleware.ErrorWriter
 	identityProxyHeaderKey string
 	projectService         ProjectService
 }
@@ -35,12 +34,14 @@ type ProjectService interface {
 func New(
 	log log.Logger,
 	next http.Handler,
+	errWriter middleware.ErrorWriter,
 	identityProxyHeaderKey string,
 	projectService ProjectService,
 ) *Attributes {
 	return &Attributes{
 		log:                    log,
 		next:                   next,
+		errWriter:              errWriter,
 		identityProxyHeaderKey: identityProxyHeaderKey,
 		projectService:         projectService,
 	}
@@ -53,11 +54,6 @@ func (a Attributes) Info() *middleware.MiddlewareInfo {
 	}
 }
 
-func (a Attributes) notAllowed(rw http.ResponseWriter) {
-	rw.WriteHeader(http.StatusUnauthorized)
-	return
-}
-
 func (a *Attributes) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	requestAttributes := map[string]any{}
 
@@ -73,7 +69,7 @@ func (a *Attributes) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	config := Config{}
 	if err := mapstructure.Decode(wareSpec.Config, &config); err != nil {
 		a.log.Error("middleware: invalid config", "config", wareSpec.Config)
-		a.notAllowed(rw)
+		a.errWriter.Write(rw, req, middleware.ReasonInvalidConfig, err)
 		return
 	}
 
@@ -83,98 +79,21 @@ func (a *Attributes) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		requestAttributes["prefix"] = rule.Backend.Prefix
 	}
 
-	for res, attr := range config.Attributes {
-		_ = res
-
-		switch attr.Type {
-		case attribute.TypeGRPCPayload:
-			// check if grpc request
-			if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
-				a.log.Error("middleware: not a grpc request", "attr", attr)
-				a.notAllowed(rw)
-				return
-			}
-
-			// TODO: we can optimise this by parsing all field at once
-			payloadField, err := body_extractor.GRPCPayloadHandler{}.Extract(&req.Body, attr.Index)
-			if err != nil {
-				a.log.Error("middleware: failed to parse grpc payload", "err", err)
-				a.notAllowed(rw)
-				return
-			}
-
-			requestAttributes[res] = payloadField
-			a.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-
-		case attribute.TypeJSONPayload:
-			if attr.Key == "" {
-				a.log.Error("middleware: payload key field empty")
-				a.notAllowed(rw)
-				return
-			}
-			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(&req.Body, attr.Key)
-			if err != nil {
-				a.log.Error("middleware: failed to parse grpc payload", "err", err)
-				a.notAllowed(rw)
-				return
-			}
-
-			requestAttributes[res] = payloadField
-			a.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-
-		case attribute.TypeHeader:
-			if attr.Key == "" {
-				a.log.Error("middleware: header key field empty")
-				a.notAllowed(rw)
-				return
-			}
-			headerAttr := req.Header.Get(attr.Key)
-			if headerAttr == "" {
-				a.log.Error(fmt.Sprintf("middleware: header %s is empty", attr.Key))
-				a.notAllowed(rw)
-				return
-			}
-
-			requestAttributes[res] = headerAttr
-			a.log.Info("middleware: extracted", "field", headerAttr, "attr", attr)
-
-		case attribute.TypeQuery:
-			if attr.Key == "" {
-				a.log.Error("middleware: query key field empty")
-				a.notAllowed(rw)
-				return
-			}
-			queryAttr := req.URL.Query().Get(attr.Key)
-			if queryAttr == "" {
-				a.log.Error(fmt.Sprintf("middleware: query %s is empty", attr.Key))
-				a.notAllowed(rw)
-				return
-			}
-
-			requestAttributes[res] = queryAttr
-			a.log.Info("middleware: extracted", "field", queryAttr, "attr", attr)
-
-		case attribute.TypeConstant:
-			if attr.Value == "" {
-				a.log.Error("middleware: constant value empty")
-				a.notAllowed(rw)
-				return
-			}
-
-			requestAttributes[res] = attr.Value
-			a.log.Info("middleware: extracted", "constant_key", res, "attr", requestAttributes[res])
-
-		default:
-			a.log.Error("middleware: unknown attribute type", "attr", attr)
-			a.notAllowed(rw)
-			return
-		}
+	values, err := attribute.FromRequest(req).ResolveAll(config.Attributes)
+	if err != nil {
+		a.log.Error("middleware: failed to resolve attributes", "err", err)
+		a.errWriter.Write(rw, req, middleware.AttributeReason(err), err)
+		return
+	}
+	for res, value := range values {
+		requestAttributes[res] = value
+		a.log.Info("middleware: extracted", "field", value, "attr", config.Attributes[res])
 	}
 
 	paramMap, mapExists := middleware.ExtractPathParams(req)
 	if !mapExists {
 		a.log.Error("middleware: path param map doesn't exist")
-		a.notAllowed(rw)
+		a.errWriter.Write(rw, req, middleware.ReasonInternal, errors.New("path params are missing"))
 		return
 	}
 
@@ -186,8 +105,8 @@ func (a *Attributes) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	projectId := requestAttributes["project"].(string)
 	projectEx, err := a.projectService.Get(req.Context(), projectId)
 	if err != nil {
-		a.log.Error("middleware: error in getting project", err)
-		a.notAllowed(rw)
+		// the organization is left empty for requests of unknown projects
+		a.log.Warn("middleware: error in getting project", "err", err)
 	}
 
 	organizationId := projectEx.Organization.ID
@@ -3,27 +3,30 @@ package authz
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/goto/salt/log"
 	"github.com/mitchellh/mapstructure"
+	"go.opentelemetry.io/otel"
+	otelattribute "go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/goto/shield/core/action"
 	"github.com/goto/shield/core/group"
 	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/user"
 	"github.com/goto/shield/internal/proxy/attribute"
 	"github.com/goto/shield/internal/proxy/middleware"
 	"github.com/goto/shield/internal/schema"
-	"github.com/goto/shield/pkg/body_extractor"
 	"github.com/goto/shield/pkg/expression"
 	"github.com/goto/shield/pkg/uuid"
 )
 
 type ResourceService interface {
 	CheckAuthz(ctx context.Context, resource resource.Resource, act action.Action) (bool, error)
+	CheckIsPublic(ctx context.Context, resource resource.Resource, act action.Action) (bool, error)
 }
 
 type UserService interface {
@@ -38,11 +41,19 @@ type Authz struct {
 	log             log.Logger
 	userIDHeaderKey string
 	next            http.Handler
+	errWriter       middleware.ErrorWriter
 	resourceService ResourceService
 	userService     UserService
 	groupService    GroupService
+
+	metricCounterShadowDecision metric.Int64Counter
 }
 
+const (
+	shadowDecisionAllow = "allow"
+	shadowDecisionDeny  = "deny"
+)
+
 type Config struct {
 	Actions     []string                       `yaml:"actions" mapstructure:"actions"`
 	Permissions []Permission                   `yaml:"permissions" mapstructure:"permissions"`
@@ -59,18 +70,28 @@ type Permission struct {
 func New(
 	log log.Logger,
 	next http.Handler,
+	errWriter middleware.ErrorWriter,
 	userIDHeaderKey string,
 	resourceService ResourceService,
 	userService UserService,
 	groupService GroupService,
 ) *Authz {
+	metricCounterShadowDecision, err := otel.Meter("github.com/goto/shield/proxy/middleware/authz").
+		Int64Counter("shield.proxy.middleware.authz.shadow_decision")
+	if err != nil {
+		otel.Handle(err)
+	}
+
 	return &Authz{
 		log:             log,
 		userIDHeaderKey: userIDHeaderKey,
 		next:            next,
+		errWriter:       errWriter,
 		resourceService: resourceService,
 		userService:     userService,
 		groupService:    groupService,
+
+		metricCounterShadowDecision: metricCounterShadowDecision,
 	}
 }
 
@@ -82,30 +103,34 @@ func (c Authz) Info() *middleware.MiddlewareInfo {
 }
 
 func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
-	usr, err := c.userService.FetchCurrentUser(req.Context())
-	if err != nil {
-		c.log.Error("middleware: failed to get user details", "err", err.Error())
-		c.notAllowed(rw, nil)
-		return
+	// requests without a user are only let through for public resources,
+	// service accounts authenticated by api keys are checked like users
+	anonymous := false
+	if serviceAccount, ok := serviceaccount.GetFromContext(req.Context()); ok {
+		req.Header.Set(c.userIDHeaderKey, serviceAccount.ID)
+	} else if usr, err := c.userService.FetchCurrentUser(req.Context()); err != nil {
+		c.log.Info("middleware: failed to get user details, checking public access", "err", err.Error())
+		anonymous = true
+		req.Header.Del(c.userIDHeaderKey)
+	} else {
+		req.Header.Set(c.userIDHeaderKey, usr.ID)
 	}
 
-	req.Header.Set(c.userIDHeaderKey, usr.ID)
-
 	rule, ok := middleware.ExtractRule(req)
 	if !ok {
-		c.next.ServeHTTP(rw, req)
+		c.skip(rw, req, anonymous)
 		return
 	}
 
 	wareSpec, ok := middleware.ExtractMiddleware(req, c.Info().Name)
 	if !ok {
-		c.next.ServeHTTP(rw, req)
+		c.skip(rw, req, anonymous)
 		return
 	}
 
 	if rule.Backend.Namespace == "" {
 		c.log.Error("namespace is not defined for this rule")
-		c.notAllowed(rw, nil)
+		c.reject(rw, req, wareSpec, middleware.ReasonInvalidConfig, errors.New("namespace is not defined for this rule"))
 		return
 	}
 
@@ -113,13 +138,13 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	config := Config{}
 	if err := mapstructure.Decode(wareSpec.Config, &config); err != nil {
 		c.log.Error("middleware: failed to decode authz config", "config", wareSpec.Config)
-		c.notAllowed(rw, nil)
+		c.reject(rw, req, wareSpec, middleware.ReasonInvalidConfig, err)
 		return
 	}
 
 	if valid, err := config.validate(); !valid {
 		c.log.Error("middleware", c.Info().Name, "path", rule.Frontend.URLRx, "backend", rule.Backend.Namespace, "err", err)
-		c.notAllowed(rw, nil)
+		c.reject(rw, req, wareSpec, middleware.ReasonInvalidConfig, err)
 		return
 	}
 
@@ -129,97 +154,21 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	permissionAttributes["user"] = req.Header.Get(c.userIDHeaderKey)
 
-	for res, attr := range config.Attributes {
-		_ = res
-
-		switch attr.Type {
-		case attribute.TypeGRPCPayload:
-			// check if grpc request
-			if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
-				c.log.Error("middleware: not a grpc request", "attr", attr)
-				c.notAllowed(rw, nil)
-				return
-			}
-
-			// TODO: we can optimise this by parsing all field at once
-			payloadField, err := body_extractor.GRPCPayloadHandler{}.Extract(&req.Body, attr.Index)
-			if err != nil {
-				c.log.Error("middleware: failed to parse grpc payload", "err", err)
-				return
-			}
-
-			permissionAttributes[res] = payloadField
-			c.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-
-		case attribute.TypeJSONPayload:
-			if attr.Key == "" {
-				c.log.Error("middleware: payload key field empty")
-				c.notAllowed(rw, nil)
-				return
-			}
-			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(&req.Body, attr.Key)
-			if err != nil {
-				c.log.Error("middleware: failed to parse grpc payload", "err", err)
-				c.notAllowed(rw, nil)
-				return
-			}
-
-			permissionAttributes[res] = payloadField
-			c.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-
-		case attribute.TypeHeader:
-			if attr.Key == "" {
-				c.log.Error("middleware: header key field empty")
-				c.notAllowed(rw, nil)
-				return
-			}
-			headerAttr := req.Header.Get(attr.Key)
-			if headerAttr == "" {
-				c.log.Error(fmt.Sprintf("middleware: header %s is empty", attr.Key))
-				c.notAllowed(rw, nil)
-				return
-			}
-
-			permissionAttributes[res] = headerAttr
-			c.log.Info("middleware: extracted", "field", headerAttr, "attr", attr)
-
-		case attribute.TypeQuery:
-			if attr.Key == "" {
-				c.log.Error("middleware: query key field empty")
-				c.notAllowed(rw, nil)
-				return
-			}
-			queryAttr := req.URL.Query().Get(attr.Key)
-			if queryAttr == "" {
-				c.log.Error(fmt.Sprintf("middleware: query %s is empty", attr.Key))
-				c.notAllowed(rw, nil)
-				return
-			}
-
-			permissionAttributes[res] = queryAttr
-			c.log.Info("middleware: extracted", "field", queryAttr, "attr", attr)
-
-		case attribute.TypeConstant:
-			if attr.Value == "" {
-				c.log.Error("middleware: constant value empty")
-				c.notAllowed(rw, nil)
-				return
-			}
-
-			permissionAttributes[res] = attr.Value
-			c.log.Info("middleware: extracted", "constant_key", res, "attr", permissionAttributes[res])
-
-		default:
-			c.log.Error("middleware: unknown attribute type", "attr", attr)
-			c.notAllowed(rw, nil)
-			return
-		}
+	values, err := attribute.FromRequest(req).ResolveAll(config.Attributes)
+	if err != nil {
+		c.log.Error("middleware: failed to resolve attributes", "err", err)
+		c.reject(rw, req, wareSpec, middleware.AttributeReason(err), err)
+		return
+	}
+	for res, value := range values {
+		permissionAttributes[res] = value
+		c.log.Info("middleware: extracted", "field", value, "attr", config.Attributes[res])
 	}
 
 	paramMap, mapExists := middleware.ExtractPathParams(req)
 	if !mapExists {
 		c.log.Error("middleware: path param map doesn't exist")
-		c.notAllowed(rw, nil)
+		c.reject(rw, req, wareSpec, middleware.ReasonInternal, errors.New("path params are missing"))
 		return
 	}
 
@@ -227,7 +176,8 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		permissionAttributes[key] = value
 	}
 
-	isAuthorized := true
+	// a request without a user has to be allowed by a public permission
+	isAuthorized := !anonymous
 	for _, permission := range config.Permissions {
 		c.log.Info("checking permission", "permission", permission.Name)
 		if !permission.Expression.IsEmpty() {
@@ -248,16 +198,24 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		res, err := c.preparePermissionResource(req.Context(), permission, permissionAttributes)
 		if err != nil {
 			c.log.Error("error while preparing permission resource", "err", err)
-			c.notAllowed(rw, err)
+			reason, err := notAllowedReason(err, anonymous)
+			c.reject(rw, req, wareSpec, reason, err)
 			return
 		}
 
-		isAuthorized, err = c.resourceService.CheckAuthz(req.Context(), res, action.Action{
-			ID: permission.Name,
-		})
+		if anonymous {
+			isAuthorized, err = c.resourceService.CheckIsPublic(req.Context(), res, action.Action{
+				ID: permission.Name,
+			})
+		} else {
+			isAuthorized, err = c.resourceService.CheckAuthz(req.Context(), res, action.Action{
+				ID: permission.Name,
+			})
+		}
 		if err != nil {
 			c.log.Error("error while performing authz permission check", "err", err)
-			c.notAllowed(rw, err)
+			reason, err := notAllowedReason(err, anonymous)
+			c.reject(rw, req, wareSpec, reason, err)
 			return
 		}
 		c.log.Info("successfully checked permission", "permission", permission.Name, "result", isAuthorized)
@@ -269,10 +227,14 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	c.log.Info("authz check successful", "user", permissionAttributes["user"], "resource", permissionAttributes["resource"], "result", isAuthorized)
 	if !isAuthorized {
 		c.log.Info("user not allowed to make request", "user", permissionAttributes["user"], "resource", permissionAttributes["resource"], "result", isAuthorized)
-		c.notAllowed(rw, nil)
+		reason, err := deniedReason(anonymous)
+		c.reject(rw, req, wareSpec, reason, err)
 		return
 	}
 
+	if wareSpec.IsShadow() {
+		c.recordShadowDecision(req, shadowDecisionAllow, "")
+	}
 	c.next.ServeHTTP(rw, req)
 }
 
@@ -300,15 +262,64 @@ func (c Authz) preparePermissionResource(ctx context.Context, perm Permission, a
 	return res, nil
 }
 
-func (w Authz) notAllowed(rw http.ResponseWriter, err error) {
-	if err != nil {
-		switch {
-		case errors.Is(err, resource.ErrNotExist):
-			rw.WriteHeader(http.StatusNotFound)
-			return
+// skip passes on requests which aren't authorized by this middleware unless
+// they don't have a user
+func (c Authz) skip(rw http.ResponseWriter, req *http.Request, anonymous bool) {
+	if anonymous {
+		reason, err := deniedReason(anonymous)
+		c.errWriter.Write(rw, req, reason, err)
+		return
+	}
+	c.next.ServeHTTP(rw, req)
+}
+
+// reject responds to requests which aren't allowed, requests of rules in shadow
+// mode are passed on after recording that they would have been denied
+func (c Authz) reject(rw http.ResponseWriter, req *http.Request, spec rule.MiddlewareSpec, reason middleware.Reason, err error) {
+	if !spec.IsShadow() {
+		c.errWriter.Write(rw, req, reason, err)
+		return
+	}
+
+	c.recordShadowDecision(req, shadowDecisionDeny, reason)
+	c.log.Warn("middleware: request would have been denied by a rule in shadow mode", "reason", reason, "err", err)
+	c.next.ServeHTTP(rw, req)
+}
+
+func (c Authz) recordShadowDecision(req *http.Request, decision string, reason middleware.Reason) {
+	ruleName := ""
+	if r, ok := middleware.ExtractRule(req); ok {
+		ruleName = r.Name()
+	}
+	c.metricCounterShadowDecision.Add(req.Context(), 1,
+		metric.WithAttributes(
+			otelattribute.String("rule", ruleName),
+			otelattribute.String("decision", decision),
+			otelattribute.String("reason", string(reason)),
+		))
+}
+
+// notAllowedReason returns the reason of requests which couldn't be authorized,
+// requests without a user can't tell missing resources from private ones
+func notAllowedReason(err error, anonymous bool) (middleware.Reason, error) {
+	switch {
+	case errors.Is(err, resource.ErrNotExist), errors.Is(err, group.ErrNotExist):
+		if anonymous {
+			return deniedReason(anonymous)
 		}
+		return middleware.ReasonResourceNotFound, err
+	default:
+		return middleware.ReasonInternal, err
+	}
+}
+
+// deniedReason returns the reason of requests without the permission, requests
+// without a user are asked to authenticate
+func deniedReason(anonymous bool) (middleware.Reason, error) {
+	if anonymous {
+		return middleware.ReasonUnauthenticated, errors.New("user is not authenticated")
 	}
-	rw.WriteHeader(http.StatusUnauthorized)
+	return middleware.ReasonPermissionDenied, errors.New("user doesn't have any of the permissions of the rule")
 }
 
 func (cg Config) validate() (bool, error) {
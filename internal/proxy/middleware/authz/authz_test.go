@@ -0,0 +1,259 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/group"
+	"github.com/goto/shield/core/resource"
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/proxy/middleware"
+)
+
+const testUserIDHeaderKey = "X-Shield-User-Id"
+
+var firehoseRule = rule.Rule{
+	Backend: rule.Backend{Namespace: "entropy"},
+	Middlewares: rule.MiddlewareSpecs{
+		rule.MiddlewareSpec{
+			Name: "authz",
+			Config: map[string]interface{}{
+				"permissions": []interface{}{
+					map[string]interface{}{
+						"name":      "view",
+						"namespace": "entropy/firehose",
+						"attribute": "firehose",
+					},
+				},
+			},
+		},
+	},
+}
+
+var shadowFirehoseRule = rule.Rule{
+	Backend: firehoseRule.Backend,
+	Middlewares: rule.MiddlewareSpecs{
+		rule.MiddlewareSpec{
+			Name:   firehoseRule.Middlewares[0].Name,
+			Config: firehoseRule.Middlewares[0].Config,
+			Mode:   rule.MiddlewareModeShadow,
+		},
+	},
+}
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title          string
+		rule           *rule.Rule
+		user           user.User
+		userErr        error
+		serviceAccount *serviceaccount.ServiceAccount
+		authorized     bool
+		public         bool
+		checkErr       error
+		wantStatus     int
+		wantReason     middleware.Reason
+		wantNext       bool
+		wantUserID     string
+		wantResource   string
+	}{
+		{
+			title:        "should check permission of the user",
+			rule:         &firehoseRule,
+			user:         user.User{ID: "user1"},
+			authorized:   true,
+			wantStatus:   http.StatusOK,
+			wantNext:     true,
+			wantUserID:   "user1",
+			wantResource: "firehose1",
+		},
+		{
+			title:      "should not allow a user without the permission even if it is public",
+			rule:       &firehoseRule,
+			user:       user.User{ID: "user1"},
+			public:     true,
+			wantStatus: http.StatusForbidden,
+			wantReason: middleware.ReasonPermissionDenied,
+		},
+		{
+			title:        "should allow a request without user to a public resource",
+			rule:         &firehoseRule,
+			userErr:      user.ErrMissingEmail,
+			public:       true,
+			wantStatus:   http.StatusOK,
+			wantNext:     true,
+			wantResource: "firehose1",
+		},
+		{
+			title:      "should not allow a request without user to a private resource",
+			rule:       &firehoseRule,
+			userErr:    user.ErrMissingEmail,
+			authorized: true,
+			wantStatus: http.StatusUnauthorized,
+			wantReason: middleware.ReasonUnauthenticated,
+		},
+		{
+			title:      "should not tell a request without user that the resource doesn't exist",
+			rule:       &firehoseRule,
+			userErr:    user.ErrMissingEmail,
+			checkErr:   resource.ErrNotExist,
+			wantStatus: http.StatusUnauthorized,
+			wantReason: middleware.ReasonUnauthenticated,
+		},
+		{
+			title:      "should tell a user that the resource doesn't exist",
+			rule:       &firehoseRule,
+			user:       user.User{ID: "user1"},
+			checkErr:   resource.ErrNotExist,
+			wantStatus: http.StatusNotFound,
+			wantReason: middleware.ReasonResourceNotFound,
+		},
+		{
+			title:      "should not pass on a request without user if authz isn't configured",
+			rule:       &rule.Rule{Backend: rule.Backend{Namespace: "entropy"}},
+			userErr:    user.ErrMissingEmail,
+			wantStatus: http.StatusUnauthorized,
+			wantReason: middleware.ReasonUnauthenticated,
+		},
+		{
+			title:      "should pass on a request of a user without the permission in shadow mode",
+			rule:       &shadowFirehoseRule,
+			user:       user.User{ID: "user1"},
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+			wantUserID: "user1",
+		},
+		{
+			title:      "should pass on a request without user to a private resource in shadow mode",
+			rule:       &shadowFirehoseRule,
+			userErr:    user.ErrMissingEmail,
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+		},
+		{
+			title:      "should pass on a request of a misconfigured rule in shadow mode",
+			rule:       &rule.Rule{Middlewares: shadowFirehoseRule.Middlewares},
+			user:       user.User{ID: "user1"},
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+			wantUserID: "user1",
+		},
+		{
+			title:          "should check permission of an authenticated service account",
+			rule:           &firehoseRule,
+			userErr:        user.ErrMissingEmail,
+			serviceAccount: &serviceaccount.ServiceAccount{ID: "sa1"},
+			authorized:     true,
+			wantStatus:     http.StatusOK,
+			wantNext:       true,
+			wantUserID:     "sa1",
+			wantResource:   "firehose1",
+		},
+		{
+			title:      "should pass on a request with user if authz isn't configured",
+			rule:       &rule.Rule{Backend: rule.Backend{Namespace: "entropy"}},
+			user:       user.User{ID: "user1"},
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+			wantUserID: "user1",
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			resourceSrv := &mockResource{authorized: tt.authorized, public: tt.public, err: tt.checkErr}
+			next := &mockNextHandler{}
+			a := New(log.NewNoop(), next, middleware.NewErrorWriter(middleware.ErrorVerbosityMinimal), testUserIDHeaderKey, resourceSrv, mockUser{user: tt.user, err: tt.userErr}, mockGroup{})
+
+			req := httptest.NewRequest(http.MethodGet, "/firehoses/firehose1", nil)
+			// a user id sent by the client is never trusted
+			req.Header.Set(testUserIDHeaderKey, "spoofed")
+			if tt.serviceAccount != nil {
+				req = req.WithContext(serviceaccount.SetContextWithServiceAccount(req.Context(), *tt.serviceAccount))
+			}
+			middleware.EnrichRule(req, tt.rule)
+			middleware.EnrichPathParams(req, map[string]string{"firehose": "firehose1"})
+			rw := httptest.NewRecorder()
+
+			a.ServeHTTP(rw, req)
+
+			assert.Equal(t, tt.wantStatus, rw.Code)
+			if tt.wantReason != "" {
+				errResp := middleware.ErrorResponse{}
+				assert.NoError(t, json.NewDecoder(rw.Body).Decode(&errResp))
+				assert.Equal(t, tt.wantReason, errResp.Reason)
+			}
+			assert.Equal(t, tt.wantNext, next.called)
+			if tt.wantNext {
+				assert.Equal(t, tt.wantUserID, next.userID)
+			}
+			assert.Equal(t, tt.wantResource, resourceSrv.allowed)
+		})
+	}
+}
+
+type mockNextHandler struct {
+	called bool
+	userID string
+}
+
+func (m *mockNextHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	m.called = true
+	m.userID = req.Header.Get(testUserIDHeaderKey)
+}
+
+type mockResource struct {
+	authorized bool
+	public     bool
+	err        error
+	allowed    string
+}
+
+func (m *mockResource) CheckAuthz(ctx context.Context, res resource.Resource, act action.Action) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	if m.authorized {
+		m.allowed = res.Name
+	}
+	return m.authorized, nil
+}
+
+func (m *mockResource) CheckIsPublic(ctx context.Context, res resource.Resource, act action.Action) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	if m.public {
+		m.allowed = res.Name
+	}
+	return m.public, nil
+}
+
+type mockUser struct {
+	user user.User
+	err  error
+}
+
+func (m mockUser) FetchCurrentUser(ctx context.Context) (user.User, error) {
+	return m.user, m.err
+}
+
+type mockGroup struct{}
+
+func (mockGroup) GetBySlug(ctx context.Context, slug string) (group.Group, error) {
+	return group.Group{}, group.ErrNotExist
+}
@@ -2,6 +2,8 @@ package basic_auth
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"net/http"
 	"regexp"
 	"strings"
@@ -9,7 +11,6 @@ import (
 
 	"github.com/goto/shield/internal/proxy/attribute"
 	"github.com/goto/shield/internal/proxy/middleware"
-	"github.com/goto/shield/pkg/body_extractor"
 	"github.com/goto/shield/pkg/httputil"
 
 	goauth "github.com/abbot/go-http-auth"
@@ -27,15 +28,22 @@ const (
 // Middleware will look for Authorization header for credentials
 // value should be "Basic <base64encoded user:password>"
 type BasicAuth struct {
-	log  log.Logger
-	next http.Handler
+	log             log.Logger
+	next            http.Handler
+	errWriter       middleware.ErrorWriter
+	userDB          *UserDB
+	credentialCache *credentialCache
 }
 
 type Config struct {
 	Users []Credentials `yaml:"users" mapstructure:"users"`
 
-	// TODO take a file instead of embedded users in yaml
+	// UserDB is a blob url of an htpasswd file, e.g. "gs://bucket/path/htpasswd",
+	// with optional capabilities of its users in a sidecar, e.g. "htpasswd.capabilities.yaml".
+	// Users embedded in the yaml take precedence over users of the file.
 	UserDB string `yaml:"userdb" mapstructure:"userdb"`
+	// UserDBSecret is required to access UserDB, in the same format as the ruleset secret
+	UserDBSecret string `yaml:"userdb_secret" mapstructure:"userdb_secret"`
 
 	// Scope is optional and used for additional policy based
 	// authorization over user
@@ -58,10 +66,18 @@ type Scope struct {
 	Attributes map[string]attribute.Attribute `yaml:"attributes" mapstructure:"attributes"` // auth field -> Attribute
 }
 
-func New(logger log.Logger, next http.Handler) *BasicAuth {
+func New(logger log.Logger, next http.Handler, errWriter middleware.ErrorWriter, userDB *UserDB) *BasicAuth {
+	cache, err := newCredentialCache()
+	if err != nil {
+		logger.Warn("middleware: failed to create basic auth credential cache", "err", err)
+	}
+
 	return &BasicAuth{
-		log:  logger,
-		next: next,
+		log:             logger,
+		next:            next,
+		errWriter:       errWriter,
+		userDB:          userDB,
+		credentialCache: cache,
 	}
 }
 
@@ -83,30 +99,34 @@ func (w *BasicAuth) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	conf := Config{}
 	if err := mapstructure.Decode(wareSpec.Config, &conf); err != nil {
 		w.log.Error("middleware: invalid config", "config", wareSpec.Config)
-		w.notAllowed(rw)
+		w.errWriter.Write(rw, req, middleware.ReasonInvalidConfig, err)
 		return
 	}
-	authenticator := goauth.NewBasicAuthenticator("shield", func(user, realm string) string {
-		for _, credential := range conf.Users {
-			if credential.User == user {
-				return credential.Password
-			}
-		}
-		return ""
-	})
 
-	var authedUser string
-	if authedUser = authenticator.CheckAuth(req); authedUser != "" {
-		req.Header.Set(httputil.HeaderXUser, authedUser)
-	} else {
-		w.notAllowed(rw)
+	users, err := w.users(req.Context(), conf)
+	if err != nil {
+		w.log.Error("middleware: failed to load basic auth userdb", "userdb", conf.UserDB, "err", err)
+		w.errWriter.Write(rw, req, middleware.ReasonInvalidConfig, err)
 		return
 	}
 
+	authedUser, ok := w.authenticate(req, users)
+	if !ok {
+		rw.Header().Set("WWW-Authenticate", `Basic realm="shield"`)
+		w.errWriter.Write(rw, req, middleware.ReasonUnauthenticated, errors.New("invalid basic auth credentials"))
+		return
+	}
+	req.Header.Set(httputil.HeaderXUser, authedUser.User)
+
 	if conf.Scope.Action != "" {
 		// basic authorization
-		if !w.authorizeRequest(conf, authedUser, req) {
-			w.notAllowed(rw)
+		allowed, reason, err := w.authorizeRequest(conf.Scope, authedUser, req)
+		if err != nil {
+			w.errWriter.Write(rw, req, reason, err)
+			return
+		}
+		if !allowed {
+			w.errWriter.Write(rw, req, middleware.ReasonPermissionDenied, errors.New("user doesn't have the capability for the action"))
 			return
 		}
 	}
@@ -114,72 +134,78 @@ func (w *BasicAuth) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	w.next.ServeHTTP(rw, req)
 }
 
-func (w BasicAuth) notAllowed(rw http.ResponseWriter) {
-	rw.WriteHeader(http.StatusUnauthorized)
-	return
+// users returns the users of the userdb and the ones embedded in the config by
+// their names
+func (w BasicAuth) users(ctx context.Context, conf Config) (map[string]Credentials, error) {
+	users := map[string]Credentials{}
+	if conf.UserDB != "" {
+		if w.userDB == nil {
+			return nil, errors.New("userdb is not supported")
+		}
+		dbUsers, err := w.userDB.Get(ctx, conf.UserDB, conf.UserDBSecret)
+		if err != nil {
+			return nil, err
+		}
+		for name, u := range dbUsers {
+			users[name] = u
+		}
+	}
+	for _, u := range conf.Users {
+		users[u.User] = u
+	}
+	return users, nil
 }
 
-func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request) bool {
-	var userCapabilities []string
-	for _, u := range conf.Users {
-		if u.User == user {
-			userCapabilities = u.Capabilities
-		}
+// authenticate returns the user of the credentials of the request, recently
+// verified credentials aren't compared with the password hash again
+func (w BasicAuth) authenticate(req *http.Request, users map[string]Credentials) (Credentials, bool) {
+	name, password, ok := req.BasicAuth()
+	if !ok {
+		return Credentials{}, false
+	}
+	user, ok := users[name]
+	if !ok || user.Password == "" {
+		return Credentials{}, false
 	}
+	if w.credentialCache.verified(name, password, user.Password) {
+		return user, true
+	}
+
+	authenticator := goauth.NewBasicAuthenticator("shield", func(string, string) string {
+		return user.Password
+	})
+	if authenticator.CheckAuth(req) != name {
+		return Credentials{}, false
+	}
+	w.credentialCache.set(name, password, user.Password)
+	return user, true
+}
+
+// authorizeRequest checks the capabilities of the user for the action of the
+// scope, the reason is returned if the action couldn't be prepared
+func (w BasicAuth) authorizeRequest(scope Scope, user Credentials, req *http.Request) (bool, middleware.Reason, error) {
+	userCapabilities := user.Capabilities
 	if len(userCapabilities) == 0 {
-		return false
+		return false, "", nil
 	}
 	// check if its superuser
 	for _, cap := range userCapabilities {
 		if cap == "*" {
-			return true
+			return true, "", nil
 		}
 	}
 
-	templateMap := map[string]interface{}{}
-	for res, attr := range conf.Scope.Attributes {
-		templateMap[res] = ""
-		switch attr.Type {
-		case attribute.TypeGRPCPayload:
-			// check if grpc request
-			if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
-				w.log.Error("middleware: not a valid grpc request")
-				return false
-			}
-
-			// TODO: we can optimise this by parsing all field at once
-			payloadField, err := body_extractor.GRPCPayloadHandler{}.Extract(&req.Body, attr.Index)
-			if err != nil {
-				w.log.Error("middleware: failed to parse grpc payload", "err", err)
-				return false
-			}
-
-			templateMap[res] = payloadField
-			w.log.Debug("middleware: extracted", "field", payloadField, "attr", attr)
-		case attribute.TypeJSONPayload:
-			if attr.Key == "" {
-				w.log.Error("middleware: payload key field empty")
-				return false
-			}
-			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(&req.Body, attr.Key)
-			if err != nil {
-				w.log.Error("middleware: failed to parse json payload", "err", err)
-				return false
-			}
-
-			templateMap[res] = payloadField
-			w.log.Debug("middleware: extracted", "field", payloadField, "attr", attr)
-		default:
-			w.log.Error("middleware: unknown attribute type", "attr", attr)
-			return false
-		}
+	templateMap, err := attribute.FromRequest(req).ResolveAll(scope.Attributes)
+	if err != nil {
+		w.log.Error("middleware: failed to resolve attributes", "err", err)
+		return false, middleware.AttributeReason(err), err
 	}
 
 	isAllowed := false
-	compiledAction, err := CompileString(conf.Scope.Action, templateMap)
+	compiledAction, err := CompileString(scope.Action, templateMap)
 	if err != nil {
 		w.log.Error("middleware: action parsing failed", "err", err)
-		return false
+		return false, middleware.ReasonInvalidConfig, err
 	}
 	for _, userCap := range userCapabilities {
 		if w.matchAction(userCap, compiledAction) {
@@ -187,7 +213,7 @@ func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request)
 			break
 		}
 	}
-	return isAllowed
+	return isAllowed, "", nil
 }
 
 func (w BasicAuth) matchAction(cap, action string) bool {
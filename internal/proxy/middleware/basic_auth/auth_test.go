@@ -0,0 +1,129 @@
+package basic_auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/middleware"
+	"github.com/goto/shield/pkg/httputil"
+)
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	userDBURL := writeUserDB(t, t.TempDir(),
+		"alice:"+testPasswordHash+"\nbob:"+testPasswordHash+"\n",
+		"alice:\n  - firehose.create\n")
+
+	table := []struct {
+		title      string
+		config     map[string]interface{}
+		user       string
+		password   string
+		wantStatus int
+		wantUser   string
+	}{
+		{
+			title:      "should authenticate a user of the userdb",
+			config:     map[string]interface{}{"userdb": userDBURL},
+			user:       "alice",
+			password:   "secret",
+			wantStatus: http.StatusOK,
+			wantUser:   "alice",
+		},
+		{
+			title:      "should not authenticate a user with a wrong password",
+			config:     map[string]interface{}{"userdb": userDBURL},
+			user:       "alice",
+			password:   "wrong",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			title: "should prefer users embedded in the config",
+			config: map[string]interface{}{
+				"userdb": userDBURL,
+				"users":  []interface{}{map[string]interface{}{"user": "alice", "password": "{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ="}},
+			},
+			user:       "alice",
+			password:   "secret",
+			wantStatus: http.StatusOK,
+			wantUser:   "alice",
+		},
+		{
+			title: "should authorize a user with the capability of the sidecar",
+			config: map[string]interface{}{
+				"userdb": userDBURL,
+				"scope":  map[string]interface{}{"action": "firehose.create"},
+			},
+			user:       "alice",
+			password:   "secret",
+			wantStatus: http.StatusOK,
+			wantUser:   "alice",
+		},
+		{
+			title: "should not authorize a user without capabilities",
+			config: map[string]interface{}{
+				"userdb": userDBURL,
+				"scope":  map[string]interface{}{"action": "firehose.create"},
+			},
+			user:       "bob",
+			password:   "secret",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			title:      "should respond with an error if the userdb can't be loaded",
+			config:     map[string]interface{}{"userdb": "file:///non/existent/htpasswd"},
+			user:       "alice",
+			password:   "secret",
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			var gotUser string
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				gotUser = req.Header.Get(httputil.HeaderXUser)
+			})
+			w := New(log.NewNoop(), next, middleware.NewErrorWriter(middleware.ErrorVerbosityMinimal), NewUserDB(log.NewNoop()))
+
+			req := httptest.NewRequest(http.MethodPost, "/firehoses", nil)
+			req.SetBasicAuth(tt.user, tt.password)
+			middleware.EnrichRule(req, &rule.Rule{
+				Middlewares: rule.MiddlewareSpecs{{Name: "basic_auth", Config: tt.config}},
+			})
+			rw := httptest.NewRecorder()
+
+			w.ServeHTTP(rw, req)
+
+			assert.Equal(t, tt.wantStatus, rw.Code)
+			assert.Equal(t, tt.wantUser, gotUser)
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Equal(t, `Basic realm="shield"`, rw.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestCredentialCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := newCredentialCache()
+	assert.NoError(t, err)
+
+	cache.set("alice", "secret", testPasswordHash)
+	cache.cache.Wait()
+
+	assert.True(t, cache.verified("alice", "secret", testPasswordHash))
+	assert.False(t, cache.verified("alice", "wrong", testPasswordHash))
+	// credentials verified against a replaced hash aren't matched
+	assert.False(t, cache.verified("alice", "secret", "{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ="))
+}
@@ -0,0 +1,67 @@
+package basic_auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+)
+
+const (
+	credentialCacheSize = 10000
+	credentialCacheTTL  = 5 * time.Minute
+)
+
+// credentialCache keeps credentials which were verified recently, so hashes
+// which are expensive to compare like bcrypt aren't compared on every request.
+// Passwords are never kept, only a keyed hash of the credentials.
+type credentialCache struct {
+	cache *ristretto.Cache
+	key   []byte
+}
+
+func newCredentialCache() (*credentialCache, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: credentialCacheSize * 10,
+		MaxCost:     credentialCacheSize,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return &credentialCache{cache: cache, key: key}, nil
+}
+
+// verified returns whether the password was verified against the hash recently,
+// a changed hash isn't matched by the password verified against the old one
+func (c *credentialCache) verified(user, password, hash string) bool {
+	if c == nil {
+		return false
+	}
+	_, ok := c.cache.Get(c.cacheKey(user, password, hash))
+	return ok
+}
+
+func (c *credentialCache) set(user, password, hash string) {
+	if c == nil {
+		return
+	}
+	c.cache.SetWithTTL(c.cacheKey(user, password, hash), true, 1, credentialCacheTTL)
+}
+
+func (c *credentialCache) cacheKey(user, password, hash string) string {
+	mac := hmac.New(sha256.New, c.key)
+	for _, field := range []string{user, password, hash} {
+		mac.Write([]byte(field))
+		mac.Write([]byte{0})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
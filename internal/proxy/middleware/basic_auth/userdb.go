@@ -0,0 +1,178 @@
+package basic_auth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"gocloud.dev/gcerrors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/goto/shield/internal/store/blob"
+)
+
+// CapabilitiesSuffix is appended to the key of a userdb to find its capabilities
+// sidecar, a yaml map of users to their capabilities
+const CapabilitiesSuffix = ".capabilities.yaml"
+
+// UserDB loads htpasswd files and their capabilities sidecars from blob storage,
+// e.g. "gs://bucket/path/htpasswd". Files are cached once used by a rule and
+// reloaded periodically after InitCache.
+type UserDB struct {
+	log log.Logger
+	mu  *sync.Mutex
+
+	cron   *cron.Cron
+	cached map[userDBSource]map[string]Credentials
+}
+
+type userDBSource struct {
+	url    string
+	secret string
+}
+
+func NewUserDB(logger log.Logger) *UserDB {
+	return &UserDB{
+		log:    logger,
+		mu:     new(sync.Mutex),
+		cached: make(map[userDBSource]map[string]Credentials),
+	}
+}
+
+// Get returns the users of the userdb by their names
+func (db *UserDB) Get(ctx context.Context, userDBURL, secret string) (map[string]Credentials, error) {
+	source := userDBSource{url: userDBURL, secret: secret}
+
+	db.mu.Lock()
+	users, ok := db.cached[source]
+	db.mu.Unlock()
+	if ok && db.cron != nil {
+		// cache must have been refreshed automatically, just return
+		return users, nil
+	}
+
+	users, err := loadUserDB(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	db.mu.Lock()
+	db.cached[source] = users
+	db.mu.Unlock()
+	return users, nil
+}
+
+func (db *UserDB) refresh(ctx context.Context) {
+	db.mu.Lock()
+	sources := make([]userDBSource, 0, len(db.cached))
+	for source := range db.cached {
+		sources = append(sources, source)
+	}
+	db.mu.Unlock()
+
+	for _, source := range sources {
+		users, err := loadUserDB(ctx, source)
+		if err != nil {
+			// users of the last successful load are kept
+			db.log.Warn("failed to refresh basic auth userdb", "userdb", source.url, "err", err)
+			continue
+		}
+
+		db.mu.Lock()
+		db.cached[source] = users
+		db.mu.Unlock()
+	}
+	db.log.Debug("basic auth userdb cache refreshed", "userdb_count", len(sources))
+}
+
+func (db *UserDB) InitCache(ctx context.Context, refreshDelay time.Duration) error {
+	db.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+	))
+	if _, err := db.cron.AddFunc("@every "+refreshDelay.String(), func() {
+		db.refresh(ctx)
+	}); err != nil {
+		return err
+	}
+	db.cron.Start()
+	return nil
+}
+
+func (db *UserDB) Close() error {
+	if db.cron != nil {
+		<-db.cron.Stop().Done()
+	}
+	return nil
+}
+
+func loadUserDB(ctx context.Context, source userDBSource) (map[string]Credentials, error) {
+	parsedURL, err := url.Parse(source.url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid userdb url "+source.url)
+	}
+	key := path.Base(parsedURL.Path)
+	parsedURL.Path = path.Dir(parsedURL.Path)
+
+	bucket, err := blob.NewStore(ctx, parsedURL.String(), source.secret)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	htpasswd, err := bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "bucket.ReadAll: "+key)
+	}
+	users, err := parseHtpasswd(htpasswd)
+	if err != nil {
+		return nil, errors.Wrap(err, key)
+	}
+
+	// the capabilities sidecar is optional
+	capabilities := map[string][]string{}
+	sidecar, err := bucket.ReadAll(ctx, key+CapabilitiesSuffix)
+	switch {
+	case gcerrors.Code(err) == gcerrors.NotFound:
+	case err != nil:
+		return nil, errors.Wrap(err, "bucket.ReadAll: "+key+CapabilitiesSuffix)
+	default:
+		if err := yaml.Unmarshal(sidecar, &capabilities); err != nil {
+			return nil, errors.Wrap(err, "yaml.Unmarshal: "+key+CapabilitiesSuffix)
+		}
+	}
+
+	for name, caps := range capabilities {
+		if u, ok := users[name]; ok {
+			u.Capabilities = caps
+			users[name] = u
+		}
+	}
+	return users, nil
+}
+
+// parseHtpasswd parses "user:hash" lines, blank lines and comments are skipped
+func parseHtpasswd(content []byte) (map[string]Credentials, error) {
+	users := map[string]Credentials{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		user, password, ok := strings.Cut(line, ":")
+		if !ok || user == "" || password == "" {
+			return nil, fmt.Errorf("invalid htpasswd entry at line %d", lineNo)
+		}
+		users[user] = Credentials{User: user, Password: password}
+	}
+	return users, scanner.Err()
+}
@@ -0,0 +1,112 @@
+package basic_auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// bcrypt hash of "secret"
+const testPasswordHash = "$2a$04$9DK4/i.G8jvVrdJ3dIXfUuXA4Uxd.mWBmiQzR.Qkgj4Gcpje4prrq"
+
+func writeUserDB(t *testing.T, dir, htpasswd, capabilities string) string {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "htpasswd"), []byte(htpasswd), 0o600))
+	if capabilities != "" {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "htpasswd"+CapabilitiesSuffix), []byte(capabilities), 0o600))
+	}
+	return "file://" + filepath.Join(dir, "htpasswd")
+}
+
+func TestUserDBGet(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title        string
+		htpasswd     string
+		capabilities string
+		want         map[string]Credentials
+		wantErr      bool
+	}{
+		{
+			title:        "should load users with their capabilities",
+			htpasswd:     "# users of the firehose api\nalice:" + testPasswordHash + "\n\nbob:{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=\n",
+			capabilities: "alice:\n  - firehose.create\n  - r#firehose\\..*\ncarol:\n  - \"*\"\n",
+			want: map[string]Credentials{
+				"alice": {User: "alice", Password: testPasswordHash, Capabilities: []string{"firehose.create", `r#firehose\..*`}},
+				"bob":   {User: "bob", Password: "{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ="},
+			},
+		},
+		{
+			title:    "should load users without a capabilities sidecar",
+			htpasswd: "alice:" + testPasswordHash,
+			want: map[string]Credentials{
+				"alice": {User: "alice", Password: testPasswordHash},
+			},
+		},
+		{
+			title:    "should return an error for an invalid entry",
+			htpasswd: "alice",
+			wantErr:  true,
+		},
+		{
+			title:        "should return an error for an invalid sidecar",
+			htpasswd:     "alice:" + testPasswordHash,
+			capabilities: "alice: [",
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			userDBURL := writeUserDB(t, t.TempDir(), tt.htpasswd, tt.capabilities)
+
+			got, err := NewUserDB(log.NewNoop()).Get(context.Background(), userDBURL, "")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUserDBRefresh(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	userDBURL := writeUserDB(t, dir, "alice:"+testPasswordHash, "")
+	db := NewUserDB(log.NewNoop())
+	assert.NoError(t, db.InitCache(context.Background(), time.Hour))
+	defer db.Close()
+
+	_, err := db.Get(context.Background(), userDBURL, "")
+	assert.NoError(t, err)
+
+	// cached users are returned until the userdb is refreshed
+	writeUserDB(t, dir, "bob:"+testPasswordHash, "")
+	got, err := db.Get(context.Background(), userDBURL, "")
+	assert.NoError(t, err)
+	assert.Contains(t, got, "alice")
+
+	db.refresh(context.Background())
+	got, err = db.Get(context.Background(), userDBURL, "")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Credentials{"bob": {User: "bob", Password: testPasswordHash}}, got)
+
+	// users of the last successful load are kept if the userdb becomes invalid
+	writeUserDB(t, dir, "bob", "")
+	db.refresh(context.Background())
+	got, err = db.Get(context.Background(), userDBURL, "")
+	assert.NoError(t, err)
+	assert.Contains(t, got, "bob")
+}
@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/goto/shield/internal/proxy/attribute"
+	"github.com/goto/shield/pkg/httputil"
+)
+
+const errorDomain = "shield"
+
+// ErrorVerbosity controls how much of the cause of a denied request is sent to the client
+type ErrorVerbosity string
+
+const (
+	// ErrorVerbosityMinimal responds with a generic message for the reason
+	ErrorVerbosityMinimal ErrorVerbosity = "minimal"
+	// ErrorVerbosityDetailed responds with the cause of the error as the message,
+	// it may expose rule configuration and shouldn't be used for public proxies
+	ErrorVerbosityDetailed ErrorVerbosity = "detailed"
+)
+
+// Reason of a request being denied by a middleware
+type Reason string
+
+const (
+	ReasonUnauthenticated  Reason = "UNAUTHENTICATED"
+	ReasonPermissionDenied Reason = "PERMISSION_DENIED"
+	ReasonResourceNotFound Reason = "RESOURCE_NOT_FOUND"
+	ReasonAttributeMissing Reason = "ATTRIBUTE_MISSING"
+	ReasonInvalidConfig    Reason = "INVALID_CONFIG"
+	ReasonInternal         Reason = "INTERNAL"
+)
+
+type reasonStatus struct {
+	httpStatus int
+	grpcCode   codes.Code
+	message    string
+}
+
+var reasonStatuses = map[Reason]reasonStatus{
+	ReasonUnauthenticated:  {http.StatusUnauthorized, codes.Unauthenticated, "request is not authenticated"},
+	ReasonPermissionDenied: {http.StatusForbidden, codes.PermissionDenied, "permission denied"},
+	ReasonResourceNotFound: {http.StatusNotFound, codes.NotFound, "resource not found"},
+	ReasonAttributeMissing: {http.StatusBadRequest, codes.InvalidArgument, "request is missing attributes required by the rule"},
+	ReasonInvalidConfig:    {http.StatusInternalServerError, codes.Internal, "rule is misconfigured"},
+	ReasonInternal:         {http.StatusInternalServerError, codes.Internal, "internal error"},
+}
+
+// AttributeReason returns the reason of a request being denied as its
+// attributes couldn't be resolved
+func AttributeReason(err error) Reason {
+	if errors.Is(err, attribute.ErrInvalidAttribute) {
+		return ReasonInvalidConfig
+	}
+	return ReasonAttributeMissing
+}
+
+// ErrorResponse is the body of the error responses of HTTP requests
+type ErrorResponse struct {
+	Code      int    `json:"code"`
+	Reason    Reason `json:"reason"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
+	Rule      string `json:"rule,omitempty"`
+}
+
+// ErrorWriter responds to requests denied by middlewares, gRPC requests are
+// responded with a status carrying the reason as error info details
+type ErrorWriter struct {
+	verbosity ErrorVerbosity
+}
+
+func NewErrorWriter(verbosity ErrorVerbosity) ErrorWriter {
+	return ErrorWriter{verbosity: verbosity}
+}
+
+func (w ErrorWriter) Write(rw http.ResponseWriter, req *http.Request, reason Reason, err error) {
+	st, ok := reasonStatuses[reason]
+	if !ok {
+		reason, st = ReasonInternal, reasonStatuses[ReasonInternal]
+	}
+
+	message := st.message
+	if w.verbosity == ErrorVerbosityDetailed && err != nil {
+		message = err.Error()
+	}
+
+	resp := ErrorResponse{
+		Code:      st.httpStatus,
+		Reason:    reason,
+		Message:   message,
+		RequestID: req.Header.Get(httputil.HeaderXRequestID),
+	}
+	if rule, ok := ExtractRule(req); ok {
+		resp.Rule = rule.Name()
+	}
+
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+		writeGRPCError(rw, st.grpcCode, resp)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(st.httpStatus)
+	_ = json.NewEncoder(rw).Encode(resp)
+}
+
+// writeGRPCError responds with a trailers-only response, gRPC clients read the
+// status from the headers when the response has no message
+func writeGRPCError(rw http.ResponseWriter, code codes.Code, resp ErrorResponse) {
+	st, err := status.New(code, resp.Message).WithDetails(&errdetails.ErrorInfo{
+		Reason: string(resp.Reason),
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"request_id": resp.RequestID,
+			"rule":       resp.Rule,
+		},
+	})
+	if err != nil {
+		st = status.New(code, resp.Message)
+	}
+
+	rw.Header().Set("Content-Type", "application/grpc")
+	rw.Header().Set("Grpc-Status", fmt.Sprintf("%d", code))
+	rw.Header().Set("Grpc-Message", encodeGRPCMessage(resp.Message))
+	if details, err := proto.Marshal(st.Proto()); err == nil {
+		rw.Header().Set("Grpc-Status-Details-Bin", base64.RawStdEncoding.EncodeToString(details))
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+// encodeGRPCMessage percent encodes the message as required for the grpc-message header
+func encodeGRPCMessage(msg string) string {
+	var sb strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			sb.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&sb, "%%%02X", c)
+	}
+	return sb.String()
+}
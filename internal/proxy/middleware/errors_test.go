@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/attribute"
+)
+
+func TestErrorWriter(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title      string
+		verbosity  ErrorVerbosity
+		reason     Reason
+		err        error
+		wantStatus int
+		wantBody   ErrorResponse
+	}{
+		{
+			title:      "should respond with a generic message",
+			verbosity:  ErrorVerbosityMinimal,
+			reason:     ReasonPermissionDenied,
+			err:        errors.New("user doesn't have the permission"),
+			wantStatus: http.StatusForbidden,
+			wantBody: ErrorResponse{
+				Code:      http.StatusForbidden,
+				Reason:    ReasonPermissionDenied,
+				Message:   "permission denied",
+				RequestID: "req1",
+				Rule:      "create-firehose",
+			},
+		},
+		{
+			title:      "should respond with the cause if detailed",
+			verbosity:  ErrorVerbosityDetailed,
+			reason:     ReasonUnauthenticated,
+			err:        errors.New("user is not authenticated"),
+			wantStatus: http.StatusUnauthorized,
+			wantBody: ErrorResponse{
+				Code:      http.StatusUnauthorized,
+				Reason:    ReasonUnauthenticated,
+				Message:   "user is not authenticated",
+				RequestID: "req1",
+				Rule:      "create-firehose",
+			},
+		},
+		{
+			title:      "should respond with an internal error for an unknown reason",
+			reason:     "UNKNOWN",
+			wantStatus: http.StatusInternalServerError,
+			wantBody: ErrorResponse{
+				Code:      http.StatusInternalServerError,
+				Reason:    ReasonInternal,
+				Message:   "internal error",
+				RequestID: "req1",
+				Rule:      "create-firehose",
+			},
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/firehoses", nil)
+			req.Header.Set("X-Request-Id", "req1")
+			EnrichRule(req, &rule.Rule{Frontend: rule.Frontend{Name: "create-firehose", URL: "/firehoses"}})
+			rw := httptest.NewRecorder()
+
+			NewErrorWriter(tt.verbosity).Write(rw, req, tt.reason, tt.err)
+
+			assert.Equal(t, tt.wantStatus, rw.Code)
+			assert.Equal(t, "application/json", rw.Header().Get("Content-Type"))
+			got := ErrorResponse{}
+			assert.NoError(t, json.NewDecoder(rw.Body).Decode(&got))
+			assert.Equal(t, tt.wantBody, got)
+		})
+	}
+}
+
+func TestErrorWriterGRPC(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/shield.v1.Firehose/Create", nil)
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("X-Request-Id", "req1")
+	EnrichRule(req, &rule.Rule{Frontend: rule.Frontend{URL: "/shield.v1.Firehose/Create"}})
+	rw := httptest.NewRecorder()
+
+	NewErrorWriter(ErrorVerbosityDetailed).Write(rw, req, ReasonAttributeMissing, errors.New("project: 100% missing"))
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "application/grpc", rw.Header().Get("Content-Type"))
+	assert.Equal(t, "3", rw.Header().Get("Grpc-Status"))
+	assert.Equal(t, "project: 100%25 missing", rw.Header().Get("Grpc-Message"))
+
+	details, err := base64.RawStdEncoding.DecodeString(rw.Header().Get("Grpc-Status-Details-Bin"))
+	assert.NoError(t, err)
+	st := &spb.Status{}
+	assert.NoError(t, proto.Unmarshal(details, st))
+	assert.Equal(t, int32(codes.InvalidArgument), st.Code)
+	assert.Len(t, st.Details, 1)
+	info := &errdetails.ErrorInfo{}
+	assert.NoError(t, st.Details[0].UnmarshalTo(info))
+	assert.Equal(t, string(ReasonAttributeMissing), info.Reason)
+	assert.Equal(t, map[string]string{"request_id": "req1", "rule": "/shield.v1.Firehose/Create"}, info.Metadata)
+}
+
+func TestAttributeReason(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ReasonInvalidConfig, AttributeReason(attribute.ErrInvalidAttribute))
+	assert.Equal(t, ReasonAttributeMissing, AttributeReason(attribute.ErrAttributeNotFound))
+	assert.Equal(t, ReasonAttributeMissing, AttributeReason(attribute.ErrNotGRPCPayload))
+}
@@ -0,0 +1,287 @@
+package headers
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/goto/salt/log"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/goto/shield/core/group"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/proxy/attribute"
+	"github.com/goto/shield/internal/proxy/middleware"
+	"github.com/goto/shield/internal/proxy/middleware/attributes"
+)
+
+const userAttributePrefix = "user."
+
+type UserService interface {
+	FetchCurrentUser(ctx context.Context) (user.User, error)
+}
+
+type GroupService interface {
+	ListUserGroups(ctx context.Context, userId string, roleId string) ([]group.Group, error)
+}
+
+type Headers struct {
+	log          log.Logger
+	next         http.Handler
+	errWriter    middleware.ErrorWriter
+	userService  UserService
+	groupService GroupService
+}
+
+type Config struct {
+	Request  Transform `yaml:"request" mapstructure:"request"`
+	Response Transform `yaml:"response" mapstructure:"response"`
+}
+
+// Transform is applied in the order remove, rename and add
+type Transform struct {
+	Remove []string          `yaml:"remove" mapstructure:"remove"`
+	Rename map[string]string `yaml:"rename" mapstructure:"rename"`
+	Add    map[string]string `yaml:"add" mapstructure:"add"`
+}
+
+func New(log log.Logger, next http.Handler, errWriter middleware.ErrorWriter, userService UserService, groupService GroupService) *Headers {
+	return &Headers{
+		log:          log,
+		next:         next,
+		errWriter:    errWriter,
+		userService:  userService,
+		groupService: groupService,
+	}
+}
+
+func (h Headers) Info() *middleware.MiddlewareInfo {
+	return &middleware.MiddlewareInfo{
+		Name:        "headers",
+		Description: "transforms request and response headers",
+	}
+}
+
+func (h *Headers) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	wareSpec, ok := middleware.ExtractMiddleware(req, h.Info().Name)
+	if !ok {
+		h.next.ServeHTTP(rw, req)
+		return
+	}
+
+	config := Config{}
+	if err := mapstructure.Decode(wareSpec.Config, &config); err != nil {
+		h.log.Error("middleware: failed to decode headers config", "config", wareSpec.Config)
+		h.errWriter.Write(rw, req, middleware.ReasonInvalidConfig, err)
+		return
+	}
+
+	values, err := h.templateValues(req, config)
+	if err != nil {
+		h.log.Error("middleware: failed to prepare header values", "err", err)
+		if errors.Is(err, user.ErrMissingEmail) || errors.Is(err, user.ErrInvalidEmail) {
+			h.errWriter.Write(rw, req, middleware.ReasonUnauthenticated, err)
+			return
+		}
+		h.errWriter.Write(rw, req, middleware.ReasonInternal, err)
+		return
+	}
+
+	config.Request.apply(req.Header, values)
+
+	h.next.ServeHTTP(&responseWriter{
+		ResponseWriter: rw,
+		transform: func(header http.Header) {
+			config.Response.apply(header, values)
+		},
+	}, req)
+}
+
+// templateValues builds the values available to header templates, user
+// profile and groups are only fetched when a template refers to them. For
+// service accounts user.id, user.name and user.metadata are taken from the
+// service account, while user.email and user.groups are left empty
+func (h Headers) templateValues(req *http.Request, config Config) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	if rl, ok := middleware.ExtractRule(req); ok {
+		values["namespace"] = rl.Backend.Namespace
+		values["prefix"] = rl.Backend.Prefix
+	}
+
+	if attrs, ok := attributes.GetAttributesFromContext(req.Context()); ok {
+		for key, value := range attrs {
+			values[key] = stringify(value)
+		}
+	}
+
+	paramMap, _ := middleware.ExtractPathParams(req)
+	for key, value := range paramMap {
+		values[key] = value
+	}
+
+	if !config.Request.refersTo(userAttributePrefix) && !config.Response.refersTo(userAttributePrefix) {
+		return values, nil
+	}
+
+	if serviceAccount, ok := serviceaccount.GetFromContext(req.Context()); ok {
+		values["user.id"] = serviceAccount.ID
+		values["user.name"] = serviceAccount.Name
+		for key, value := range serviceAccount.Metadata {
+			values[userAttributePrefix+"metadata."+key] = stringify(value)
+		}
+		return values, nil
+	}
+
+	currentUser, err := h.userService.FetchCurrentUser(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	values["user.id"] = currentUser.ID
+	values["user.name"] = currentUser.Name
+	values["user.email"] = currentUser.Email
+	for key, value := range currentUser.Metadata {
+		values[userAttributePrefix+"metadata."+key] = stringify(value)
+	}
+
+	if config.Request.refersTo("user.groups") || config.Response.refersTo("user.groups") {
+		groups, err := h.groupService.ListUserGroups(req.Context(), currentUser.ID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		slugs := map[string]bool{}
+		for _, grp := range groups {
+			slugs[grp.Slug] = true
+		}
+
+		var groupSlugs []string
+		for slug := range slugs {
+			groupSlugs = append(groupSlugs, slug)
+		}
+		sort.Strings(groupSlugs)
+		values["user.groups"] = strings.Join(groupSlugs, ",")
+	}
+
+	return values, nil
+}
+
+func (t Transform) apply(header http.Header, values map[string]interface{}) {
+	for _, key := range t.Remove {
+		header.Del(key)
+	}
+
+	for from, to := range t.Rename {
+		vals := header.Values(from)
+		if len(vals) == 0 {
+			continue
+		}
+		header.Del(from)
+		for _, val := range vals {
+			header.Add(to, val)
+		}
+	}
+
+	for key, value := range t.Add {
+		header.Set(key, attribute.Compose(value, withDefaults(value, values)))
+	}
+}
+
+func (t Transform) refersTo(prefix string) bool {
+	for _, value := range t.Add {
+		if strings.Contains(value, "${"+prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// withDefaults adds an empty value for every tag in the template
+// which is not available, so that they are rendered as empty
+func withDefaults(template string, values map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		result[key] = value
+	}
+
+	for _, part := range strings.Split(template, "${")[1:] {
+		end := strings.Index(part, "}")
+		if end < 0 {
+			continue
+		}
+		if _, ok := result[part[:end]]; !ok {
+			result[part[:end]] = ""
+		}
+	}
+	return result
+}
+
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ",")
+	case []interface{}:
+		var items []string
+		for _, item := range v {
+			items = append(items, fmt.Sprint(item))
+		}
+		return strings.Join(items, ",")
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// responseWriter transforms the response headers before they are written
+type responseWriter struct {
+	http.ResponseWriter
+	transform   func(header http.Header)
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.transform(w.Header())
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer, for http.ResponseController
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %T does not support hijacking", http.ErrNotSupported, w.ResponseWriter)
+	}
+	return hijacker.Hijack()
+}
@@ -0,0 +1,220 @@
+package headers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/group"
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/proxy/middleware"
+	"github.com/goto/shield/internal/proxy/middleware/attributes"
+	"github.com/goto/shield/internal/proxy/middleware/headers"
+	"github.com/goto/shield/pkg/metadata"
+)
+
+type userService struct {
+	usr user.User
+	err error
+}
+
+func (s userService) FetchCurrentUser(ctx context.Context) (user.User, error) {
+	return s.usr, s.err
+}
+
+type groupService struct {
+	groups []group.Group
+	err    error
+}
+
+func (s groupService) ListUserGroups(ctx context.Context, userId string, roleId string) ([]group.Group, error) {
+	return s.groups, s.err
+}
+
+var testUser = user.User{
+	ID:       "2e73f4a2-3763-4dc6-a00f-e8d1e1c7a8e0",
+	Name:     "John Doe",
+	Email:    "john.doe@gotocompany.com",
+	Metadata: map[string]any{"team": "platform"},
+}
+
+func newRequest(config map[string]interface{}) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "http://localhost/api/firehoses", nil)
+	req.Header.Set("X-Internal", "secret")
+	req.Header.Set("X-Old", "value")
+	middleware.EnrichRule(req, &rule.Rule{
+		Backend: rule.Backend{Namespace: "entropy"},
+		Middlewares: rule.MiddlewareSpecs{
+			{Name: "headers", Config: config},
+		},
+	})
+	*req = *req.WithContext(attributes.SetContextWithAttributes(req.Context(), map[string]any{
+		"project":      "e16f46cf-6e1e-4802-967a-ea4008ee0ca3",
+		"organization": "39e63abd-0fb0-4f5a-ac24-92bf83e1f920",
+	}))
+	return req
+}
+
+func TestHeaders_ServeHTTP(t *testing.T) {
+	logger := log.NewZap()
+	errWriter := middleware.NewErrorWriter(middleware.ErrorVerbosityMinimal)
+
+	t.Run("should transform request and response headers", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("X-Internal"))
+			assert.Empty(t, r.Header.Get("X-Old"))
+			assert.Equal(t, "value", r.Header.Get("X-New"))
+			assert.Equal(t, testUser.ID, r.Header.Get("X-Shield-User-Id"))
+			assert.Equal(t, testUser.Name, r.Header.Get("X-Shield-User-Name"))
+			assert.Equal(t, "platform", r.Header.Get("X-Shield-User-Team"))
+			assert.Equal(t, "admins,viewers", r.Header.Get("X-Shield-Groups"))
+			assert.Equal(t, "39e63abd-0fb0-4f5a-ac24-92bf83e1f920/e16f46cf-6e1e-4802-967a-ea4008ee0ca3", r.Header.Get("X-Shield-Scope"))
+
+			w.Header().Set("Server", "nginx")
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		h := headers.New(logger, next, errWriter, userService{usr: testUser}, groupService{groups: []group.Group{
+			{Slug: "viewers"}, {Slug: "admins"}, {Slug: "viewers"},
+		}})
+
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, newRequest(map[string]interface{}{
+			"request": map[string]interface{}{
+				"remove": []string{"X-Internal"},
+				"rename": map[string]string{"X-Old": "X-New"},
+				"add": map[string]string{
+					"X-Shield-User-Id":   "${user.id}",
+					"X-Shield-User-Name": "${user.name}",
+					"X-Shield-User-Team": "${user.metadata.team}",
+					"X-Shield-Groups":    "${user.groups}",
+					"X-Shield-Scope":     "${organization}/${project}",
+				},
+			},
+			"response": map[string]interface{}{
+				"remove": []string{"Server"},
+				"add":    map[string]string{"X-Shield-Namespace": "${namespace}"},
+			},
+		}))
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		assert.Empty(t, rw.Header().Get("Server"))
+		assert.Equal(t, "entropy", rw.Header().Get("X-Shield-Namespace"))
+	})
+
+	t.Run("should not fetch user if templates do not refer to it", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "entropy", r.Header.Get("X-Namespace"))
+			assert.Equal(t, "-", r.Header.Get("X-Missing"))
+		})
+
+		h := headers.New(logger, next, errWriter, userService{err: errors.New("should not be called")}, groupService{})
+
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, newRequest(map[string]interface{}{
+			"request": map[string]interface{}{
+				"add": map[string]string{
+					"X-Namespace": "${namespace}",
+					"X-Missing":   "-${unknown}",
+				},
+			},
+		}))
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	t.Run("should return unauthorized if user cannot be fetched", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("next should not be called")
+		})
+
+		h := headers.New(logger, next, errWriter, userService{err: user.ErrMissingEmail}, groupService{})
+
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, newRequest(map[string]interface{}{
+			"request": map[string]interface{}{
+				"add": map[string]string{"X-Shield-User-Id": "${user.id}"},
+			},
+		}))
+
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	})
+	t.Run("should return internal error if user groups cannot be fetched", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("next should not be called")
+		})
+
+		h := headers.New(logger, next, errWriter, userService{usr: testUser}, groupService{err: errors.New("db down")})
+
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, newRequest(map[string]interface{}{
+			"request": map[string]interface{}{
+				"add": map[string]string{"X-Shield-Groups": "${user.groups}"},
+			},
+		}))
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+	})
+
+	t.Run("should use service account as user if request is made by a service account", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "7f1b4bd2-7a1c-4f6c-9a0c-3b1f0e4b1f8e", r.Header.Get("X-Shield-User-Id"))
+			assert.Equal(t, "deployer", r.Header.Get("X-Shield-User-Name"))
+			assert.Equal(t, "platform", r.Header.Get("X-Shield-User-Team"))
+			assert.Empty(t, r.Header.Get("X-Shield-User-Email"))
+			assert.Empty(t, r.Header.Get("X-Shield-Groups"))
+		})
+
+		h := headers.New(logger, next, errWriter, userService{err: user.ErrMissingEmail}, groupService{err: errors.New("should not be called")})
+
+		req := newRequest(map[string]interface{}{
+			"request": map[string]interface{}{
+				"add": map[string]string{
+					"X-Shield-User-Id":    "${user.id}",
+					"X-Shield-User-Name":  "${user.name}",
+					"X-Shield-User-Team":  "${user.metadata.team}",
+					"X-Shield-User-Email": "${user.email}",
+					"X-Shield-Groups":     "${user.groups}",
+				},
+			},
+		})
+		req = req.WithContext(serviceaccount.SetContextWithServiceAccount(req.Context(), serviceaccount.ServiceAccount{
+			ID:       "7f1b4bd2-7a1c-4f6c-9a0c-3b1f0e4b1f8e",
+			Name:     "deployer",
+			Metadata: metadata.Metadata{"team": "platform"},
+		}))
+
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	t.Run("should pass flush and hijack through to the underlying writer", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rc := http.NewResponseController(w)
+			assert.NoError(t, rc.Flush())
+			_, _, err := rc.Hijack()
+			assert.ErrorIs(t, err, http.ErrNotSupported)
+		})
+
+		h := headers.New(logger, next, errWriter, userService{}, groupService{})
+
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, newRequest(map[string]interface{}{
+			"response": map[string]interface{}{
+				"add": map[string]string{"X-Shield-Namespace": "${namespace}"},
+			},
+		}))
+
+		assert.True(t, rw.Flushed)
+		assert.Equal(t, "entropy", rw.Header().Get("X-Shield-Namespace"))
+	})
+}
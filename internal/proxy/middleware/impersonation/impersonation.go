@@ -0,0 +1,83 @@
+package impersonation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/platform"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/proxy/middleware"
+	errpkg "github.com/goto/shield/pkg/errors"
+)
+
+type Impersonator interface {
+	Impersonate(ctx context.Context, email string) (user.User, user.User, error)
+}
+
+// Impersonation runs requests with the act as header as the user of its
+// email, if the user of the identity proxy header is allowed to impersonate
+// them. It applies to every rule, the following middlewares and the backend
+// only see the impersonated user.
+type Impersonation struct {
+	log                    log.Logger
+	next                   http.Handler
+	errWriter              middleware.ErrorWriter
+	identityProxyHeaderKey string
+	impersonator           Impersonator
+}
+
+func New(logger log.Logger, next http.Handler, errWriter middleware.ErrorWriter, identityProxyHeaderKey string, impersonator Impersonator) *Impersonation {
+	return &Impersonation{
+		log:                    logger,
+		next:                   next,
+		errWriter:              errWriter,
+		identityProxyHeaderKey: identityProxyHeaderKey,
+		impersonator:           impersonator,
+	}
+}
+
+func (w Impersonation) Info() *middleware.MiddlewareInfo {
+	return &middleware.MiddlewareInfo{
+		Name:        "impersonation",
+		Description: "run requests as another user",
+	}
+}
+
+func (w *Impersonation) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	email := strings.TrimSpace(req.Header.Get(platform.ActAsHeader))
+	if email == "" {
+		w.next.ServeHTTP(rw, req)
+		return
+	}
+
+	ctx := user.SetContextWithEmail(req.Context(), req.Header.Get(w.identityProxyHeaderKey))
+	impersonatorUser, impersonatedUser, err := w.impersonator.Impersonate(ctx, email)
+	if err != nil {
+		switch {
+		case errors.Is(err, errpkg.ErrForbidden),
+			errors.Is(err, platform.ErrImpersonateAdmin):
+			w.errWriter.Write(rw, req, middleware.ReasonPermissionDenied, err)
+		case errors.Is(err, user.ErrNotExist):
+			w.errWriter.Write(rw, req, middleware.ReasonResourceNotFound, err)
+		case errors.Is(err, user.ErrInvalidEmail),
+			errors.Is(err, user.ErrMissingEmail):
+			w.errWriter.Write(rw, req, middleware.ReasonUnauthenticated, err)
+		default:
+			w.log.Error("middleware: failed to impersonate user", "err", err)
+			w.errWriter.Write(rw, req, middleware.ReasonInternal, err)
+		}
+		return
+	}
+
+	ctx = activity.SetContextWithImpersonator(req.Context(), activity.Actor{ID: impersonatorUser.ID, Email: impersonatorUser.Email})
+	req = req.WithContext(ctx)
+	req.Header.Set(w.identityProxyHeaderKey, impersonatedUser.Email)
+	req.Header.Del(platform.ActAsHeader)
+
+	w.next.ServeHTTP(rw, req)
+}
@@ -0,0 +1,123 @@
+package impersonation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/platform"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/proxy/middleware"
+	errpkg "github.com/goto/shield/pkg/errors"
+)
+
+const testIdentityProxyHeaderKey = "X-Shield-Email"
+
+var (
+	testSupportUser      = user.User{ID: "support-id", Email: "support@example.com"}
+	testImpersonatedUser = user.User{ID: "user-id", Email: "user@example.com"}
+)
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title            string
+		actAs            string
+		impersonateErr   error
+		wantStatus       int
+		wantIdentity     string
+		wantImpersonator bool
+	}{
+		{
+			title:            "should pass on the request as the impersonated user",
+			actAs:            testImpersonatedUser.Email,
+			wantStatus:       http.StatusOK,
+			wantIdentity:     testImpersonatedUser.Email,
+			wantImpersonator: true,
+		},
+		{
+			title:        "should pass on the request as is without act as header",
+			wantStatus:   http.StatusOK,
+			wantIdentity: testSupportUser.Email,
+		},
+		{
+			title:          "should deny users without the impersonate permission",
+			actAs:          testImpersonatedUser.Email,
+			impersonateErr: errpkg.ErrForbidden,
+			wantStatus:     http.StatusForbidden,
+		},
+		{
+			title:          "should deny impersonating platform admins",
+			actAs:          testImpersonatedUser.Email,
+			impersonateErr: platform.ErrImpersonateAdmin,
+			wantStatus:     http.StatusForbidden,
+		},
+		{
+			title:          "should respond with not found if impersonated user doesn't exist",
+			actAs:          "unknown@example.com",
+			impersonateErr: user.ErrNotExist,
+			wantStatus:     http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				gotHeaders       http.Header
+				gotImpersonator  activity.Actor
+				hasImpersonator  bool
+				impersonatorUser string
+			)
+			next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				gotHeaders = req.Header
+				gotImpersonator, hasImpersonator = activity.GetImpersonatorFromContext(req.Context())
+			})
+			w := New(log.NewNoop(), next, middleware.NewErrorWriter(middleware.ErrorVerbosityMinimal), testIdentityProxyHeaderKey,
+				mockImpersonator{err: tt.impersonateErr, caller: &impersonatorUser})
+
+			req := httptest.NewRequest(http.MethodGet, "/firehoses", nil)
+			req.Header.Set(testIdentityProxyHeaderKey, testSupportUser.Email)
+			if tt.actAs != "" {
+				req.Header.Set(platform.ActAsHeader, tt.actAs)
+			}
+			rw := httptest.NewRecorder()
+
+			w.ServeHTTP(rw, req)
+
+			assert.Equal(t, tt.wantStatus, rw.Code)
+			assert.Equal(t, tt.wantImpersonator, hasImpersonator)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, tt.wantIdentity, gotHeaders.Get(testIdentityProxyHeaderKey))
+			assert.Empty(t, gotHeaders.Get(platform.ActAsHeader))
+			if tt.wantImpersonator {
+				// the user of the identity header is the one impersonating
+				assert.Equal(t, testSupportUser.Email, impersonatorUser)
+				assert.Equal(t, activity.Actor{ID: testSupportUser.ID, Email: testSupportUser.Email}, gotImpersonator)
+			}
+		})
+	}
+}
+
+type mockImpersonator struct {
+	err    error
+	caller *string
+}
+
+func (m mockImpersonator) Impersonate(ctx context.Context, email string) (user.User, user.User, error) {
+	*m.caller, _ = user.GetEmailFromContext(ctx)
+	if m.err != nil {
+		return user.User{}, user.User{}, m.err
+	}
+	return testSupportUser, testImpersonatedUser, nil
+}
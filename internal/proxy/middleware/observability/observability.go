@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/goto/shield/internal/proxy/middleware"
+	"github.com/goto/shield/pkg/httputil"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
@@ -14,10 +15,6 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	headerRequestID = "X-Request-Id"
-)
-
 type Ware struct {
 	log         *log.Zap
 	otelHandler http.Handler
@@ -64,10 +61,10 @@ func (m *Ware) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 }
 
 func setRequestID(req *http.Request) string {
-	reqID := strings.TrimSpace(req.Header.Get(headerRequestID))
+	reqID := strings.TrimSpace(req.Header.Get(httputil.HeaderXRequestID))
 	if reqID == "" {
 		reqID = xid.New().String()
-		req.Header.Set(headerRequestID, reqID)
+		req.Header.Set(httputil.HeaderXRequestID, reqID)
 	}
 
 	return reqID
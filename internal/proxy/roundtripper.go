@@ -3,16 +3,24 @@ package proxy
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/goto/shield/core/rule"
 	"github.com/goto/shield/internal/proxy/hook"
+	"github.com/goto/shield/internal/proxy/middleware"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/goto/salt/log"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
+	"google.golang.org/grpc/codes"
 )
 
 type h2cTransportWrapper struct {
@@ -26,8 +34,9 @@ type h2cTransportWrapper struct {
 	httpTransport *otelhttp.Transport
 	grpcTransport *otelhttp.Transport
 
-	log  log.Logger
-	hook hook.Service
+	log      log.Logger
+	hook     hook.Service
+	breakers *circuitBreakers
 }
 
 func (t *h2cTransportWrapper) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -48,13 +57,45 @@ func (t *h2cTransportWrapper) RoundTrip(req *http.Request) (*http.Response, erro
 		transport = t.grpcTransport
 	}
 
+	// retries stop once the client is gone, the request itself outlives it for the hooks
+	clientCtx := req.Context()
 	req = req.WithContext(context.WithoutCancel(req.Context()))
 
+	var backend rule.Backend
+	if matchedRule, ok := middleware.ExtractRule(req); ok {
+		backend = matchedRule.Backend
+	}
+
+	breaker := t.breakers.get(backend)
+	if breaker != nil && !breaker.allow() {
+		logger.Warn("request_rejected", zap.String("reason", "circuit breaker open"))
+		t.breakers.rejected(req.Context(), backend)
+		return serviceUnavailable(req), nil
+	}
+
 	logger.Info("request_forwarded")
 
-	res, err := transport.RoundTrip(req)
-	if err != nil {
+	record := func(success bool) {
+		if breaker != nil {
+			breaker.record(success)
+		}
+		if picked, ok := req.Context().Value(ctxTargetKey).(*target); ok {
+			picked.record(success)
+		}
+	}
+
+	res, err := t.roundTripWithRetry(clientCtx, req, transport, backend, logger)
+	switch {
+	case err != nil:
+		record(false)
 		return res, err
+	case isGRPC(req) && res.StatusCode == http.StatusOK && res.Header.Get("Grpc-Status") == "":
+		// the status of streamed gRPC responses is only known from the trailers
+		res.Body = &recordOnTrailers{ReadCloser: res.Body, res: res, record: record}
+	case isGRPC(req) && res.StatusCode == http.StatusOK:
+		record(!isGRPCServerFailure(res.Header.Get("Grpc-Status")))
+	default:
+		record(res.StatusCode < http.StatusInternalServerError)
 	}
 
 	logger.Info("request_completed", zap.String("status", res.Status))
@@ -62,6 +103,192 @@ func (t *h2cTransportWrapper) RoundTrip(req *http.Request) (*http.Response, erro
 	return t.hook.ServeHook(res, nil)
 }
 
+// roundTripWithRetry sends the request applying the backend timeout, idempotent
+// requests and the gRPC methods opted in by the backend are retried with an
+// exponential backoff on errors and gateway failures, until the client is gone
+func (t *h2cTransportWrapper) roundTripWithRetry(clientCtx context.Context, req *http.Request, transport http.RoundTripper, backend rule.Backend, logger *zap.Logger) (*http.Response, error) {
+	attempts := 1
+	if isRetryable(req, backend.Retry) && backend.Retry.Attempts > 0 {
+		attempts += backend.Retry.Attempts
+	}
+
+	backoff := backend.Retry.Backoff
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			if body, ok := middleware.ExtractRequestBody(req); ok {
+				req.Body = body
+			}
+		}
+
+		res, err := t.roundTripWithTimeout(req, transport, backend.Timeout)
+		if attempt >= attempts || !shouldRetry(res, err) {
+			return res, err
+		}
+
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		logger.Warn("request_retried", zap.Int("attempt", attempt), zap.Error(err))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-clientCtx.Done():
+			timer.Stop()
+			return nil, clientCtx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+func (t *h2cTransportWrapper) roundTripWithTimeout(req *http.Request, transport http.RoundTripper, timeout time.Duration) (*http.Response, error) {
+	if timeout <= 0 {
+		return transport.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	res, err := transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return res, err
+	}
+
+	// the timeout covers reading the body as well, release it once the body is closed
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// recordOnTrailers records the result of a gRPC response once its body is
+// read, when the trailers carrying the grpc-status are available
+type recordOnTrailers struct {
+	io.ReadCloser
+	res    *http.Response
+	record func(success bool)
+	once   sync.Once
+}
+
+func (r *recordOnTrailers) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF {
+		r.done()
+	}
+	return n, err
+}
+
+func (r *recordOnTrailers) Close() error {
+	r.done()
+	return r.ReadCloser.Close()
+}
+
+func (r *recordOnTrailers) done() {
+	r.once.Do(func() {
+		r.record(!isGRPCServerFailure(r.res.Trailer.Get("Grpc-Status")))
+	})
+}
+
+func isGRPC(req *http.Request) bool {
+	return strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc")
+}
+
+// isRetryable reports whether the request can be sent again, gRPC requests are
+// all POSTs and only retried for the methods the backend declares safe to retry
+func isRetryable(req *http.Request, retry rule.Retry) bool {
+	if !isGRPC(req) {
+		return isIdempotent(req.Method)
+	}
+	for _, method := range retry.GRPCMethods {
+		if method == "*" || method == req.URL.Path {
+			return true
+		}
+	}
+	return false
+}
+
+// isGRPCServerFailure reports whether the grpc-status is a failure of the backend
+// rather than of the request, an empty status is a successful response
+func isGRPCServerFailure(grpcStatus string) bool {
+	switch grpcStatus {
+	case strconv.Itoa(int(codes.Unknown)),
+		strconv.Itoa(int(codes.DeadlineExceeded)),
+		strconv.Itoa(int(codes.Internal)),
+		strconv.Itoa(int(codes.Unavailable)),
+		strconv.Itoa(int(codes.DataLoss)):
+		return true
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	// gRPC requests are only retried if the backend failed before responding,
+	// the status of a trailers-only response is sent with the headers
+	if isGRPC(res.Request) && res.StatusCode == http.StatusOK {
+		return res.Header.Get("Grpc-Status") == strconv.Itoa(int(codes.Unavailable))
+	}
+
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// serviceUnavailable responds to requests rejected by an open circuit breaker,
+// gRPC requests get a trailers-only response with an unavailable status
+func serviceUnavailable(req *http.Request) *http.Response {
+	if isGRPC(req) {
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK)),
+			StatusCode: http.StatusOK,
+			Proto:      req.Proto,
+			ProtoMajor: req.ProtoMajor,
+			ProtoMinor: req.ProtoMinor,
+			Header: http.Header{
+				"Content-Type": []string{"application/grpc"},
+				"Grpc-Status":  []string{strconv.Itoa(int(codes.Unavailable))},
+				"Grpc-Message": []string{"circuit breaker open"},
+			},
+			Body:          http.NoBody,
+			ContentLength: 0,
+			Request:       req,
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", http.StatusServiceUnavailable, http.StatusText(http.StatusServiceUnavailable)),
+		StatusCode:    http.StatusServiceUnavailable,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{},
+		Body:          http.NoBody,
+		ContentLength: 0,
+		Request:       req,
+	}
+}
+
 func NewH2cRoundTripper(log log.Logger, hook hook.Service) http.RoundTripper {
 	return &h2cTransportWrapper{
 		httpTransport: otelhttp.NewTransport(&http.Transport{
@@ -78,7 +305,8 @@ func NewH2cRoundTripper(log log.Logger, hook hook.Service) http.RoundTripper {
 			AllowHTTP:          true,
 			DisableCompression: true,
 		}),
-		log:  log,
-		hook: hook,
+		log:      log,
+		hook:     hook,
+		breakers: newCircuitBreakers(),
 	}
 }
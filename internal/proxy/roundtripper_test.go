@@ -0,0 +1,243 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/goto/shield/core/rule"
+	"github.com/goto/shield/internal/proxy/hook"
+	"github.com/goto/shield/internal/proxy/middleware"
+)
+
+func newBackendRequest(t *testing.T, method, url string, backend rule.Backend) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(method, url, nil)
+	req.RequestURI = ""
+	*req = *req.WithContext(log.NewZap().NewContext(req.Context()))
+	middleware.EnrichRule(req, &rule.Rule{Backend: backend})
+	return req
+}
+
+func newGRPCBackend(handler http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(h2c.NewHandler(handler, &http2.Server{}))
+}
+
+func newGRPCRequest(t *testing.T, url string, backend rule.Backend) *http.Request {
+	t.Helper()
+	req := newBackendRequest(t, http.MethodPost, url+"/gotocompany.entropy.v1beta1.ResourceService/GetResource", backend)
+	req.Header.Set("Content-Type", "application/grpc")
+	return req
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	cb := newCircuitBreaker(rule.CircuitBreaker{Threshold: 2, Cooldown: time.Minute})
+	cb.now = func() time.Time { return now }
+
+	assert.True(t, cb.allow())
+	cb.record(false)
+	assert.Equal(t, breakerClosed, cb.currentState())
+	cb.record(false)
+	assert.Equal(t, breakerOpen, cb.currentState())
+	assert.False(t, cb.allow())
+
+	now = now.Add(time.Minute)
+	assert.True(t, cb.allow(), "should allow a trial request after cooldown")
+	assert.False(t, cb.allow(), "should allow only a single trial request")
+	cb.record(false)
+	assert.Equal(t, breakerOpen, cb.currentState(), "should open again if trial fails")
+
+	now = now.Add(time.Minute)
+	assert.True(t, cb.allow())
+	cb.record(true)
+	assert.Equal(t, breakerClosed, cb.currentState())
+	assert.True(t, cb.allow())
+}
+
+func TestRoundTrip(t *testing.T) {
+	logger := log.NewZap()
+
+	t.Run("should retry idempotent requests on gateway failures", func(t *testing.T) {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		rt := NewH2cRoundTripper(logger, hook.New())
+		res, err := rt.RoundTrip(newBackendRequest(t, http.MethodGet, srv.URL, rule.Backend{
+			URL:   srv.URL,
+			Retry: rule.Retry{Attempts: 2, Backoff: time.Millisecond},
+		}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should not retry non idempotent requests", func(t *testing.T) {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		rt := NewH2cRoundTripper(logger, hook.New())
+		res, err := rt.RoundTrip(newBackendRequest(t, http.MethodPost, srv.URL, rule.Backend{
+			URL:   srv.URL,
+			Retry: rule.Retry{Attempts: 2, Backoff: time.Millisecond},
+		}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should time out slow backends", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer srv.Close()
+
+		rt := NewH2cRoundTripper(logger, hook.New())
+		_, err := rt.RoundTrip(newBackendRequest(t, http.MethodPost, srv.URL, rule.Backend{
+			URL:     srv.URL,
+			Timeout: 10 * time.Millisecond,
+		}))
+
+		assert.ErrorContains(t, err, "context deadline exceeded")
+	})
+
+	t.Run("should reject requests with service unavailable once circuit is open", func(t *testing.T) {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		backend := rule.Backend{
+			URL:            srv.URL,
+			CircuitBreaker: rule.CircuitBreaker{Threshold: 2, Cooldown: time.Minute},
+		}
+		rt := NewH2cRoundTripper(logger, hook.New())
+		for i := 0; i < 2; i++ {
+			res, err := rt.RoundTrip(newBackendRequest(t, http.MethodGet, srv.URL, backend))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		}
+
+		res, err := rt.RoundTrip(newBackendRequest(t, http.MethodGet, srv.URL, backend))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+	t.Run("should stop retrying once the client is gone", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		req := newBackendRequest(t, http.MethodGet, srv.URL, rule.Backend{
+			URL:   srv.URL,
+			Retry: rule.Retry{Attempts: 2, Backoff: time.Minute},
+		})
+		ctx, cancel := context.WithCancel(req.Context())
+		req = req.WithContext(ctx)
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		rt := NewH2cRoundTripper(logger, hook.New())
+		started := time.Now()
+		_, err := rt.RoundTrip(req)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, time.Since(started), time.Second)
+	})
+
+	t.Run("should retry only the grpc methods opted in by the backend", func(t *testing.T) {
+		var calls int32
+		srv := newGRPCBackend(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/grpc")
+			if atomic.AddInt32(&calls, 1) < 2 {
+				w.Header().Set("Grpc-Status", "14")
+			} else {
+				w.Header().Set("Grpc-Status", "0")
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+		defer srv.Close()
+
+		rt := NewH2cRoundTripper(logger, hook.New())
+		res, err := rt.RoundTrip(newGRPCRequest(t, srv.URL, rule.Backend{
+			URL:   srv.URL,
+			Retry: rule.Retry{Attempts: 2, Backoff: time.Millisecond},
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, "14", res.Header.Get("Grpc-Status"))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+		atomic.StoreInt32(&calls, 0)
+		res, err = rt.RoundTrip(newGRPCRequest(t, srv.URL, rule.Backend{
+			URL: srv.URL,
+			Retry: rule.Retry{
+				Attempts:    2,
+				Backoff:     time.Millisecond,
+				GRPCMethods: []string{"/gotocompany.entropy.v1beta1.ResourceService/GetResource"},
+			},
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, "0", res.Header.Get("Grpc-Status"))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should open the circuit on grpc failures sent as trailers", func(t *testing.T) {
+		var calls int32
+		srv := newGRPCBackend(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Content-Type", "application/grpc")
+			w.Header().Set("Trailer", "Grpc-Status")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte{0, 0, 0, 0, 0})
+			w.Header().Set("Grpc-Status", "13")
+		})
+		defer srv.Close()
+
+		backend := rule.Backend{
+			URL:            srv.URL,
+			CircuitBreaker: rule.CircuitBreaker{Threshold: 1, Cooldown: time.Minute},
+		}
+		rt := NewH2cRoundTripper(logger, hook.New())
+		res, err := rt.RoundTrip(newGRPCRequest(t, srv.URL, backend))
+		assert.NoError(t, err)
+		_, _ = io.ReadAll(res.Body)
+		res.Body.Close()
+		assert.Equal(t, "13", res.Trailer.Get("Grpc-Status"))
+
+		res, err = rt.RoundTrip(newGRPCRequest(t, srv.URL, backend))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/grpc", res.Header.Get("Content-Type"))
+		assert.Equal(t, "14", res.Header.Get("Grpc-Status"))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
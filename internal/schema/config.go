@@ -3,6 +3,8 @@ package schema
 import (
 	"fmt"
 	"maps"
+	"slices"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -15,12 +17,18 @@ type RoleConfig struct {
 type PermissionsConfig struct {
 	Name  string   `yaml:"name" json:"name"`
 	Roles []string `yaml:"roles" json:"roles"`
+	// Expression is used instead of the union of roles, e.g. "editor & !suspended"
+	// is written as "editor - suspended" and "viewer + parent->view"
+	Expression string `yaml:"expression" json:"expression,omitempty"`
 }
 
 type ResourceTypeConfig struct {
 	Name        string              `yaml:"name" json:"name"`
 	Roles       []RoleConfig        `yaml:"roles" json:"roles"`
 	Permissions []PermissionsConfig `yaml:"permissions" json:"permissions"`
+	// Parent is the resource type permissions are inherited from, either a
+	// resource type of the same group or one of another group as group/type
+	Parent string `yaml:"parent" json:"parent,omitempty"`
 }
 
 type ResourceConfig struct {
@@ -39,19 +47,144 @@ func ParseConfigYaml(fileBytes []byte) (map[string]ResourceConfig, error) {
 	if err := yaml.Unmarshal(fileBytes, &config); err != nil {
 		return map[string]ResourceConfig{}, err
 	}
+
+	for name, c := range config {
+		if c.Type == "resource_group" {
+			for _, rt := range c.ResourceTypes {
+				if err := validateParent(name, c, rt); err != nil {
+					return map[string]ResourceConfig{}, err
+				}
+				predefined := PreDefinedResourceGroupNamespaceConfig
+				if rt.Parent != "" {
+					predefined.InheritedNamespaces = append(slices.Clone(predefined.InheritedNamespaces), InheritedNamespace{Name: ParentRelationName})
+				}
+				if err := validatePermissions(fmt.Sprintf("%s/%s", name, rt.Name), rt.Roles, rt.Permissions, predefined); err != nil {
+					return map[string]ResourceConfig{}, err
+				}
+			}
+			continue
+		}
+		if err := validatePermissions(name, c.Roles, c.Permissions, PreDefinedSystemNamespaceConfig[name]); err != nil {
+			return map[string]ResourceConfig{}, err
+		}
+	}
+
 	return config, nil
 }
 
+// validateParent checks the parent of a resource type is a resource type of the
+// group, parents in other groups can only be checked once every config is loaded
+func validateParent(name string, c ResourceConfig, rt ResourceTypeConfig) error {
+	if rt.Parent == "" {
+		return nil
+	}
+
+	for _, r := range rt.Roles {
+		if r.Name == ParentRelationName {
+			return fmt.Errorf("%w: role %s of %s/%s is reserved for the parent", ErrInvalidDetail, r.Name, name, rt.Name)
+		}
+	}
+
+	group, resourceType, found := strings.Cut(rt.Parent, "/")
+	if !found {
+		group, resourceType = name, rt.Parent
+	}
+	if group == "" || resourceType == "" || strings.Contains(resourceType, "/") {
+		return fmt.Errorf("%w: parent %s of %s/%s is not a resource type", ErrInvalidDetail, rt.Parent, name, rt.Name)
+	}
+	if group != name {
+		return nil
+	}
+
+	for _, t := range c.ResourceTypes {
+		if t.Name == resourceType {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: parent %s of %s/%s is not a resource type of %s", ErrInvalidDetail, rt.Parent, name, rt.Name, name)
+}
+
+// validatePermissions checks the expressions of permissions only refer to
+// roles, permissions and relations the namespace is going to have
+func validatePermissions(namespaceID string, roleConfigs []RoleConfig, permissionConfigs []PermissionsConfig, predefined NamespaceConfig) error {
+	relations := make(map[string]bool)
+	for r := range predefined.Roles {
+		relations[r] = true
+	}
+	for r := range InheritedRelations {
+		relations[r] = true
+	}
+	for _, ins := range predefined.InheritedNamespaces {
+		relations[ins.Name] = true
+	}
+	for _, r := range roleConfigs {
+		relations[r.Name] = true
+	}
+
+	permissions := make(map[string]bool)
+	for p := range predefined.Permissions {
+		permissions[p] = true
+	}
+	for _, p := range permissionConfigs {
+		permissions[p.Name] = true
+	}
+
+	for _, p := range permissionConfigs {
+		if p.Expression == "" {
+			continue
+		}
+		if len(p.Roles) > 0 {
+			return fmt.Errorf("%w: permission %s of %s has both roles and an expression", ErrInvalidExpression, p.Name, namespaceID)
+		}
+
+		expr, err := ParsePermissionExpression(p.Expression)
+		if err != nil {
+			return fmt.Errorf("permission %s of %s: %w", p.Name, namespaceID, err)
+		}
+
+		for _, leaf := range expr.Leaves() {
+			switch {
+			case leaf.Relation == p.Name:
+				return fmt.Errorf("%w: permission %s of %s refers to itself", ErrInvalidExpression, p.Name, namespaceID)
+			case leaf.Arrow != "" && !relations[leaf.Relation]:
+				return fmt.Errorf("%w: permission %s of %s walks %s which is not a relation", ErrInvalidExpression, p.Name, namespaceID, leaf.Relation)
+			case !relations[leaf.Relation] && !permissions[leaf.Relation]:
+				return fmt.Errorf("%w: permission %s of %s refers to unknown %s", ErrInvalidExpression, p.Name, namespaceID, leaf.Relation)
+			}
+		}
+	}
+
+	return nil
+}
+
 func GetNamespacesForResourceGroup(name string, c ResourceConfig) NamespaceConfigMapType {
 	namespaceConfig := NamespaceConfigMapType{}
 
 	for _, v := range c.ResourceTypes {
-		maps.Copy(namespaceConfig, GetNamespaceFromConfig(name, v.Roles, v.Permissions, v.Name))
+		resourceTypeConfig := GetNamespaceFromConfig(name, v.Roles, v.Permissions, v.Name)
+		if v.Parent != "" {
+			namespaceID := fmt.Sprintf("%s/%s", name, v.Name)
+			nc := resourceTypeConfig[namespaceID]
+			nc.InheritedNamespaces = append(nc.InheritedNamespaces, InheritedNamespace{
+				Name:        ParentRelationName,
+				NamespaceId: ParentNamespace(name, v.Parent),
+			})
+			resourceTypeConfig[namespaceID] = nc
+		}
+		maps.Copy(namespaceConfig, resourceTypeConfig)
 	}
 
 	return namespaceConfig
 }
 
+// ParentNamespace returns the namespace of the parent of a resource type in the group
+func ParentNamespace(group, parent string) string {
+	if strings.Contains(parent, "/") {
+		return parent
+	}
+	return fmt.Sprintf("%s/%s", group, parent)
+}
+
 func GetNamespaceFromConfig(name string, rolesConfigs []RoleConfig, permissionConfigs []PermissionsConfig, resourceType ...string) NamespaceConfigMapType {
 	tnc := NamespaceConfig{
 		Roles:       make(map[string][]string),
@@ -62,15 +195,41 @@ func GetNamespaceFromConfig(name string, rolesConfigs []RoleConfig, permissionCo
 		tnc.Roles[v1.Name] = v1.Principals
 	}
 
-	for _, v2 := range permissionConfigs {
-		tnc.Permissions[v2.Name] = v2.Roles
-	}
-
+	predefined := PreDefinedSystemNamespaceConfig[name]
 	if len(resourceType) == 0 {
 		tnc.Type = SystemNamespace
 	} else {
 		tnc.Type = ResourceGroupNamespace
 		name = fmt.Sprintf("%s/%s", name, resourceType[0])
+		predefined = PreDefinedResourceGroupNamespaceConfig
+	}
+
+	for _, v2 := range permissionConfigs {
+		if v2.Expression == "" {
+			tnc.Permissions[v2.Name] = v2.Roles
+			continue
+		}
+
+		// expressions are validated while parsing the config
+		expr, err := ParsePermissionExpression(v2.Expression)
+		if err != nil {
+			continue
+		}
+		if tnc.PermissionExpressions == nil {
+			tnc.PermissionExpressions = make(map[string]string)
+		}
+		tnc.PermissionExpressions[v2.Name] = expr.String()
+
+		// policies are kept for the roles granting the permission
+		roles := make([]string, 0)
+		for _, r := range expr.GrantingRelations() {
+			if _, ok := tnc.Roles[r]; ok {
+				roles = append(roles, r)
+			} else if _, ok := predefined.Roles[r]; ok {
+				roles = append(roles, r)
+			}
+		}
+		tnc.Permissions[v2.Name] = roles
 	}
 
 	return NamespaceConfigMapType{name: tnc}
@@ -0,0 +1,159 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfigYaml_Parent(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name: "should accept parents in the group, in other groups and parent arrows in expressions",
+			config: `
+grafana:
+  type: resource_group
+  resource_types:
+    - name: folder
+      parent: folder
+    - name: dashboard
+      parent: folder
+    - name: panel
+      parent: grafana/dashboard
+      permissions:
+        - name: view
+          expression: owner + parent->view
+    - name: alert
+      parent: alerting/rule
+`,
+		},
+		{
+			name: "should reject parents which are not resource types of the group",
+			config: `
+grafana:
+  type: resource_group
+  resource_types:
+    - name: dashboard
+      parent: folder
+`,
+			wantErr: true,
+		},
+		{
+			name: "should reject roles named after the parent relation",
+			config: `
+grafana:
+  type: resource_group
+  resource_types:
+    - name: folder
+    - name: dashboard
+      parent: folder
+      roles:
+        - name: parent
+          principals:
+            - shield/user
+`,
+			wantErr: true,
+		},
+		{
+			name: "should reject parent arrows of resource types without a parent",
+			config: `
+grafana:
+  type: resource_group
+  resource_types:
+    - name: dashboard
+      permissions:
+        - name: view
+          expression: owner + parent->view
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfigYaml([]byte(tt.config))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetNamespacesForResourceGroup_Parent(t *testing.T) {
+	got := GetNamespacesForResourceGroup("grafana", ResourceConfig{
+		Type: "resource_group",
+		ResourceTypes: []ResourceTypeConfig{
+			{Name: "folder"},
+			{Name: "dashboard", Parent: "folder"},
+		},
+	})
+
+	assert.Empty(t, got["grafana/folder"].InheritedNamespaces)
+	assert.Equal(t, []InheritedNamespace{
+		{Name: ParentRelationName, NamespaceId: "grafana/folder"},
+	}, got["grafana/dashboard"].InheritedNamespaces)
+}
+
+func TestSchemaService_ParentType(t *testing.T) {
+	s := newStoredSchemaService(&stored{
+		config: GetNamespacesForResourceGroup("grafana", ResourceConfig{
+			Type: "resource_group",
+			ResourceTypes: []ResourceTypeConfig{
+				{Name: "folder"},
+				{Name: "dashboard", Parent: "folder"},
+			},
+		}),
+	}, SchemaMigrationConfig{})
+
+	parentType, err := s.ParentType(context.Background(), "grafana/dashboard")
+	assert.NoError(t, err)
+	assert.Equal(t, "grafana/folder", parentType)
+
+	parentType, err = s.ParentType(context.Background(), "grafana/folder")
+	assert.NoError(t, err)
+	assert.Empty(t, parentType)
+}
+
+func TestSchemaService_namespaceConfig(t *testing.T) {
+	st := &stored{config: GetNamespacesForResourceGroup("entropy", ResourceConfig{
+		Type:          "resource_group",
+		ResourceTypes: []ResourceTypeConfig{{Name: "firehose"}},
+	})}
+	s := newStoredSchemaService(st, SchemaMigrationConfig{BootstrapServiceDataKey: true})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := s.namespaceConfig(context.Background())
+			assert.NoError(t, err)
+			assert.Contains(t, got, ServiceDataKeyNamespace)
+			assert.Contains(t, got, "entropy/firehose")
+		}()
+	}
+	wg.Wait()
+	assert.NotContains(t, PreDefinedSystemNamespaceConfig, ServiceDataKeyNamespace)
+
+	// the cached configs are used until the resources config is upserted
+	st.config = GetNamespacesForResourceGroup("entropy", ResourceConfig{
+		Type:          "resource_group",
+		ResourceTypes: []ResourceTypeConfig{{Name: "dagger"}},
+	})
+	got, err := s.namespaceConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Contains(t, got, "entropy/firehose")
+
+	s.resetNamespaceConfig()
+	got, err = s.namespaceConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Contains(t, got, "entropy/dagger")
+	assert.NotContains(t, got, "entropy/firehose")
+}
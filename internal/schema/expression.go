@@ -0,0 +1,221 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type ExpressionOperator string
+
+const (
+	UnionOperator        ExpressionOperator = "+"
+	IntersectionOperator ExpressionOperator = "&"
+	ExclusionOperator    ExpressionOperator = "-"
+)
+
+var ErrInvalidExpression = errors.New("invalid permission expression")
+
+// PermissionExpression is a parsed permission expression in spicedb syntax, e.g.
+// "editor & (owner - suspended)" or "viewer + parent->view". Operators have the
+// same precedence and are evaluated left to right as in spicedb.
+type PermissionExpression struct {
+	// Operator joins the children, it is empty for a single relation
+	Operator ExpressionOperator
+	Children []PermissionExpression
+
+	// Relation is a role, permission or relation of the resource
+	Relation string
+	// Arrow is the permission or role walked to through Relation
+	Arrow string
+}
+
+func ParsePermissionExpression(expression string) (PermissionExpression, error) {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return PermissionExpression{}, err
+	}
+
+	p := &expressionParser{tokens: tokens}
+	expr, err := p.parseExpression()
+	if err != nil {
+		return PermissionExpression{}, err
+	}
+	if p.pos < len(p.tokens) {
+		return PermissionExpression{}, fmt.Errorf("%w: unexpected %q in %q", ErrInvalidExpression, p.tokens[p.pos], expression)
+	}
+
+	return expr, nil
+}
+
+func (e PermissionExpression) String() string {
+	if e.Operator == "" {
+		if e.Arrow != "" {
+			return fmt.Sprintf("%s->%s", e.Relation, e.Arrow)
+		}
+		return e.Relation
+	}
+
+	children := make([]string, 0, len(e.Children))
+	for _, c := range e.Children {
+		if c.Operator != "" {
+			children = append(children, fmt.Sprintf("(%s)", c.String()))
+		} else {
+			children = append(children, c.String())
+		}
+	}
+	return strings.Join(children, fmt.Sprintf(" %s ", e.Operator))
+}
+
+// Leaves returns every relation or arrow referenced by the expression
+func (e PermissionExpression) Leaves() []PermissionExpression {
+	if e.Operator == "" {
+		return []PermissionExpression{e}
+	}
+
+	var leaves []PermissionExpression
+	for _, c := range e.Children {
+		leaves = append(leaves, c.Leaves()...)
+	}
+	return leaves
+}
+
+// GrantingRelations returns the relations of the resource which grant the
+// permission, relations which are excluded or walked through are left out
+func (e PermissionExpression) GrantingRelations() []string {
+	switch e.Operator {
+	case "":
+		if e.Arrow != "" {
+			return nil
+		}
+		return []string{e.Relation}
+	case ExclusionOperator:
+		return e.Children[0].GrantingRelations()
+	}
+
+	var relations []string
+	for _, c := range e.Children {
+		relations = AppendIfUnique(relations, c.GrantingRelations())
+	}
+	return relations
+}
+
+func tokenizeExpression(expression string) ([]string, error) {
+	var tokens []string
+	runes := []rune(expression)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '>':
+			tokens = append(tokens, "->")
+			i++
+		case strings.ContainsRune("+&-()", r):
+			tokens = append(tokens, string(r))
+		case isIdentifierRune(r):
+			start := i
+			for i+1 < len(runes) && isIdentifierRune(runes[i+1]) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i+1]))
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q in %q", ErrInvalidExpression, r, expression)
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: expression is empty", ErrInvalidExpression)
+	}
+	return tokens, nil
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type expressionParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *expressionParser) next() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	return token, true
+}
+
+func (p *expressionParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *expressionParser) parseExpression() (PermissionExpression, error) {
+	left, grouped, err := p.parseTerm()
+	if err != nil {
+		return PermissionExpression{}, err
+	}
+
+	for {
+		op := ExpressionOperator(p.peek())
+		if op != UnionOperator && op != IntersectionOperator && op != ExclusionOperator {
+			return left, nil
+		}
+		p.pos++
+
+		right, _, err := p.parseTerm()
+		if err != nil {
+			return PermissionExpression{}, err
+		}
+
+		// a chain of the same operator is kept flat, "a - b - c" excludes both b and c from a
+		if left.Operator == op && !grouped {
+			left.Children = append(left.Children, right)
+		} else {
+			left = PermissionExpression{Operator: op, Children: []PermissionExpression{left, right}}
+		}
+		grouped = false
+	}
+}
+
+func (p *expressionParser) parseTerm() (PermissionExpression, bool, error) {
+	token, ok := p.next()
+	if !ok {
+		return PermissionExpression{}, false, fmt.Errorf("%w: unexpected end of expression", ErrInvalidExpression)
+	}
+
+	if token == "(" {
+		expr, err := p.parseExpression()
+		if err != nil {
+			return PermissionExpression{}, false, err
+		}
+		if closing, _ := p.next(); closing != ")" {
+			return PermissionExpression{}, false, fmt.Errorf("%w: missing closing parenthesis", ErrInvalidExpression)
+		}
+		return expr, true, nil
+	}
+
+	if !isIdentifier(token) {
+		return PermissionExpression{}, false, fmt.Errorf("%w: unexpected %q", ErrInvalidExpression, token)
+	}
+
+	leaf := PermissionExpression{Relation: token}
+	if p.peek() == "->" {
+		p.pos++
+		arrow, _ := p.next()
+		if !isIdentifier(arrow) {
+			return PermissionExpression{}, false, fmt.Errorf("%w: arrow from %q must point to a permission", ErrInvalidExpression, token)
+		}
+		leaf.Arrow = arrow
+	}
+	return leaf, false, nil
+}
+
+func isIdentifier(token string) bool {
+	return token != "" && isIdentifierRune([]rune(token)[0])
+}
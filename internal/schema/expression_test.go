@@ -0,0 +1,202 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePermissionExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+		granting   []string
+		wantErr    bool
+	}{
+		{
+			name:       "should parse a single relation",
+			expression: "owner",
+			want:       "owner",
+			granting:   []string{"owner"},
+		},
+		{
+			name:       "should parse a union of relations and arrows",
+			expression: "viewer+editor + organization->view",
+			want:       "viewer + editor + organization->view",
+			granting:   []string{"viewer", "editor"},
+		},
+		{
+			name:       "should leave excluded relations out of granting relations",
+			expression: "editor - suspended - banned",
+			want:       "editor - suspended - banned",
+			granting:   []string{"editor"},
+		},
+		{
+			name:       "should evaluate operators left to right",
+			expression: "viewer + editor & approved",
+			want:       "(viewer + editor) & approved",
+			granting:   []string{"viewer", "editor", "approved"},
+		},
+		{
+			name:       "should keep parenthesized expressions",
+			expression: "(owner - suspended) & (editor + organization->edit)",
+			want:       "(owner - suspended) & (editor + organization->edit)",
+			granting:   []string{"owner", "editor"},
+		},
+		{
+			name:       "should not flatten a parenthesized chain",
+			expression: "(editor - suspended) - banned",
+			want:       "(editor - suspended) - banned",
+			granting:   []string{"editor"},
+		},
+		{
+			name:       "should return error if expression is empty",
+			expression: "  ",
+			wantErr:    true,
+		},
+		{
+			name:       "should return error if an operand is missing",
+			expression: "owner +",
+			wantErr:    true,
+		},
+		{
+			name:       "should return error if a parenthesis is not closed",
+			expression: "(owner + editor",
+			wantErr:    true,
+		},
+		{
+			name:       "should return error if an arrow does not point to a permission",
+			expression: "organization->",
+			wantErr:    true,
+		},
+		{
+			name:       "should return error on unknown characters",
+			expression: "owner | editor",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePermissionExpression(tt.expression)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidExpression)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+			assert.ElementsMatch(t, tt.granting, got.GrantingRelations())
+		})
+	}
+}
+
+func TestParseConfigYaml_Expressions(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name: "should accept expressions on roles, permissions and inherited relations",
+			config: `
+entropy:
+  type: resource_group
+  resource_types:
+    - name: firehose
+      roles:
+        - name: suspended
+          principals:
+            - shield/user
+      permissions:
+        - name: view
+          roles:
+            - owner
+        - name: configure
+          expression: (owner + organization->edit) - suspended
+        - name: audit
+          expression: view & configure
+`,
+		},
+		{
+			name: "should reject unknown relations",
+			config: `
+entropy:
+  type: resource_group
+  resource_types:
+    - name: firehose
+      permissions:
+        - name: configure
+          expression: owner - suspended
+`,
+			wantErr: true,
+		},
+		{
+			name: "should reject arrows through permissions",
+			config: `
+entropy:
+  type: resource_group
+  resource_types:
+    - name: firehose
+      permissions:
+        - name: view
+          roles:
+            - owner
+        - name: configure
+          expression: view->edit
+`,
+			wantErr: true,
+		},
+		{
+			name: "should reject permissions referring to themselves",
+			config: `
+entropy:
+  type: resource_group
+  resource_types:
+    - name: firehose
+      permissions:
+        - name: configure
+          expression: owner + configure
+`,
+			wantErr: true,
+		},
+		{
+			name: "should reject permissions with both roles and an expression",
+			config: `
+entropy:
+  type: resource_group
+  resource_types:
+    - name: firehose
+      permissions:
+        - name: configure
+          roles:
+            - owner
+          expression: owner
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfigYaml([]byte(tt.config))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidExpression)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetNamespaceFromConfig_Expressions(t *testing.T) {
+	got := GetNamespaceFromConfig("entropy", []RoleConfig{
+		{Name: "suspended", Principals: []string{UserPrincipal}},
+	}, []PermissionsConfig{
+		{Name: "view", Roles: []string{OwnerRole}},
+		{Name: "configure", Expression: "owner+organization->edit - suspended"},
+	}, "firehose")
+
+	assert.Equal(t, map[string]string{
+		"configure": "(owner + organization->edit) - suspended",
+	}, got["entropy/firehose"].PermissionExpressions)
+	assert.Equal(t, []string{OwnerRole}, got["entropy/firehose"].Permissions["configure"])
+}
@@ -0,0 +1,198 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/goto/shield/core/role"
+)
+
+var ErrInvalidOrganizationRole = errors.New("custom role name can only have lowercase letters, numbers and underscores and up to 27 characters")
+
+// organization role relations are prefixed with the organization, spicedb
+// relation names are limited to 64 characters which leaves 27 for the name
+var (
+	organizationRoleNamePattern     = regexp.MustCompile(`^[a-z][a-z0-9_]{0,25}[a-z0-9]$`)
+	organizationRoleRelationPattern = regexp.MustCompile(`^org_([0-9a-f]{32})_`)
+)
+
+// OrganizationRoleRelation returns the relation of a custom role of an
+// organization, roles of every organization share the namespace definition
+func OrganizationRoleRelation(orgID, name string) string {
+	return fmt.Sprintf("org_%s_%s", strings.ReplaceAll(orgID, "-", ""), name)
+}
+
+// OrganizationOfRelation returns the organization of a custom role relation
+func OrganizationOfRelation(relationName string) (string, bool) {
+	match := organizationRoleRelationPattern.FindStringSubmatch(relationName)
+	if match == nil {
+		return "", false
+	}
+
+	id := match[1]
+	return fmt.Sprintf("%s-%s-%s-%s-%s", id[0:8], id[8:12], id[12:16], id[16:20], id[20:32]), true
+}
+
+// UpsertOrganizationRole creates or updates a custom role of an organization
+// composed of permissions of the namespace and adds it to the authz schema
+func (s SchemaService) UpsertOrganizationRole(ctx context.Context, toUpsert role.Role) (role.Role, error) {
+	if strings.TrimSpace(toUpsert.OrgID) == "" {
+		return role.Role{}, role.ErrInvalidDetail
+	}
+	if !organizationRoleNamePattern.MatchString(toUpsert.Name) {
+		return role.Role{}, fmt.Errorf("%w: %s", role.ErrInvalidDetail, ErrInvalidOrganizationRole.Error())
+	}
+	if len(toUpsert.Permissions) == 0 {
+		return role.Role{}, fmt.Errorf("%w: custom role needs at least one permission", role.ErrInvalidDetail)
+	}
+
+	namespaceConfigMap, err := s.namespaceConfig(ctx)
+	if err != nil {
+		return role.Role{}, err
+	}
+
+	nc, ok := namespaceConfigMap[toUpsert.NamespaceID]
+	if !ok || nc.Type != ResourceGroupNamespace {
+		return role.Role{}, fmt.Errorf("%w: namespace %s is not a configured resource", role.ErrInvalidDetail, toUpsert.NamespaceID)
+	}
+	for _, p := range toUpsert.Permissions {
+		if _, ok := nc.Permissions[p]; !ok {
+			return role.Role{}, fmt.Errorf("%w: permission %s doesn't exist in namespace %s", role.ErrInvalidDetail, p, toUpsert.NamespaceID)
+		}
+	}
+
+	// the role is only stored if the authz schema with it is written, a failed
+	// write rolls back the role along with the schema version
+	ctx = s.pgRepository.WithTransaction(ctx)
+
+	relationName := OrganizationRoleRelation(toUpsert.OrgID, toUpsert.Name)
+	upserted, err := s.roleService.Upsert(ctx, role.Role{
+		ID:          GetRoleID(toUpsert.NamespaceID, relationName),
+		Name:        toUpsert.Name,
+		Types:       []string{UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
+		NamespaceID: toUpsert.NamespaceID,
+		OrgID:       toUpsert.OrgID,
+		Permissions: toUpsert.Permissions,
+		Metadata:    toUpsert.Metadata,
+	})
+	if err != nil {
+		if txErr := s.pgRepository.Rollback(ctx, err); txErr != nil {
+			return role.Role{}, txErr
+		}
+		return role.Role{}, err
+	}
+
+	if err := s.writeSchema(ctx, namespaceConfigMap); err != nil {
+		if txErr := s.pgRepository.Rollback(ctx, err); txErr != nil {
+			return role.Role{}, txErr
+		}
+		return role.Role{}, err
+	}
+
+	if err := s.pgRepository.Commit(ctx); err != nil {
+		return role.Role{}, err
+	}
+
+	return upserted, nil
+}
+
+// DeleteOrganizationRole deletes a custom role of an organization along with
+// its relations and removes it from the authz schema
+func (s SchemaService) DeleteOrganizationRole(ctx context.Context, orgID, roleID string) error {
+	r, err := s.roleService.Get(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	if r.OrgID == "" || r.OrgID != orgID {
+		return role.ErrNotExist
+	}
+
+	if err := s.authzEngine.DeleteRelations(ctx, r.NamespaceID, GetRoleName(r.ID)); err != nil {
+		return err
+	}
+	if err := s.relationRepository.DeleteByRoleID(ctx, r.ID); err != nil {
+		return err
+	}
+	if err := s.roleService.Delete(ctx, r.ID); err != nil {
+		return err
+	}
+
+	namespaceConfigMap, err := s.namespaceConfig(ctx)
+	if err != nil {
+		return err
+	}
+	return s.writeSchema(ctx, namespaceConfigMap)
+}
+
+// writeSchema writes the authz schema of the namespaces with the custom roles
+// of organizations
+func (s SchemaService) writeSchema(ctx context.Context, namespaceConfigMap NamespaceConfigMapType) error {
+	organizationRoles, err := s.organizationRoles(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.writeVersionedSchema(ctx, withOrganizationRoles(namespaceConfigMap, organizationRoles))
+}
+
+func (s SchemaService) organizationRoles(ctx context.Context) ([]role.Role, error) {
+	roles, err := s.roleService.List(ctx, role.Filter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var organizationRoles []role.Role
+	for _, r := range roles {
+		if r.OrgID != "" {
+			organizationRoles = append(organizationRoles, r)
+		}
+	}
+	return organizationRoles, nil
+}
+
+// withOrganizationRoles adds custom roles as relations of their namespace which
+// grant their permissions, permissions no longer configured are left out
+func withOrganizationRoles(namespaceConfigMap NamespaceConfigMapType, organizationRoles []role.Role) NamespaceConfigMapType {
+	if len(organizationRoles) == 0 {
+		return namespaceConfigMap
+	}
+
+	combinedMap := make(NamespaceConfigMapType)
+	maps.Copy(combinedMap, namespaceConfigMap)
+	copied := make(map[string]bool)
+
+	for _, r := range organizationRoles {
+		nc, ok := combinedMap[r.NamespaceID]
+		if !ok {
+			continue
+		}
+
+		// the maps of the namespace config are shared with the predefined configs
+		if !copied[r.NamespaceID] {
+			nc.Roles = maps.Clone(nc.Roles)
+			nc.Permissions = maps.Clone(nc.Permissions)
+			nc.PermissionExpressions = maps.Clone(nc.PermissionExpressions)
+			copied[r.NamespaceID] = true
+		}
+
+		relationName := GetRoleName(r.ID)
+		nc.Roles[relationName] = r.Types
+		for _, p := range r.Permissions {
+			roles, ok := nc.Permissions[p]
+			if !ok {
+				continue
+			}
+			nc.Permissions[p] = append(append([]string{}, roles...), relationName)
+			if expression, ok := nc.PermissionExpressions[p]; ok {
+				nc.PermissionExpressions[p] = fmt.Sprintf("(%s) + %s", expression, relationName)
+			}
+		}
+		combinedMap[r.NamespaceID] = nc
+	}
+
+	return combinedMap
+}
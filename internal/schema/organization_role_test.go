@@ -0,0 +1,170 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/shield/core/role"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type configuredSchema struct{}
+
+// GetSchema returns a fresh config every time as merging predefined configs
+// modifies it
+func (configuredSchema) GetSchema(ctx context.Context) (NamespaceConfigMapType, error) {
+	return NamespaceConfigMapType{
+		"entropy/firehose": {
+			Type:        ResourceGroupNamespace,
+			Roles:       map[string][]string{"owner": {UserPrincipal}},
+			Permissions: map[string][]string{"view": {"owner"}},
+		},
+	}, nil
+}
+
+const testOrgID = "4d7d7b3d-5b8a-4e3b-9d4c-6a2f1e0c8b9a"
+
+func TestOrganizationRoleRelation(t *testing.T) {
+	relationName := OrganizationRoleRelation(testOrgID, "deployer")
+	assert.Equal(t, "org_4d7d7b3d5b8a4e3b9d4c6a2f1e0c8b9a_deployer", relationName)
+
+	orgID, ok := OrganizationOfRelation(relationName)
+	assert.True(t, ok)
+	assert.Equal(t, testOrgID, orgID)
+
+	_, ok = OrganizationOfRelation("owner")
+	assert.False(t, ok)
+}
+
+func TestWithOrganizationRoles(t *testing.T) {
+	configMap := NamespaceConfigMapType{
+		"entropy/firehose": {
+			Type:  ResourceGroupNamespace,
+			Roles: map[string][]string{"owner": {UserPrincipal}},
+			Permissions: map[string][]string{
+				"view": {"owner"},
+				"edit": {"owner"},
+			},
+			PermissionExpressions: map[string]string{"edit": "owner - suspended"},
+		},
+	}
+	relationName := OrganizationRoleRelation(testOrgID, "deployer")
+
+	combined := withOrganizationRoles(configMap, []role.Role{{
+		ID:          GetRoleID("entropy/firehose", relationName),
+		NamespaceID: "entropy/firehose",
+		OrgID:       testOrgID,
+		Types:       []string{UserPrincipal, GroupPrincipal},
+		Permissions: []string{"edit", "removed"},
+	}})
+
+	nc := combined["entropy/firehose"]
+	assert.Equal(t, []string{UserPrincipal, GroupPrincipal}, nc.Roles[relationName])
+	assert.Equal(t, []string{"owner"}, nc.Permissions["view"])
+	assert.Equal(t, []string{"owner", relationName}, nc.Permissions["edit"])
+	assert.Equal(t, "(owner - suspended) + "+relationName, nc.PermissionExpressions["edit"])
+	assert.NotContains(t, nc.Permissions, "removed")
+
+	// the given config is left untouched
+	assert.NotContains(t, configMap["entropy/firehose"].Roles, relationName)
+	assert.Equal(t, []string{"owner"}, configMap["entropy/firehose"].Permissions["edit"])
+}
+
+func TestSchemaService_UpsertOrganizationRole(t *testing.T) {
+	newService := func(st *stored) SchemaService {
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+		s.schemaConfig = configuredSchema{}
+		return s
+	}
+
+	t.Run("should reject invalid names and unknown permissions", func(t *testing.T) {
+		s := newService(newFirehoseStore())
+
+		for _, r := range []role.Role{
+			{Name: "deployer", NamespaceID: "entropy/firehose", Permissions: []string{"view"}},
+			{OrgID: testOrgID, Name: "Deployer", NamespaceID: "entropy/firehose", Permissions: []string{"view"}},
+			{OrgID: testOrgID, Name: "deployer", NamespaceID: "entropy/firehose"},
+			{OrgID: testOrgID, Name: "deployer", NamespaceID: "entropy/dagger", Permissions: []string{"view"}},
+			{OrgID: testOrgID, Name: "deployer", NamespaceID: "entropy/firehose", Permissions: []string{"deploy"}},
+		} {
+			_, err := s.UpsertOrganizationRole(context.Background(), r)
+			assert.ErrorIs(t, err, role.ErrInvalidDetail)
+		}
+	})
+
+	t.Run("should store the role and write it to the authz schema", func(t *testing.T) {
+		st := newFirehoseStore()
+		s := newService(st)
+
+		upserted, err := s.UpsertOrganizationRole(context.Background(), role.Role{
+			OrgID:       testOrgID,
+			Name:        "deployer",
+			NamespaceID: "entropy/firehose",
+			Permissions: []string{"view"},
+		})
+		assert.NoError(t, err)
+
+		relationName := OrganizationRoleRelation(testOrgID, "deployer")
+		assert.Equal(t, GetRoleID("entropy/firehose", relationName), upserted.ID)
+		assert.Contains(t, st.written["entropy/firehose"].Roles, relationName)
+		assert.Contains(t, st.written["entropy/firehose"].Permissions["view"], relationName)
+		assert.True(t, st.committed)
+	})
+
+	t.Run("should roll back the role if the authz schema can't be written", func(t *testing.T) {
+		st := newFirehoseStore()
+		st.writeErr = errors.New("spicedb is down")
+		s := newService(st)
+
+		_, err := s.UpsertOrganizationRole(context.Background(), role.Role{
+			OrgID:       testOrgID,
+			Name:        "deployer",
+			NamespaceID: "entropy/firehose",
+			Permissions: []string{"view"},
+		})
+		assert.ErrorIs(t, err, st.writeErr)
+		assert.True(t, st.rolledBack)
+		assert.False(t, st.committed)
+	})
+}
+
+func TestSchemaService_DeleteOrganizationRole(t *testing.T) {
+	relationName := OrganizationRoleRelation(testOrgID, "deployer")
+	newStore := func() *stored {
+		st := newFirehoseStore()
+		st.roles = append(st.roles, role.Role{
+			ID:          GetRoleID("entropy/firehose", relationName),
+			NamespaceID: "entropy/firehose",
+			OrgID:       testOrgID,
+			Permissions: []string{"view"},
+		})
+		return st
+	}
+
+	t.Run("should not delete roles of other organizations", func(t *testing.T) {
+		st := newStore()
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+		s.schemaConfig = configuredSchema{}
+
+		err := s.DeleteOrganizationRole(context.Background(), "0e0a1c43-0b43-4e44-a3f0-e0c2d6d7a0c4", GetRoleID("entropy/firehose", relationName))
+		assert.ErrorIs(t, err, role.ErrNotExist)
+		err = s.DeleteOrganizationRole(context.Background(), testOrgID, "entropy/firehose:owner")
+		assert.ErrorIs(t, err, role.ErrNotExist)
+		assert.Empty(t, st.deleted)
+	})
+
+	t.Run("should delete the role with its relations", func(t *testing.T) {
+		st := newStore()
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+		s.schemaConfig = configuredSchema{}
+
+		assert.NoError(t, s.DeleteOrganizationRole(context.Background(), testOrgID, GetRoleID("entropy/firehose", relationName)))
+		assert.Equal(t, []string{
+			"authz relations entropy/firehose#" + relationName,
+			"relations entropy/firehose:" + relationName,
+			"role entropy/firehose:" + relationName,
+		}, st.deleted)
+	})
+}
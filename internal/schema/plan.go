@@ -0,0 +1,353 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goto/shield/core/policy"
+	"github.com/goto/shield/core/role"
+)
+
+type ChangeAction string
+
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+const (
+	EntityNamespace   = "namespace"
+	EntityRole        = "role"
+	EntityAction      = "action"
+	EntityPolicy      = "policy"
+	EntityAuthzSchema = "authz_schema"
+)
+
+var ErrDestructiveMigration = errors.New("migration removes roles or permissions which still have relations, use force to apply")
+
+// Change is a single difference between the configured resources and the
+// stored namespaces, roles, actions, policies or the authz schema
+type Change struct {
+	Action ChangeAction
+	Entity string
+	ID     string
+	Detail string
+	// LiveRelations is the number of relations still depending on a removed entity
+	LiveRelations int64
+}
+
+func (c Change) Destructive() bool {
+	return c.Action == ChangeDelete && c.LiveRelations > 0
+}
+
+type Plan struct {
+	Changes []Change
+}
+
+func (p Plan) Destructive() []Change {
+	var changes []Change
+	for _, c := range p.Changes {
+		if c.Destructive() {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+func (p Plan) IsDestructive() bool {
+	return len(p.Destructive()) > 0
+}
+
+// Plan compares the merged resources config with what is currently stored
+// without writing anything
+func (s SchemaService) Plan(ctx context.Context) (Plan, error) {
+	namespaceConfigMap, err := s.loadNamespaceConfig(ctx)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	return s.plan(ctx, namespaceConfigMap)
+}
+
+// PlanConfig plans the given resources config as if it was upserted with the
+// name, an empty config plans the currently configured resources instead
+func (s SchemaService) PlanConfig(ctx context.Context, name string, config string) (Plan, error) {
+	if strings.TrimSpace(name) == "" && strings.TrimSpace(config) == "" {
+		return s.Plan(ctx)
+	}
+
+	if s.appConfig.ConfigStorage != RESOURCES_CONFIG_STORAGE_PG {
+		return Plan{}, ErrPlanNotSupported
+	}
+
+	configMap, err := parseResourcesConfig(name, config)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	// the candidate config is only visible inside the transaction which is always rolled back
+	ctx = s.pgRepository.WithTransaction(ctx)
+	if _, err := s.pgRepository.UpsertConfig(ctx, name, configMap); err != nil {
+		_ = s.pgRepository.Rollback(ctx, err)
+		return Plan{}, err
+	}
+
+	plan, err := s.Plan(ctx)
+	if txErr := s.pgRepository.Rollback(ctx, errPlanOnly); txErr != nil && err == nil {
+		return Plan{}, txErr
+	}
+	return plan, err
+}
+
+// Migrate runs the migrations and prunes what was removed from the resources
+// config unless it would remove roles or permissions which are still used by
+// relations, force applies them anyway and deletes the relations of removed roles
+func (s SchemaService) Migrate(ctx context.Context, force bool) (Plan, error) {
+	plan, err := s.Plan(ctx)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	if plan.IsDestructive() && !force {
+		return plan, ErrDestructiveMigration
+	}
+
+	return plan, s.runMigrations(ctx, true, force)
+}
+
+func (s SchemaService) plan(ctx context.Context, namespaceConfigMap NamespaceConfigMapType) (Plan, error) {
+	desired, err := desiredState(namespaceConfigMap)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	var changes []Change
+
+	namespaces, err := s.namespaceService.List(ctx)
+	if err != nil {
+		return Plan{}, err
+	}
+	existingNamespaces := make(map[string]bool)
+	for _, ns := range namespaces {
+		existingNamespaces[ns.ID] = true
+		if !desired.namespaces[ns.ID] {
+			changes = append(changes, Change{Action: ChangeDelete, Entity: EntityNamespace, ID: ns.ID})
+		}
+	}
+	for id := range desired.namespaces {
+		if !existingNamespaces[id] {
+			changes = append(changes, Change{Action: ChangeCreate, Entity: EntityNamespace, ID: id})
+		}
+	}
+
+	roles, err := s.roleService.List(ctx, role.Filter{})
+	if err != nil {
+		return Plan{}, err
+	}
+	existingRoles := make(map[string]bool)
+	var organizationRoles []role.Role
+	for _, r := range roles {
+		existingRoles[r.ID] = true
+		if r.OrgID != "" {
+			organizationRoles = append(organizationRoles, r)
+			continue
+		}
+		principals, ok := desired.roles[r.ID]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Action: ChangeDelete, Entity: EntityRole, ID: r.ID, Detail: strings.Join(r.Types, ", ")})
+		case !sameElements(principals, r.Types):
+			changes = append(changes, Change{Action: ChangeUpdate, Entity: EntityRole, ID: r.ID, Detail: strings.Join(principals, ", ")})
+		}
+	}
+	for id, principals := range desired.roles {
+		if !existingRoles[id] {
+			changes = append(changes, Change{Action: ChangeCreate, Entity: EntityRole, ID: id, Detail: strings.Join(principals, ", ")})
+		}
+	}
+
+	actions, err := s.actionService.List(ctx)
+	if err != nil {
+		return Plan{}, err
+	}
+	existingActions := make(map[string]bool)
+	for _, a := range actions {
+		existingActions[a.ID] = true
+		if _, ok := desired.actions[a.ID]; !ok {
+			changes = append(changes, Change{Action: ChangeDelete, Entity: EntityAction, ID: a.ID, Detail: a.NamespaceID})
+		}
+	}
+	for id, namespaceID := range desired.actions {
+		if !existingActions[id] {
+			changes = append(changes, Change{Action: ChangeCreate, Entity: EntityAction, ID: id, Detail: namespaceID})
+		}
+	}
+
+	policies, err := s.policyService.List(ctx, policy.Filters{})
+	if err != nil {
+		return Plan{}, err
+	}
+	existingPolicies := make(map[string]bool)
+	for _, p := range policies {
+		key := policyKey(p.RoleID, p.NamespaceID, p.ActionID)
+		existingPolicies[key] = true
+		if !desired.policies[key] {
+			changes = append(changes, Change{Action: ChangeDelete, Entity: EntityPolicy, ID: p.ID, Detail: key})
+		}
+	}
+	for key := range desired.policies {
+		if !existingPolicies[key] {
+			changes = append(changes, Change{Action: ChangeCreate, Entity: EntityPolicy, Detail: key})
+		}
+	}
+
+	schemaChanges, err := s.authzEngine.DiffSchema(ctx, withOrganizationRoles(namespaceConfigMap, organizationRoles))
+	if err != nil {
+		return Plan{}, fmt.Errorf("%w: %s", ErrMigration, err.Error())
+	}
+	changes = append(changes, schemaChanges...)
+
+	counter := liveRelationCounter{repository: s.relationRepository, byRole: map[string]int64{}, byNamespace: map[string]int64{}}
+	for i, c := range changes {
+		if c.Action != ChangeDelete {
+			continue
+		}
+		if changes[i].LiveRelations, err = counter.count(ctx, c); err != nil {
+			return Plan{}, err
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Entity != changes[j].Entity {
+			return entityOrder[changes[i].Entity] < entityOrder[changes[j].Entity]
+		}
+		if changes[i].ID != changes[j].ID {
+			return changes[i].ID < changes[j].ID
+		}
+		return changes[i].Detail < changes[j].Detail
+	})
+
+	return Plan{Changes: changes}, nil
+}
+
+var entityOrder = map[string]int{
+	EntityNamespace:   0,
+	EntityRole:        1,
+	EntityAction:      2,
+	EntityPolicy:      3,
+	EntityAuthzSchema: 4,
+}
+
+// state is what RunMigrations would upsert for a namespace config
+type state struct {
+	namespaces map[string]bool
+	// roles maps role ids to their principals
+	roles map[string][]string
+	// actions maps action ids to their namespace
+	actions  map[string]string
+	policies map[string]bool
+}
+
+func desiredState(namespaceConfigMap NamespaceConfigMapType) (state, error) {
+	st := state{
+		namespaces: map[string]bool{},
+		roles:      map[string][]string{},
+		actions:    map[string]string{},
+		policies:   map[string]bool{},
+	}
+
+	for namespaceId, v := range namespaceConfigMap {
+		st.namespaces[namespaceId] = true
+		for roleId, principals := range v.Roles {
+			st.roles[GetRoleID(namespaceId, roleId)] = principals
+		}
+		for _, ins := range v.InheritedNamespaces {
+			st.roles[GetRoleID(namespaceId, ins.Name)] = []string{ins.NamespaceId}
+		}
+		for actionId, roles := range v.Permissions {
+			actionID := fmt.Sprintf("%s.%s", actionId, namespaceId)
+			st.actions[actionID] = namespaceId
+			for _, r := range roles {
+				transformedRole, err := getRoleAndPrincipal(r, namespaceId)
+				if err != nil {
+					return state{}, fmt.Errorf("%w: %s", ErrMigration, err.Error())
+				}
+				roleId := GetRoleID(GetNamespace(transformedRole.NamespaceID), transformedRole.ID)
+				st.policies[policyKey(roleId, namespaceId, actionID)] = true
+			}
+		}
+	}
+
+	return st, nil
+}
+
+func policyKey(roleID, namespaceID, actionID string) string {
+	return fmt.Sprintf("role %s on namespace %s with action %s", roleID, namespaceID, actionID)
+}
+
+func sameElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, v := range a {
+		if !Contains(b, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// liveRelationCounter counts relations depending on removed entities, a removed
+// role or relation is used by relations with that role, a removed action,
+// permission or namespace by every relation on objects of the namespace
+type liveRelationCounter struct {
+	repository  RelationRepository
+	byRole      map[string]int64
+	byNamespace map[string]int64
+}
+
+func (c liveRelationCounter) count(ctx context.Context, change Change) (int64, error) {
+	switch change.Entity {
+	case EntityRole:
+		return c.countByRole(ctx, change.ID)
+	case EntityAction:
+		return c.countByNamespace(ctx, change.Detail)
+	case EntityNamespace:
+		return c.countByNamespace(ctx, change.ID)
+	case EntityAuthzSchema:
+		namespaceID, name, found := strings.Cut(change.ID, "#")
+		if found && strings.HasPrefix(change.Detail, "relation ") {
+			return c.countByRole(ctx, GetRoleID(namespaceID, name))
+		}
+		return c.countByNamespace(ctx, namespaceID)
+	}
+	return 0, nil
+}
+
+func (c liveRelationCounter) countByRole(ctx context.Context, roleID string) (int64, error) {
+	if n, ok := c.byRole[roleID]; ok {
+		return n, nil
+	}
+	n, err := c.repository.CountByRoleID(ctx, roleID)
+	if err != nil {
+		return 0, err
+	}
+	c.byRole[roleID] = n
+	return n, nil
+}
+
+func (c liveRelationCounter) countByNamespace(ctx context.Context, namespaceID string) (int64, error) {
+	if n, ok := c.byNamespace[namespaceID]; ok {
+		return n, nil
+	}
+	n, err := c.repository.CountByObjectNamespaceID(ctx, namespaceID)
+	if err != nil {
+		return 0, err
+	}
+	c.byNamespace[namespaceID] = n
+	return n, nil
+}
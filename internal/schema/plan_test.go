@@ -0,0 +1,311 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/policy"
+	"github.com/goto/shield/core/role"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// stored fakes the repositories and services used by the schema service,
+// deletions are recorded as "<entity> <id>"
+type stored struct {
+	namespaces []namespace.Namespace
+	roles      []role.Role
+	actions    []action.Action
+	policies   []policy.Policy
+	relations  map[string]int64
+	deleted    []string
+	written    NamespaceConfigMapType
+	// schemaChanges are returned when diffing the authz schema
+	schemaChanges []Change
+	versions      []SchemaVersion
+	// config is the configured resources config
+	config NamespaceConfigMapType
+	// writeErr is returned when writing the authz schema
+	writeErr   error
+	committed  bool
+	rolledBack bool
+}
+
+func (s *stored) List(ctx context.Context) ([]namespace.Namespace, error) { return s.namespaces, nil }
+func (s *stored) Upsert(ctx context.Context, ns namespace.Namespace) (namespace.Namespace, error) {
+	return ns, nil
+}
+
+type storedRoles struct{ *stored }
+
+func (s storedRoles) Get(ctx context.Context, id string) (role.Role, error) {
+	for _, r := range s.roles {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return role.Role{}, role.ErrNotExist
+}
+
+func (s storedRoles) List(ctx context.Context, flt role.Filter) ([]role.Role, error) {
+	return s.roles, nil
+}
+
+func (s storedRoles) Upsert(ctx context.Context, r role.Role) (role.Role, error) {
+	s.roles = append(s.roles, r)
+	return r, nil
+}
+
+func (s storedRoles) Delete(ctx context.Context, id string) error {
+	s.deleted = append(s.deleted, "role "+id)
+	return nil
+}
+
+type storedActions struct{ *stored }
+
+func (s storedActions) List(ctx context.Context) ([]action.Action, error) { return s.actions, nil }
+func (s storedActions) Upsert(ctx context.Context, a action.Action) (action.Action, error) {
+	return a, nil
+}
+
+func (s storedActions) Delete(ctx context.Context, id string) error {
+	s.deleted = append(s.deleted, "action "+id)
+	return nil
+}
+
+type storedPolicies struct{ *stored }
+
+func (s storedPolicies) List(ctx context.Context, filter policy.Filters) ([]policy.Policy, error) {
+	return s.policies, nil
+}
+
+func (s storedPolicies) Upsert(ctx context.Context, p *policy.Policy) ([]policy.Policy, error) {
+	return nil, nil
+}
+
+func (s storedPolicies) Delete(ctx context.Context, id string) error {
+	s.deleted = append(s.deleted, "policy "+id)
+	return nil
+}
+
+func (s *stored) CountByRoleID(ctx context.Context, roleID string) (int64, error) {
+	return s.relations[roleID], nil
+}
+
+func (s *stored) CountByObjectNamespaceID(ctx context.Context, namespaceID string) (int64, error) {
+	return s.relations[namespaceID], nil
+}
+
+func (s *stored) DeleteByRoleID(ctx context.Context, roleID string) error {
+	s.deleted = append(s.deleted, "relations "+roleID)
+	return nil
+}
+
+type storedSchema struct{ *stored }
+
+func (s storedSchema) WriteSchema(ctx context.Context, schema NamespaceConfigMapType) (string, error) {
+	if s.writeErr != nil {
+		return "", s.writeErr
+	}
+	s.written = schema
+	return fmt.Sprint(schema), nil
+}
+
+func (s storedSchema) DiffSchema(ctx context.Context, schema NamespaceConfigMapType) ([]Change, error) {
+	return s.schemaChanges, nil
+}
+
+type storedVersions struct{ *stored }
+
+func (s storedVersions) Create(ctx context.Context, version SchemaVersion) (SchemaVersion, error) {
+	version.Version = int64(len(s.versions) + 1)
+	s.versions = append(s.versions, version)
+	return version, nil
+}
+
+func (s storedVersions) Get(ctx context.Context, version int64) (SchemaVersion, error) {
+	for _, v := range s.versions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return SchemaVersion{}, ErrSchemaVersionNotExist
+}
+
+func (s storedVersions) GetLatest(ctx context.Context) (SchemaVersion, error) {
+	if len(s.versions) == 0 {
+		return SchemaVersion{}, ErrSchemaVersionNotExist
+	}
+	return s.versions[len(s.versions)-1], nil
+}
+
+func (s storedVersions) List(ctx context.Context) ([]SchemaVersion, error) {
+	return s.versions, nil
+}
+
+func (s storedSchema) DeleteRelations(ctx context.Context, namespaceID, relationName string) error {
+	s.deleted = append(s.deleted, "authz relations "+namespaceID+"#"+relationName)
+	return nil
+}
+
+type storedTransactor struct{ *stored }
+
+func (s storedTransactor) WithTransaction(ctx context.Context) context.Context { return ctx }
+
+func (s storedTransactor) UpsertConfig(ctx context.Context, name string, config NamespaceConfigMapType) (Config, error) {
+	s.config = config
+	return Config{Name: name}, nil
+}
+
+func (s storedTransactor) Rollback(ctx context.Context, err error) error {
+	s.rolledBack = true
+	return nil
+}
+
+func (s storedTransactor) Commit(ctx context.Context) error {
+	s.committed = true
+	return nil
+}
+
+type storedConfig struct{ *stored }
+
+func (s storedConfig) GetSchema(ctx context.Context) (NamespaceConfigMapType, error) {
+	return s.config, nil
+}
+
+func newStoredSchemaService(st *stored, cfg SchemaMigrationConfig) SchemaService {
+	return SchemaService{
+		logger:                  log.NewNoop(),
+		schemaConfig:            storedConfig{st},
+		pgRepository:            storedTransactor{st},
+		namespaceService:        st,
+		roleService:             storedRoles{st},
+		actionService:           storedActions{st},
+		policyService:           storedPolicies{st},
+		authzEngine:             storedSchema{st},
+		relationRepository:      st,
+		schemaVersionRepository: storedVersions{st},
+		schemaMigrationConfig:   cfg,
+		predefinedNamespaces:    predefinedNamespaces(cfg),
+		namespaceConfigCache:    &namespaceConfigCache{},
+	}
+}
+
+var firehoseConfig = NamespaceConfigMapType{
+	"entropy/firehose": {
+		Type: ResourceGroupNamespace,
+		Roles: map[string][]string{
+			"owner":  {"shield/user", "shield/group"},
+			"viewer": {"shield/user"},
+		},
+		Permissions: map[string][]string{
+			"view": {"owner", "viewer"},
+		},
+	},
+}
+
+func newFirehoseStore() *stored {
+	return &stored{
+		namespaces: []namespace.Namespace{{ID: "entropy/firehose"}},
+		roles: []role.Role{
+			{ID: "entropy/firehose:owner", Name: "owner", NamespaceID: "entropy/firehose", Types: []string{"shield/user"}},
+			{ID: "entropy/firehose:editor", Name: "editor", NamespaceID: "entropy/firehose", Types: []string{"shield/user"}},
+		},
+		actions: []action.Action{
+			{ID: "view.entropy/firehose", NamespaceID: "entropy/firehose"},
+			{ID: "edit.entropy/firehose", NamespaceID: "entropy/firehose"},
+		},
+		policies: []policy.Policy{
+			{ID: "p1", RoleID: "entropy/firehose:owner", NamespaceID: "entropy/firehose", ActionID: "view.entropy/firehose"},
+			{ID: "p2", RoleID: "entropy/firehose:editor", NamespaceID: "entropy/firehose", ActionID: "view.entropy/firehose"},
+		},
+		relations: map[string]int64{"entropy/firehose:editor": 3},
+	}
+}
+
+func TestSchemaService_Plan(t *testing.T) {
+	s := newStoredSchemaService(newFirehoseStore(), SchemaMigrationConfig{})
+
+	plan, err := s.plan(context.Background(), firehoseConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Action: ChangeDelete, Entity: EntityRole, ID: "entropy/firehose:editor", Detail: "shield/user", LiveRelations: 3},
+		{Action: ChangeUpdate, Entity: EntityRole, ID: "entropy/firehose:owner", Detail: "shield/user, shield/group"},
+		{Action: ChangeCreate, Entity: EntityRole, ID: "entropy/firehose:viewer", Detail: "shield/user"},
+		{Action: ChangeDelete, Entity: EntityAction, ID: "edit.entropy/firehose", Detail: "entropy/firehose"},
+		{Action: ChangeCreate, Entity: EntityPolicy, Detail: "role entropy/firehose:viewer on namespace entropy/firehose with action view.entropy/firehose"},
+		{Action: ChangeDelete, Entity: EntityPolicy, ID: "p2", Detail: "role entropy/firehose:editor on namespace entropy/firehose with action view.entropy/firehose"},
+	}, plan.Changes)
+	assert.True(t, plan.IsDestructive())
+	assert.Len(t, plan.Destructive(), 1)
+}
+
+func TestSchemaService_Migrate(t *testing.T) {
+	st := newFirehoseStore()
+	// merging the predefined namespaces modifies the roles of the configured ones
+	st.config = NamespaceConfigMapType{
+		"entropy/firehose": {
+			Type:        ResourceGroupNamespace,
+			Roles:       map[string][]string{"owner": {"shield/user"}},
+			Permissions: map[string][]string{"view": {"owner"}},
+		},
+	}
+	st.roles = append(st.roles, role.Role{ID: "entropy/firehose:deployer", Name: "deployer", NamespaceID: "entropy/firehose"})
+	st.relations["entropy/firehose:deployer"] = 1
+	s := newStoredSchemaService(st, SchemaMigrationConfig{})
+
+	plan, err := s.Migrate(context.Background(), false)
+	assert.ErrorIs(t, err, ErrDestructiveMigration)
+	assert.True(t, plan.IsDestructive())
+	assert.Empty(t, st.deleted)
+	assert.Nil(t, st.written)
+}
+
+func TestSchemaService_Prune(t *testing.T) {
+	t.Run("should delete policies before actions and keep roles which still have relations", func(t *testing.T) {
+		st := newFirehoseStore()
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+
+		assert.NoError(t, s.prune(context.Background(), firehoseConfig, false))
+		assert.Equal(t, []string{
+			"policy p2",
+			"action edit.entropy/firehose",
+		}, st.deleted)
+	})
+
+	t.Run("should delete relations of removed roles if forced", func(t *testing.T) {
+		st := newFirehoseStore()
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+
+		assert.NoError(t, s.prune(context.Background(), firehoseConfig, true))
+		assert.Equal(t, []string{
+			"policy p2",
+			"action edit.entropy/firehose",
+			"authz relations entropy/firehose#editor",
+			"relations entropy/firehose:editor",
+			"role entropy/firehose:editor",
+		}, st.deleted)
+	})
+	t.Run("should keep custom roles of organizations", func(t *testing.T) {
+		st := newFirehoseStore()
+		st.roles = append(st.roles, role.Role{
+			ID:          "entropy/firehose:org_4d7d7b3d5b8a4e3b9d4c6a2f1e0c8b9a_deployer",
+			NamespaceID: "entropy/firehose",
+			OrgID:       "4d7d7b3d-5b8a-4e3b-9d4c-6a2f1e0c8b9a",
+		})
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+
+		assert.NoError(t, s.prune(context.Background(), firehoseConfig, true))
+		assert.NotContains(t, st.deleted, "role entropy/firehose:org_4d7d7b3d5b8a4e3b9d4c6a2f1e0c8b9a_deployer")
+
+		plan, err := s.plan(context.Background(), firehoseConfig)
+		assert.NoError(t, err)
+		for _, c := range plan.Changes {
+			assert.NotEqual(t, "entropy/firehose:org_4d7d7b3d5b8a4e3b9d4c6a2f1e0c8b9a_deployer", c.ID)
+		}
+	})
+}
@@ -7,10 +7,15 @@ const (
 	ProjectNamespace        = "shield/project"
 	GroupNamespace          = "shield/group"
 	ServiceDataKeyNamespace = "shield/servicedata_key"
+	PlatformNamespace       = "shield/platform"
+
+	// PlatformID is the id of the only object of the platform namespace
+	PlatformID = "platform"
 
 	// relation
 	OrganizationRelationName = "organization"
 	ProjectRelationName      = "project"
+	ParentRelationName       = "parent"
 	GroupRelationName        = "group"
 
 	// roles
@@ -19,19 +24,28 @@ const (
 	ViewerRole  = "viewer"
 	ManagerRole = "manager"
 	MemberRole  = "member"
+	AdminRole   = "admin"
+	// ImpersonatorRole can run requests as other users
+	ImpersonatorRole = "impersonator"
 
 	// permissions
 	ViewPermission   = "view"
 	EditPermission   = "edit"
 	DeletePermission = "delete"
+	// AdministerPermission allows managing namespaces, roles, actions,
+	// policies, resources and rules configs
+	AdministerPermission = "administer"
+	// ImpersonatePermission allows acting as users who aren't platform admins
+	ImpersonatePermission = "impersonate"
 
 	// synthetic permission
 	MembershipPermission = "membership"
 
 	// principals
-	UserPrincipal         = "shield/user"
-	GroupPrincipal        = "shield/group"
-	UserPrincipalWildcard = "shield/user:*"
+	UserPrincipal           = "shield/user"
+	GroupPrincipal          = "shield/group"
+	ServiceAccountPrincipal = "shield/serviceaccount"
+	UserPrincipalWildcard   = "shield/user:*"
 )
 
 var InheritedRelations = map[string]bool{
@@ -41,9 +55,9 @@ var InheritedRelations = map[string]bool{
 
 var OrganizationNamespaceConfig = NamespaceConfig{
 	Roles: map[string][]string{
-		OwnerRole:  {UserPrincipal, GroupPrincipal},
-		EditorRole: {UserPrincipal, GroupPrincipal},
-		ViewerRole: {UserPrincipal, GroupPrincipal},
+		OwnerRole:  {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
+		EditorRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
+		ViewerRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
 	},
 	Permissions: map[string][]string{
 		EditPermission: {
@@ -63,9 +77,9 @@ var ProjectNamespaceConfig = NamespaceConfig{
 		},
 	},
 	Roles: map[string][]string{
-		OwnerRole:  {UserPrincipal, GroupPrincipal},
-		EditorRole: {UserPrincipal, GroupPrincipal},
-		ViewerRole: {UserPrincipal, GroupPrincipal},
+		OwnerRole:  {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
+		EditorRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
+		ViewerRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal, UserPrincipalWildcard},
 	},
 	Permissions: map[string][]string{
 		EditPermission: {
@@ -94,7 +108,7 @@ var GroupNamespaceConfig = NamespaceConfig{
 		},
 	},
 	Roles: map[string][]string{
-		MemberRole:  {UserPrincipal},
+		MemberRole:  {UserPrincipal, ServiceAccountPrincipal},
 		ManagerRole: {UserPrincipal},
 	},
 	Permissions: map[string][]string{
@@ -131,9 +145,9 @@ var ServiceDataKeyConfig = NamespaceConfig{
 		},
 	},
 	Roles: map[string][]string{
-		EditorRole: {UserPrincipal, GroupPrincipal, UserPrincipalWildcard},
-		ViewerRole: {UserPrincipal, GroupPrincipal, UserPrincipalWildcard},
-		OwnerRole:  {UserPrincipal, GroupPrincipal},
+		EditorRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal, UserPrincipalWildcard},
+		ViewerRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal, UserPrincipalWildcard},
+		OwnerRole:  {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
 	},
 	Permissions: map[string][]string{
 		EditPermission: {
@@ -160,11 +174,28 @@ var ServiceDataKeyConfig = NamespaceConfig{
 	},
 }
 
+var PlatformNamespaceConfig = NamespaceConfig{
+	Roles: map[string][]string{
+		AdminRole:        {UserPrincipal},
+		ImpersonatorRole: {UserPrincipal},
+	},
+	Permissions: map[string][]string{
+		AdministerPermission: {
+			AdminRole,
+		},
+		ImpersonatePermission: {
+			AdminRole, ImpersonatorRole,
+		},
+	},
+}
+
 var PreDefinedSystemNamespaceConfig = NamespaceConfigMapType{
-	UserPrincipal:         NamespaceConfig{},
-	OrganizationNamespace: OrganizationNamespaceConfig,
-	ProjectNamespace:      ProjectNamespaceConfig,
-	GroupNamespace:        GroupNamespaceConfig,
+	UserPrincipal:           NamespaceConfig{},
+	ServiceAccountPrincipal: NamespaceConfig{},
+	PlatformNamespace:       PlatformNamespaceConfig,
+	OrganizationNamespace:   OrganizationNamespaceConfig,
+	ProjectNamespace:        ProjectNamespaceConfig,
+	GroupNamespace:          GroupNamespaceConfig,
 }
 
 var PreDefinedResourceGroupNamespaceConfig = NamespaceConfig{
@@ -180,9 +211,9 @@ var PreDefinedResourceGroupNamespaceConfig = NamespaceConfig{
 		},
 	},
 	Roles: map[string][]string{
-		OwnerRole:  {UserPrincipal, GroupPrincipal},
-		EditorRole: {UserPrincipal, GroupPrincipal},
-		ViewerRole: {UserPrincipal, GroupPrincipal},
+		OwnerRole:  {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
+		EditorRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal},
+		ViewerRole: {UserPrincipal, GroupPrincipal, ServiceAccountPrincipal, UserPrincipalWildcard},
 	},
 	Permissions: map[string][]string{
 		EditPermission: {
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/goto/shield/core/action"
 	"github.com/goto/shield/core/namespace"
 	"github.com/goto/shield/core/policy"
+	"github.com/goto/shield/core/relation"
 	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/core/user"
 
@@ -23,8 +25,11 @@ var (
 	SystemNamespace        NamespaceType = "system_namespace"
 	ResourceGroupNamespace NamespaceType = "resource_group_namespace"
 
-	ErrMigration     = errors.New("error in migrating authz schema")
-	ErrInvalidDetail = errors.New("error in schema config")
+	ErrMigration        = errors.New("error in migrating authz schema")
+	ErrInvalidDetail    = errors.New("error in schema config")
+	ErrPlanNotSupported = errors.New("planning a resources config is only supported with postgres storage")
+
+	errPlanOnly = errors.New("rolling back planned resources config")
 )
 
 const (
@@ -56,24 +61,35 @@ type NamespaceConfig struct {
 	Type                NamespaceType
 	Roles               map[string][]string
 	Permissions         map[string][]string
+	// PermissionExpressions replaces the union of roles of a permission in the
+	// authz schema, roles of the permission are still used for policies
+	PermissionExpressions map[string]string `json:",omitempty"`
 }
 
 type NamespaceConfigMapType map[string]NamespaceConfig
 
 type NamespaceService interface {
+	List(ctx context.Context) ([]namespace.Namespace, error)
 	Upsert(ctx context.Context, ns namespace.Namespace) (namespace.Namespace, error)
 }
 
 type RoleService interface {
+	Get(ctx context.Context, id string) (role.Role, error)
+	List(ctx context.Context, flt role.Filter) ([]role.Role, error)
 	Upsert(ctx context.Context, toCreate role.Role) (role.Role, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type PolicyService interface {
+	List(ctx context.Context, filter policy.Filters) ([]policy.Policy, error)
 	Upsert(ctx context.Context, policy *policy.Policy) ([]policy.Policy, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type ActionService interface {
+	List(ctx context.Context) ([]action.Action, error)
 	Upsert(ctx context.Context, action action.Action) (action.Action, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type PGRepository interface {
@@ -92,7 +108,21 @@ type FileService interface {
 }
 
 type AuthzEngine interface {
-	WriteSchema(ctx context.Context, schema NamespaceConfigMapType) error
+	// WriteSchema writes the schema generated from the namespace configs and returns it
+	WriteSchema(ctx context.Context, schema NamespaceConfigMapType) (string, error)
+	DiffSchema(ctx context.Context, schema NamespaceConfigMapType) ([]Change, error)
+	DeleteRelations(ctx context.Context, namespaceID, relationName string) error
+}
+
+type RelationRepository interface {
+	CountByRoleID(ctx context.Context, roleID string) (int64, error)
+	CountByObjectNamespaceID(ctx context.Context, namespaceID string) (int64, error)
+	DeleteByRoleID(ctx context.Context, roleID string) error
+}
+
+type RelationService interface {
+	Create(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
+	GetRelationByFields(ctx context.Context, rel relation.RelationV2) (relation.RelationV2, error)
 }
 
 type UserRepository interface {
@@ -106,17 +136,29 @@ type SchemaMigrationConfig struct {
 }
 
 type SchemaService struct {
-	logger                log.Logger
-	appConfig             AppConfig
-	schemaConfig          FileService
-	pgRepository          PGRepository
-	namespaceService      NamespaceService
-	roleService           RoleService
-	actionService         ActionService
-	policyService         PolicyService
-	authzEngine           AuthzEngine
-	userRepository        UserRepository
-	schemaMigrationConfig SchemaMigrationConfig
+	logger                  log.Logger
+	appConfig               AppConfig
+	schemaConfig            FileService
+	pgRepository            PGRepository
+	namespaceService        NamespaceService
+	roleService             RoleService
+	actionService           ActionService
+	policyService           PolicyService
+	authzEngine             AuthzEngine
+	userRepository          UserRepository
+	relationRepository      RelationRepository
+	relationService         RelationService
+	schemaVersionRepository SchemaVersionRepository
+	schemaMigrationConfig   SchemaMigrationConfig
+	predefinedNamespaces    NamespaceConfigMapType
+	namespaceConfigCache    *namespaceConfigCache
+}
+
+// namespaceConfigCache holds the merged namespace configs used on request
+// paths, they are only loaded again once the resources config is upserted
+type namespaceConfigCache struct {
+	mu     sync.Mutex
+	config NamespaceConfigMapType
 }
 
 func NewSchemaMigrationService(
@@ -130,33 +172,60 @@ func NewSchemaMigrationService(
 	policyService PolicyService,
 	authzEngine AuthzEngine,
 	userRepository UserRepository,
+	relationRepository RelationRepository,
+	relationService RelationService,
+	schemaVersionRepository SchemaVersionRepository,
 	schemaMigrationConfig SchemaMigrationConfig,
 ) *SchemaService {
 	return &SchemaService{
-		logger:                logger,
-		appConfig:             appConfig,
-		schemaConfig:          schemaConfig,
-		pgRepository:          pgRepository,
-		namespaceService:      namespaceService,
-		roleService:           roleService,
-		actionService:         actionService,
-		policyService:         policyService,
-		authzEngine:           authzEngine,
-		userRepository:        userRepository,
-		schemaMigrationConfig: schemaMigrationConfig,
+		logger:                  logger,
+		appConfig:               appConfig,
+		schemaConfig:            schemaConfig,
+		pgRepository:            pgRepository,
+		namespaceService:        namespaceService,
+		roleService:             roleService,
+		actionService:           actionService,
+		policyService:           policyService,
+		authzEngine:             authzEngine,
+		userRepository:          userRepository,
+		relationRepository:      relationRepository,
+		relationService:         relationService,
+		schemaVersionRepository: schemaVersionRepository,
+		schemaMigrationConfig:   schemaMigrationConfig,
+		predefinedNamespaces:    predefinedNamespaces(schemaMigrationConfig),
+		namespaceConfigCache:    &namespaceConfigCache{},
+	}
+}
+
+// predefinedNamespaces returns the predefined system namespaces, with the
+// service data key namespace if it is configured to be bootstrapped
+func predefinedNamespaces(cfg SchemaMigrationConfig) NamespaceConfigMapType {
+	predefined := maps.Clone(PreDefinedSystemNamespaceConfig)
+	if cfg.BootstrapServiceDataKey {
+		predefined[ServiceDataKeyNamespace] = ServiceDataKeyConfig
 	}
+	return predefined
 }
 
+// RunMigrations upserts the resources config without deleting anything removed
+// from it, such entities are only pruned by Migrate after checking the plan
 func (s SchemaService) RunMigrations(ctx context.Context) error {
+	return s.runMigrations(ctx, false, false)
+}
+
+// runMigrations upserts the resources config, prune deletes what was removed
+// from it and force deletes the relations of removed roles along with them
+func (s SchemaService) runMigrations(ctx context.Context, prune, force bool) error {
 	defaultUser := user.User{
 		Name:  s.schemaMigrationConfig.DefaultSystemEmail,
 		Email: s.schemaMigrationConfig.DefaultSystemEmail,
 	}
 
-	if _, err := s.userRepository.GetByEmail(ctx, defaultUser.Email); err != nil {
+	fetchedUser, err := s.userRepository.GetByEmail(ctx, defaultUser.Email)
+	if err != nil {
 		// creating predefined user for log activity if user not exist
 		if err == user.ErrNotExist {
-			if _, err := s.userRepository.Create(ctx, defaultUser); err != nil {
+			if fetchedUser, err = s.userRepository.Create(ctx, defaultUser); err != nil {
 				return err
 			}
 		} else {
@@ -164,8 +233,9 @@ func (s SchemaService) RunMigrations(ctx context.Context) error {
 			return err
 		}
 	}
+	defaultUser = fetchedUser
 
-	namespaceConfigMap, err := s.schemaConfig.GetSchema(ctx)
+	namespaceConfigMap, err := s.loadNamespaceConfig(ctx)
 	if err != nil {
 		return err
 	}
@@ -173,23 +243,6 @@ func (s SchemaService) RunMigrations(ctx context.Context) error {
 	// setting context with predefined user email
 	ctx = user.SetContextWithEmail(ctx, defaultUser.Email)
 
-	// append service data key schema if configured to be bootstrapped automatically
-	if s.schemaMigrationConfig.BootstrapServiceDataKey {
-		PreDefinedSystemNamespaceConfig[ServiceDataKeyNamespace] = ServiceDataKeyConfig
-	}
-
-	// combining predefined and configured namespaces
-	namespaceConfigMap = MergeNamespaceConfigMap(namespaceConfigMap, PreDefinedSystemNamespaceConfig)
-
-	// adding predefined roles and permissions for resource group namespaces
-	for n, nc := range namespaceConfigMap {
-		if nc.Type == ResourceGroupNamespace {
-			namespaceConfigMap = MergeNamespaceConfigMap(namespaceConfigMap, NamespaceConfigMapType{
-				n: PreDefinedResourceGroupNamespaceConfig,
-			})
-		}
-	}
-
 	// spiceDBSchema := GenerateSchema(namespaceConfigMap)
 
 	// iterate over namespace
@@ -284,35 +337,194 @@ func (s SchemaService) RunMigrations(ctx context.Context) error {
 		}
 	}
 
-	if err = s.authzEngine.WriteSchema(ctx, namespaceConfigMap); err != nil {
+	if prune {
+		if err = s.prune(ctx, namespaceConfigMap, force); err != nil {
+			return fmt.Errorf("%w: %s", ErrMigration, err.Error())
+		}
+	}
+
+	if err = s.writeSchema(ctx, namespaceConfigMap); err != nil {
+		return fmt.Errorf("%w: %s", ErrMigration, err.Error())
+	}
+
+	if err = s.bootstrapPlatformAdmin(ctx, defaultUser.ID); err != nil {
 		return fmt.Errorf("%w: %s", ErrMigration, err.Error())
 	}
 
 	return nil
 }
 
-func (s SchemaService) UpsertConfig(ctx context.Context, name string, config string) (Config, error) {
-	if strings.TrimSpace(name) == "" {
-		return Config{}, ErrInvalidDetail
+// bootstrapPlatformAdmin makes the default system user a platform admin, so
+// that there is always someone able to grant the role to others
+func (s SchemaService) bootstrapPlatformAdmin(ctx context.Context, userID string) error {
+	adminRelation := relation.RelationV2{
+		Object: relation.Object{
+			ID:          PlatformID,
+			NamespaceID: PlatformNamespace,
+		},
+		Subject: relation.Subject{
+			ID:        userID,
+			Namespace: UserPrincipal,
+			RoleID:    AdminRole,
+		},
 	}
 
-	if strings.TrimSpace(config) == "" {
-		return Config{}, ErrInvalidDetail
+	_, err := s.relationService.GetRelationByFields(ctx, adminRelation)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, relation.ErrNotExist) {
+		return err
 	}
 
-	resourceConfig, err := ParseConfigYaml([]byte(config))
+	s.logger.Info(fmt.Sprintf("add %s as platform admin", s.schemaMigrationConfig.DefaultSystemEmail))
+	_, err = s.relationService.Create(ctx, adminRelation)
+	return err
+}
+
+// prune deletes policies, actions and roles which are no longer in the resources
+// config, policies go first as they reference both actions and roles. Roles which
+// still have relations are kept unless forced, which deletes those relations.
+func (s SchemaService) prune(ctx context.Context, namespaceConfigMap NamespaceConfigMapType, force bool) error {
+	desired, err := desiredState(namespaceConfigMap)
 	if err != nil {
-		return Config{}, ErrInvalidDetail
+		return err
 	}
 
-	configMap := make(NamespaceConfigMapType)
-	for k, v := range resourceConfig {
-		if v.Type == "resource_group" {
-			configMap = MergeNamespaceConfigMap(configMap, GetNamespacesForResourceGroup(k, v))
-		} else {
-			configMap = MergeNamespaceConfigMap(GetNamespaceFromConfig(k, v.Roles, v.Permissions), configMap)
+	policies, err := s.policyService.List(ctx, policy.Filters{})
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		if desired.policies[policyKey(p.RoleID, p.NamespaceID, p.ActionID)] {
+			continue
+		}
+		s.logger.Info(fmt.Sprintf("delete policy for role %s on namespace %s with action %s", p.RoleID, p.NamespaceID, p.ActionID))
+		if err := s.policyService.Delete(ctx, p.ID); err != nil {
+			return err
+		}
+	}
+
+	actions, err := s.actionService.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range actions {
+		if _, ok := desired.actions[a.ID]; ok {
+			continue
+		}
+		s.logger.Info(fmt.Sprintf("delete action %s under namespace %s", a.ID, a.NamespaceID))
+		if err := s.actionService.Delete(ctx, a.ID); err != nil {
+			return err
+		}
+	}
+
+	roles, err := s.roleService.List(ctx, role.Filter{})
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		// custom roles of organizations are not part of the resources config
+		if _, ok := desired.roles[r.ID]; ok || r.OrgID != "" {
+			continue
+		}
+
+		count, err := s.relationRepository.CountByRoleID(ctx, r.ID)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			if !force {
+				s.logger.Warn(fmt.Sprintf("keep role %s removed from resources config, it still has %d relations", r.ID, count))
+				continue
+			}
+
+			s.logger.Info(fmt.Sprintf("delete %d relations of role %s", count, r.ID))
+			if err := s.authzEngine.DeleteRelations(ctx, r.NamespaceID, r.Name); err != nil {
+				return err
+			}
+			if err := s.relationRepository.DeleteByRoleID(ctx, r.ID); err != nil {
+				return err
+			}
+		}
+
+		s.logger.Info(fmt.Sprintf("delete role %s under namespace %s", r.ID, r.NamespaceID))
+		if err := s.roleService.Delete(ctx, r.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// namespaceConfig returns the cached namespace configs, it loads them if the
+// cache is empty. The returned map is shared and must not be modified.
+func (s SchemaService) namespaceConfig(ctx context.Context) (NamespaceConfigMapType, error) {
+	s.namespaceConfigCache.mu.Lock()
+	defer s.namespaceConfigCache.mu.Unlock()
+
+	if s.namespaceConfigCache.config == nil {
+		namespaceConfigMap, err := s.loadNamespaceConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.namespaceConfigCache.config = namespaceConfigMap
+	}
+	return s.namespaceConfigCache.config, nil
+}
+
+// resetNamespaceConfig empties the cache so the namespace configs are loaded
+// again on their next use
+func (s SchemaService) resetNamespaceConfig() {
+	s.namespaceConfigCache.mu.Lock()
+	defer s.namespaceConfigCache.mu.Unlock()
+
+	s.namespaceConfigCache.config = nil
+}
+
+// loadNamespaceConfig combines the configured namespaces with the predefined ones
+func (s SchemaService) loadNamespaceConfig(ctx context.Context) (NamespaceConfigMapType, error) {
+	namespaceConfigMap, err := s.schemaConfig.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// combining predefined and configured namespaces
+	namespaceConfigMap = MergeNamespaceConfigMap(namespaceConfigMap, s.predefinedNamespaces)
+
+	// adding predefined roles and permissions for resource group namespaces
+	for n, nc := range namespaceConfigMap {
+		if nc.Type == ResourceGroupNamespace {
+			namespaceConfigMap = MergeNamespaceConfigMap(namespaceConfigMap, NamespaceConfigMapType{
+				n: PreDefinedResourceGroupNamespaceConfig,
+			})
+		}
+	}
+
+	return namespaceConfigMap, nil
+}
+
+// ParentType returns the namespace the parent of resources of the namespace has
+// to belong to, it is empty if the resource type doesn't declare a parent
+func (s SchemaService) ParentType(ctx context.Context, namespaceID string) (string, error) {
+	namespaceConfigMap, err := s.namespaceConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, ins := range namespaceConfigMap[namespaceID].InheritedNamespaces {
+		if ins.Name == ParentRelationName {
+			return ins.NamespaceId, nil
 		}
 	}
+	return "", nil
+}
+
+func (s SchemaService) UpsertConfig(ctx context.Context, name string, config string) (Config, error) {
+	configMap, err := parseResourcesConfig(name, config)
+	if err != nil {
+		return Config{}, err
+	}
 
 	ctx = s.pgRepository.WithTransaction(ctx)
 
@@ -325,7 +537,8 @@ func (s SchemaService) UpsertConfig(ctx context.Context, name string, config str
 	}
 
 	if s.appConfig.ConfigStorage == RESOURCES_CONFIG_STORAGE_PG {
-		if err := s.RunMigrations(ctx); err != nil {
+		// destructive changes are only applied with a forced migration
+		if _, err := s.Migrate(ctx, false); err != nil {
 			if txErr := s.pgRepository.Rollback(ctx, err); txErr != nil {
 				return Config{}, err
 			}
@@ -337,10 +550,37 @@ func (s SchemaService) UpsertConfig(ctx context.Context, name string, config str
 	if err != nil {
 		return Config{}, err
 	}
+	s.resetNamespaceConfig()
 
 	return res, nil
 }
 
+func parseResourcesConfig(name string, config string) (NamespaceConfigMapType, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, ErrInvalidDetail
+	}
+
+	if strings.TrimSpace(config) == "" {
+		return nil, ErrInvalidDetail
+	}
+
+	resourceConfig, err := ParseConfigYaml([]byte(config))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDetail, err.Error())
+	}
+
+	configMap := make(NamespaceConfigMapType)
+	for k, v := range resourceConfig {
+		if v.Type == "resource_group" {
+			configMap = MergeNamespaceConfigMap(configMap, GetNamespacesForResourceGroup(k, v))
+		} else {
+			configMap = MergeNamespaceConfigMap(GetNamespaceFromConfig(k, v.Roles, v.Permissions), configMap)
+		}
+	}
+
+	return configMap, nil
+}
+
 func MergeNamespaceConfigMap(smallMap, largeMap NamespaceConfigMapType) NamespaceConfigMapType {
 	combinedMap := make(NamespaceConfigMapType)
 	maps.Copy(combinedMap, smallMap)
@@ -367,6 +607,7 @@ func MergeNamespaceConfigMap(smallMap, largeMap NamespaceConfigMapType) Namespac
 		if value, ok := combinedMap[namespaceName]; ok {
 			value.Type = namespaceConfig.Type
 			value.InheritedNamespaces = AppendIfUnique(value.InheritedNamespaces, namespaceConfig.InheritedNamespaces)
+			value.PermissionExpressions = mergeExpressions(value.PermissionExpressions, namespaceConfig.PermissionExpressions)
 			combinedMap[namespaceName] = value
 		}
 	}
@@ -374,6 +615,18 @@ func MergeNamespaceConfigMap(smallMap, largeMap NamespaceConfigMapType) Namespac
 	return combinedMap
 }
 
+// mergeExpressions keeps the expressions of the first map over the second one
+func mergeExpressions(first, second map[string]string) map[string]string {
+	if len(second) == 0 {
+		return first
+	}
+
+	merged := make(map[string]string)
+	maps.Copy(merged, second)
+	maps.Copy(merged, first)
+	return merged
+}
+
 func NewSchemaMigrationConfig(defaultSystemEmail string, bootstrapServiceDataKey bool) SchemaMigrationConfig {
 	return SchemaMigrationConfig{
 		DefaultSystemEmail:      defaultSystemEmail,
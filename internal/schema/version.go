@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	ErrSchemaVersionNotExist = errors.New("schema version doesn't exist")
+	ErrDestructiveRollback   = errors.New("rollback removes definitions or relations which still have relations")
+)
+
+// SchemaVersion is an authz schema written to the authz engine along with the
+// namespace config it was generated from
+type SchemaVersion struct {
+	Version   int64
+	Schema    string
+	Config    NamespaceConfigMapType
+	CreatedAt time.Time
+}
+
+type SchemaVersionRepository interface {
+	Create(ctx context.Context, version SchemaVersion) (SchemaVersion, error)
+	Get(ctx context.Context, version int64) (SchemaVersion, error)
+	GetLatest(ctx context.Context) (SchemaVersion, error)
+	List(ctx context.Context) ([]SchemaVersion, error)
+}
+
+func (s SchemaService) ListSchemaVersions(ctx context.Context) ([]SchemaVersion, error) {
+	return s.schemaVersionRepository.List(ctx)
+}
+
+// RollbackSchema writes the authz schema of a previous version, it is refused if
+// definitions or relations it removes still have relations. Only the authz
+// schema is restored, namespaces, roles, actions and policies are left as is.
+func (s SchemaService) RollbackSchema(ctx context.Context, version int64) (Plan, error) {
+	target, err := s.schemaVersionRepository.Get(ctx, version)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	changes, err := s.authzEngine.DiffSchema(ctx, target.Config)
+	if err != nil {
+		return Plan{}, fmt.Errorf("%w: %s", ErrMigration, err.Error())
+	}
+
+	counter := liveRelationCounter{repository: s.relationRepository, byRole: map[string]int64{}, byNamespace: map[string]int64{}}
+	for i, c := range changes {
+		if c.Action != ChangeDelete {
+			continue
+		}
+		if changes[i].LiveRelations, err = counter.count(ctx, c); err != nil {
+			return Plan{}, err
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].ID != changes[j].ID {
+			return changes[i].ID < changes[j].ID
+		}
+		return changes[i].Detail < changes[j].Detail
+	})
+
+	plan := Plan{Changes: changes}
+	if plan.IsDestructive() {
+		return plan, ErrDestructiveRollback
+	}
+
+	if err := s.writeVersionedSchema(ctx, target.Config); err != nil {
+		return plan, err
+	}
+	return plan, nil
+}
+
+// writeVersionedSchema writes the authz schema and stores it as a new version
+// unless it is the same as the latest one
+func (s SchemaService) writeVersionedSchema(ctx context.Context, namespaceConfigMap NamespaceConfigMapType) error {
+	written, err := s.authzEngine.WriteSchema(ctx, namespaceConfigMap)
+	if err != nil {
+		return err
+	}
+
+	latest, err := s.schemaVersionRepository.GetLatest(ctx)
+	switch {
+	case errors.Is(err, ErrSchemaVersionNotExist):
+	case err != nil:
+		return err
+	case latest.Schema == written:
+		return nil
+	}
+
+	created, err := s.schemaVersionRepository.Create(ctx, SchemaVersion{
+		Schema: written,
+		Config: namespaceConfigMap,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info(fmt.Sprintf("stored authz schema version %d", created.Version))
+	return nil
+}
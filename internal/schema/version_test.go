@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaService_WriteVersionedSchema(t *testing.T) {
+	st := newFirehoseStore()
+	s := newStoredSchemaService(st, SchemaMigrationConfig{})
+
+	assert.NoError(t, s.writeVersionedSchema(context.Background(), firehoseConfig))
+	assert.NoError(t, s.writeVersionedSchema(context.Background(), firehoseConfig))
+	assert.Len(t, st.versions, 1, "unchanged schema should not be stored again")
+
+	assert.NoError(t, s.writeVersionedSchema(context.Background(), NamespaceConfigMapType{}))
+	assert.Len(t, st.versions, 2)
+	assert.Equal(t, firehoseConfig, st.versions[0].Config)
+}
+
+func TestSchemaService_RollbackSchema(t *testing.T) {
+	t.Run("should return error if version doesn't exist", func(t *testing.T) {
+		s := newStoredSchemaService(newFirehoseStore(), SchemaMigrationConfig{})
+
+		_, err := s.RollbackSchema(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrSchemaVersionNotExist)
+	})
+
+	t.Run("should refuse to remove relations which still have relations", func(t *testing.T) {
+		st := newFirehoseStore()
+		st.versions = []SchemaVersion{{Version: 1, Schema: "v1", Config: firehoseConfig}}
+		st.schemaChanges = []Change{{Action: ChangeDelete, Entity: EntityAuthzSchema, ID: "entropy/firehose#editor", Detail: "relation editor"}}
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+
+		plan, err := s.RollbackSchema(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrDestructiveRollback)
+		assert.Equal(t, int64(3), plan.Changes[0].LiveRelations)
+		assert.Nil(t, st.written)
+		assert.Len(t, st.versions, 1)
+	})
+
+	t.Run("should write the schema of the version as a new version", func(t *testing.T) {
+		st := newFirehoseStore()
+		st.versions = []SchemaVersion{
+			{Version: 1, Schema: "v1", Config: firehoseConfig},
+			{Version: 2, Schema: "v2", Config: NamespaceConfigMapType{}},
+		}
+		st.schemaChanges = []Change{{Action: ChangeCreate, Entity: EntityAuthzSchema, ID: "entropy/firehose#viewer", Detail: "relation viewer"}}
+		s := newStoredSchemaService(st, SchemaMigrationConfig{})
+
+		plan, err := s.RollbackSchema(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Len(t, plan.Changes, 1)
+		assert.Equal(t, firehoseConfig, st.written)
+		assert.Len(t, st.versions, 3)
+		assert.Equal(t, firehoseConfig, st.versions[2].Config)
+	})
+}
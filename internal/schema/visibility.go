@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var ErrPermissionNotPublic = errors.New("permission can't be made public as none of its roles allow every user")
+
+// PublicRole returns the role of the namespace through which every user is
+// granted the permission when it is related with the user wildcard. Out of the
+// roles allowing the wildcard the one granting the fewest permissions is picked,
+// so making a resource publicly viewable doesn't make it editable as well.
+func (s SchemaService) PublicRole(ctx context.Context, namespaceID, permission string) (string, error) {
+	namespaceConfigMap, err := s.namespaceConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return publicRole(namespaceConfigMap[namespaceID], permission, namespaceID)
+}
+
+func publicRole(nc NamespaceConfig, permission, namespaceID string) (string, error) {
+	selected := ""
+	fewestPermissions := 0
+	for _, r := range nc.Permissions[permission] {
+		// roles inherited through another namespace can't be related with users
+		if strings.Contains(r, ":") || !slices.Contains(nc.Roles[r], UserPrincipalWildcard) {
+			continue
+		}
+
+		granted := 0
+		for _, roles := range nc.Permissions {
+			if slices.Contains(roles, r) {
+				granted++
+			}
+		}
+		if selected == "" || granted < fewestPermissions || (granted == fewestPermissions && r < selected) {
+			selected = r
+			fewestPermissions = granted
+		}
+	}
+
+	if selected == "" {
+		return "", fmt.Errorf("%w: %s#%s", ErrPermissionNotPublic, namespaceID, permission)
+	}
+	return selected, nil
+}
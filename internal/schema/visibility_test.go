@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		nc         NamespaceConfig
+		permission string
+		want       string
+		wantErr    error
+	}{
+		{
+			name:       "should pick the viewer role of a project for view",
+			nc:         ProjectNamespaceConfig,
+			permission: ViewPermission,
+			want:       ViewerRole,
+		},
+		{
+			name:       "should pick the role granting the fewest permissions",
+			nc:         ServiceDataKeyConfig,
+			permission: ViewPermission,
+			want:       ViewerRole,
+		},
+		{
+			name:       "should pick the only role allowing the wildcard",
+			nc:         ServiceDataKeyConfig,
+			permission: EditPermission,
+			want:       EditorRole,
+		},
+		{
+			name:       "should return an error if no role of the permission allows the wildcard",
+			nc:         ProjectNamespaceConfig,
+			permission: EditPermission,
+			wantErr:    ErrPermissionNotPublic,
+		},
+		{
+			name:       "should return an error for an unknown permission",
+			nc:         OrganizationNamespaceConfig,
+			permission: ViewPermission,
+			wantErr:    ErrPermissionNotPublic,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := publicRole(tt.nc, tt.permission, "shield/test")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/goto/shield/internal/server/grpc_interceptors"
+)
+
+func newCallerAuthenticator(cfg Config) (*grpc_interceptors.CallerAuthenticator, error) {
+	// client certificates are only verified when the API is served over TLS
+	if tlsCfg := cfg.Authentication.TLS; tlsCfg.ClientCAFile != "" && (tlsCfg.CertFile == "" || tlsCfg.KeyFile == "") {
+		return nil, errors.New("client ca is configured but cert file or key file is not")
+	}
+
+	var upstreams []grpc_interceptors.TrustedUpstream
+	for _, upstreamCfg := range cfg.Authentication.TrustedUpstreams {
+		if upstreamCfg.Secret == "" && upstreamCfg.CommonName == "" {
+			return nil, fmt.Errorf("trusted upstream %s needs a secret or a common name", upstreamCfg.Name)
+		}
+		if upstreamCfg.CommonName != "" && cfg.Authentication.TLS.ClientCAFile == "" {
+			return nil, fmt.Errorf("trusted upstream %s is identified by a client certificate but client ca is not configured", upstreamCfg.Name)
+		}
+
+		secret, err := readSecret(upstreamCfg.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("trusted upstream %s: %w", upstreamCfg.Name, err)
+		}
+		if upstreamCfg.Secret != "" && secret == "" {
+			return nil, fmt.Errorf("trusted upstream %s: secret %s is empty", upstreamCfg.Name, upstreamCfg.Secret)
+		}
+		upstreams = append(upstreams, grpc_interceptors.TrustedUpstream{
+			Name:       upstreamCfg.Name,
+			Secret:     secret,
+			CommonName: upstreamCfg.CommonName,
+		})
+	}
+
+	var jwtVerifier *grpc_interceptors.JWTVerifier
+	if jwtCfg := cfg.Authentication.JWT; jwtCfg.PublicKeyPath != "" {
+		key, err := readRSAPublicKey(jwtCfg.PublicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		jwtVerifier = grpc_interceptors.NewJWTVerifier(key, jwtCfg.Issuer, jwtCfg.Audience, jwtCfg.EmailClaim)
+	}
+
+	return grpc_interceptors.NewCallerAuthenticator(cfg.IdentityProxyHeader, cfg.Authentication.SecretHeader, upstreams, jwtVerifier), nil
+}
+
+// newServerTLSConfig returns the tls config of the gRPC server, client
+// certificates are optional and verified against the client ca
+func newServerTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		caPEM, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in client ca %s", cfg.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
+func readRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	keyPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no public key found in %s", path)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key in %s is not an rsa key", path)
+	}
+	return rsaKey, nil
+}
+
+// readSecret reads secrets in the same format as the ruleset secret, e.g.
+// "env://NAME", "file:///path" or "val://value". Everything after the scheme
+// is used as is, so values may contain characters such as ":", "@" or "/"
+func readSecret(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	scheme, value, ok := strings.Cut(secret, "://")
+	if !ok {
+		return "", fmt.Errorf(`unsupported secret %s, possible schemes supported: "env:// file:// val://"`, secret)
+	}
+	switch scheme {
+	case "env":
+		envValue, ok := os.LookupEnv(value)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", value)
+		}
+		return envValue, nil
+	case "file":
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(content)), nil
+	case "val":
+		return value, nil
+	default:
+		return "", fmt.Errorf(`unsupported secret %s, possible schemes supported: "env:// file:// val://"`, secret)
+	}
+}
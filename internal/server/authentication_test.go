@@ -0,0 +1,46 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSecret(t *testing.T) {
+	t.Setenv("SHIELD_TEST_UPSTREAM_SECRET", "s3cr3t")
+
+	tests := []struct {
+		name    string
+		secret  string
+		want    string
+		wantErr bool
+	}{
+		{name: "should return empty secret if not configured", secret: "", want: ""},
+		{name: "should read secret from environment variable", secret: "env://SHIELD_TEST_UPSTREAM_SECRET", want: "s3cr3t"},
+		{name: "should return error if environment variable is not set", secret: "env://SHIELD_TEST_UNSET_SECRET", wantErr: true},
+		{name: "should keep value as is", secret: "val://user:p@ss/word", want: "user:p@ss/word"},
+		{name: "should return error for unsupported scheme", secret: "vault://secret", wantErr: true},
+		{name: "should return error without scheme", secret: "secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readSecret(tt.secret)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewCallerAuthenticator(t *testing.T) {
+	t.Setenv("SHIELD_TEST_EMPTY_SECRET", "")
+
+	_, err := newCallerAuthenticator(Config{Authentication: AuthenticationConfig{
+		TrustedUpstreams: []TrustedUpstreamConfig{{Name: "gateway", Secret: "env://SHIELD_TEST_EMPTY_SECRET"}},
+	}})
+	assert.Error(t, err)
+}
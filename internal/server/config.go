@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/goto/shield/internal/store/inmemory"
 )
@@ -18,6 +19,62 @@ type ServiceDataConfig struct {
 	DefaultServiceDataProject string `yaml:"default_service_data_project" mapstructure:"default_service_data_project" default:"system"`
 }
 
+type InvitationConfig struct {
+	// Expiry is how long invitations can be accepted for
+	Expiry time.Duration `yaml:"expiry" mapstructure:"expiry" default:"168h"`
+}
+
+type AccessRequestConfig struct {
+	// ExpiryCheckInterval is how often roles granted until an expiry are checked and revoked
+	ExpiryCheckInterval time.Duration `yaml:"expiry_check_interval" mapstructure:"expiry_check_interval" default:"1m"`
+}
+
+// AuthenticationConfig verifies the callers of the admin API before trusting the
+// identity they assert, by default the identity header of every caller is trusted
+type AuthenticationConfig struct {
+	Enabled bool `yaml:"enabled" mapstructure:"enabled"`
+
+	// SecretHeader carries the pre-shared secret of trusted upstreams
+	SecretHeader string `yaml:"secret_header" mapstructure:"secret_header" default:"X-Shield-Upstream-Secret"`
+
+	// TrustedUpstreams are the callers allowed to assert the identity of users
+	// with the identity header, e.g. gateways which authenticate users themselves
+	TrustedUpstreams []TrustedUpstreamConfig `yaml:"trusted_upstreams" mapstructure:"trusted_upstreams"`
+
+	JWT JWTConfig `yaml:"jwt" mapstructure:"jwt"`
+
+	TLS TLSConfig `yaml:"tls" mapstructure:"tls"`
+}
+
+type TrustedUpstreamConfig struct {
+	Name string `yaml:"name" mapstructure:"name"`
+
+	// Secret is the pre-shared secret sent by the upstream in the secret header,
+	// in the same format as the ruleset secret
+	Secret string `yaml:"secret" mapstructure:"secret"`
+
+	// CommonName of the client certificate of the upstream, requires mTLS
+	CommonName string `yaml:"common_name" mapstructure:"common_name"`
+}
+
+// JWTConfig verifies RS256 signed bearer tokens of users, tokens are only
+// accepted if a public key is configured
+type JWTConfig struct {
+	// PublicKeyPath is a path of the PEM encoded RSA public key of the issuer
+	PublicKeyPath string `yaml:"public_key_path" mapstructure:"public_key_path"`
+	Issuer        string `yaml:"issuer" mapstructure:"issuer"`
+	Audience      string `yaml:"audience" mapstructure:"audience"`
+	EmailClaim    string `yaml:"email_claim" mapstructure:"email_claim" default:"email"`
+}
+
+// TLSConfig serves the gRPC API over TLS, client certificates signed by the
+// client CA identify trusted upstreams
+type TLSConfig struct {
+	CertFile     string `yaml:"cert_file" mapstructure:"cert_file"`
+	KeyFile      string `yaml:"key_file" mapstructure:"key_file"`
+	ClientCAFile string `yaml:"client_ca_file" mapstructure:"client_ca_file"`
+}
+
 func (cfg Config) grpcAddr() string { return fmt.Sprintf("%s:%d", cfg.Host, cfg.GRPC.Port) }
 
 type Config struct {
@@ -70,4 +127,10 @@ type Config struct {
 	CacheConfig inmemory.Config `yaml:"cache" mapstructure:"cache"`
 
 	InactiveEmailTag string `yaml:"inactive_email_tag" mapstructure:"inactive_email_tag" default:"inactive"`
+
+	Authentication AuthenticationConfig `yaml:"authentication" mapstructure:"authentication"`
+
+	Invitation InvitationConfig `yaml:"invitation" mapstructure:"invitation"`
+
+	AccessRequest AccessRequestConfig `yaml:"access_request" mapstructure:"access_request"`
 }
@@ -0,0 +1,206 @@
+package grpc_interceptors
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	"golang.org/x/oauth2/jws"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidToken      = errors.New("invalid token")
+	ErrUntrustedUpstream = errors.New("caller isn't allowed to assert identity")
+)
+
+// TrustedUpstream is a caller allowed to assert the identity of users, it is
+// identified by the pre-shared secret or the common name of its client
+// certificate
+type TrustedUpstream struct {
+	Name       string
+	Secret     string
+	CommonName string
+}
+
+// JWTVerifier verifies RS256 signed tokens and returns the email of the user
+type JWTVerifier struct {
+	key        *rsa.PublicKey
+	issuer     string
+	audience   string
+	emailClaim string
+}
+
+func NewJWTVerifier(key *rsa.PublicKey, issuer, audience, emailClaim string) *JWTVerifier {
+	return &JWTVerifier{
+		key:        key,
+		issuer:     issuer,
+		audience:   audience,
+		emailClaim: emailClaim,
+	}
+}
+
+func (v JWTVerifier) Verify(token string, now time.Time) (string, error) {
+	if err := jws.Verify(token, v.key); err != nil {
+		return "", ErrInvalidToken
+	}
+
+	parts := strings.Split(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	claims := map[string]any{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", ErrInvalidToken
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok || !now.Before(time.Unix(int64(exp), 0)) {
+		return "", ErrInvalidToken
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Before(time.Unix(int64(nbf), 0)) {
+		return "", ErrInvalidToken
+	}
+	if v.issuer != "" && claims["iss"] != v.issuer {
+		return "", ErrInvalidToken
+	}
+	if v.audience != "" && !hasAudience(claims["aud"], v.audience) {
+		return "", ErrInvalidToken
+	}
+
+	email, _ := claims[v.emailClaim].(string)
+	if email == "" {
+		return "", ErrInvalidToken
+	}
+	return email, nil
+}
+
+func hasAudience(claim any, audience string) bool {
+	switch aud := claim.(type) {
+	case string:
+		return aud == audience
+	case []any:
+		for _, a := range aud {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CallerAuthenticator verifies the caller of a request before trusting the
+// identity it asserts. Users authenticate with a JWT, service accounts with an
+// api key, and only trusted upstreams may assert an identity in the identity
+// header.
+type CallerAuthenticator struct {
+	identityHeader string
+	secretHeader   string
+	upstreams      []TrustedUpstream
+	jwtVerifier    *JWTVerifier
+}
+
+func NewCallerAuthenticator(identityHeader, secretHeader string, upstreams []TrustedUpstream, jwtVerifier *JWTVerifier) *CallerAuthenticator {
+	return &CallerAuthenticator{
+		identityHeader: identityHeader,
+		secretHeader:   secretHeader,
+		upstreams:      upstreams,
+		jwtVerifier:    jwtVerifier,
+	}
+}
+
+// Identify returns the email of the user of the request, it is empty for
+// requests without a user and for api keys which are authenticated by
+// AuthenticateServiceAccount
+func (a CallerAuthenticator) Identify(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	token := bearerToken(md)
+	if strings.HasPrefix(token, serviceaccount.KeyPrefix) {
+		return "", nil
+	}
+	if token != "" && a.jwtVerifier != nil {
+		return a.jwtVerifier.Verify(token, time.Now())
+	}
+
+	var email string
+	if values := md.Get(a.identityHeader); len(values) > 0 {
+		email = values[0]
+	}
+	if email == "" {
+		return "", nil
+	}
+	if _, ok := a.trustedUpstream(ctx, md); !ok {
+		return "", ErrUntrustedUpstream
+	}
+	return email, nil
+}
+
+func (a CallerAuthenticator) trustedUpstream(ctx context.Context, md metadata.MD) (TrustedUpstream, bool) {
+	var secret string
+	if values := md.Get(a.secretHeader); len(values) > 0 {
+		secret = values[0]
+	}
+	commonName := clientCommonName(ctx)
+
+	for _, upstream := range a.upstreams {
+		if upstream.Secret != "" && secret != "" &&
+			subtle.ConstantTimeCompare([]byte(upstream.Secret), []byte(secret)) == 1 {
+			return upstream, true
+		}
+		if upstream.CommonName != "" && upstream.CommonName == commonName {
+			return upstream, true
+		}
+	}
+	return TrustedUpstream{}, false
+}
+
+// clientCommonName returns the common name of the verified client certificate
+// of the connection
+func clientCommonName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+func bearerToken(md metadata.MD) string {
+	for _, value := range md.Get("authorization") {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):])
+		}
+	}
+	return ""
+}
+
+// AuthenticateCaller enriches the ctx with the identity of the caller like
+// EnrichCtxWithIdentity, but only after verifying the caller
+func AuthenticateCaller(authenticator *CallerAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		email, err := authenticator.Identify(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		ctx = user.SetContextWithEmail(ctx, email)
+		return handler(ctx, req)
+	}
+}
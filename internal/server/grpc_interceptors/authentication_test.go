@@ -0,0 +1,149 @@
+package grpc_interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2/jws"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	testIdentityHeader = "x-shield-email"
+	testSecretHeader   = "x-shield-upstream-secret"
+)
+
+func TestCallerAuthenticator_Identify(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	signToken := func(t *testing.T, key *rsa.PrivateKey, claims jws.ClaimSet) string {
+		t.Helper()
+		token, err := jws.Encode(&jws.Header{Algorithm: "RS256", Typ: "JWT"}, &claims, key)
+		assert.NoError(t, err)
+		return token
+	}
+	validClaims := jws.ClaimSet{
+		Iss:           "https://issuer.example.com",
+		Aud:           "shield",
+		Exp:           time.Now().Add(time.Hour).Unix(),
+		PrivateClaims: map[string]interface{}{"email": "jwt@example.com"},
+	}
+	expiredClaims := validClaims
+	expiredClaims.Iat = time.Now().Add(-2 * time.Hour).Unix()
+	expiredClaims.Exp = time.Now().Add(-time.Hour).Unix()
+	notYetValidClaims := validClaims
+	notYetValidClaims.PrivateClaims = map[string]interface{}{"email": "jwt@example.com", "nbf": time.Now().Add(time.Minute * 30).Unix()}
+	otherAudienceClaims := validClaims
+	otherAudienceClaims.Aud = "other"
+
+	authenticator := NewCallerAuthenticator(testIdentityHeader, testSecretHeader,
+		[]TrustedUpstream{
+			{Name: "gateway", Secret: "gateway-secret"},
+			{Name: "frontend", CommonName: "frontend.internal"},
+		},
+		NewJWTVerifier(&key.PublicKey, "https://issuer.example.com", "shield", "email"),
+	)
+
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		commonName string
+		want       string
+		wantErr    error
+	}{
+		{
+			name: "should trust the identity asserted by an upstream with the secret",
+			md:   metadata.Pairs(testIdentityHeader, "user@example.com", testSecretHeader, "gateway-secret"),
+			want: "user@example.com",
+		},
+		{
+			name:       "should trust the identity asserted by an upstream with the client certificate",
+			md:         metadata.Pairs(testIdentityHeader, "user@example.com"),
+			commonName: "frontend.internal",
+			want:       "user@example.com",
+		},
+		{
+			name:    "should not trust the identity asserted by an unknown caller",
+			md:      metadata.Pairs(testIdentityHeader, "user@example.com"),
+			wantErr: ErrUntrustedUpstream,
+		},
+		{
+			name:    "should not trust the identity asserted with a wrong secret",
+			md:      metadata.Pairs(testIdentityHeader, "user@example.com", testSecretHeader, "wrong"),
+			wantErr: ErrUntrustedUpstream,
+		},
+		{
+			name:       "should not trust a client certificate of another upstream",
+			md:         metadata.Pairs(testIdentityHeader, "user@example.com"),
+			commonName: "other.internal",
+			wantErr:    ErrUntrustedUpstream,
+		},
+		{
+			name: "should let requests without an identity through",
+			md:   metadata.Pairs(),
+			want: "",
+		},
+		{
+			name: "should return the email of a valid jwt",
+			md:   metadata.Pairs("authorization", "Bearer "+signToken(t, key, validClaims)),
+			want: "jwt@example.com",
+		},
+		{
+			name:    "should not accept an expired jwt",
+			md:      metadata.Pairs("authorization", "Bearer "+signToken(t, key, expiredClaims)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "should not accept a jwt before its not before time",
+			md:      metadata.Pairs("authorization", "Bearer "+signToken(t, key, notYetValidClaims)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "should not accept a jwt of another audience",
+			md:      metadata.Pairs("authorization", "Bearer "+signToken(t, key, otherAudienceClaims)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "should not accept a jwt signed by another key",
+			md:      metadata.Pairs("authorization", "Bearer "+signToken(t, otherKey, validClaims)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "should leave api keys to the service account authentication",
+			md:   metadata.Pairs("authorization", "Bearer shk_key.secret", testIdentityHeader, "user@example.com"),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			if tt.commonName != "" {
+				ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+					VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: tt.commonName}}}},
+				}}})
+			}
+
+			got, err := authenticator.Identify(ctx)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
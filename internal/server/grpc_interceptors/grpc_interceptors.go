@@ -2,13 +2,23 @@ package grpc_interceptors
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/platform"
+	"github.com/goto/shield/core/serviceaccount"
 	"github.com/goto/shield/core/user"
+	errpkg "github.com/goto/shield/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+const bearerPrefix = "Bearer "
+
 func EnrichCtxWithIdentity(identityHeader string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -26,3 +36,129 @@ func EnrichCtxWithIdentity(identityHeader string) grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+type ServiceAccountAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (serviceaccount.ServiceAccount, error)
+}
+
+// AuthenticateServiceAccount authenticates service accounts by the api key in
+// the authorization metadata, value should be "Bearer <key>". Requests of an
+// authenticated service account don't have a user identity.
+func AuthenticateServiceAccount(authenticator ServiceAccountAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		// other bearer tokens aren't api keys of service accounts
+		token := bearerToken(md)
+		if !strings.HasPrefix(token, serviceaccount.KeyPrefix) {
+			return handler(ctx, req)
+		}
+
+		serviceAccount, err := authenticator.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, serviceaccount.ErrInvalidKey) {
+				return nil, status.Error(codes.Unauthenticated, "invalid api key")
+			}
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		ctx = user.SetContextWithEmail(ctx, "")
+		ctx = serviceaccount.SetContextWithServiceAccount(ctx, serviceAccount)
+		return handler(ctx, req)
+	}
+}
+
+type PlatformAdminChecker interface {
+	CheckAdmin(ctx context.Context) error
+}
+
+// PlatformAdminMethods mutate namespaces, roles, actions, policies, resources
+// and rules configs which are shared by every organization
+var PlatformAdminMethods = []string{
+	"/gotocompany.shield.v1beta1.ShieldService/CreateRole",
+	"/gotocompany.shield.v1beta1.ShieldService/UpdateRole",
+	"/gotocompany.shield.v1beta1.ShieldService/CreateAction",
+	"/gotocompany.shield.v1beta1.ShieldService/UpdateAction",
+	"/gotocompany.shield.v1beta1.ShieldService/CreateNamespace",
+	"/gotocompany.shield.v1beta1.ShieldService/UpdateNamespace",
+	"/gotocompany.shield.v1beta1.ShieldService/CreatePolicy",
+	"/gotocompany.shield.v1beta1.ShieldService/UpdatePolicy",
+	"/gotocompany.shield.v1beta1.ShieldService/UpsertResourcesConfig",
+	"/gotocompany.shield.v1beta1.ShieldService/UpsertRulesConfig",
+}
+
+// RequirePlatformAdmin only lets platform admins call the methods, it has to
+// run after the identity of the caller is in the ctx
+func RequirePlatformAdmin(checker PlatformAdminChecker, methods []string) grpc.UnaryServerInterceptor {
+	restricted := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		restricted[method] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if !restricted[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		if err := checker.CheckAdmin(ctx); err != nil {
+			switch {
+			case errors.Is(err, errpkg.ErrForbidden):
+				return nil, status.Error(codes.PermissionDenied, errpkg.ErrForbidden.Error())
+			case errors.Is(err, user.ErrInvalidEmail),
+				errors.Is(err, user.ErrMissingEmail):
+				return nil, status.Error(codes.Unauthenticated, errpkg.ErrUnauthenticated.Error())
+			default:
+				return nil, status.Error(codes.Internal, "internal server error")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+type Impersonator interface {
+	Impersonate(ctx context.Context, email string) (user.User, user.User, error)
+}
+
+// ActAs runs the request as the user of the act as metadata if the current
+// user is allowed to impersonate them, activities of the request record the
+// current user as the impersonator
+func ActAs(impersonator Impersonator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		var email string
+		if values := md.Get(platform.ActAsHeader); len(values) > 0 {
+			email = strings.TrimSpace(values[0])
+		}
+		if email == "" {
+			return handler(ctx, req)
+		}
+
+		if _, ok := serviceaccount.GetFromContext(ctx); ok {
+			return nil, status.Error(codes.PermissionDenied, "service accounts can't impersonate users")
+		}
+
+		impersonatorUser, impersonatedUser, err := impersonator.Impersonate(ctx, email)
+		if err != nil {
+			switch {
+			case errors.Is(err, platform.ErrImpersonateAdmin):
+				return nil, status.Error(codes.PermissionDenied, platform.ErrImpersonateAdmin.Error())
+			case errors.Is(err, errpkg.ErrForbidden):
+				return nil, status.Error(codes.PermissionDenied, errpkg.ErrForbidden.Error())
+			case errors.Is(err, user.ErrNotExist):
+				return nil, status.Error(codes.NotFound, user.ErrNotExist.Error())
+			case errors.Is(err, user.ErrInvalidEmail),
+				errors.Is(err, user.ErrMissingEmail):
+				return nil, status.Error(codes.Unauthenticated, errpkg.ErrUnauthenticated.Error())
+			default:
+				return nil, status.Error(codes.Internal, "internal server error")
+			}
+		}
+
+		ctx = user.SetContextWithEmail(ctx, impersonatedUser.Email)
+		ctx = activity.SetContextWithImpersonator(ctx, activity.Actor{ID: impersonatorUser.ID, Email: impersonatorUser.Email})
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,196 @@
+package grpc_interceptors
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/goto/shield/core/activity"
+	"github.com/goto/shield/core/platform"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/core/user"
+	errpkg "github.com/goto/shield/pkg/errors"
+	shieldv1beta1 "github.com/goto/shield/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type platformAdminCheckerFunc func(ctx context.Context) error
+
+func (f platformAdminCheckerFunc) CheckAdmin(ctx context.Context) error {
+	return f(ctx)
+}
+
+type impersonatorFunc func(ctx context.Context, email string) (user.User, user.User, error)
+
+func (f impersonatorFunc) Impersonate(ctx context.Context, email string) (user.User, user.User, error) {
+	return f(ctx, email)
+}
+
+func TestRequirePlatformAdmin(t *testing.T) {
+	t.Parallel()
+
+	const restrictedMethod = "/gotocompany.shield.v1beta1.ShieldService/CreateNamespace"
+
+	tests := []struct {
+		name     string
+		method   string
+		checkErr error
+		wantCode codes.Code
+	}{
+		{
+			name:     "should let platform admins call restricted methods",
+			method:   restrictedMethod,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "should deny restricted methods to other users",
+			method:   restrictedMethod,
+			checkErr: errpkg.ErrForbidden,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "should deny restricted methods to unauthenticated callers",
+			method:   restrictedMethod,
+			checkErr: user.ErrMissingEmail,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "should not check callers of other methods",
+			method:   "/gotocompany.shield.v1beta1.ShieldService/ListNamespaces",
+			checkErr: errpkg.ErrForbidden,
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checker := platformAdminCheckerFunc(func(ctx context.Context) error { return tt.checkErr })
+			interceptor := RequirePlatformAdmin(checker, PlatformAdminMethods)
+
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestPlatformAdminMethods(t *testing.T) {
+	t.Parallel()
+
+	// configs shared by every organization
+	sharedConfigs := []string{"Role", "Action", "Namespace", "Policy", "ResourcesConfig", "RulesConfig"}
+	mutations := []string{"Create", "Update", "Upsert", "Delete"}
+
+	methods := []string{"UpdateRole", "UpdateAction", "UpdatePolicy"}
+	for _, method := range shieldv1beta1.ShieldService_ServiceDesc.Methods {
+		methods = append(methods, method.MethodName)
+	}
+
+	checker := platformAdminCheckerFunc(func(ctx context.Context) error { return errpkg.ErrForbidden })
+	interceptor := RequirePlatformAdmin(checker, PlatformAdminMethods)
+	for _, method := range methods {
+		for _, mutation := range mutations {
+			if !slices.Contains(sharedConfigs, strings.TrimPrefix(method, mutation)) {
+				continue
+			}
+
+			fullMethod := fmt.Sprintf("/%s/%s", shieldv1beta1.ShieldService_ServiceDesc.ServiceName, method)
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: fullMethod},
+				func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), "%s is not restricted to platform admins", fullMethod)
+		}
+	}
+}
+
+func TestActAs(t *testing.T) {
+	t.Parallel()
+
+	supportUser := user.User{ID: "support-id", Email: "support@example.com"}
+	impersonatedUser := user.User{ID: "user-id", Email: "user@example.com"}
+
+	tests := []struct {
+		name             string
+		md               metadata.MD
+		serviceAccount   bool
+		impersonateErr   error
+		wantCode         codes.Code
+		wantEmail        string
+		wantImpersonator bool
+	}{
+		{
+			name:             "should run the request as the impersonated user",
+			md:               metadata.Pairs(platform.ActAsHeader, impersonatedUser.Email),
+			wantCode:         codes.OK,
+			wantEmail:        impersonatedUser.Email,
+			wantImpersonator: true,
+		},
+		{
+			name:      "should run the request as the current user without act as",
+			md:        metadata.Pairs(),
+			wantCode:  codes.OK,
+			wantEmail: supportUser.Email,
+		},
+		{
+			name:           "should deny users without the impersonate permission",
+			md:             metadata.Pairs(platform.ActAsHeader, impersonatedUser.Email),
+			impersonateErr: errpkg.ErrForbidden,
+			wantCode:       codes.PermissionDenied,
+		},
+		{
+			name:           "should deny impersonating platform admins",
+			md:             metadata.Pairs(platform.ActAsHeader, impersonatedUser.Email),
+			impersonateErr: platform.ErrImpersonateAdmin,
+			wantCode:       codes.PermissionDenied,
+		},
+		{
+			name:           "should deny service accounts",
+			md:             metadata.Pairs(platform.ActAsHeader, impersonatedUser.Email),
+			serviceAccount: true,
+			wantCode:       codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := ActAs(impersonatorFunc(func(ctx context.Context, email string) (user.User, user.User, error) {
+				if tt.impersonateErr != nil {
+					return user.User{}, user.User{}, tt.impersonateErr
+				}
+				return supportUser, impersonatedUser, nil
+			}))
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			ctx = user.SetContextWithEmail(ctx, supportUser.Email)
+			if tt.serviceAccount {
+				ctx = serviceaccount.SetContextWithServiceAccount(ctx, serviceaccount.ServiceAccount{ID: "sa-id"})
+			}
+
+			var email string
+			var impersonator activity.Actor
+			var hasImpersonator bool
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				email, _ = user.GetEmailFromContext(ctx)
+				impersonator, hasImpersonator = activity.GetImpersonatorFromContext(ctx)
+				return nil, nil
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantEmail, email)
+			assert.Equal(t, tt.wantImpersonator, hasImpersonator)
+			if tt.wantImpersonator {
+				assert.Equal(t, activity.Actor{ID: supportUser.ID, Email: supportUser.Email}, impersonator)
+			}
+		})
+	}
+}
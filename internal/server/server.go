@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/goto/salt/log"
 	"github.com/goto/salt/mux"
+	"github.com/goto/shield/core/platform"
 	"github.com/goto/shield/internal/api"
 	"github.com/goto/shield/internal/api/v1beta1"
 	"github.com/goto/shield/internal/server/grpc_interceptors"
@@ -26,6 +28,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -41,13 +44,39 @@ func Serve(
 ) error {
 	httpMux := http.NewServeMux()
 
+	// the identity header is trusted as is unless callers are authenticated
+	identityInterceptor := grpc_interceptors.EnrichCtxWithIdentity(cfg.IdentityProxyHeader)
+	if cfg.Authentication.Enabled {
+		callerAuthenticator, err := newCallerAuthenticator(cfg)
+		if err != nil {
+			return err
+		}
+		identityInterceptor = grpc_interceptors.AuthenticateCaller(callerAuthenticator)
+	}
+
+	grpcServerOpts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		getGRPCMiddleware(logger, nrApp, identityInterceptor, deps.ServiceAccountService, deps.PlatformService),
+	}
+	gatewayCredentials := insecure.NewCredentials()
+	if cfg.Authentication.TLS.CertFile != "" {
+		tlsConfig, err := newServerTLSConfig(cfg.Authentication.TLS)
+		if err != nil {
+			return err
+		}
+		grpcServerOpts = append(grpcServerOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		// the gateway dials the server of this process, without a client
+		// certificate it can't assert identities by itself
+		gatewayCredentials = credentials.NewTLS(&tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS12})
+	}
+
 	grpcDialCtx, grpcDialCancel := context.WithTimeout(ctx, time.Second*5)
 	defer grpcDialCancel()
 
 	grpcConn, err := grpc.DialContext(
 		grpcDialCtx,
 		cfg.grpcAddr(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(gatewayCredentials),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
 			grpc.MaxCallSendMsgSize(cfg.GRPC.MaxSendMsgSize),
@@ -58,7 +87,7 @@ func Serve(
 
 	grpcGateway := runtime.NewServeMux(
 		runtime.WithHealthEndpointAt(grpc_health_v1.NewHealthClient(grpcConn), "/ping"),
-		runtime.WithIncomingHeaderMatcher(customHeaderMatcherFunc(map[string]bool{cfg.IdentityProxyHeader: true})),
+		runtime.WithIncomingHeaderMatcher(customHeaderMatcherFunc(map[string]bool{cfg.IdentityProxyHeader: true, cfg.Authentication.SecretHeader: true, platform.ActAsHeader: true})),
 	)
 
 	httpMux.Handle("/admin/", http.StripPrefix("/admin", grpcGateway))
@@ -69,7 +98,7 @@ func Serve(
 
 	grpcServiceDataGateway := runtime.NewServeMux(
 		runtime.WithHealthEndpointAt(grpc_health_v1.NewHealthClient(grpcConn), "/ping"),
-		runtime.WithIncomingHeaderMatcher(customHeaderMatcherFunc(map[string]bool{cfg.IdentityProxyHeader: true})),
+		runtime.WithIncomingHeaderMatcher(customHeaderMatcherFunc(map[string]bool{cfg.IdentityProxyHeader: true, cfg.Authentication.SecretHeader: true, platform.ActAsHeader: true})),
 	)
 
 	httpMux.Handle(fmt.Sprintf("%s/", cfg.PublicAPIPrefix), http.StripPrefix(cfg.PublicAPIPrefix, grpcServiceDataGateway))
@@ -82,10 +111,7 @@ func Serve(
 		return err
 	}
 
-	grpcServer := grpc.NewServer(
-		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		getGRPCMiddleware(cfg, logger, nrApp),
-	)
+	grpcServer := grpc.NewServer(grpcServerOpts...)
 	reflection.Register(grpcServer)
 
 	healthHandler := health.NewHandler()
@@ -126,8 +152,12 @@ func Serve(
 	return nil
 }
 
-// REVISIT: passing config.Shield as reference
-func getGRPCMiddleware(cfg Config, logger log.Logger, nrApp *newrelic.Application) grpc.ServerOption {
+type PlatformService interface {
+	grpc_interceptors.PlatformAdminChecker
+	grpc_interceptors.Impersonator
+}
+
+func getGRPCMiddleware(logger log.Logger, nrApp *newrelic.Application, identityInterceptor grpc.UnaryServerInterceptor, serviceAccountService grpc_interceptors.ServiceAccountAuthenticator, platformService PlatformService) grpc.ServerOption {
 	recoveryFunc := func(p interface{}) (err error) {
 		fmt.Println("-----------------------------")
 		return status.Errorf(codes.Internal, "internal server error")
@@ -144,8 +174,11 @@ func getGRPCMiddleware(cfg Config, logger log.Logger, nrApp *newrelic.Applicatio
 	}
 	return grpc.UnaryInterceptor(
 		grpc_middleware.ChainUnaryServer(
-			grpc_interceptors.EnrichCtxWithIdentity(cfg.IdentityProxyHeader),
+			identityInterceptor,
+			grpc_interceptors.AuthenticateServiceAccount(serviceAccountService),
+			grpc_interceptors.ActAs(platformService),
 			grpc_zap.UnaryServerInterceptor(grpcZapLogger.Desugar()),
+			grpc_interceptors.RequirePlatformAdmin(platformService, grpc_interceptors.PlatformAdminMethods),
 			grpc_recovery.UnaryServerInterceptor(grpcRecoveryOpts...),
 			grpc_ctxtags.UnaryServerInterceptor(),
 			nrgrpc.UnaryServerInterceptor(nrApp),
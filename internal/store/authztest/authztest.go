@@ -0,0 +1,336 @@
+// Package authztest is a conformance suite for relation.AuthzRepository
+// implementations. It writes the predefined namespaces with a resource
+// namespace to the authz engine, adds a fixed set of relations and checks the
+// results of every method against them.
+package authztest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/internal/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ResourceNamespace is configured with the predefined resource group config
+const ResourceNamespace = "entropy/firehose"
+
+// Backend is an authz engine under test, every test gets a new backend with
+// nothing written to it
+type Backend struct {
+	Repository relation.AuthzRepository
+	Engine     schema.AuthzEngine
+}
+
+// Namespaces returns the predefined namespaces along with a resource namespace
+func Namespaces() schema.NamespaceConfigMapType {
+	return schema.NamespaceConfigMapType{
+		schema.UserPrincipal:           {},
+		schema.OrganizationNamespace:   schema.OrganizationNamespaceConfig,
+		schema.ProjectNamespace:        schema.ProjectNamespaceConfig,
+		schema.GroupNamespace:          schema.GroupNamespaceConfig,
+		schema.ServiceDataKeyNamespace: schema.ServiceDataKeyConfig,
+		ResourceNamespace:              schema.PreDefinedResourceGroupNamespaceConfig,
+	}
+}
+
+// Relations are added to every backend before it is tested
+var Relations = []relation.RelationV2{
+	newRelation(schema.OrganizationNamespace, "org1", schema.OwnerRole, schema.UserPrincipal, "alice"),
+	newRelation(schema.OrganizationNamespace, "org1", schema.ViewerRole, schema.GroupPrincipal, "group1"),
+	newRelation(schema.GroupNamespace, "group1", schema.MemberRole, schema.UserPrincipal, "bob"),
+	newRelation(schema.GroupNamespace, "group1", schema.ManagerRole, schema.UserPrincipal, "carol"),
+	newRelation(schema.ProjectNamespace, "project1", schema.OrganizationRelationName, schema.OrganizationNamespace, "org1"),
+	newRelation(schema.ProjectNamespace, "project1", schema.EditorRole, schema.UserPrincipal, "dave"),
+	newRelation(ResourceNamespace, "firehose1", schema.OrganizationRelationName, schema.OrganizationNamespace, "org1"),
+	newRelation(ResourceNamespace, "firehose1", schema.ProjectRelationName, schema.ProjectNamespace, "project1"),
+	newRelation(ResourceNamespace, "firehose2", schema.ProjectRelationName, schema.ProjectNamespace, "project1"),
+	newRelation(ResourceNamespace, "firehose2", schema.OwnerRole, schema.UserPrincipal, "erin"),
+	newRelation(schema.ServiceDataKeyNamespace, "key1", schema.ViewerRole, schema.UserPrincipal, "*"),
+	newRelation(schema.ServiceDataKeyNamespace, "key2", schema.OwnerRole, schema.UserPrincipal, "alice"),
+}
+
+func newRelation(objectNamespace, objectID, roleName, subjectNamespace, subjectID string) relation.RelationV2 {
+	return relation.RelationV2{
+		Object:  relation.Object{ID: objectID, NamespaceID: objectNamespace},
+		Subject: relation.Subject{ID: subjectID, Namespace: subjectNamespace, RoleID: schema.GetRoleID(objectNamespace, roleName)},
+	}
+}
+
+// UserCheck is the relation checked for a user on an object
+func UserCheck(objectNamespace, objectID, userID string) relation.Relation {
+	return relation.Relation{
+		ObjectNamespace:  namespace.Namespace{ID: objectNamespace},
+		ObjectID:         objectID,
+		SubjectNamespace: namespace.Namespace{ID: schema.UserPrincipal},
+		SubjectID:        userID,
+	}
+}
+
+// Run runs the conformance suite against backends built by newBackend
+func Run(t *testing.T, newBackend func(t *testing.T) Backend) {
+	setup := func(t *testing.T) relation.AuthzRepository {
+		t.Helper()
+
+		backend := newBackend(t)
+		_, err := backend.Engine.WriteSchema(context.Background(), Namespaces())
+		require.NoError(t, err)
+		for _, rel := range Relations {
+			require.NoError(t, backend.Repository.AddV2(context.Background(), rel))
+		}
+		return backend.Repository
+	}
+
+	t.Run("Check", func(t *testing.T) { testCheck(t, setup(t)) })
+	t.Run("BulkCheck", func(t *testing.T) { testBulkCheck(t, setup(t)) })
+	t.Run("CheckIsPublic", func(t *testing.T) { testCheckIsPublic(t, setup(t)) })
+	t.Run("LookupResources", func(t *testing.T) { testLookupResources(t, setup(t)) })
+	t.Run("DeleteSubjectRelations", func(t *testing.T) { testDeleteSubjectRelations(t, setup(t)) })
+}
+
+func testCheck(t *testing.T, repository relation.AuthzRepository) {
+	tests := []struct {
+		description string
+		rel         relation.Relation
+		permission  string
+		want        bool
+	}{
+		{
+			description: "should allow a role of the object",
+			rel:         UserCheck(schema.OrganizationNamespace, "org1", "alice"),
+			permission:  schema.EditPermission,
+			want:        true,
+		},
+		{
+			description: "should allow members of a group with a role",
+			rel:         UserCheck(schema.OrganizationNamespace, "org1", "bob"),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should not allow a permission the role of the group doesn't grant",
+			rel:         UserCheck(schema.OrganizationNamespace, "org1", "bob"),
+			permission:  schema.EditPermission,
+			want:        false,
+		},
+		{
+			description: "should allow membership to members and managers of a group",
+			rel:         UserCheck(schema.GroupNamespace, "group1", "carol"),
+			permission:  schema.MembershipPermission,
+			want:        true,
+		},
+		{
+			description: "should not allow members to edit a group",
+			rel:         UserCheck(schema.GroupNamespace, "group1", "bob"),
+			permission:  schema.EditPermission,
+			want:        false,
+		},
+		{
+			description: "should allow roles of the organization on a project",
+			rel:         UserCheck(schema.ProjectNamespace, "project1", "alice"),
+			permission:  schema.DeletePermission,
+			want:        true,
+		},
+		{
+			description: "should allow roles of the organization on a resource",
+			rel:         UserCheck(ResourceNamespace, "firehose1", "bob"),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should allow roles of the project on a resource",
+			rel:         UserCheck(ResourceNamespace, "firehose2", "dave"),
+			permission:  schema.EditPermission,
+			want:        true,
+		},
+		{
+			description: "should not allow a permission the role of the project doesn't grant",
+			rel:         UserCheck(ResourceNamespace, "firehose2", "dave"),
+			permission:  schema.DeletePermission,
+			want:        false,
+		},
+		{
+			description: "should not allow users without relations",
+			rel:         UserCheck(ResourceNamespace, "firehose1", "erin"),
+			permission:  schema.ViewPermission,
+			want:        false,
+		},
+		{
+			description: "should allow any user through a wildcard",
+			rel:         UserCheck(schema.ServiceDataKeyNamespace, "key1", "frank"),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should not allow a permission the wildcard doesn't grant",
+			rel:         UserCheck(schema.ServiceDataKeyNamespace, "key1", "frank"),
+			permission:  schema.EditPermission,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := repository.Check(context.Background(), tt.rel, action.Action{ID: tt.permission})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func testBulkCheck(t *testing.T, repository relation.AuthzRepository) {
+	tests := []struct {
+		description string
+		rels        []relation.Relation
+		acts        []action.Action
+		want        []relation.Permission
+		wantErr     error
+	}{
+		{
+			description: "should return an error if relations and actions don't match",
+			rels:        []relation.Relation{UserCheck(ResourceNamespace, "firehose1", "alice")},
+			wantErr:     relation.ErrInvalidDetail,
+		},
+		{
+			description: "should check every relation with the action at the same index",
+			rels: []relation.Relation{
+				UserCheck(ResourceNamespace, "firehose1", "alice"),
+				UserCheck(ResourceNamespace, "firehose2", "alice"),
+				UserCheck(ResourceNamespace, "firehose2", "erin"),
+			},
+			acts: []action.Action{{ID: schema.DeletePermission}, {ID: schema.ViewPermission}, {ID: schema.DeletePermission}},
+			want: []relation.Permission{
+				{ObjectID: "firehose1", ObjectNamespace: ResourceNamespace, Permission: schema.DeletePermission, Allowed: true},
+				{ObjectID: "firehose2", ObjectNamespace: ResourceNamespace, Permission: schema.ViewPermission, Allowed: false},
+				{ObjectID: "firehose2", ObjectNamespace: ResourceNamespace, Permission: schema.DeletePermission, Allowed: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := repository.BulkCheck(context.Background(), tt.rels, tt.acts)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func testCheckIsPublic(t *testing.T, repository relation.AuthzRepository) {
+	tests := []struct {
+		description string
+		rel         relation.Relation
+		permission  string
+		want        bool
+	}{
+		{
+			description: "should be public if a wildcard grants the permission",
+			rel:         UserCheck(schema.ServiceDataKeyNamespace, "key1", ""),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should not be public if the wildcard doesn't grant the permission",
+			rel:         UserCheck(schema.ServiceDataKeyNamespace, "key1", ""),
+			permission:  schema.EditPermission,
+			want:        false,
+		},
+		{
+			description: "should not be public if only users have the permission",
+			rel:         UserCheck(schema.ServiceDataKeyNamespace, "key2", ""),
+			permission:  schema.ViewPermission,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := repository.CheckIsPublic(context.Background(), tt.rel, action.Action{ID: tt.permission})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func testLookupResources(t *testing.T, repository relation.AuthzRepository) {
+	tests := []struct {
+		description  string
+		resourceType string
+		permission   string
+		subjectID    string
+		want         []string
+	}{
+		{
+			description:  "should return resources through roles of the organization",
+			resourceType: ResourceNamespace,
+			permission:   schema.ViewPermission,
+			subjectID:    "bob",
+			want:         []string{"firehose1"},
+		},
+		{
+			description:  "should return resources through roles of the project",
+			resourceType: ResourceNamespace,
+			permission:   schema.EditPermission,
+			subjectID:    "dave",
+			want:         []string{"firehose1", "firehose2"},
+		},
+		{
+			description:  "should return groups of members",
+			resourceType: schema.GroupNamespace,
+			permission:   schema.MembershipPermission,
+			subjectID:    "bob",
+			want:         []string{"group1"},
+		},
+		{
+			description:  "should return resources public through a wildcard",
+			resourceType: schema.ServiceDataKeyNamespace,
+			permission:   schema.ViewPermission,
+			subjectID:    "frank",
+			want:         []string{"key1"},
+		},
+		{
+			description:  "should return nothing if the subject has no relation",
+			resourceType: ResourceNamespace,
+			permission:   schema.ViewPermission,
+			subjectID:    "frank",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := repository.LookupResources(context.Background(), tt.resourceType, tt.permission, schema.UserPrincipal, tt.subjectID)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func testDeleteSubjectRelations(t *testing.T, repository relation.AuthzRepository) {
+	ctx := context.Background()
+	view := action.Action{ID: schema.ViewPermission}
+
+	require.NoError(t, repository.DeleteSubjectRelations(ctx, ResourceNamespace, "firehose1"))
+
+	allowed, err := repository.Check(ctx, UserCheck(ResourceNamespace, "firehose1", "alice"), view)
+	assert.NoError(t, err)
+	assert.False(t, allowed, "relations of the resource should be deleted")
+
+	allowed, err = repository.Check(ctx, UserCheck(ResourceNamespace, "firehose2", "erin"), view)
+	assert.NoError(t, err)
+	assert.True(t, allowed, "relations of other resources should be kept")
+
+	allowed, err = repository.Check(ctx, UserCheck(schema.OrganizationNamespace, "org1", "alice"), view)
+	assert.NoError(t, err)
+	assert.True(t, allowed, "relations of the organization should be kept")
+
+	require.NoError(t, repository.DeleteSubjectRelations(ctx, ResourceNamespace, ""))
+
+	allowed, err = repository.Check(ctx, UserCheck(ResourceNamespace, "firehose2", "erin"), view)
+	assert.NoError(t, err)
+	assert.False(t, allowed, "relations of every resource of the namespace should be deleted")
+}
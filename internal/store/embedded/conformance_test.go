@@ -0,0 +1,52 @@
+package embedded
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/internal/store/authztest"
+)
+
+// tableRelationRepository stores relations in the relations table before adding
+// them to the engine and deletes them after, as the relation service does
+type tableRelationRepository struct {
+	*RelationRepository
+	table *relationTable
+}
+
+func (r tableRelationRepository) AddV2(ctx context.Context, rel relation.RelationV2) error {
+	r.table.relations = append(r.table.relations, rel)
+	return r.RelationRepository.AddV2(ctx, rel)
+}
+
+func (r tableRelationRepository) DeleteV2(ctx context.Context, rel relation.RelationV2) error {
+	if err := r.RelationRepository.DeleteV2(ctx, rel); err != nil {
+		return err
+	}
+	r.table.delete(func(stored relation.RelationV2) bool { return stored == rel })
+	return nil
+}
+
+func TestConformance(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		authztest.Run(t, func(t *testing.T) authztest.Backend {
+			engine := New(NewMemoryTupleStore(), nil)
+			return authztest.Backend{
+				Repository: NewRelationRepository(engine),
+				Engine:     NewPolicyRepository(engine),
+			}
+		})
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		authztest.Run(t, func(t *testing.T) authztest.Backend {
+			table := &relationTable{}
+			engine := New(NewPostgresTupleStore(table), nil)
+			return authztest.Backend{
+				Repository: tableRelationRepository{RelationRepository: NewRelationRepository(engine), table: table},
+				Engine:     NewPolicyRepository(engine),
+			}
+		})
+	})
+}
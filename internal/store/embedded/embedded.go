@@ -0,0 +1,287 @@
+package embedded
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/internal/store/spicedb/schema_generator"
+)
+
+const (
+	TupleStorePostgres = "postgres"
+	TupleStoreMemory   = "memory"
+
+	wildcardSubjectID = "*"
+
+	// schemaRefreshInterval is how often the latest stored schema version is
+	// checked, schemas written by other instances are used once it changed
+	schemaRefreshInterval = 5 * time.Second
+)
+
+var (
+	ErrSchemaNotWritten  = errors.New("authz schema has not been written to the embedded engine")
+	ErrUnknownPermission = errors.New("permission or relation doesn't exist in the authz schema")
+)
+
+type SchemaVersionRepository interface {
+	GetLatest(ctx context.Context) (schema.SchemaVersion, error)
+}
+
+// Engine evaluates permissions of the namespace configs against the stored
+// tuples in process, it is shared by the relation and policy repositories in
+// the same way as a spicedb client
+type Engine struct {
+	tuples                  TupleStore
+	schemaVersionRepository SchemaVersionRepository
+
+	mu         sync.RWMutex
+	namespaces schema.NamespaceConfigMapType
+	source     string
+	// version is the stored schema version the namespace configs were last read from
+	version   int64
+	checkedAt time.Time
+
+	now func() time.Time
+}
+
+// New creates an embedded engine, if a version repository is given the latest
+// stored schema version is used until a schema is written, and whenever a newer
+// version is stored afterwards
+func New(tuples TupleStore, schemaVersionRepository SchemaVersionRepository) *Engine {
+	return &Engine{
+		tuples:                  tuples,
+		schemaVersionRepository: schemaVersionRepository,
+		now:                     time.Now,
+	}
+}
+
+func (e *Engine) writeSchema(namespaces schema.NamespaceConfigMapType) string {
+	source := strings.Join(schema_generator.GenerateSchema(namespaces), "\n")
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.namespaces = namespaces
+	e.source = source
+	// the version of the written schema is stored after it, the previous
+	// version is kept until then so that the written schema isn't reverted
+	e.checkedAt = e.now()
+	return source
+}
+
+// schema returns the namespace configs and the schema generated from them
+func (e *Engine) schema(ctx context.Context) (schema.NamespaceConfigMapType, string, error) {
+	e.mu.RLock()
+	namespaces, source, checkedAt := e.namespaces, e.source, e.checkedAt
+	e.mu.RUnlock()
+
+	if e.schemaVersionRepository == nil || (namespaces != nil && e.now().Sub(checkedAt) < schemaRefreshInterval) {
+		if namespaces == nil {
+			return nil, "", ErrSchemaNotWritten
+		}
+		return namespaces, source, nil
+	}
+
+	latest, err := e.schemaVersionRepository.GetLatest(ctx)
+	switch {
+	case errors.Is(err, schema.ErrSchemaVersionNotExist):
+		if namespaces == nil {
+			return nil, "", ErrSchemaNotWritten
+		}
+	case err != nil:
+		return nil, "", err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkedAt = e.now()
+	if e.namespaces == nil || (err == nil && latest.Version != e.version) {
+		e.version = latest.Version
+		e.namespaces = latest.Config
+		e.source = strings.Join(schema_generator.GenerateSchema(latest.Config), "\n")
+	}
+	return e.namespaces, e.source, nil
+}
+
+type subjectRef struct {
+	namespace string
+	id        string
+}
+
+// checker evaluates permissions for a single subject, a wildcard subject only
+// matches wildcard tuples while any other subject also matches them
+type checker struct {
+	tuples     TupleStore
+	namespaces schema.NamespaceConfigMapType
+	subject    subjectRef
+	// visiting guards against cycles between permissions of related objects
+	visiting map[string]bool
+}
+
+func (e *Engine) newChecker(ctx context.Context, subject subjectRef) (*checker, error) {
+	namespaces, _, err := e.schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checker{
+		tuples:     e.tuples,
+		namespaces: namespaces,
+		subject:    subject,
+		visiting:   make(map[string]bool),
+	}, nil
+}
+
+// check evaluates a permission or relation of the object
+func (c *checker) check(ctx context.Context, namespaceID, objectID, name string) (bool, error) {
+	config, ok := c.namespaces[namespaceID]
+	if !ok {
+		return false, fmt.Errorf("%w: %s#%s", ErrUnknownPermission, namespaceID, name)
+	}
+
+	if _, ok := config.Permissions[name]; ok {
+		return c.permission(ctx, namespaceID, objectID, name, config)
+	}
+	if _, ok := config.Roles[name]; ok {
+		return c.relation(ctx, namespaceID, objectID, name)
+	}
+	for _, ins := range config.InheritedNamespaces {
+		if ins.Name == name {
+			return c.relation(ctx, namespaceID, objectID, name)
+		}
+	}
+
+	return false, fmt.Errorf("%w: %s#%s", ErrUnknownPermission, namespaceID, name)
+}
+
+// permission evaluates the expression of a permission or otherwise the union of
+// its roles and the permission with the same name of the parent
+func (c *checker) permission(ctx context.Context, namespaceID, objectID, name string, config schema.NamespaceConfig) (bool, error) {
+	key := fmt.Sprintf("%s:%s#%s", namespaceID, objectID, name)
+	if c.visiting[key] {
+		return false, nil
+	}
+	c.visiting[key] = true
+	defer delete(c.visiting, key)
+
+	if expression, ok := config.PermissionExpressions[name]; ok {
+		if expr, err := schema.ParsePermissionExpression(expression); err == nil {
+			return c.expression(ctx, namespaceID, objectID, expr)
+		}
+	}
+
+	for _, r := range config.Permissions[name] {
+		var allowed bool
+		var err error
+		if relationName, target, found := strings.Cut(r, ":"); found {
+			allowed, err = c.arrow(ctx, namespaceID, objectID, relationName, target)
+		} else {
+			allowed, err = c.check(ctx, namespaceID, objectID, r)
+		}
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	for _, ins := range config.InheritedNamespaces {
+		if ins.Name != schema.ParentRelationName {
+			continue
+		}
+		if _, ok := c.namespaces[ins.NamespaceId].Permissions[name]; ok {
+			return c.arrow(ctx, namespaceID, objectID, schema.ParentRelationName, name)
+		}
+	}
+
+	return false, nil
+}
+
+func (c *checker) expression(ctx context.Context, namespaceID, objectID string, expr schema.PermissionExpression) (bool, error) {
+	switch expr.Operator {
+	case "":
+		if expr.Arrow != "" {
+			return c.arrow(ctx, namespaceID, objectID, expr.Relation, expr.Arrow)
+		}
+		return c.check(ctx, namespaceID, objectID, expr.Relation)
+	case schema.IntersectionOperator:
+		for _, child := range expr.Children {
+			allowed, err := c.expression(ctx, namespaceID, objectID, child)
+			if err != nil || !allowed {
+				return false, err
+			}
+		}
+		return true, nil
+	case schema.ExclusionOperator:
+		allowed, err := c.expression(ctx, namespaceID, objectID, expr.Children[0])
+		if err != nil || !allowed {
+			return false, err
+		}
+		for _, child := range expr.Children[1:] {
+			excluded, err := c.expression(ctx, namespaceID, objectID, child)
+			if err != nil {
+				return false, err
+			}
+			if excluded {
+				return false, nil
+			}
+		}
+		return true, nil
+	default:
+		for _, child := range expr.Children {
+			allowed, err := c.expression(ctx, namespaceID, objectID, child)
+			if err != nil || allowed {
+				return allowed, err
+			}
+		}
+		return false, nil
+	}
+}
+
+// arrow evaluates the target permission or relation on the objects related to
+// the object through the relation
+func (c *checker) arrow(ctx context.Context, namespaceID, objectID, relationName, target string) (bool, error) {
+	tuples, err := c.tuples.Subjects(ctx, namespaceID, objectID, relationName)
+	if err != nil {
+		return false, err
+	}
+
+	for _, t := range tuples {
+		if t.SubjectID == wildcardSubjectID {
+			continue
+		}
+		allowed, err := c.check(ctx, t.SubjectNamespace, t.SubjectID, target)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
+
+// relation matches the subjects of the object with the relation, groups are
+// related through their membership as in the spicedb schema
+func (c *checker) relation(ctx context.Context, namespaceID, objectID, relationName string) (bool, error) {
+	tuples, err := c.tuples.Subjects(ctx, namespaceID, objectID, relationName)
+	if err != nil {
+		return false, err
+	}
+
+	for _, t := range tuples {
+		if t.SubjectNamespace == c.subject.namespace && (t.SubjectID == c.subject.id || t.SubjectID == wildcardSubjectID) {
+			return true, nil
+		}
+	}
+	for _, t := range tuples {
+		if t.SubjectNamespace != schema.GroupPrincipal {
+			continue
+		}
+		allowed, err := c.check(ctx, schema.GroupNamespace, t.SubjectID, schema.MembershipPermission)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	return false, nil
+}
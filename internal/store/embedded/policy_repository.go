@@ -0,0 +1,39 @@
+package embedded
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/internal/store/spicedb/schema_generator"
+)
+
+// PolicyRepository writes the namespace configs to the embedded engine, the
+// schema is rendered in spicedb syntax so versions and plans read the same
+// with either engine
+type PolicyRepository struct {
+	engine *Engine
+}
+
+func NewPolicyRepository(engine *Engine) *PolicyRepository {
+	return &PolicyRepository{
+		engine: engine,
+	}
+}
+
+func (r PolicyRepository) WriteSchema(ctx context.Context, schema schema.NamespaceConfigMapType) (string, error) {
+	return r.engine.writeSchema(schema), nil
+}
+
+func (r PolicyRepository) DiffSchema(ctx context.Context, namespaceConfigMap schema.NamespaceConfigMapType) ([]schema.Change, error) {
+	_, current, err := r.engine.schema(ctx)
+	if err != nil && !errors.Is(err, ErrSchemaNotWritten) {
+		return nil, err
+	}
+
+	return schema_generator.DiffSchema(current, schema_generator.GenerateSchema(namespaceConfigMap)), nil
+}
+
+func (r PolicyRepository) DeleteRelations(ctx context.Context, namespaceID, relationName string) error {
+	return r.engine.tuples.DeleteByRelation(ctx, namespaceID, relationName)
+}
@@ -0,0 +1,130 @@
+package embedded
+
+import (
+	"context"
+	"strings"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/pkg/str"
+)
+
+type RelationRepository struct {
+	engine *Engine
+}
+
+func NewRelationRepository(engine *Engine) *RelationRepository {
+	return &RelationRepository{
+		engine: engine,
+	}
+}
+
+func (r RelationRepository) Add(ctx context.Context, rel relation.Relation) error {
+	return r.engine.tuples.Write(ctx, Tuple{
+		ObjectNamespace:  str.DefaultStringIfEmpty(rel.ObjectNamespace.ID, rel.ObjectNamespaceID),
+		ObjectID:         rel.ObjectID,
+		Relation:         str.DefaultStringIfEmpty(rel.Role.ID, rel.RoleID),
+		SubjectNamespace: str.DefaultStringIfEmpty(rel.SubjectNamespace.ID, rel.SubjectNamespaceID),
+		SubjectID:        rel.SubjectID,
+	})
+}
+
+func (r RelationRepository) AddV2(ctx context.Context, rel relation.RelationV2) error {
+	return r.engine.tuples.Write(ctx, toTuple(rel))
+}
+
+func (r RelationRepository) DeleteV2(ctx context.Context, rel relation.RelationV2) error {
+	return r.engine.tuples.Delete(ctx, toTuple(rel))
+}
+
+func (r RelationRepository) DeleteSubjectRelations(ctx context.Context, resourceType, optionalResourceID string) error {
+	return r.engine.tuples.DeleteByObject(ctx, resourceType, optionalResourceID)
+}
+
+func (r RelationRepository) Check(ctx context.Context, rel relation.Relation, act action.Action) (bool, error) {
+	c, err := r.engine.newChecker(ctx, subjectRef{
+		namespace: str.DefaultStringIfEmpty(rel.SubjectNamespace.ID, rel.SubjectNamespaceID),
+		id:        rel.SubjectID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return c.check(ctx, str.DefaultStringIfEmpty(rel.ObjectNamespace.ID, rel.ObjectNamespaceID), rel.ObjectID, act.ID)
+}
+
+// BulkCheck checks every relation with the action at the same index, a failed
+// check is not allowed as with spicedb
+func (r RelationRepository) BulkCheck(ctx context.Context, rels []relation.Relation, acts []action.Action) ([]relation.Permission, error) {
+	if len(rels) != len(acts) {
+		return []relation.Permission{}, relation.ErrInvalidDetail
+	}
+
+	var result []relation.Permission
+	for i, rel := range rels {
+		allowed, err := r.Check(ctx, rel, acts[i])
+		if err != nil {
+			allowed = false
+		}
+		result = append(result, relation.Permission{
+			ObjectID:        rel.ObjectID,
+			ObjectNamespace: str.DefaultStringIfEmpty(rel.ObjectNamespace.ID, rel.ObjectNamespaceID),
+			Permission:      acts[i].ID,
+			Allowed:         allowed,
+		})
+	}
+
+	return result, nil
+}
+
+// CheckIsPublic checks whether every subject of the subject namespace has the
+// permission through a wildcard
+func (r RelationRepository) CheckIsPublic(ctx context.Context, rel relation.Relation, act action.Action) (bool, error) {
+	return r.Check(ctx, relation.Relation{
+		ObjectNamespaceID:  str.DefaultStringIfEmpty(rel.ObjectNamespace.ID, rel.ObjectNamespaceID),
+		ObjectID:           rel.ObjectID,
+		SubjectNamespaceID: str.DefaultStringIfEmpty(rel.SubjectNamespace.ID, rel.SubjectNamespaceID),
+		SubjectID:          wildcardSubjectID,
+	}, act)
+}
+
+func (r RelationRepository) LookupResources(ctx context.Context, resourceType, permission, subjectType, subjectID string) ([]string, error) {
+	c, err := r.engine.newChecker(ctx, subjectRef{namespace: subjectType, id: subjectID})
+	if err != nil {
+		return []string{}, err
+	}
+
+	objectIDs, err := r.engine.tuples.ObjectIDs(ctx, resourceType)
+	if err != nil {
+		return []string{}, err
+	}
+
+	var res []string
+	for _, id := range objectIDs {
+		allowed, err := c.check(ctx, resourceType, id, permission)
+		if err != nil {
+			return []string{}, err
+		}
+		if allowed {
+			res = append(res, id)
+		}
+	}
+
+	return res, nil
+}
+
+func toTuple(rel relation.RelationV2) Tuple {
+	// the role is either the name of the relation or its id prefixed with the namespace
+	relationName := rel.Subject.RoleID
+	if _, name, found := strings.Cut(relationName, ":"); found {
+		relationName = name
+	}
+
+	return Tuple{
+		ObjectNamespace:  rel.Object.NamespaceID,
+		ObjectID:         rel.Object.ID,
+		Relation:         relationName,
+		SubjectNamespace: rel.Subject.Namespace,
+		SubjectID:        rel.Subject.ID,
+	}
+}
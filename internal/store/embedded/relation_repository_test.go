@@ -0,0 +1,416 @@
+package embedded
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/goto/shield/core/action"
+	"github.com/goto/shield/core/namespace"
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/internal/schema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	firehoseNamespace = "entropy/firehose"
+	daggerNamespace   = "entropy/dagger"
+)
+
+func testSchema() schema.NamespaceConfigMapType {
+	return schema.NamespaceConfigMapType{
+		schema.UserPrincipal:           {},
+		schema.OrganizationNamespace:   schema.OrganizationNamespaceConfig,
+		schema.ProjectNamespace:        schema.ProjectNamespaceConfig,
+		schema.GroupNamespace:          schema.GroupNamespaceConfig,
+		schema.ServiceDataKeyNamespace: schema.ServiceDataKeyConfig,
+		firehoseNamespace:              schema.PreDefinedResourceGroupNamespaceConfig,
+		daggerNamespace: {
+			Type: schema.ResourceGroupNamespace,
+			InheritedNamespaces: []schema.InheritedNamespace{
+				{Name: schema.ParentRelationName, NamespaceId: firehoseNamespace},
+			},
+			Roles: map[string][]string{
+				"owner":     {schema.UserPrincipal, schema.GroupPrincipal},
+				"suspended": {schema.UserPrincipal},
+			},
+			Permissions: map[string][]string{
+				"view": {"owner"},
+				"edit": {"owner"},
+			},
+			PermissionExpressions: map[string]string{
+				"edit": "owner - suspended",
+			},
+		},
+	}
+}
+
+func newTestRepository(t *testing.T, rels ...relation.RelationV2) RelationRepository {
+	t.Helper()
+
+	engine := New(NewMemoryTupleStore(), nil)
+	_, err := NewPolicyRepository(engine).WriteSchema(context.Background(), testSchema())
+	assert.NoError(t, err)
+
+	repository := *NewRelationRepository(engine)
+	for _, rel := range rels {
+		assert.NoError(t, repository.AddV2(context.Background(), rel))
+	}
+	return repository
+}
+
+func newRelation(objectNamespace, objectID, roleID, subjectNamespace, subjectID string) relation.RelationV2 {
+	return relation.RelationV2{
+		Object:  relation.Object{ID: objectID, NamespaceID: objectNamespace},
+		Subject: relation.Subject{ID: subjectID, Namespace: subjectNamespace, RoleID: schema.GetRoleID(objectNamespace, roleID)},
+	}
+}
+
+func userCheck(objectNamespace, objectID, userID string) relation.Relation {
+	return relation.Relation{
+		ObjectNamespace:  namespace.Namespace{ID: objectNamespace},
+		ObjectID:         objectID,
+		SubjectNamespace: namespace.Namespace{ID: schema.UserPrincipal},
+		SubjectID:        userID,
+	}
+}
+
+var testRelations = []relation.RelationV2{
+	newRelation(schema.OrganizationNamespace, "org1", schema.OwnerRole, schema.UserPrincipal, "alice"),
+	newRelation(schema.OrganizationNamespace, "org1", schema.ViewerRole, schema.GroupPrincipal, "group1"),
+	newRelation(schema.GroupNamespace, "group1", schema.MemberRole, schema.UserPrincipal, "bob"),
+	newRelation(schema.ProjectNamespace, "project1", schema.OrganizationRelationName, schema.OrganizationNamespace, "org1"),
+	newRelation(firehoseNamespace, "firehose1", schema.ProjectRelationName, schema.ProjectNamespace, "project1"),
+	newRelation(firehoseNamespace, "firehose1", schema.OrganizationRelationName, schema.OrganizationNamespace, "org1"),
+	newRelation(firehoseNamespace, "firehose2", schema.EditorRole, schema.UserPrincipal, "carol"),
+	newRelation(daggerNamespace, "dagger1", schema.ParentRelationName, firehoseNamespace, "firehose1"),
+	newRelation(daggerNamespace, "dagger1", "owner", schema.UserPrincipal, "dave"),
+	newRelation(daggerNamespace, "dagger1", "owner", schema.UserPrincipal, "erin"),
+	newRelation(daggerNamespace, "dagger1", "suspended", schema.UserPrincipal, "erin"),
+	newRelation(schema.ServiceDataKeyNamespace, "key1", schema.ViewerRole, schema.UserPrincipal, "*"),
+}
+
+func TestRelationRepository_Check(t *testing.T) {
+	repository := newTestRepository(t, testRelations...)
+
+	tests := []struct {
+		description string
+		rel         relation.Relation
+		permission  string
+		want        bool
+		wantErr     error
+	}{
+		{
+			description: "should allow a role of the object",
+			rel:         userCheck(schema.OrganizationNamespace, "org1", "alice"),
+			permission:  schema.EditPermission,
+			want:        true,
+		},
+		{
+			description: "should allow members of a group with a role",
+			rel:         userCheck(schema.OrganizationNamespace, "org1", "bob"),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should not allow a permission the role doesn't grant",
+			rel:         userCheck(schema.OrganizationNamespace, "org1", "bob"),
+			permission:  schema.EditPermission,
+			want:        false,
+		},
+		{
+			description: "should allow roles of inherited namespaces",
+			rel:         userCheck(firehoseNamespace, "firehose1", "alice"),
+			permission:  schema.DeletePermission,
+			want:        true,
+		},
+		{
+			description: "should allow roles of groups on inherited namespaces",
+			rel:         userCheck(firehoseNamespace, "firehose1", "bob"),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should not allow users without relations",
+			rel:         userCheck(firehoseNamespace, "firehose1", "carol"),
+			permission:  schema.ViewPermission,
+			want:        false,
+		},
+		{
+			description: "should allow permissions of the parent",
+			rel:         userCheck(daggerNamespace, "dagger1", "bob"),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should evaluate permission expressions",
+			rel:         userCheck(daggerNamespace, "dagger1", "dave"),
+			permission:  schema.EditPermission,
+			want:        true,
+		},
+		{
+			description: "should exclude subjects of permission expressions",
+			rel:         userCheck(daggerNamespace, "dagger1", "erin"),
+			permission:  schema.EditPermission,
+			want:        false,
+		},
+		{
+			description: "should allow any user through a wildcard",
+			rel:         userCheck(schema.ServiceDataKeyNamespace, "key1", "frank"),
+			permission:  schema.ViewPermission,
+			want:        true,
+		},
+		{
+			description: "should return an error if the permission doesn't exist",
+			rel:         userCheck(daggerNamespace, "dagger1", "dave"),
+			permission:  "deploy",
+			wantErr:     ErrUnknownPermission,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := repository.Check(context.Background(), tt.rel, action.Action{ID: tt.permission})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRelationRepository_BulkCheck(t *testing.T) {
+	repository := newTestRepository(t, testRelations...)
+
+	_, err := repository.BulkCheck(context.Background(), []relation.Relation{userCheck(firehoseNamespace, "firehose1", "alice")}, nil)
+	assert.ErrorIs(t, err, relation.ErrInvalidDetail)
+
+	got, err := repository.BulkCheck(context.Background(),
+		[]relation.Relation{
+			userCheck(firehoseNamespace, "firehose1", "alice"),
+			userCheck(firehoseNamespace, "firehose2", "alice"),
+			userCheck(firehoseNamespace, "firehose1", "alice"),
+		},
+		[]action.Action{{ID: schema.EditPermission}, {ID: schema.EditPermission}, {ID: "deploy"}},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []relation.Permission{
+		{ObjectID: "firehose1", ObjectNamespace: firehoseNamespace, Permission: schema.EditPermission, Allowed: true},
+		{ObjectID: "firehose2", ObjectNamespace: firehoseNamespace, Permission: schema.EditPermission, Allowed: false},
+		{ObjectID: "firehose1", ObjectNamespace: firehoseNamespace, Permission: "deploy", Allowed: false},
+	}, got)
+}
+
+func TestRelationRepository_CheckIsPublic(t *testing.T) {
+	repository := newTestRepository(t, append(testRelations,
+		newRelation(schema.ServiceDataKeyNamespace, "key2", schema.ViewerRole, schema.UserPrincipal, "alice"),
+	)...)
+
+	public, err := repository.CheckIsPublic(context.Background(), userCheck(schema.ServiceDataKeyNamespace, "key1", ""), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.True(t, public)
+
+	public, err = repository.CheckIsPublic(context.Background(), userCheck(schema.ServiceDataKeyNamespace, "key2", ""), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.False(t, public)
+}
+
+func TestRelationRepository_LookupResources(t *testing.T) {
+	repository := newTestRepository(t, testRelations...)
+
+	got, err := repository.LookupResources(context.Background(), firehoseNamespace, schema.ViewPermission, schema.UserPrincipal, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"firehose1"}, got)
+
+	got, err = repository.LookupResources(context.Background(), firehoseNamespace, schema.EditPermission, schema.UserPrincipal, "carol")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"firehose2"}, got)
+
+	got, err = repository.LookupResources(context.Background(), schema.GroupNamespace, schema.MembershipPermission, schema.UserPrincipal, "alice")
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestRelationRepository_Delete(t *testing.T) {
+	repository := newTestRepository(t, testRelations...)
+	ctx := context.Background()
+
+	assert.NoError(t, repository.DeleteV2(ctx, newRelation(schema.GroupNamespace, "group1", schema.MemberRole, schema.UserPrincipal, "bob")))
+	allowed, err := repository.Check(ctx, userCheck(schema.OrganizationNamespace, "org1", "bob"), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	assert.NoError(t, repository.DeleteSubjectRelations(ctx, firehoseNamespace, "firehose1"))
+	allowed, err = repository.Check(ctx, userCheck(firehoseNamespace, "firehose1", "alice"), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = repository.Check(ctx, userCheck(firehoseNamespace, "firehose2", "carol"), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+type latestVersion struct {
+	version schema.SchemaVersion
+	err     error
+}
+
+func (v latestVersion) GetLatest(ctx context.Context) (schema.SchemaVersion, error) {
+	return v.version, v.err
+}
+
+func TestEngine_Schema(t *testing.T) {
+	ctx := context.Background()
+	check := userCheck(schema.OrganizationNamespace, "org1", "alice")
+
+	t.Run("should return an error until a schema is written", func(t *testing.T) {
+		repository := NewRelationRepository(New(NewMemoryTupleStore(), latestVersion{err: schema.ErrSchemaVersionNotExist}))
+
+		_, err := repository.Check(ctx, check, action.Action{ID: schema.ViewPermission})
+		assert.ErrorIs(t, err, ErrSchemaNotWritten)
+	})
+
+	t.Run("should use the latest schema version until a schema is written", func(t *testing.T) {
+		engine := New(NewMemoryTupleStore(), latestVersion{version: schema.SchemaVersion{Version: 1, Config: testSchema()}})
+		repository := NewRelationRepository(engine)
+		assert.NoError(t, repository.AddV2(ctx, testRelations[0]))
+
+		allowed, err := repository.Check(ctx, check, action.Action{ID: schema.ViewPermission})
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+
+		changes, err := NewPolicyRepository(engine).DiffSchema(ctx, testSchema())
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("should use a newer schema version once it is checked again", func(t *testing.T) {
+		versions := &latestVersion{version: schema.SchemaVersion{Version: 1, Config: testSchema()}}
+		now := time.Now()
+		engine := New(NewMemoryTupleStore(), versions)
+		engine.now = func() time.Time { return now }
+		policyRepository := NewPolicyRepository(engine)
+
+		changes, err := policyRepository.DiffSchema(ctx, testSchema())
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+
+		// another instance wrote a schema without the dagger namespace
+		updated := testSchema()
+		delete(updated, daggerNamespace)
+		versions.version = schema.SchemaVersion{Version: 2, Config: updated}
+
+		changes, err = policyRepository.DiffSchema(ctx, updated)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, changes)
+
+		now = now.Add(schemaRefreshInterval)
+		changes, err = policyRepository.DiffSchema(ctx, updated)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
+
+func TestPolicyRepository(t *testing.T) {
+	ctx := context.Background()
+	engine := New(NewMemoryTupleStore(), nil)
+	policyRepository := NewPolicyRepository(engine)
+
+	changes, err := policyRepository.DiffSchema(ctx, testSchema())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, changes)
+
+	written, err := policyRepository.WriteSchema(ctx, testSchema())
+	assert.NoError(t, err)
+	assert.Contains(t, written, "definition entropy/dagger")
+
+	again, err := policyRepository.WriteSchema(ctx, testSchema())
+	assert.NoError(t, err)
+	assert.Equal(t, written, again)
+
+	repository := NewRelationRepository(engine)
+	assert.NoError(t, repository.AddV2(ctx, testRelations[8]))
+	assert.NoError(t, policyRepository.DeleteRelations(ctx, daggerNamespace, "owner"))
+
+	allowed, err := repository.Check(ctx, userCheck(daggerNamespace, "dagger1", "dave"), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+type relationTable struct {
+	relations []relation.RelationV2
+	deleted   []string
+}
+
+func (r *relationTable) ListByObject(ctx context.Context, namespaceID, objectID, roleID string) ([]relation.RelationV2, error) {
+	var relations []relation.RelationV2
+	for _, rel := range r.relations {
+		if rel.Object.NamespaceID == namespaceID && rel.Object.ID == objectID && rel.Subject.RoleID == roleID {
+			relations = append(relations, rel)
+		}
+	}
+	return relations, nil
+}
+
+func (r *relationTable) ListObjectIDs(ctx context.Context, namespaceID string) ([]string, error) {
+	var objectIDs []string
+	for _, rel := range r.relations {
+		if rel.Object.NamespaceID == namespaceID && !schema.Contains(objectIDs, rel.Object.ID) {
+			objectIDs = append(objectIDs, rel.Object.ID)
+		}
+	}
+	return objectIDs, nil
+}
+
+func (r *relationTable) DeleteByObject(ctx context.Context, namespaceID, objectID string) error {
+	r.deleted = append(r.deleted, "object "+namespaceID+":"+objectID)
+	r.delete(func(rel relation.RelationV2) bool {
+		return rel.Object.NamespaceID == namespaceID && (objectID == "" || rel.Object.ID == objectID)
+	})
+	return nil
+}
+
+func (r *relationTable) DeleteByRoleID(ctx context.Context, roleID string) error {
+	r.deleted = append(r.deleted, "role "+roleID)
+	r.delete(func(rel relation.RelationV2) bool { return rel.Subject.RoleID == roleID })
+	return nil
+}
+
+func (r *relationTable) delete(match func(rel relation.RelationV2) bool) {
+	var kept []relation.RelationV2
+	for _, rel := range r.relations {
+		if !match(rel) {
+			kept = append(kept, rel)
+		}
+	}
+	r.relations = kept
+}
+
+func TestPostgresTupleStore(t *testing.T) {
+	ctx := context.Background()
+	table := &relationTable{relations: slices.Clone(testRelations)}
+	engine := New(NewPostgresTupleStore(table), nil)
+	_, err := NewPolicyRepository(engine).WriteSchema(ctx, testSchema())
+	assert.NoError(t, err)
+	repository := NewRelationRepository(engine)
+
+	allowed, err := repository.Check(ctx, userCheck(firehoseNamespace, "firehose1", "bob"), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	// relations are written and deleted in postgres by the relation service
+	assert.NoError(t, repository.DeleteV2(ctx, testRelations[2]))
+	allowed, err = repository.Check(ctx, userCheck(firehoseNamespace, "firehose1", "bob"), action.Action{ID: schema.ViewPermission})
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	assert.NoError(t, repository.DeleteSubjectRelations(ctx, firehoseNamespace, ""))
+	assert.NoError(t, NewPolicyRepository(engine).DeleteRelations(ctx, daggerNamespace, "owner"))
+	assert.Equal(t, []string{
+		"object " + firehoseNamespace + ":",
+		"role " + daggerNamespace + ":owner",
+	}, table.deleted)
+}
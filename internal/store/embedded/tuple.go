@@ -0,0 +1,174 @@
+package embedded
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/goto/shield/core/relation"
+	"github.com/goto/shield/internal/schema"
+)
+
+// Tuple relates a subject to an object through a role or an inherited
+// namespace relation of the object
+type Tuple struct {
+	ObjectNamespace  string
+	ObjectID         string
+	Relation         string
+	SubjectNamespace string
+	SubjectID        string
+}
+
+type TupleStore interface {
+	Write(ctx context.Context, tuple Tuple) error
+	Delete(ctx context.Context, tuple Tuple) error
+	// DeleteByObject deletes the tuples of an object of the namespace or of
+	// every object of the namespace if the object id is empty
+	DeleteByObject(ctx context.Context, namespaceID, objectID string) error
+	DeleteByRelation(ctx context.Context, namespaceID, relationName string) error
+	// Subjects returns the tuples of the object with the relation
+	Subjects(ctx context.Context, namespaceID, objectID, relationName string) ([]Tuple, error)
+	// ObjectIDs returns the ids of objects of the namespace having any tuple
+	ObjectIDs(ctx context.Context, namespaceID string) ([]string, error)
+}
+
+// MemoryTupleStore keeps tuples in memory, they are lost when shield stops
+type MemoryTupleStore struct {
+	mu     sync.RWMutex
+	tuples map[Tuple]bool
+}
+
+func NewMemoryTupleStore() *MemoryTupleStore {
+	return &MemoryTupleStore{
+		tuples: make(map[Tuple]bool),
+	}
+}
+
+func (s *MemoryTupleStore) Write(ctx context.Context, tuple Tuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tuples[tuple] = true
+	return nil
+}
+
+func (s *MemoryTupleStore) Delete(ctx context.Context, tuple Tuple) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tuples, tuple)
+	return nil
+}
+
+func (s *MemoryTupleStore) DeleteByObject(ctx context.Context, namespaceID, objectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for t := range s.tuples {
+		if t.ObjectNamespace == namespaceID && (objectID == "" || t.ObjectID == objectID) {
+			delete(s.tuples, t)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryTupleStore) DeleteByRelation(ctx context.Context, namespaceID, relationName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for t := range s.tuples {
+		if t.ObjectNamespace == namespaceID && t.Relation == relationName {
+			delete(s.tuples, t)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryTupleStore) Subjects(ctx context.Context, namespaceID, objectID, relationName string) ([]Tuple, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var tuples []Tuple
+	for t := range s.tuples {
+		if t.ObjectNamespace == namespaceID && t.ObjectID == objectID && t.Relation == relationName {
+			tuples = append(tuples, t)
+		}
+	}
+	return tuples, nil
+}
+
+func (s *MemoryTupleStore) ObjectIDs(ctx context.Context, namespaceID string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	found := make(map[string]bool)
+	var objectIDs []string
+	for t := range s.tuples {
+		if t.ObjectNamespace == namespaceID && !found[t.ObjectID] {
+			found[t.ObjectID] = true
+			objectIDs = append(objectIDs, t.ObjectID)
+		}
+	}
+	sort.Strings(objectIDs)
+	return objectIDs, nil
+}
+
+type RelationTableRepository interface {
+	ListByObject(ctx context.Context, namespaceID, objectID, roleID string) ([]relation.RelationV2, error)
+	ListObjectIDs(ctx context.Context, namespaceID string) ([]string, error)
+	DeleteByObject(ctx context.Context, namespaceID, objectID string) error
+	DeleteByRoleID(ctx context.Context, roleID string) error
+}
+
+// PostgresTupleStore reads tuples from the relations table. Relations are
+// stored by the relation service before they are added to the authz engine and
+// deleted after they are removed from it, so writing and deleting a single
+// tuple is left to the relation service.
+type PostgresTupleStore struct {
+	repository RelationTableRepository
+}
+
+func NewPostgresTupleStore(repository RelationTableRepository) *PostgresTupleStore {
+	return &PostgresTupleStore{
+		repository: repository,
+	}
+}
+
+func (s PostgresTupleStore) Write(ctx context.Context, tuple Tuple) error {
+	return nil
+}
+
+func (s PostgresTupleStore) Delete(ctx context.Context, tuple Tuple) error {
+	return nil
+}
+
+func (s PostgresTupleStore) DeleteByObject(ctx context.Context, namespaceID, objectID string) error {
+	return s.repository.DeleteByObject(ctx, namespaceID, objectID)
+}
+
+func (s PostgresTupleStore) DeleteByRelation(ctx context.Context, namespaceID, relationName string) error {
+	return s.repository.DeleteByRoleID(ctx, schema.GetRoleID(namespaceID, relationName))
+}
+
+func (s PostgresTupleStore) Subjects(ctx context.Context, namespaceID, objectID, relationName string) ([]Tuple, error) {
+	relations, err := s.repository.ListByObject(ctx, namespaceID, objectID, schema.GetRoleID(namespaceID, relationName))
+	if err != nil {
+		return nil, err
+	}
+
+	tuples := make([]Tuple, 0, len(relations))
+	for _, rel := range relations {
+		tuples = append(tuples, Tuple{
+			ObjectNamespace:  rel.Object.NamespaceID,
+			ObjectID:         rel.Object.ID,
+			Relation:         relationName,
+			SubjectNamespace: rel.Subject.Namespace,
+			SubjectID:        rel.Subject.ID,
+		})
+	}
+	return tuples, nil
+}
+
+func (s PostgresTupleStore) ObjectIDs(ctx context.Context, namespaceID string) ([]string, error) {
+	return s.repository.ListObjectIDs(ctx, namespaceID)
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/goto/shield/core/accessrequest"
+)
+
+type AccessRequest struct {
+	ID            string         `db:"id"`
+	UserID        string         `db:"user_id"`
+	NamespaceID   string         `db:"namespace_id"`
+	ObjectID      string         `db:"object_id"`
+	RoleID        string         `db:"role_id"`
+	Reason        string         `db:"reason"`
+	State         string         `db:"state"`
+	ReviewedBy    sql.NullString `db:"reviewed_by"`
+	ReviewedAt    sql.NullTime   `db:"reviewed_at"`
+	ReviewComment string         `db:"review_comment"`
+	ExpiresAt     sql.NullTime   `db:"expires_at"`
+	RelationID    sql.NullString `db:"relation_id"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func (from AccessRequest) transformToAccessRequest() accessrequest.AccessRequest {
+	return accessrequest.AccessRequest{
+		ID:            from.ID,
+		UserID:        from.UserID,
+		NamespaceID:   from.NamespaceID,
+		ObjectID:      from.ObjectID,
+		RoleID:        from.RoleID,
+		Reason:        from.Reason,
+		State:         accessrequest.State(from.State),
+		ReviewedBy:    from.ReviewedBy.String,
+		ReviewedAt:    from.ReviewedAt.Time,
+		ReviewComment: from.ReviewComment,
+		ExpiresAt:     from.ExpiresAt.Time,
+		RelationID:    from.RelationID.String,
+		CreatedAt:     from.CreatedAt,
+		UpdatedAt:     from.UpdatedAt,
+	}
+}
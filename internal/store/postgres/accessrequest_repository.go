@@ -0,0 +1,288 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/goto/shield/core/accessrequest"
+	"github.com/goto/shield/pkg/db"
+	newrelic "github.com/newrelic/go-agent/v3/newrelic"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+type AccessRequestRepository struct {
+	dbc *db.Client
+}
+
+func NewAccessRequestRepository(dbc *db.Client) *AccessRequestRepository {
+	return &AccessRequestRepository{
+		dbc: dbc,
+	}
+}
+
+func (r AccessRequestRepository) Create(ctx context.Context, accessRequest accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+	query, params, err := dialect.Insert(TABLE_ACCESS_REQUESTS).Rows(
+		goqu.Record{
+			"user_id":      accessRequest.UserID,
+			"namespace_id": accessRequest.NamespaceID,
+			"object_id":    accessRequest.ObjectID,
+			"role_id":      accessRequest.RoleID,
+			"reason":       accessRequest.Reason,
+			"state":        accessrequest.StatePending,
+		}).Returning(&AccessRequest{}).ToSQL()
+	if err != nil {
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Create"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_ACCESS_REQUESTS),
+		}...,
+	)
+
+	var accessRequestModel AccessRequest
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_ACCESS_REQUESTS,
+				Operation:  "Create",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&accessRequestModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, errDuplicateKey):
+			return accessrequest.AccessRequest{}, accessrequest.ErrConflict
+		case errors.Is(err, errForeignKeyViolation),
+			errors.Is(err, errInvalidTexRepresentation):
+			return accessrequest.AccessRequest{}, accessrequest.ErrInvalidDetail
+		default:
+			return accessrequest.AccessRequest{}, err
+		}
+	}
+
+	return accessRequestModel.transformToAccessRequest(), nil
+}
+
+func (r AccessRequestRepository) GetByID(ctx context.Context, id string) (accessrequest.AccessRequest, error) {
+	query, params, err := dialect.Select(&AccessRequest{}).From(TABLE_ACCESS_REQUESTS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetByID"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_ACCESS_REQUESTS),
+		}...,
+	)
+
+	var accessRequestModel AccessRequest
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_ACCESS_REQUESTS,
+				Operation:  "GetByID",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.GetContext(ctx, &accessRequestModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return accessrequest.AccessRequest{}, accessrequest.ErrNotExist
+		case errors.Is(err, errInvalidTexRepresentation):
+			return accessrequest.AccessRequest{}, accessrequest.ErrInvalidID
+		default:
+			return accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return accessRequestModel.transformToAccessRequest(), nil
+}
+
+// List returns the access requests matching the filter, newest first
+func (r AccessRequestRepository) List(ctx context.Context, flt accessrequest.Filter) ([]accessrequest.AccessRequest, error) {
+	sqlStatement := dialect.Select(&AccessRequest{}).From(TABLE_ACCESS_REQUESTS)
+	if flt.UserID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"user_id": flt.UserID})
+	}
+	if flt.NamespaceID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"namespace_id": flt.NamespaceID})
+	}
+	if flt.ObjectID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"object_id": flt.ObjectID})
+	}
+	if flt.State != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"state": flt.State})
+	}
+	query, params, err := sqlStatement.Order(goqu.C("created_at").Desc()).ToSQL()
+	if err != nil {
+		return []accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.list(ctx, "List", query, params)
+}
+
+// ListExpired returns the approved access requests whose grant expired at the given time
+func (r AccessRequestRepository) ListExpired(ctx context.Context, at time.Time) ([]accessrequest.AccessRequest, error) {
+	query, params, err := dialect.Select(&AccessRequest{}).From(TABLE_ACCESS_REQUESTS).Where(
+		goqu.Ex{"state": accessrequest.StateApproved},
+		goqu.C("expires_at").Lte(at),
+	).Order(goqu.C("expires_at").Asc()).ToSQL()
+	if err != nil {
+		return []accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.list(ctx, "ListExpired", query, params)
+}
+
+// Review approves or rejects a pending access request, requests which are
+// already reviewed are left as they are
+func (r AccessRequestRepository) Review(ctx context.Context, review accessrequest.Review) (accessrequest.AccessRequest, error) {
+	record := goqu.Record{
+		"state":          review.State,
+		"reviewed_by":    review.ReviewedBy,
+		"reviewed_at":    goqu.L("NOW()"),
+		"review_comment": review.Comment,
+		"updated_at":     goqu.L("NOW()"),
+	}
+	if !review.ExpiresAt.IsZero() {
+		record["expires_at"] = review.ExpiresAt
+	}
+	if review.RelationID != "" {
+		record["relation_id"] = review.RelationID
+	}
+
+	query, params, err := dialect.Update(TABLE_ACCESS_REQUESTS).Set(record).Where(goqu.Ex{
+		"id":    review.ID,
+		"state": accessrequest.StatePending,
+	}).Returning(&AccessRequest{}).ToSQL()
+	if err != nil {
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.transition(ctx, "Review", query, params)
+}
+
+// Expire marks an approved access request expired once its role is revoked
+func (r AccessRequestRepository) Expire(ctx context.Context, id string) (accessrequest.AccessRequest, error) {
+	query, params, err := dialect.Update(TABLE_ACCESS_REQUESTS).Set(goqu.Record{
+		"state":      accessrequest.StateExpired,
+		"updated_at": goqu.L("NOW()"),
+	}).Where(goqu.Ex{
+		"id":    id,
+		"state": accessrequest.StateApproved,
+	}).Returning(&AccessRequest{}).ToSQL()
+	if err != nil {
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.transition(ctx, "Expire", query, params)
+}
+
+func (r AccessRequestRepository) list(ctx context.Context, method, query string, params []interface{}) ([]accessrequest.AccessRequest, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", method),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_ACCESS_REQUESTS),
+		}...,
+	)
+
+	var fetchedAccessRequests []AccessRequest
+	if err := r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_ACCESS_REQUESTS,
+				Operation:  method,
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.SelectContext(ctx, &fetchedAccessRequests, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []accessrequest.AccessRequest{}, nil
+		case errors.Is(err, errInvalidTexRepresentation):
+			return []accessrequest.AccessRequest{}, nil
+		default:
+			return []accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	var transformedAccessRequests []accessrequest.AccessRequest
+	for _, ar := range fetchedAccessRequests {
+		transformedAccessRequests = append(transformedAccessRequests, ar.transformToAccessRequest())
+	}
+
+	return transformedAccessRequests, nil
+}
+
+// transition runs an update conditional on the state of the access request,
+// no row is returned when the request has already left that state
+func (r AccessRequestRepository) transition(ctx context.Context, method, query string, params []interface{}) (accessrequest.AccessRequest, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", method),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_ACCESS_REQUESTS),
+		}...,
+	)
+
+	var accessRequestModel AccessRequest
+	if err := r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_ACCESS_REQUESTS,
+				Operation:  method,
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&accessRequestModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return accessrequest.AccessRequest{}, accessrequest.ErrNotPending
+		case errors.Is(err, errForeignKeyViolation),
+			errors.Is(err, errInvalidTexRepresentation):
+			return accessrequest.AccessRequest{}, accessrequest.ErrInvalidDetail
+		default:
+			return accessrequest.AccessRequest{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return accessRequestModel.transformToAccessRequest(), nil
+}
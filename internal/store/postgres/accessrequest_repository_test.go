@@ -0,0 +1,244 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/accessrequest"
+	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/project"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/internal/store/postgres"
+	"github.com/goto/shield/pkg/db"
+	"github.com/goto/shield/pkg/uuid"
+	"github.com/ory/dockertest"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccessRequestRepositoryTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	client         *db.Client
+	pool           *dockertest.Pool
+	resource       *dockertest.Resource
+	repository     *postgres.AccessRequestRepository
+	users          []user.User
+	projects       []project.Project
+	accessRequests []accessrequest.AccessRequest
+}
+
+func (s *AccessRequestRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewAccessRequestRepository(s.client)
+
+	if _, err = bootstrapNamespace(s.client); err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.users, err = bootstrapUser(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	var orgs []organization.Organization
+	orgs, err = bootstrapOrganization(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.projects, err = bootstrapProject(s.client, orgs)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) SetupTest() {
+	s.accessRequests = nil
+	for _, usr := range s.users[:2] {
+		created, err := s.repository.Create(s.ctx, accessrequest.AccessRequest{
+			UserID:      usr.ID,
+			NamespaceID: schema.ProjectNamespace,
+			ObjectID:    s.projects[0].ID,
+			RoleID:      schema.ViewerRole,
+			Reason:      "debugging an incident",
+		})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		s.accessRequests = append(s.accessRequests, created)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_ACCESS_REQUESTS),
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *AccessRequestRepositoryTestSuite) TestCreate() {
+	if s.accessRequests[0].State != accessrequest.StatePending {
+		s.T().Fatalf("got state %s, expected was %s", s.accessRequests[0].State, accessrequest.StatePending)
+	}
+
+	// only one request of a role can be pending
+	_, err := s.repository.Create(s.ctx, accessrequest.AccessRequest{
+		UserID:      s.users[0].ID,
+		NamespaceID: schema.ProjectNamespace,
+		ObjectID:    s.projects[0].ID,
+		RoleID:      schema.ViewerRole,
+	})
+	if err != accessrequest.ErrConflict {
+		s.T().Fatalf("got error %v, expected was %v", err, accessrequest.ErrConflict)
+	}
+
+	_, err = s.repository.Create(s.ctx, accessrequest.AccessRequest{
+		UserID:      uuid.NewString(),
+		NamespaceID: schema.ProjectNamespace,
+		ObjectID:    s.projects[0].ID,
+		RoleID:      schema.ViewerRole,
+	})
+	if err != accessrequest.ErrInvalidDetail {
+		s.T().Fatalf("got error %v, expected was %v", err, accessrequest.ErrInvalidDetail)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TestGetByID() {
+	got, err := s.repository.GetByID(s.ctx, s.accessRequests[0].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if !cmp.Equal(got, s.accessRequests[0]) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, s.accessRequests[0])
+	}
+
+	_, err = s.repository.GetByID(s.ctx, uuid.NewString())
+	if err != accessrequest.ErrNotExist {
+		s.T().Fatalf("got error %v, expected was %v", err, accessrequest.ErrNotExist)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TestList() {
+	got, err := s.repository.List(s.ctx, accessrequest.Filter{NamespaceID: schema.ProjectNamespace, ObjectID: s.projects[0].ID})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	expected := []accessrequest.AccessRequest{s.accessRequests[1], s.accessRequests[0]}
+	if !cmp.Equal(got, expected) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, expected)
+	}
+
+	got, err = s.repository.List(s.ctx, accessrequest.Filter{UserID: s.users[0].ID, State: accessrequest.StatePending})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if !cmp.Equal(got, s.accessRequests[:1]) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, s.accessRequests[:1])
+	}
+
+	got, err = s.repository.List(s.ctx, accessrequest.Filter{UserID: s.users[0].ID, State: accessrequest.StateApproved})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if len(got) != 0 {
+		s.T().Fatalf("got result %+v, expected was empty", got)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TestTransitions() {
+	rejected, err := s.repository.Review(s.ctx, accessrequest.Review{
+		ID:         s.accessRequests[0].ID,
+		State:      accessrequest.StateRejected,
+		ReviewedBy: s.users[2].ID,
+		Comment:    "not needed",
+	})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if rejected.State != accessrequest.StateRejected || rejected.ReviewedBy != s.users[2].ID || rejected.ReviewedAt.IsZero() {
+		s.T().Fatalf("got result %+v, expected was a request rejected by %s", rejected, s.users[2].ID)
+	}
+
+	// reviewed requests can't be reviewed again
+	_, err = s.repository.Review(s.ctx, accessrequest.Review{
+		ID:         s.accessRequests[0].ID,
+		State:      accessrequest.StateApproved,
+		ReviewedBy: s.users[2].ID,
+	})
+	if err != accessrequest.ErrNotPending {
+		s.T().Fatalf("got error %v, expected was %v", err, accessrequest.ErrNotPending)
+	}
+
+	expiresAt := time.Now().Add(time.Hour)
+	relationID := uuid.NewString()
+	approved, err := s.repository.Review(s.ctx, accessrequest.Review{
+		ID:         s.accessRequests[1].ID,
+		State:      accessrequest.StateApproved,
+		ReviewedBy: s.users[2].ID,
+		ExpiresAt:  expiresAt,
+		RelationID: relationID,
+	})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if approved.State != accessrequest.StateApproved || approved.ExpiresAt.IsZero() || approved.RelationID != relationID {
+		s.T().Fatalf("got result %+v, expected was a time-bound approved request", approved)
+	}
+
+	got, err := s.repository.ListExpired(s.ctx, time.Now())
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if len(got) != 0 {
+		s.T().Fatalf("got result %+v, expected was empty", got)
+	}
+
+	got, err = s.repository.ListExpired(s.ctx, expiresAt.Add(time.Minute))
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != approved.ID {
+		s.T().Fatalf("got result %+v, expected was the approved request", got)
+	}
+
+	expired, err := s.repository.Expire(s.ctx, approved.ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if expired.State != accessrequest.StateExpired {
+		s.T().Fatalf("got state %s, expected was %s", expired.State, accessrequest.StateExpired)
+	}
+	if _, err := s.repository.Expire(s.ctx, approved.ID); err != accessrequest.ErrNotPending {
+		s.T().Fatalf("got error %v, expected was %v", err, accessrequest.ErrNotPending)
+	}
+}
+
+func TestAccessRequestRepository(t *testing.T) {
+	suite.Run(t, new(AccessRequestRepositoryTestSuite))
+}
@@ -227,3 +227,59 @@ func (r ActionRepository) Update(ctx context.Context, act action.Action) (action
 
 	return actionModel.transformToAction(), nil
 }
+
+func (r ActionRepository) Delete(ctx context.Context, id string) error {
+	if strings.TrimSpace(id) == "" {
+		return action.ErrInvalidID
+	}
+
+	query, params, err := dialect.Delete(TABLE_ACTIONS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Delete"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_ACTIONS),
+		}...,
+	)
+
+	return r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_ACTIONS,
+				Operation:  "Delete",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			switch {
+			case errors.Is(err, errForeignKeyViolation):
+				return fmt.Errorf("%w: %s", action.ErrInvalidDetail, err)
+			default:
+				return err
+			}
+		}
+
+		count, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return nil
+		}
+
+		return action.ErrNotExist
+	})
+}
@@ -285,6 +285,45 @@ func (s *ActionRepositoryTestSuite) TestUpdate() {
 	}
 }
 
+func (s *ActionRepositoryTestSuite) TestDelete() {
+	type testCase struct {
+		Description string
+		DeletedID   string
+		ErrString   string
+	}
+
+	testCases := []testCase{
+		{
+			Description: "should delete a action",
+			DeletedID:   "action2",
+		},
+		{
+			Description: "should return error if id is empty",
+			ErrString:   action.ErrInvalidID.Error(),
+		},
+		{
+			Description: "should return error if id not exist",
+			DeletedID:   "10000",
+			ErrString:   action.ErrNotExist.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			err := s.repository.Delete(s.ctx, tc.DeletedID)
+			if tc.ErrString != "" {
+				if err == nil || err.Error() != tc.ErrString {
+					s.T().Fatalf("got error %v, expected was %s", err, tc.ErrString)
+				}
+				return
+			}
+			if err != nil {
+				s.T().Fatalf("got error %s, expected was nil", err.Error())
+			}
+		})
+	}
+}
+
 func TestActionRepository(t *testing.T) {
 	suite.Run(t, new(ActionRepositoryTestSuite))
 }
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/goto/shield/core/invitation"
+)
+
+type Invitation struct {
+	ID          string       `db:"id"`
+	Email       string       `db:"email"`
+	NamespaceID string       `db:"namespace_id"`
+	ObjectID    string       `db:"object_id"`
+	RoleID      string       `db:"role_id"`
+	InvitedBy   string       `db:"invited_by"`
+	ExpiresAt   time.Time    `db:"expires_at"`
+	AcceptedAt  sql.NullTime `db:"accepted_at"`
+	RevokedAt   sql.NullTime `db:"revoked_at"`
+	CreatedAt   time.Time    `db:"created_at"`
+}
+
+func (from Invitation) transformToInvitation() invitation.Invitation {
+	return invitation.Invitation{
+		ID:          from.ID,
+		Email:       from.Email,
+		NamespaceID: from.NamespaceID,
+		ObjectID:    from.ObjectID,
+		RoleID:      from.RoleID,
+		InvitedBy:   from.InvitedBy,
+		ExpiresAt:   from.ExpiresAt,
+		AcceptedAt:  from.AcceptedAt.Time,
+		RevokedAt:   from.RevokedAt.Time,
+		CreatedAt:   from.CreatedAt,
+	}
+}
@@ -0,0 +1,257 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/goto/shield/core/invitation"
+	"github.com/goto/shield/pkg/db"
+	newrelic "github.com/newrelic/go-agent/v3/newrelic"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+type InvitationRepository struct {
+	dbc *db.Client
+}
+
+func NewInvitationRepository(dbc *db.Client) *InvitationRepository {
+	return &InvitationRepository{
+		dbc: dbc,
+	}
+}
+
+func (r InvitationRepository) Create(ctx context.Context, inv invitation.Invitation) (invitation.Invitation, error) {
+	query, params, err := dialect.Insert(TABLE_INVITATIONS).Rows(
+		goqu.Record{
+			"email":        inv.Email,
+			"namespace_id": inv.NamespaceID,
+			"object_id":    inv.ObjectID,
+			"role_id":      inv.RoleID,
+			"invited_by":   inv.InvitedBy,
+			"expires_at":   inv.ExpiresAt,
+		}).Returning(&Invitation{}).ToSQL()
+	if err != nil {
+		return invitation.Invitation{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Create"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_INVITATIONS),
+		}...,
+	)
+
+	var invitationModel Invitation
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_INVITATIONS,
+				Operation:  "Create",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&invitationModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, errForeignKeyViolation),
+			errors.Is(err, errInvalidTexRepresentation):
+			return invitation.Invitation{}, invitation.ErrInvalidDetail
+		default:
+			return invitation.Invitation{}, err
+		}
+	}
+
+	return invitationModel.transformToInvitation(), nil
+}
+
+func (r InvitationRepository) GetByID(ctx context.Context, id string) (invitation.Invitation, error) {
+	query, params, err := dialect.Select(&Invitation{}).From(TABLE_INVITATIONS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return invitation.Invitation{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetByID"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_INVITATIONS),
+		}...,
+	)
+
+	var invitationModel Invitation
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_INVITATIONS,
+				Operation:  "GetByID",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.GetContext(ctx, &invitationModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return invitation.Invitation{}, invitation.ErrNotExist
+		case errors.Is(err, errInvalidTexRepresentation):
+			return invitation.Invitation{}, invitation.ErrInvalidID
+		default:
+			return invitation.Invitation{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return invitationModel.transformToInvitation(), nil
+}
+
+// List returns the pending invitations matching the filter, newest first
+func (r InvitationRepository) List(ctx context.Context, flt invitation.Filter) ([]invitation.Invitation, error) {
+	sqlStatement := dialect.Select(&Invitation{}).From(TABLE_INVITATIONS).Where(
+		goqu.C("accepted_at").IsNull(),
+		goqu.C("revoked_at").IsNull(),
+		goqu.C("expires_at").Gt(goqu.L("NOW()")),
+	)
+	if flt.NamespaceID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"namespace_id": flt.NamespaceID})
+	}
+	if flt.ObjectID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"object_id": flt.ObjectID})
+	}
+	if flt.Email != "" {
+		sqlStatement = sqlStatement.Where(goqu.Func("LOWER", goqu.C("email")).Eq(strings.ToLower(flt.Email)))
+	}
+	query, params, err := sqlStatement.Order(goqu.C("created_at").Desc()).ToSQL()
+	if err != nil {
+		return []invitation.Invitation{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "List"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_INVITATIONS),
+		}...,
+	)
+
+	var fetchedInvitations []Invitation
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_INVITATIONS,
+				Operation:  "List",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.SelectContext(ctx, &fetchedInvitations, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []invitation.Invitation{}, nil
+		case errors.Is(err, errInvalidTexRepresentation):
+			return []invitation.Invitation{}, nil
+		default:
+			return []invitation.Invitation{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	var transformedInvitations []invitation.Invitation
+	for _, inv := range fetchedInvitations {
+		transformedInvitations = append(transformedInvitations, inv.transformToInvitation())
+	}
+
+	return transformedInvitations, nil
+}
+
+func (r InvitationRepository) Revoke(ctx context.Context, id string) (invitation.Invitation, error) {
+	query, params, err := dialect.Update(TABLE_INVITATIONS).Set(goqu.Record{
+		"revoked_at": goqu.L("NOW()"),
+	}).Where(goqu.Ex{
+		"id":          id,
+		"accepted_at": nil,
+		"revoked_at":  nil,
+	}).Returning(&Invitation{}).ToSQL()
+	if err != nil {
+		return invitation.Invitation{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.resolve(ctx, "Revoke", query, params)
+}
+
+// Accept marks a pending invitation accepted, invitations which are already
+// accepted, revoked or expired are left as they are
+func (r InvitationRepository) Accept(ctx context.Context, id string) (invitation.Invitation, error) {
+	query, params, err := dialect.Update(TABLE_INVITATIONS).Set(goqu.Record{
+		"accepted_at": goqu.L("NOW()"),
+	}).Where(goqu.Ex{
+		"id":          id,
+		"accepted_at": nil,
+		"revoked_at":  nil,
+	}, goqu.C("expires_at").Gt(goqu.L("NOW()"))).Returning(&Invitation{}).ToSQL()
+	if err != nil {
+		return invitation.Invitation{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.resolve(ctx, "Accept", query, params)
+}
+
+// resolve runs an update of a pending invitation, no row is returned when the
+// invitation isn't pending anymore
+func (r InvitationRepository) resolve(ctx context.Context, method, query string, params []interface{}) (invitation.Invitation, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", method),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_INVITATIONS),
+		}...,
+	)
+
+	var invitationModel Invitation
+	if err := r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_INVITATIONS,
+				Operation:  method,
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&invitationModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return invitation.Invitation{}, invitation.ErrNotPending
+		case errors.Is(err, errInvalidTexRepresentation):
+			return invitation.Invitation{}, invitation.ErrInvalidID
+		default:
+			return invitation.Invitation{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return invitationModel.transformToInvitation(), nil
+}
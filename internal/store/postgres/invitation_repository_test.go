@@ -0,0 +1,189 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/invitation"
+	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/user"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/internal/store/postgres"
+	"github.com/goto/shield/pkg/db"
+	"github.com/goto/shield/pkg/uuid"
+	"github.com/ory/dockertest"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvitationRepositoryTestSuite struct {
+	suite.Suite
+	ctx         context.Context
+	client      *db.Client
+	pool        *dockertest.Pool
+	resource    *dockertest.Resource
+	repository  *postgres.InvitationRepository
+	users       []user.User
+	orgs        []organization.Organization
+	invitations []invitation.Invitation
+}
+
+func (s *InvitationRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewInvitationRepository(s.client)
+
+	if _, err = bootstrapNamespace(s.client); err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.users, err = bootstrapUser(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.orgs, err = bootstrapOrganization(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *InvitationRepositoryTestSuite) SetupTest() {
+	s.invitations = nil
+	for _, email := range []string{"invitee-1@gotocompany.com", "Invitee-2@gotocompany.com"} {
+		created, err := s.repository.Create(s.ctx, invitation.Invitation{
+			Email:       email,
+			NamespaceID: schema.OrganizationNamespace,
+			ObjectID:    s.orgs[0].ID,
+			RoleID:      schema.ViewerRole,
+			InvitedBy:   s.users[0].ID,
+			ExpiresAt:   time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		s.invitations = append(s.invitations, created)
+	}
+}
+
+func (s *InvitationRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *InvitationRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *InvitationRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_INVITATIONS),
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *InvitationRepositoryTestSuite) TestCreate() {
+	_, err := s.repository.Create(s.ctx, invitation.Invitation{
+		Email:       "invitee-1@gotocompany.com",
+		NamespaceID: "unknown/namespace",
+		ObjectID:    s.orgs[0].ID,
+		RoleID:      schema.ViewerRole,
+		InvitedBy:   s.users[0].ID,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	if err != invitation.ErrInvalidDetail {
+		s.T().Fatalf("got error %v, expected was %v", err, invitation.ErrInvalidDetail)
+	}
+}
+
+func (s *InvitationRepositoryTestSuite) TestGetByID() {
+	got, err := s.repository.GetByID(s.ctx, s.invitations[0].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if !cmp.Equal(got, s.invitations[0]) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, s.invitations[0])
+	}
+
+	_, err = s.repository.GetByID(s.ctx, uuid.NewString())
+	if err != invitation.ErrNotExist {
+		s.T().Fatalf("got error %v, expected was %v", err, invitation.ErrNotExist)
+	}
+}
+
+func (s *InvitationRepositoryTestSuite) TestList() {
+	got, err := s.repository.List(s.ctx, invitation.Filter{NamespaceID: schema.OrganizationNamespace, ObjectID: s.orgs[0].ID})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	expected := []invitation.Invitation{s.invitations[1], s.invitations[0]}
+	if !cmp.Equal(got, expected) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, expected)
+	}
+
+	// emails are matched regardless of their case
+	got, err = s.repository.List(s.ctx, invitation.Filter{Email: "invitee-2@gotocompany.com"})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if !cmp.Equal(got, s.invitations[1:]) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, s.invitations[1:])
+	}
+
+	// revoked invitations aren't pending
+	if _, err := s.repository.Revoke(s.ctx, s.invitations[0].ID); err != nil {
+		s.T().Fatal(err)
+	}
+	got, err = s.repository.List(s.ctx, invitation.Filter{NamespaceID: schema.OrganizationNamespace, ObjectID: s.orgs[0].ID})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if !cmp.Equal(got, s.invitations[1:]) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, s.invitations[1:])
+	}
+}
+
+func (s *InvitationRepositoryTestSuite) TestRevokeAndAccept() {
+	revoked, err := s.repository.Revoke(s.ctx, s.invitations[0].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if revoked.RevokedAt.IsZero() {
+		s.T().Fatalf("got result %+v, expected was a revoked invitation", revoked)
+	}
+	if _, err := s.repository.Accept(s.ctx, s.invitations[0].ID); err != invitation.ErrNotPending {
+		s.T().Fatalf("got error %v, expected was %v", err, invitation.ErrNotPending)
+	}
+
+	accepted, err := s.repository.Accept(s.ctx, s.invitations[1].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	expected := s.invitations[1]
+	expected.AcceptedAt = time.Now()
+	if !cmp.Equal(accepted, expected, cmpopts.EquateApproxTime(time.Minute)) {
+		s.T().Fatalf("got result %+v, expected was %+v", accepted, expected)
+	}
+	if _, err := s.repository.Revoke(s.ctx, s.invitations[1].ID); err != invitation.ErrNotPending {
+		s.T().Fatalf("got error %v, expected was %v", err, invitation.ErrNotPending)
+	}
+}
+
+func TestInvitationRepository(t *testing.T) {
+	suite.Run(t, new(InvitationRepositoryTestSuite))
+}
@@ -0,0 +1,2 @@
+ALTER TABLE resources
+    DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,2 @@
+ALTER TABLE resources
+    ADD COLUMN IF NOT EXISTS parent_id uuid REFERENCES resources (id);
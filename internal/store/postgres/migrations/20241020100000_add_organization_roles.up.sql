@@ -0,0 +1,5 @@
+ALTER TABLE roles
+    ADD COLUMN IF NOT EXISTS org_id uuid REFERENCES organizations (id),
+    ADD COLUMN IF NOT EXISTS permissions varchar[];
+
+CREATE INDEX IF NOT EXISTS roles_org_id_idx ON roles (org_id);
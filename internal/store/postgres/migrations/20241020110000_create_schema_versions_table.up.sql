@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS schema_versions
+(
+    version     BIGSERIAL       NOT NULL PRIMARY KEY,
+    schema      text            NOT NULL,
+    config      jsonb           NOT NULL,
+    created_at  timestamptz     NOT NULL    DEFAULT NOW()
+);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS service_accounts
+(
+    id          uuid            PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    name        varchar         NOT NULL,
+    org_id      uuid            NOT NULL        REFERENCES organizations(id),
+    metadata    jsonb,
+    created_at  timestamptz     NOT NULL        DEFAULT NOW(),
+    updated_at  timestamptz     NOT NULL        DEFAULT NOW(),
+    UNIQUE (org_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS service_account_keys
+(
+    id                  uuid            PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    service_account_id  uuid            NOT NULL        REFERENCES service_accounts(id) ON DELETE CASCADE,
+    hash                varchar         NOT NULL,
+    expires_at          timestamptz,
+    revoked_at          timestamptz,
+    created_at          timestamptz     NOT NULL        DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS service_account_keys_service_account_id_idx ON service_account_keys (service_account_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS invitations
+(
+    id              uuid            PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    email           varchar         NOT NULL,
+    namespace_id    varchar         NOT NULL        REFERENCES namespaces(id),
+    object_id       uuid            NOT NULL,
+    role_id         varchar         NOT NULL,
+    invited_by      uuid            NOT NULL        REFERENCES users(id),
+    expires_at      timestamptz     NOT NULL,
+    accepted_at     timestamptz,
+    revoked_at      timestamptz,
+    created_at      timestamptz     NOT NULL        DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS invitations_namespace_id_object_id_idx ON invitations (namespace_id, object_id);
+CREATE INDEX IF NOT EXISTS invitations_email_idx ON invitations (email);
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS access_requests
+(
+    id              uuid            PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    user_id         uuid            NOT NULL        REFERENCES users(id),
+    namespace_id    varchar         NOT NULL        REFERENCES namespaces(id),
+    object_id       uuid            NOT NULL,
+    role_id         varchar         NOT NULL,
+    reason          varchar         NOT NULL        DEFAULT '',
+    state           varchar         NOT NULL        DEFAULT 'pending',
+    reviewed_by     uuid                            REFERENCES users(id),
+    reviewed_at     timestamptz,
+    review_comment  varchar         NOT NULL        DEFAULT '',
+    expires_at      timestamptz,
+    -- the relation created by the approval, it is only revoked on expiry
+    -- if it wasn't granted again since
+    relation_id     uuid,
+    created_at      timestamptz     NOT NULL        DEFAULT NOW(),
+    updated_at      timestamptz     NOT NULL        DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS access_requests_namespace_id_object_id_idx ON access_requests (namespace_id, object_id);
+CREATE INDEX IF NOT EXISTS access_requests_user_id_idx ON access_requests (user_id);
+-- a user has at most one pending request of a role on an object
+CREATE UNIQUE INDEX IF NOT EXISTS access_requests_pending_idx ON access_requests (user_id, namespace_id, object_id, role_id) WHERE state = 'pending';
+-- approved requests are scanned for grants to expire
+CREATE INDEX IF NOT EXISTS access_requests_expires_at_idx ON access_requests (expires_at) WHERE state = 'approved';
@@ -274,3 +274,61 @@ func (r PolicyRepository) Update(ctx context.Context, toUpdate *policy.Policy) (
 
 	return policyID, nil
 }
+
+func (r PolicyRepository) Delete(ctx context.Context, id string) error {
+	if strings.TrimSpace(id) == "" {
+		return policy.ErrInvalidID
+	}
+
+	query, params, err := dialect.Delete(TABLE_POLICIES).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Delete"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_POLICIES),
+		}...,
+	)
+
+	return r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_POLICIES,
+				Operation:  "Delete",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			switch {
+			case errors.Is(err, errForeignKeyViolation):
+				return fmt.Errorf("%w: %s", policy.ErrInvalidDetail, err)
+			case errors.Is(err, errInvalidTexRepresentation):
+				return policy.ErrInvalidUUID
+			default:
+				return err
+			}
+		}
+
+		count, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return nil
+		}
+
+		return policy.ErrNotExist
+	})
+}
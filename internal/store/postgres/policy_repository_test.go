@@ -322,6 +322,50 @@ func (s *PolicyRepositoryTestSuite) TestUpdate() {
 	}
 }
 
+func (s *PolicyRepositoryTestSuite) TestDelete() {
+	type testCase struct {
+		Description string
+		DeletedID   string
+		ErrString   string
+	}
+
+	testCases := []testCase{
+		{
+			Description: "should delete a policy",
+			DeletedID:   s.policyIDs[0],
+		},
+		{
+			Description: "should return error if id is empty",
+			ErrString:   policy.ErrInvalidID.Error(),
+		},
+		{
+			Description: "should return error if id not exist",
+			DeletedID:   uuid.NewString(),
+			ErrString:   policy.ErrNotExist.Error(),
+		},
+		{
+			Description: "should return error if id is not uuid",
+			DeletedID:   "random",
+			ErrString:   policy.ErrInvalidUUID.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			err := s.repository.Delete(s.ctx, tc.DeletedID)
+			if tc.ErrString != "" {
+				if err == nil || err.Error() != tc.ErrString {
+					s.T().Fatalf("got error %v, expected was %s", err, tc.ErrString)
+				}
+				return
+			}
+			if err != nil {
+				s.T().Fatalf("got error %s, expected was nil", err.Error())
+			}
+		})
+	}
+}
+
 func TestPolicyRepository(t *testing.T) {
 	suite.Run(t, new(PolicyRepositoryTestSuite))
 }
@@ -20,23 +20,28 @@ var (
 )
 
 const (
-	TABLE_ACTIONS           = "actions"
-	TABLE_GROUPS            = "groups"
-	TABLE_NAMESPACES        = "namespaces"
-	TABLE_ORGANIZATIONS     = "organizations"
-	TABLE_POLICIES          = "policies"
-	TABLE_PROJECTS          = "projects"
-	TABLE_RELATIONS         = "relations"
-	TABLE_RESOURCES         = "resources"
-	TABLE_ROLES             = "roles"
-	TABLE_USERS             = "users"
-	TABLE_METADATA          = "metadata"
-	TABLE_METADATA_KEYS     = "metadata_keys"
-	TABLE_ACTIVITY          = "activities"
-	TABLE_SERVICE_DATA      = "servicedata"
-	TABLE_SERVICE_DATA_KEYS = "servicedata_keys"
-	TABLE_RULE_CONFIGS      = "rule_configs"
-	TABLE_RESOURCE_CONFIGS  = "resource_configs"
+	TABLE_ACTIONS              = "actions"
+	TABLE_GROUPS               = "groups"
+	TABLE_NAMESPACES           = "namespaces"
+	TABLE_ORGANIZATIONS        = "organizations"
+	TABLE_POLICIES             = "policies"
+	TABLE_PROJECTS             = "projects"
+	TABLE_RELATIONS            = "relations"
+	TABLE_RESOURCES            = "resources"
+	TABLE_ROLES                = "roles"
+	TABLE_USERS                = "users"
+	TABLE_METADATA             = "metadata"
+	TABLE_METADATA_KEYS        = "metadata_keys"
+	TABLE_ACTIVITY             = "activities"
+	TABLE_SERVICE_DATA         = "servicedata"
+	TABLE_SERVICE_DATA_KEYS    = "servicedata_keys"
+	TABLE_RULE_CONFIGS         = "rule_configs"
+	TABLE_RESOURCE_CONFIGS     = "resource_configs"
+	TABLE_SCHEMA_VERSIONS      = "schema_versions"
+	TABLE_SERVICE_ACCOUNTS     = "service_accounts"
+	TABLE_SERVICE_ACCOUNT_KEYS = "service_account_keys"
+	TABLE_INVITATIONS          = "invitations"
+	TABLE_ACCESS_REQUESTS      = "access_requests"
 )
 
 func checkPostgresError(err error) error {
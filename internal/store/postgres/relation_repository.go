@@ -36,8 +36,10 @@ func (r RelationRepository) Create(ctx context.Context, relationToCreate relatio
 			"object_id":            relationToCreate.Object.ID,
 			"role_id":              schema.GetRoleID(relationToCreate.Object.NamespaceID, relationToCreate.Subject.RoleID),
 		}).OnConflict(
+		// granting an existing relation again marks it updated
 		goqu.DoUpdate("subject_namespace_id, subject_id, object_namespace_id,  object_id, role_id", goqu.Record{
 			"subject_namespace_id": relationToCreate.Subject.Namespace,
+			"updated_at":           goqu.L("NOW()"),
 		})).Returning(&relationCols{}).ToSQL()
 	if err != nil {
 		return relation.RelationV2{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -279,3 +281,210 @@ func (r RelationRepository) GetByFields(ctx context.Context, rel relation.Relati
 
 	return fetchedRelation.transformToRelationV2(), nil
 }
+
+func (r RelationRepository) CountByRoleID(ctx context.Context, roleID string) (int64, error) {
+	return r.count(ctx, "CountByRoleID", goqu.Ex{"role_id": roleID})
+}
+
+func (r RelationRepository) CountByObjectNamespaceID(ctx context.Context, namespaceID string) (int64, error) {
+	return r.count(ctx, "CountByObjectNamespaceID", goqu.Ex{"object_namespace_id": namespaceID})
+}
+
+func (r RelationRepository) DeleteByRoleID(ctx context.Context, roleID string) error {
+	query, params, err := dialect.Delete(TABLE_RELATIONS).Where(goqu.Ex{
+		"role_id": roleID,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "DeleteByRoleID"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_RELATIONS),
+		}...,
+	)
+
+	return r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_RELATIONS,
+				Operation:  "DeleteByRoleID",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return checkPostgresError(err)
+		}
+		return nil
+	})
+}
+
+func (r RelationRepository) count(ctx context.Context, method string, where goqu.Ex) (int64, error) {
+	query, params, err := dialect.Select(goqu.COUNT("*")).From(TABLE_RELATIONS).Where(where).ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", method),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_RELATIONS),
+		}...,
+	)
+
+	var count int64
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_RELATIONS,
+				Operation:  method,
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.GetContext(ctx, &count, query, params...)
+	}); err != nil {
+		return 0, fmt.Errorf("%w: %s", dbErr, checkPostgresError(err))
+	}
+
+	return count, nil
+}
+
+// ListByObject returns the relations of the object with the role
+func (r RelationRepository) ListByObject(ctx context.Context, namespaceID, objectID, roleID string) ([]relation.RelationV2, error) {
+	query, params, err := dialect.Select(&relationCols{}).From(TABLE_RELATIONS).Where(goqu.Ex{
+		"object_namespace_id": namespaceID,
+		"object_id":           objectID,
+		"role_id":             roleID,
+	}).ToSQL()
+	if err != nil {
+		return []relation.RelationV2{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListByObject"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_RELATIONS),
+		}...,
+	)
+
+	var fetchedRelations []Relation
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_RELATIONS,
+				Operation:  "ListByObject",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.SelectContext(ctx, &fetchedRelations, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []relation.RelationV2{}, nil
+		}
+		return []relation.RelationV2{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	transformedRelations := make([]relation.RelationV2, 0, len(fetchedRelations))
+	for _, r := range fetchedRelations {
+		transformedRelations = append(transformedRelations, r.transformToRelationV2())
+	}
+
+	return transformedRelations, nil
+}
+
+// ListObjectIDs returns the ids of objects of the namespace having relations
+func (r RelationRepository) ListObjectIDs(ctx context.Context, namespaceID string) ([]string, error) {
+	query, params, err := dialect.Select("object_id").Distinct().From(TABLE_RELATIONS).Where(goqu.Ex{
+		"object_namespace_id": namespaceID,
+	}).Order(goqu.C("object_id").Asc()).ToSQL()
+	if err != nil {
+		return []string{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListObjectIDs"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_RELATIONS),
+		}...,
+	)
+
+	var objectIDs []string
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_RELATIONS,
+				Operation:  "ListObjectIDs",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.SelectContext(ctx, &objectIDs, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []string{}, nil
+		}
+		return []string{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return objectIDs, nil
+}
+
+// DeleteByObject deletes the relations of an object of the namespace or of
+// every object of the namespace if the object id is empty
+func (r RelationRepository) DeleteByObject(ctx context.Context, namespaceID, objectID string) error {
+	where := goqu.Ex{"object_namespace_id": namespaceID}
+	if objectID != "" {
+		where["object_id"] = objectID
+	}
+
+	query, params, err := dialect.Delete(TABLE_RELATIONS).Where(where).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "DeleteByObject"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_RELATIONS),
+		}...,
+	)
+
+	return r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_RELATIONS,
+				Operation:  "DeleteByObject",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return checkPostgresError(err)
+		}
+		return nil
+	})
+}
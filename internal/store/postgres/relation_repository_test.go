@@ -317,6 +317,143 @@ func (s *RelationRepositoryTestSuite) TestDeleteByID() {
 	}
 }
 
+func (s *RelationRepositoryTestSuite) TestCountByRoleID() {
+	type testCase struct {
+		Description   string
+		RoleID        string
+		ExpectedCount int64
+	}
+
+	testCases := []testCase{
+		{
+			Description:   "should count relations with the role",
+			RoleID:        "ns1:role1",
+			ExpectedCount: 1,
+		},
+		{
+			Description:   "should return zero if no relation has the role",
+			RoleID:        "ns1:role2",
+			ExpectedCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.CountByRoleID(s.ctx, tc.RoleID)
+			if err != nil {
+				s.T().Fatalf("got error %s, expected was nil", err.Error())
+			}
+			if got != tc.ExpectedCount {
+				s.T().Fatalf("got result %d, expected was %d", got, tc.ExpectedCount)
+			}
+		})
+	}
+}
+
+func (s *RelationRepositoryTestSuite) TestCountByObjectNamespaceID() {
+	type testCase struct {
+		Description   string
+		NamespaceID   string
+		ExpectedCount int64
+	}
+
+	testCases := []testCase{
+		{
+			Description:   "should count relations on objects of the namespace",
+			NamespaceID:   "ns2",
+			ExpectedCount: 1,
+		},
+		{
+			Description:   "should return zero if no relation is on objects of the namespace",
+			NamespaceID:   "ns3",
+			ExpectedCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.CountByObjectNamespaceID(s.ctx, tc.NamespaceID)
+			if err != nil {
+				s.T().Fatalf("got error %s, expected was nil", err.Error())
+			}
+			if got != tc.ExpectedCount {
+				s.T().Fatalf("got result %d, expected was %d", got, tc.ExpectedCount)
+			}
+		})
+	}
+}
+
+func (s *RelationRepositoryTestSuite) TestListByObject() {
+	type testCase struct {
+		Description       string
+		NamespaceID       string
+		ObjectID          string
+		RoleID            string
+		ExpectedRelations []relation.RelationV2
+	}
+
+	testCases := []testCase{
+		{
+			Description:       "should list relations of the object with the role",
+			NamespaceID:       "ns1",
+			ObjectID:          "uuid2",
+			RoleID:            "ns1:role1",
+			ExpectedRelations: []relation.RelationV2{s.relations[0]},
+		},
+		{
+			Description:       "should return empty if the object has no relation with the role",
+			NamespaceID:       "ns1",
+			ObjectID:          "uuid2",
+			RoleID:            "ns1:role2",
+			ExpectedRelations: []relation.RelationV2{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.ListByObject(s.ctx, tc.NamespaceID, tc.ObjectID, tc.RoleID)
+			if err != nil {
+				s.T().Fatalf("got error %s, expected was nil", err.Error())
+			}
+			if !cmp.Equal(got, tc.ExpectedRelations, cmpopts.IgnoreFields(relation.RelationV2{}, "CreatedAt", "UpdatedAt")) {
+				s.T().Fatalf("got result %+v, expected was %+v", got, tc.ExpectedRelations)
+			}
+		})
+	}
+}
+
+func (s *RelationRepositoryTestSuite) TestListObjectIDs() {
+	got, err := s.repository.ListObjectIDs(s.ctx, "ns2")
+	if err != nil {
+		s.T().Fatalf("got error %s, expected was nil", err.Error())
+	}
+	if !cmp.Equal(got, []string{"uuid4"}) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, []string{"uuid4"})
+	}
+}
+
+func (s *RelationRepositoryTestSuite) TestDeleteByObject() {
+	if err := s.repository.DeleteByObject(s.ctx, "ns1", ""); err != nil {
+		s.T().Fatalf("got error %s, expected was nil", err.Error())
+	}
+
+	count, err := s.repository.CountByObjectNamespaceID(s.ctx, "ns1")
+	if err != nil {
+		s.T().Fatalf("got error %s, expected was nil", err.Error())
+	}
+	if count != 0 {
+		s.T().Fatalf("got %d relations left, expected was 0", count)
+	}
+
+	count, err = s.repository.CountByObjectNamespaceID(s.ctx, "ns2")
+	if err != nil {
+		s.T().Fatalf("got error %s, expected was nil", err.Error())
+	}
+	if count != 1 {
+		s.T().Fatalf("got %d relations left, expected was 1", count)
+	}
+}
+
 func TestRelationRepository(t *testing.T) {
 	suite.Run(t, new(RelationRepositoryTestSuite))
 }
@@ -20,6 +20,7 @@ type Resource struct {
 	Namespace      Namespace      `db:"namespace"`
 	User           User           `db:"user"`
 	UserID         sql.NullString `db:"user_id"`
+	ParentID       sql.NullString `db:"parent_id"`
 	CreatedAt      time.Time      `db:"created_at"`
 	UpdatedAt      time.Time      `db:"updated_at"`
 	DeletedAt      sql.NullTime   `db:"deleted_at"`
@@ -35,6 +36,7 @@ func (from Resource) transformToResource() resource.Resource {
 		NamespaceID:    from.NamespaceID,
 		OrganizationID: from.OrganizationID,
 		UserID:         from.UserID.String,
+		ParentID:       from.ParentID.String,
 		CreatedAt:      from.CreatedAt,
 		UpdatedAt:      from.UpdatedAt,
 	}
@@ -48,6 +50,7 @@ type ResourceCols struct {
 	OrganizationID string         `db:"org_id"`
 	NamespaceID    string         `db:"namespace_id"`
 	UserID         sql.NullString `db:"user_id"`
+	ParentID       sql.NullString `db:"parent_id"`
 	CreatedAt      time.Time      `db:"created_at"`
 	UpdatedAt      time.Time      `db:"updated_at"`
 }
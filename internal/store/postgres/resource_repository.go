@@ -35,6 +35,7 @@ func (r ResourceRepository) Upsert(ctx context.Context, res resource.Resource) (
 	}
 
 	userID := sql.NullString{String: res.UserID, Valid: res.UserID != ""}
+	parentID := sql.NullString{String: res.ParentID, Valid: res.ParentID != ""}
 
 	query, params, err := dialect.Insert(TABLE_RESOURCES).Rows(
 		goqu.Record{
@@ -44,6 +45,7 @@ func (r ResourceRepository) Upsert(ctx context.Context, res resource.Resource) (
 			"org_id":       res.OrganizationID,
 			"namespace_id": res.NamespaceID,
 			"user_id":      userID,
+			"parent_id":    parentID,
 		}).OnConflict(
 		goqu.DoUpdate("ON CONSTRAINT resources_urn_unique", goqu.Record{
 			"name":         res.Name,
@@ -51,6 +53,7 @@ func (r ResourceRepository) Upsert(ctx context.Context, res resource.Resource) (
 			"org_id":       res.OrganizationID,
 			"namespace_id": res.NamespaceID,
 			"user_id":      userID,
+			"parent_id":    parentID,
 		})).Returning(&ResourceCols{}).ToSQL()
 	if err != nil {
 		return resource.Resource{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -99,6 +102,7 @@ func (r ResourceRepository) Create(ctx context.Context, res resource.Resource) (
 	}
 
 	userID := sql.NullString{String: res.UserID, Valid: res.UserID != ""}
+	parentID := sql.NullString{String: res.ParentID, Valid: res.ParentID != ""}
 
 	query, params, err := dialect.Insert(TABLE_RESOURCES).Rows(
 		goqu.Record{
@@ -108,6 +112,7 @@ func (r ResourceRepository) Create(ctx context.Context, res resource.Resource) (
 			"org_id":       res.OrganizationID,
 			"namespace_id": res.NamespaceID,
 			"user_id":      userID,
+			"parent_id":    parentID,
 		}).Returning(&ResourceCols{}).ToSQL()
 	if err != nil {
 		return resource.Resource{}, fmt.Errorf("%w: %s", queryErr, err)
@@ -434,6 +439,62 @@ func (r ResourceRepository) GetByNamespace(ctx context.Context, name string, ns
 	return fetchedResource.transformToResource(), nil
 }
 
+func (r ResourceRepository) Delete(ctx context.Context, id string) error {
+	if strings.TrimSpace(id) == "" {
+		return resource.ErrInvalidID
+	}
+
+	query, params, err := dialect.Delete(TABLE_RESOURCES).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Delete"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_RESOURCES),
+		}...,
+	)
+
+	return r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_RESOURCES,
+				Operation:  "Delete",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			switch {
+			case errors.Is(err, errInvalidTexRepresentation):
+				return resource.ErrInvalidUUID
+			default:
+				return err
+			}
+		}
+
+		count, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return nil
+		}
+
+		return resource.ErrNotExist
+	})
+}
+
 func (r ResourceRepository) UpsertConfig(ctx context.Context, name string, config schema.NamespaceConfigMapType) (schema.Config, error) {
 	configJson, err := json.Marshal(config)
 	if err != nil {
@@ -376,6 +376,40 @@ func (s *ResourceRepositoryTestSuite) TestCreate() {
 				UserID:         s.resources[0].UserID,
 			},
 		},
+		{
+			Description: "should create a resource with a parent",
+			ResourceToCreate: resource.Resource{
+				URN:            "new-urn-5",
+				Name:           "resource5",
+				ProjectID:      s.resources[0].ProjectID,
+				OrganizationID: s.resources[0].OrganizationID,
+				NamespaceID:    s.resources[0].NamespaceID,
+				UserID:         s.resources[0].UserID,
+				ParentID:       s.resources[0].Idxa,
+			},
+			ExpectedResource: resource.Resource{
+				URN:            "new-urn-5",
+				Name:           "resource5",
+				ProjectID:      s.resources[0].ProjectID,
+				OrganizationID: s.resources[0].OrganizationID,
+				NamespaceID:    s.resources[0].NamespaceID,
+				UserID:         s.resources[0].UserID,
+				ParentID:       s.resources[0].Idxa,
+			},
+		},
+		{
+			Description: "should return error if parent does not exist",
+			ResourceToCreate: resource.Resource{
+				URN:            "new-urn-notexist",
+				Name:           "resource4",
+				ProjectID:      s.resources[0].ProjectID,
+				OrganizationID: s.resources[0].OrganizationID,
+				NamespaceID:    s.resources[0].NamespaceID,
+				UserID:         s.resources[0].UserID,
+				ParentID:       uuid.NewString(),
+			},
+			ErrString: resource.ErrInvalidDetail.Error(),
+		},
 		{
 			Description: "should return error if namespace id does not exist",
 			ResourceToCreate: resource.Resource{
@@ -885,6 +919,22 @@ func (s *ResourceRepositoryTestSuite) TestUpsertResourceConfigs() {
 	}
 }
 
+func (s *ResourceRepositoryTestSuite) TestDelete() {
+	if err := s.repository.Delete(s.ctx, s.resources[0].Idxa); err != nil {
+		s.T().Fatal(err)
+	}
+	if _, err := s.repository.GetByID(s.ctx, s.resources[0].Idxa); err != resource.ErrNotExist {
+		s.T().Fatalf("got error %v, expected was %v", err, resource.ErrNotExist)
+	}
+
+	if err := s.repository.Delete(s.ctx, s.resources[0].Idxa); err != resource.ErrNotExist {
+		s.T().Fatalf("got error %v, expected was %v", err, resource.ErrNotExist)
+	}
+	if err := s.repository.Delete(s.ctx, ""); err != resource.ErrInvalidID {
+		s.T().Fatalf("got error %v, expected was %v", err, resource.ErrInvalidID)
+	}
+}
+
 func TestResourceRepository(t *testing.T) {
 	suite.Run(t, new(ResourceRepositoryTestSuite))
 }
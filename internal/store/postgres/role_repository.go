@@ -12,6 +12,7 @@ import (
 	"github.com/goto/shield/core/namespace"
 	"github.com/goto/shield/core/role"
 	"github.com/goto/shield/pkg/db"
+	"github.com/lib/pq"
 	newrelic "github.com/newrelic/go-agent/v3/newrelic"
 	"go.nhat.io/otelsql"
 	"go.opentelemetry.io/otel/attribute"
@@ -34,6 +35,8 @@ func (r RoleRepository) buildListQuery(dialect goqu.DialectWrapper) *goqu.Select
 		goqu.I("r.name"),
 		goqu.I("r.types"),
 		goqu.I("r.namespace_id"),
+		goqu.I("r.org_id"),
+		goqu.I("r.permissions"),
 		goqu.I("r.metadata"),
 		goqu.I("namespaces.id").As(goqu.C("namespace.id")),
 		goqu.I("namespaces.name").As(goqu.C("namespace.name")),
@@ -114,10 +117,13 @@ func (r RoleRepository) Upsert(ctx context.Context, rl role.Role) (string, error
 			"types":        goqu.L("$3"),
 			"namespace_id": goqu.L("$4"),
 			"metadata":     goqu.L("$5"),
+			"org_id":       goqu.L("$6"),
+			"permissions":  goqu.L("$7"),
 		}).OnConflict(
 		goqu.DoUpdate("id", goqu.Record{
-			"types":    goqu.L("$3"),
-			"metadata": goqu.L("$5"),
+			"types":       goqu.L("$3"),
+			"metadata":    goqu.L("$5"),
+			"permissions": goqu.L("$7"),
 		},
 		)).Returning("id").ToSQL()
 	if err != nil {
@@ -126,6 +132,8 @@ func (r RoleRepository) Upsert(ctx context.Context, rl role.Role) (string, error
 
 	types := strings.Join(rl.Types, ",")
 	types = fmt.Sprintf("{%s}", types)
+	orgID := sql.NullString{String: rl.OrgID, Valid: rl.OrgID != ""}
+	permissions := pq.StringArray(rl.Permissions)
 
 	ctx = otelsql.WithCustomAttributes(
 		ctx,
@@ -148,13 +156,14 @@ func (r RoleRepository) Upsert(ctx context.Context, rl role.Role) (string, error
 			defer nr.End()
 		}
 
-		return r.dbc.QueryRowxContext(ctx, query, rl.ID, rl.Name, types, rl.NamespaceID, marshaledMetadata).Scan(&roleID)
+		return r.dbc.QueryRowxContext(ctx, query, rl.ID, rl.Name, types, rl.NamespaceID, marshaledMetadata, orgID, permissions).Scan(&roleID)
 	}); err != nil {
 		err = checkPostgresError(err)
 		switch {
 		case errors.Is(err, errDuplicateKey):
 			return "", role.ErrConflict
-		case errors.Is(err, errForeignKeyViolation):
+		case errors.Is(err, errForeignKeyViolation),
+			errors.Is(err, errInvalidTexRepresentation):
 			return "", role.ErrInvalidDetail
 		default:
 			return "", err
@@ -164,8 +173,13 @@ func (r RoleRepository) Upsert(ctx context.Context, rl role.Role) (string, error
 	return roleID, nil
 }
 
-func (r RoleRepository) List(ctx context.Context) ([]role.Role, error) {
-	query, params, err := r.buildListQuery(dialect).ToSQL()
+func (r RoleRepository) List(ctx context.Context, flt role.Filter) ([]role.Role, error) {
+	sqlStatement := r.buildListQuery(dialect)
+	if flt.OrgID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"r.org_id": flt.OrgID})
+	}
+
+	query, params, err := sqlStatement.ToSQL()
 	if err != nil {
 		return []role.Role{}, fmt.Errorf("%w: %s", queryErr, err)
 	}
@@ -275,3 +289,59 @@ func (r RoleRepository) Update(ctx context.Context, rl role.Role) (string, error
 
 	return roleID, nil
 }
+
+func (r RoleRepository) Delete(ctx context.Context, id string) error {
+	if strings.TrimSpace(id) == "" {
+		return role.ErrInvalidID
+	}
+
+	query, params, err := dialect.Delete(TABLE_ROLES).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Delete"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_ROLES),
+		}...,
+	)
+
+	return r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_ROLES,
+				Operation:  "Delete",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			err = checkPostgresError(err)
+			switch {
+			case errors.Is(err, errForeignKeyViolation):
+				return fmt.Errorf("%w: %s", role.ErrInvalidDetail, err)
+			default:
+				return err
+			}
+		}
+
+		count, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return nil
+		}
+
+		return role.ErrNotExist
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"github.com/goto/salt/log"
 	"github.com/goto/shield/core/namespace"
 	"github.com/goto/shield/core/role"
@@ -185,6 +186,7 @@ func (s *RoleRepositoryTestSuite) TestUpsert() {
 func (s *RoleRepositoryTestSuite) TestList() {
 	type testCase struct {
 		Description   string
+		Filter        role.Filter
 		ExpectedRoles []role.Role
 		ErrString     string
 	}
@@ -265,11 +267,15 @@ func (s *RoleRepositoryTestSuite) TestList() {
 				},
 			},
 		},
+		{
+			Description: "should get no roles of an organization without custom roles",
+			Filter:      role.Filter{OrgID: uuid.NewString()},
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.Description, func() {
-			got, err := s.repository.List(s.ctx)
+			got, err := s.repository.List(s.ctx, tc.Filter)
 			if tc.ErrString != "" {
 				if err.Error() != tc.ErrString {
 					s.T().Fatalf("got error %s, expected was %s", err.Error(), tc.ErrString)
@@ -357,6 +363,45 @@ func (s *RoleRepositoryTestSuite) TestUpdate() {
 	}
 }
 
+func (s *RoleRepositoryTestSuite) TestDelete() {
+	type testCase struct {
+		Description string
+		DeletedID   string
+		ErrString   string
+	}
+
+	testCases := []testCase{
+		{
+			Description: "should delete a role",
+			DeletedID:   "ns1:role1",
+		},
+		{
+			Description: "should return error if id is empty",
+			ErrString:   role.ErrInvalidID.Error(),
+		},
+		{
+			Description: "should return error if id not exist",
+			DeletedID:   "ns1:role100",
+			ErrString:   role.ErrNotExist.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			err := s.repository.Delete(s.ctx, tc.DeletedID)
+			if tc.ErrString != "" {
+				if err == nil || err.Error() != tc.ErrString {
+					s.T().Fatalf("got error %v, expected was %s", err, tc.ErrString)
+				}
+				return
+			}
+			if err != nil {
+				s.T().Fatalf("got error %s, expected was nil", err.Error())
+			}
+		})
+	}
+}
+
 func TestRoleRepository(t *testing.T) {
 	suite.Run(t, new(RoleRepositoryTestSuite))
 }
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/goto/shield/internal/schema"
+)
+
+type SchemaVersion struct {
+	Version   int64     `db:"version"`
+	Schema    string    `db:"schema"`
+	Config    string    `db:"config"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (from SchemaVersion) transformToSchemaVersion() (schema.SchemaVersion, error) {
+	var config schema.NamespaceConfigMapType
+	if err := json.Unmarshal([]byte(from.Config), &config); err != nil {
+		return schema.SchemaVersion{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	return schema.SchemaVersion{
+		Version:   from.Version,
+		Schema:    from.Schema,
+		Config:    config,
+		CreatedAt: from.CreatedAt,
+	}, nil
+}
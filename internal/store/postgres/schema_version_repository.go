@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/pkg/db"
+	newrelic "github.com/newrelic/go-agent/v3/newrelic"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+type SchemaVersionRepository struct {
+	dbc *db.Client
+}
+
+func NewSchemaVersionRepository(dbc *db.Client) *SchemaVersionRepository {
+	return &SchemaVersionRepository{
+		dbc: dbc,
+	}
+}
+
+func (r SchemaVersionRepository) Create(ctx context.Context, version schema.SchemaVersion) (schema.SchemaVersion, error) {
+	configJson, err := json.Marshal(version.Config)
+	if err != nil {
+		return schema.SchemaVersion{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	query, params, err := dialect.Insert(TABLE_SCHEMA_VERSIONS).Rows(
+		goqu.Record{
+			"schema": version.Schema,
+			"config": configJson,
+		}).Returning(&SchemaVersion{}).ToSQL()
+	if err != nil {
+		return schema.SchemaVersion{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Create"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SCHEMA_VERSIONS),
+		}...,
+	)
+
+	var versionModel SchemaVersion
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SCHEMA_VERSIONS,
+				Operation:  "Create",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&versionModel)
+	}); err != nil {
+		return schema.SchemaVersion{}, checkPostgresError(err)
+	}
+
+	return versionModel.transformToSchemaVersion()
+}
+
+func (r SchemaVersionRepository) Get(ctx context.Context, version int64) (schema.SchemaVersion, error) {
+	query, params, err := dialect.Select(&SchemaVersion{}).From(TABLE_SCHEMA_VERSIONS).Where(goqu.Ex{
+		"version": version,
+	}).ToSQL()
+	if err != nil {
+		return schema.SchemaVersion{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.get(ctx, "Get", query, params)
+}
+
+func (r SchemaVersionRepository) GetLatest(ctx context.Context) (schema.SchemaVersion, error) {
+	query, params, err := dialect.Select(&SchemaVersion{}).From(TABLE_SCHEMA_VERSIONS).
+		Order(goqu.C("version").Desc()).Limit(1).ToSQL()
+	if err != nil {
+		return schema.SchemaVersion{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.get(ctx, "GetLatest", query, params)
+}
+
+func (r SchemaVersionRepository) get(ctx context.Context, method, query string, params []interface{}) (schema.SchemaVersion, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", method),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SCHEMA_VERSIONS),
+		}...,
+	)
+
+	var versionModel SchemaVersion
+	if err := r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SCHEMA_VERSIONS,
+				Operation:  method,
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.GetContext(ctx, &versionModel, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return schema.SchemaVersion{}, schema.ErrSchemaVersionNotExist
+		}
+		return schema.SchemaVersion{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	return versionModel.transformToSchemaVersion()
+}
+
+func (r SchemaVersionRepository) List(ctx context.Context) ([]schema.SchemaVersion, error) {
+	query, params, err := dialect.Select(&SchemaVersion{}).From(TABLE_SCHEMA_VERSIONS).
+		Order(goqu.C("version").Desc()).ToSQL()
+	if err != nil {
+		return []schema.SchemaVersion{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "List"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SCHEMA_VERSIONS),
+		}...,
+	)
+
+	var fetchedVersions []SchemaVersion
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SCHEMA_VERSIONS,
+				Operation:  "List",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.SelectContext(ctx, &fetchedVersions, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []schema.SchemaVersion{}, nil
+		}
+		return []schema.SchemaVersion{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var transformedVersions []schema.SchemaVersion
+	for _, v := range fetchedVersions {
+		transformedVersion, err := v.transformToSchemaVersion()
+		if err != nil {
+			return []schema.SchemaVersion{}, err
+		}
+		transformedVersions = append(transformedVersions, transformedVersion)
+	}
+
+	return transformedVersions, nil
+}
@@ -0,0 +1,155 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/internal/schema"
+	"github.com/goto/shield/internal/store/postgres"
+	"github.com/goto/shield/pkg/db"
+	"github.com/ory/dockertest"
+	"github.com/stretchr/testify/suite"
+)
+
+type SchemaVersionRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.SchemaVersionRepository
+	versions   []schema.SchemaVersion
+}
+
+var testSchemaVersions = []schema.SchemaVersion{
+	{
+		Schema: "definition entropy/firehose {}",
+		Config: schema.NamespaceConfigMapType{
+			"entropy/firehose": {Type: schema.ResourceGroupNamespace},
+		},
+	},
+	{
+		Schema: "definition entropy/firehose {}\ndefinition entropy/dagger {}",
+		Config: schema.NamespaceConfigMapType{
+			"entropy/firehose": {Type: schema.ResourceGroupNamespace},
+			"entropy/dagger":   {Type: schema.ResourceGroupNamespace},
+		},
+	},
+}
+
+func (s *SchemaVersionRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewSchemaVersionRepository(s.client)
+}
+
+func (s *SchemaVersionRepositoryTestSuite) SetupTest() {
+	s.versions = nil
+	for _, v := range testSchemaVersions {
+		created, err := s.repository.Create(s.ctx, v)
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		s.versions = append(s.versions, created)
+	}
+}
+
+func (s *SchemaVersionRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *SchemaVersionRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *SchemaVersionRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_SCHEMA_VERSIONS),
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *SchemaVersionRepositoryTestSuite) TestGet() {
+	type testCase struct {
+		Description     string
+		SelectedVersion int64
+		ExpectedVersion schema.SchemaVersion
+		ErrString       string
+	}
+
+	testCases := []testCase{
+		{
+			Description:     "should get a schema version with its config",
+			SelectedVersion: 1,
+			ExpectedVersion: schema.SchemaVersion{
+				Version: 1,
+				Schema:  testSchemaVersions[0].Schema,
+				Config:  testSchemaVersions[0].Config,
+			},
+		},
+		{
+			Description:     "should return error no exist if can't found schema version",
+			SelectedVersion: 1000,
+			ErrString:       schema.ErrSchemaVersionNotExist.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			got, err := s.repository.Get(s.ctx, tc.SelectedVersion)
+			if tc.ErrString != "" {
+				if err.Error() != tc.ErrString {
+					s.T().Fatalf("got error %s, expected was %s", err.Error(), tc.ErrString)
+				}
+			}
+			if !cmp.Equal(got, tc.ExpectedVersion, cmpopts.IgnoreFields(schema.SchemaVersion{}, "CreatedAt")) {
+				s.T().Fatalf("got result %+v, expected was %+v", got, tc.ExpectedVersion)
+			}
+		})
+	}
+}
+
+func (s *SchemaVersionRepositoryTestSuite) TestGetLatest() {
+	got, err := s.repository.GetLatest(s.ctx)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if got.Version != 2 || got.Schema != testSchemaVersions[1].Schema {
+		s.T().Fatalf("got result %+v, expected was version 2", got)
+	}
+}
+
+func (s *SchemaVersionRepositoryTestSuite) TestList() {
+	got, err := s.repository.List(s.ctx)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	expected := []schema.SchemaVersion{
+		{Version: 2, Schema: testSchemaVersions[1].Schema, Config: testSchemaVersions[1].Config},
+		{Version: 1, Schema: testSchemaVersions[0].Schema, Config: testSchemaVersions[0].Config},
+	}
+	if !cmp.Equal(got, expected, cmpopts.IgnoreFields(schema.SchemaVersion{}, "CreatedAt")) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, expected)
+	}
+}
+
+func TestSchemaVersionRepository(t *testing.T) {
+	suite.Run(t, new(SchemaVersionRepositoryTestSuite))
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/goto/shield/core/serviceaccount"
+)
+
+type ServiceAccount struct {
+	ID        string    `db:"id"`
+	Name      string    `db:"name"`
+	OrgID     string    `db:"org_id"`
+	Metadata  []byte    `db:"metadata"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (from ServiceAccount) transformToServiceAccount() (serviceaccount.ServiceAccount, error) {
+	var unmarshalledMetadata map[string]any
+	if len(from.Metadata) > 0 {
+		if err := json.Unmarshal(from.Metadata, &unmarshalledMetadata); err != nil {
+			return serviceaccount.ServiceAccount{}, err
+		}
+	}
+
+	return serviceaccount.ServiceAccount{
+		ID:             from.ID,
+		Name:           from.Name,
+		OrganizationID: from.OrgID,
+		Metadata:       unmarshalledMetadata,
+		CreatedAt:      from.CreatedAt,
+		UpdatedAt:      from.UpdatedAt,
+	}, nil
+}
+
+type ServiceAccountKey struct {
+	ID               string       `db:"id"`
+	ServiceAccountID string       `db:"service_account_id"`
+	Hash             string       `db:"hash"`
+	ExpiresAt        sql.NullTime `db:"expires_at"`
+	RevokedAt        sql.NullTime `db:"revoked_at"`
+	CreatedAt        time.Time    `db:"created_at"`
+}
+
+func (from ServiceAccountKey) transformToKey() serviceaccount.Key {
+	return serviceaccount.Key{
+		ID:               from.ID,
+		ServiceAccountID: from.ServiceAccountID,
+		Hash:             from.Hash,
+		ExpiresAt:        from.ExpiresAt.Time,
+		RevokedAt:        from.RevokedAt.Time,
+		CreatedAt:        from.CreatedAt,
+	}
+}
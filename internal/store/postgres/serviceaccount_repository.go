@@ -0,0 +1,364 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/pkg/db"
+	newrelic "github.com/newrelic/go-agent/v3/newrelic"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+type ServiceAccountRepository struct {
+	dbc *db.Client
+}
+
+func NewServiceAccountRepository(dbc *db.Client) *ServiceAccountRepository {
+	return &ServiceAccountRepository{
+		dbc: dbc,
+	}
+}
+
+func (r ServiceAccountRepository) Create(ctx context.Context, serviceAccount serviceaccount.ServiceAccount) (serviceaccount.ServiceAccount, error) {
+	marshaledMetadata, err := json.Marshal(serviceAccount.Metadata)
+	if err != nil {
+		return serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	query, params, err := dialect.Insert(TABLE_SERVICE_ACCOUNTS).Rows(
+		goqu.Record{
+			"name":     serviceAccount.Name,
+			"org_id":   serviceAccount.OrganizationID,
+			"metadata": marshaledMetadata,
+		}).Returning(&ServiceAccount{}).ToSQL()
+	if err != nil {
+		return serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Create"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SERVICE_ACCOUNTS),
+		}...,
+	)
+
+	var serviceAccountModel ServiceAccount
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SERVICE_ACCOUNTS,
+				Operation:  "Create",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&serviceAccountModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, errDuplicateKey):
+			return serviceaccount.ServiceAccount{}, serviceaccount.ErrConflict
+		case errors.Is(err, errForeignKeyViolation),
+			errors.Is(err, errInvalidTexRepresentation):
+			return serviceaccount.ServiceAccount{}, serviceaccount.ErrInvalidDetail
+		default:
+			return serviceaccount.ServiceAccount{}, err
+		}
+	}
+
+	transformedServiceAccount, err := serviceAccountModel.transformToServiceAccount()
+	if err != nil {
+		return serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	return transformedServiceAccount, nil
+}
+
+func (r ServiceAccountRepository) GetByID(ctx context.Context, id string) (serviceaccount.ServiceAccount, error) {
+	query, params, err := dialect.Select(&ServiceAccount{}).From(TABLE_SERVICE_ACCOUNTS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetByID"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SERVICE_ACCOUNTS),
+		}...,
+	)
+
+	var serviceAccountModel ServiceAccount
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SERVICE_ACCOUNTS,
+				Operation:  "GetByID",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.GetContext(ctx, &serviceAccountModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return serviceaccount.ServiceAccount{}, serviceaccount.ErrNotExist
+		case errors.Is(err, errInvalidTexRepresentation):
+			return serviceaccount.ServiceAccount{}, serviceaccount.ErrInvalidID
+		default:
+			return serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	transformedServiceAccount, err := serviceAccountModel.transformToServiceAccount()
+	if err != nil {
+		return serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	return transformedServiceAccount, nil
+}
+
+func (r ServiceAccountRepository) List(ctx context.Context, flt serviceaccount.Filter) ([]serviceaccount.ServiceAccount, error) {
+	sqlStatement := dialect.Select(&ServiceAccount{}).From(TABLE_SERVICE_ACCOUNTS)
+	if flt.OrganizationID != "" {
+		sqlStatement = sqlStatement.Where(goqu.Ex{"org_id": flt.OrganizationID})
+	}
+	query, params, err := sqlStatement.Order(goqu.C("name").Asc()).ToSQL()
+	if err != nil {
+		return []serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "List"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SERVICE_ACCOUNTS),
+		}...,
+	)
+
+	var fetchedServiceAccounts []ServiceAccount
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SERVICE_ACCOUNTS,
+				Operation:  "List",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.SelectContext(ctx, &fetchedServiceAccounts, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []serviceaccount.ServiceAccount{}, nil
+		case errors.Is(err, errInvalidTexRepresentation):
+			return []serviceaccount.ServiceAccount{}, nil
+		default:
+			return []serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	var transformedServiceAccounts []serviceaccount.ServiceAccount
+	for _, sa := range fetchedServiceAccounts {
+		transformedServiceAccount, err := sa.transformToServiceAccount()
+		if err != nil {
+			return []serviceaccount.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		transformedServiceAccounts = append(transformedServiceAccounts, transformedServiceAccount)
+	}
+
+	return transformedServiceAccounts, nil
+}
+
+func (r ServiceAccountRepository) CreateKey(ctx context.Context, key serviceaccount.Key) (serviceaccount.Key, error) {
+	record := goqu.Record{
+		"service_account_id": key.ServiceAccountID,
+		"hash":               key.Hash,
+	}
+	if !key.ExpiresAt.IsZero() {
+		record["expires_at"] = key.ExpiresAt
+	}
+
+	query, params, err := dialect.Insert(TABLE_SERVICE_ACCOUNT_KEYS).Rows(record).Returning(&ServiceAccountKey{}).ToSQL()
+	if err != nil {
+		return serviceaccount.Key{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.writeKey(ctx, "CreateKey", query, params)
+}
+
+func (r ServiceAccountRepository) GetKey(ctx context.Context, id string) (serviceaccount.Key, error) {
+	query, params, err := dialect.Select(&ServiceAccountKey{}).From(TABLE_SERVICE_ACCOUNT_KEYS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return serviceaccount.Key{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetKey"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SERVICE_ACCOUNT_KEYS),
+		}...,
+	)
+
+	var keyModel ServiceAccountKey
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SERVICE_ACCOUNT_KEYS,
+				Operation:  "GetKey",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.GetContext(ctx, &keyModel, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows),
+			errors.Is(err, errInvalidTexRepresentation):
+			return serviceaccount.Key{}, serviceaccount.ErrKeyNotExist
+		default:
+			return serviceaccount.Key{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return keyModel.transformToKey(), nil
+}
+
+func (r ServiceAccountRepository) ListKeys(ctx context.Context, serviceAccountID string) ([]serviceaccount.Key, error) {
+	query, params, err := dialect.Select(&ServiceAccountKey{}).From(TABLE_SERVICE_ACCOUNT_KEYS).Where(goqu.Ex{
+		"service_account_id": serviceAccountID,
+	}).Order(goqu.C("created_at").Desc()).ToSQL()
+	if err != nil {
+		return []serviceaccount.Key{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListKeys"),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SERVICE_ACCOUNT_KEYS),
+		}...,
+	)
+
+	var fetchedKeys []ServiceAccountKey
+	if err = r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SERVICE_ACCOUNT_KEYS,
+				Operation:  "ListKeys",
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.SelectContext(ctx, &fetchedKeys, query, params...)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []serviceaccount.Key{}, nil
+		}
+		return []serviceaccount.Key{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var transformedKeys []serviceaccount.Key
+	for _, k := range fetchedKeys {
+		transformedKeys = append(transformedKeys, k.transformToKey())
+	}
+
+	return transformedKeys, nil
+}
+
+func (r ServiceAccountRepository) UpdateKeyExpiry(ctx context.Context, id string, expiresAt time.Time) (serviceaccount.Key, error) {
+	query, params, err := dialect.Update(TABLE_SERVICE_ACCOUNT_KEYS).Set(goqu.Record{
+		"expires_at": expiresAt,
+	}).Where(goqu.Ex{
+		"id": id,
+	}).Returning(&ServiceAccountKey{}).ToSQL()
+	if err != nil {
+		return serviceaccount.Key{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.writeKey(ctx, "UpdateKeyExpiry", query, params)
+}
+
+func (r ServiceAccountRepository) RevokeKey(ctx context.Context, id string) (serviceaccount.Key, error) {
+	query, params, err := dialect.Update(TABLE_SERVICE_ACCOUNT_KEYS).Set(goqu.Record{
+		"revoked_at": goqu.L("NOW()"),
+	}).Where(goqu.Ex{
+		"id":         id,
+		"revoked_at": nil,
+	}).Returning(&ServiceAccountKey{}).ToSQL()
+	if err != nil {
+		return serviceaccount.Key{}, fmt.Errorf("%w: %s", queryErr, err)
+	}
+
+	return r.writeKey(ctx, "RevokeKey", query, params)
+}
+
+func (r ServiceAccountRepository) writeKey(ctx context.Context, method, query string, params []interface{}) (serviceaccount.Key, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", method),
+			attribute.String(string(semconv.DBSQLTableKey), TABLE_SERVICE_ACCOUNT_KEYS),
+		}...,
+	)
+
+	var keyModel ServiceAccountKey
+	if err := r.dbc.WithTimeout(ctx, func(ctx context.Context) error {
+		nrCtx := newrelic.FromContext(ctx)
+		if nrCtx != nil {
+			nr := newrelic.DatastoreSegment{
+				Product:    newrelic.DatastorePostgres,
+				Collection: TABLE_SERVICE_ACCOUNT_KEYS,
+				Operation:  method,
+				StartTime:  nrCtx.StartSegmentNow(),
+			}
+			defer nr.End()
+		}
+
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&keyModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows),
+			errors.Is(err, errForeignKeyViolation),
+			errors.Is(err, errInvalidTexRepresentation):
+			return serviceaccount.Key{}, serviceaccount.ErrKeyNotExist
+		default:
+			return serviceaccount.Key{}, fmt.Errorf("%w: %s", dbErr, err)
+		}
+	}
+
+	return keyModel.transformToKey(), nil
+}
@@ -0,0 +1,189 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/goto/salt/log"
+	"github.com/goto/shield/core/organization"
+	"github.com/goto/shield/core/serviceaccount"
+	"github.com/goto/shield/internal/store/postgres"
+	"github.com/goto/shield/pkg/db"
+	"github.com/goto/shield/pkg/uuid"
+	"github.com/ory/dockertest"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceAccountRepositoryTestSuite struct {
+	suite.Suite
+	ctx             context.Context
+	client          *db.Client
+	pool            *dockertest.Pool
+	resource        *dockertest.Resource
+	repository      *postgres.ServiceAccountRepository
+	orgs            []organization.Organization
+	serviceAccounts []serviceaccount.ServiceAccount
+}
+
+func (s *ServiceAccountRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewZap()
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewServiceAccountRepository(s.client)
+
+	s.orgs, err = bootstrapOrganization(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *ServiceAccountRepositoryTestSuite) SetupTest() {
+	s.serviceAccounts = nil
+	for _, name := range []string{"deployer", "reporter"} {
+		created, err := s.repository.Create(s.ctx, serviceaccount.ServiceAccount{
+			Name:           name,
+			OrganizationID: s.orgs[0].ID,
+		})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		s.serviceAccounts = append(s.serviceAccounts, created)
+	}
+}
+
+func (s *ServiceAccountRepositoryTestSuite) TearDownSuite() {
+	// Clean tests
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *ServiceAccountRepositoryTestSuite) TearDownTest() {
+	if err := s.cleanup(); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *ServiceAccountRepositoryTestSuite) cleanup() error {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_SERVICE_ACCOUNT_KEYS),
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_SERVICE_ACCOUNTS),
+	}
+	return execQueries(context.TODO(), s.client, queries)
+}
+
+func (s *ServiceAccountRepositoryTestSuite) TestCreate() {
+	_, err := s.repository.Create(s.ctx, serviceaccount.ServiceAccount{
+		Name:           "deployer",
+		OrganizationID: s.orgs[0].ID,
+	})
+	if err != serviceaccount.ErrConflict {
+		s.T().Fatalf("got error %v, expected was %v", err, serviceaccount.ErrConflict)
+	}
+
+	_, err = s.repository.Create(s.ctx, serviceaccount.ServiceAccount{
+		Name:           "deployer",
+		OrganizationID: uuid.NewString(),
+	})
+	if err != serviceaccount.ErrInvalidDetail {
+		s.T().Fatalf("got error %v, expected was %v", err, serviceaccount.ErrInvalidDetail)
+	}
+}
+
+func (s *ServiceAccountRepositoryTestSuite) TestGetByID() {
+	got, err := s.repository.GetByID(s.ctx, s.serviceAccounts[0].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if !cmp.Equal(got, s.serviceAccounts[0]) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, s.serviceAccounts[0])
+	}
+
+	_, err = s.repository.GetByID(s.ctx, uuid.NewString())
+	if err != serviceaccount.ErrNotExist {
+		s.T().Fatalf("got error %v, expected was %v", err, serviceaccount.ErrNotExist)
+	}
+}
+
+func (s *ServiceAccountRepositoryTestSuite) TestList() {
+	got, err := s.repository.List(s.ctx, serviceaccount.Filter{OrganizationID: s.orgs[0].ID})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if !cmp.Equal(got, s.serviceAccounts) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, s.serviceAccounts)
+	}
+
+	got, err = s.repository.List(s.ctx, serviceaccount.Filter{OrganizationID: s.orgs[1].ID})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if len(got) != 0 {
+		s.T().Fatalf("got result %+v, expected was empty", got)
+	}
+}
+
+func (s *ServiceAccountRepositoryTestSuite) TestKeys() {
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+	created, err := s.repository.CreateKey(s.ctx, serviceaccount.Key{
+		ServiceAccountID: s.serviceAccounts[0].ID,
+		Hash:             "hash",
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	got, err := s.repository.GetKey(s.ctx, created.ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	expected := serviceaccount.Key{
+		ID:               created.ID,
+		ServiceAccountID: s.serviceAccounts[0].ID,
+		Hash:             "hash",
+		ExpiresAt:        expiresAt,
+	}
+	if !cmp.Equal(got, expected, cmpopts.IgnoreFields(serviceaccount.Key{}, "CreatedAt"), cmpopts.EquateApproxTime(time.Millisecond)) {
+		s.T().Fatalf("got result %+v, expected was %+v", got, expected)
+	}
+
+	revoked, err := s.repository.RevokeKey(s.ctx, created.ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if revoked.RevokedAt.IsZero() {
+		s.T().Fatalf("got result %+v, expected was a revoked key", revoked)
+	}
+
+	// a revoked key can't be revoked again
+	if _, err := s.repository.RevokeKey(s.ctx, created.ID); err != serviceaccount.ErrKeyNotExist {
+		s.T().Fatalf("got error %v, expected was %v", err, serviceaccount.ErrKeyNotExist)
+	}
+
+	keys, err := s.repository.ListKeys(s.ctx, s.serviceAccounts[0].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].ID != created.ID {
+		s.T().Fatalf("got result %+v, expected was the created key", keys)
+	}
+
+	if _, err := s.repository.GetKey(s.ctx, uuid.NewString()); err != serviceaccount.ErrKeyNotExist {
+		s.T().Fatalf("got error %v, expected was %v", err, serviceaccount.ErrKeyNotExist)
+	}
+}
+
+func TestServiceAccountRepository(t *testing.T) {
+	suite.Run(t, new(ServiceAccountRepositoryTestSuite))
+}
@@ -0,0 +1,91 @@
+package spicedb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/ory/dockertest"
+	"github.com/ory/dockertest/docker"
+
+	"github.com/goto/shield/internal/store/authztest"
+	"github.com/goto/shield/internal/store/spicedb"
+)
+
+const preSharedKey = "conformance"
+
+func newTestClient(logger log.Logger) (*spicedb.SpiceDB, *dockertest.Pool, *dockertest.Resource, error) {
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not create dockertest pool: %w", err)
+	}
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository:   "authzed/spicedb",
+		Tag:          "v1.32.0",
+		Cmd:          []string{"serve", "--grpc-preshared-key", preSharedKey, "--datastore-engine", "memory"},
+		ExposedPorts: []string{"50051/tcp"},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not start resource: %w", err)
+	}
+
+	// Tell docker to hard kill the container in 120 seconds
+	if err := resource.Expire(120); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// exponential backoff-retry, because the application in the container might not be ready to accept connections yet
+	pool.MaxWait = 60 * time.Second
+
+	var client *spicedb.SpiceDB
+	if err = pool.Retry(func() error {
+		client, err = spicedb.New(spicedb.Config{
+			Host:         "localhost",
+			Port:         resource.GetPort("50051/tcp"),
+			PreSharedKey: preSharedKey,
+		}, logger)
+		return err
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("could not connect to docker: %w", err)
+	}
+
+	return client, pool, resource, nil
+}
+
+func TestConformance(t *testing.T) {
+	client, pool, resource, err := newTestClient(log.NewNoop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := pool.Purge(resource); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	authztest.Run(t, func(t *testing.T) authztest.Backend {
+		relationRepository := spicedb.NewRelationRepository(client)
+		policyRepository := spicedb.NewPolicyRepository(client)
+
+		// relations of the previous test are deleted once the schema is written
+		if _, err := policyRepository.WriteSchema(context.Background(), authztest.Namespaces()); err != nil {
+			t.Fatal(err)
+		}
+		for namespaceID := range authztest.Namespaces() {
+			if err := relationRepository.DeleteSubjectRelations(context.Background(), namespaceID, ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return authztest.Backend{
+			Repository: relationRepository,
+			Engine:     policyRepository,
+		}
+	})
+}
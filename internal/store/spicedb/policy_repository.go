@@ -10,13 +10,18 @@ import (
 	"github.com/goto/shield/internal/store/spicedb/schema_generator"
 
 	authzedpb "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PolicyRepository struct {
 	spiceDB *SpiceDB
 }
 
-var ErrWritingSchema = errors.New("error in writing schema to spicedb")
+var (
+	ErrWritingSchema = errors.New("error in writing schema to spicedb")
+	ErrReadingSchema = errors.New("error in reading schema from spicedb")
+)
 
 func NewPolicyRepository(spiceDB *SpiceDB) *PolicyRepository {
 	return &PolicyRepository{
@@ -24,12 +29,42 @@ func NewPolicyRepository(spiceDB *SpiceDB) *PolicyRepository {
 	}
 }
 
-func (r PolicyRepository) WriteSchema(ctx context.Context, schema schema.NamespaceConfigMapType) error {
-	generatedSchema := schema_generator.GenerateSchema(schema)
-	request := &authzedpb.WriteSchemaRequest{Schema: strings.Join(generatedSchema, "\n")}
-	fmt.Println(strings.Join(generatedSchema, "\n"))
+func (r PolicyRepository) WriteSchema(ctx context.Context, schema schema.NamespaceConfigMapType) (string, error) {
+	generatedSchema := strings.Join(schema_generator.GenerateSchema(schema), "\n")
+	request := &authzedpb.WriteSchemaRequest{Schema: generatedSchema}
+	fmt.Println(generatedSchema)
 	if _, err := r.spiceDB.client.WriteSchema(ctx, request); err != nil {
-		return fmt.Errorf("%w: %s", ErrWritingSchema, err.Error())
+		return "", fmt.Errorf("%w: %s", ErrWritingSchema, err.Error())
+	}
+
+	return generatedSchema, nil
+}
+
+func (r PolicyRepository) DiffSchema(ctx context.Context, schema schema.NamespaceConfigMapType) ([]schema.Change, error) {
+	var current string
+	res, err := r.spiceDB.client.ReadSchema(ctx, &authzedpb.ReadSchemaRequest{})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		// no schema has been written yet
+	case err != nil:
+		return nil, fmt.Errorf("%w: %s", ErrReadingSchema, err.Error())
+	default:
+		current = res.GetSchemaText()
+	}
+
+	return schema_generator.DiffSchema(current, schema_generator.GenerateSchema(schema)), nil
+}
+
+func (r PolicyRepository) DeleteRelations(ctx context.Context, namespaceID, relationName string) error {
+	request := &authzedpb.DeleteRelationshipsRequest{
+		RelationshipFilter: &authzedpb.RelationshipFilter{
+			ResourceType:     namespaceID,
+			OptionalRelation: relationName,
+		},
+	}
+
+	if _, err := r.spiceDB.client.DeleteRelationships(ctx, request); err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,87 @@
+package schema_generator
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/goto/shield/internal/schema"
+)
+
+// DiffSchema compares the current spicedb schema with the generated
+// definitions, changes are reported per definition, relation and permission
+func DiffSchema(current string, desired []string) []schema.Change {
+	currentEntries := parseSchema(current)
+	desiredEntries := parseSchema(strings.Join(desired, "\n"))
+
+	changes := make([]schema.Change, 0)
+	for id, line := range desiredEntries {
+		currentLine, ok := currentEntries[id]
+		switch {
+		case !ok:
+			changes = append(changes, schema.Change{Action: schema.ChangeCreate, Entity: schema.EntityAuthzSchema, ID: id, Detail: line})
+		case currentLine != line:
+			changes = append(changes, schema.Change{Action: schema.ChangeUpdate, Entity: schema.EntityAuthzSchema, ID: id, Detail: line})
+		}
+	}
+	for id, line := range currentEntries {
+		if _, ok := desiredEntries[id]; !ok {
+			changes = append(changes, schema.Change{Action: schema.ChangeDelete, Entity: schema.EntityAuthzSchema, ID: id, Detail: line})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].ID < changes[j].ID
+	})
+	return changes
+}
+
+// parseSchema maps every definition to "definition <name>" and every relation
+// and permission to its normalized line keyed by "<definition>#<name>"
+func parseSchema(source string) map[string]string {
+	entries := make(map[string]string)
+
+	var definition string
+	for _, line := range strings.Split(source, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "definition":
+			definition = strings.TrimSuffix(fields[1], "{")
+			entries[definition] = "definition " + definition
+		case "relation":
+			if definition == "" {
+				continue
+			}
+			name := strings.TrimSuffix(fields[1], ":")
+			entries[definition+"#"+name] = normalize("relation "+name+":", strings.Join(fields[2:], " "), "|")
+		case "permission":
+			if definition == "" || len(fields) < 4 {
+				continue
+			}
+			entries[definition+"#"+fields[1]] = normalize("permission "+fields[1]+" =", strings.Join(fields[3:], " "), "+")
+		}
+	}
+
+	return entries
+}
+
+// normalize sorts the operands of a plain union as the generator does not keep their order
+func normalize(prefix, expression, operator string) string {
+	if strings.Contains(expression, "(") || strings.Contains(expression, " & ") || strings.Contains(expression, " - ") {
+		// redundant parentheses are dropped so expressions compare equal however they were written
+		if expr, err := schema.ParsePermissionExpression(expression); err == nil {
+			return prefix + " " + expr.String()
+		}
+		return prefix + " " + expression
+	}
+
+	operands := strings.Split(expression, operator)
+	for i := range operands {
+		operands[i] = strings.TrimSpace(operands[i])
+	}
+	sort.Strings(operands)
+	return prefix + " " + strings.Join(operands, " "+operator+" ")
+}
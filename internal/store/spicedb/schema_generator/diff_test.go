@@ -0,0 +1,75 @@
+package schema_generator
+
+import (
+	"testing"
+
+	"github.com/goto/shield/internal/schema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSchema(t *testing.T) {
+	current := `definition shield/user {}
+
+definition entropy/firehose {
+	relation owner: shield/user | shield/group#membership
+	relation viewer: shield/user
+	permission view = viewer + owner
+	permission delete = owner
+}`
+
+	t.Run("should report no changes when only the order differs", func(t *testing.T) {
+		desired := []string{
+			"definition shield/user {}",
+			`definition entropy/firehose {
+	relation viewer: shield/user
+	relation owner: shield/group#membership | shield/user
+	permission delete = owner
+	permission view = owner + viewer
+}`,
+		}
+
+		assert.Empty(t, DiffSchema(current, desired))
+	})
+
+	t.Run("should report no changes when only redundant parentheses differ", func(t *testing.T) {
+		current := `definition entropy/firehose {
+	relation viewer: shield/user
+	relation suspended: shield/user
+	permission configure = viewer + organization->edit - suspended
+}`
+		desired := []string{`definition entropy/firehose {
+	relation viewer: shield/user
+	relation suspended: shield/user
+	permission configure = (viewer + organization->edit) - suspended
+}`}
+
+		assert.Empty(t, DiffSchema(current, desired))
+	})
+
+	t.Run("should report created, updated and deleted relations and permissions", func(t *testing.T) {
+		desired := []string{
+			"definition shield/user {}",
+			`definition entropy/firehose {
+	relation owner: shield/user | shield/group#membership
+	relation editor: shield/user
+	permission view = owner + editor
+}`,
+		}
+
+		assert.Equal(t, []schema.Change{
+			{Action: schema.ChangeDelete, Entity: schema.EntityAuthzSchema, ID: "entropy/firehose#delete", Detail: "permission delete = owner"},
+			{Action: schema.ChangeCreate, Entity: schema.EntityAuthzSchema, ID: "entropy/firehose#editor", Detail: "relation editor: shield/user"},
+			{Action: schema.ChangeUpdate, Entity: schema.EntityAuthzSchema, ID: "entropy/firehose#view", Detail: "permission view = editor + owner"},
+			{Action: schema.ChangeDelete, Entity: schema.EntityAuthzSchema, ID: "entropy/firehose#viewer", Detail: "relation viewer: shield/user"},
+		}, DiffSchema(current, desired))
+	})
+
+	t.Run("should report every entry of a new definition", func(t *testing.T) {
+		changes := DiffSchema("", []string{`definition shield/user {}`})
+
+		assert.Equal(t, []schema.Change{
+			{Action: schema.ChangeCreate, Entity: schema.EntityAuthzSchema, ID: "shield/user", Detail: "definition shield/user"},
+		}, changes)
+	})
+}
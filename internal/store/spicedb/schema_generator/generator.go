@@ -1,6 +1,8 @@
 package schema_generator
 
 import (
+	"sort"
+
 	"github.com/goto/shield/internal/schema"
 
 	sdbnamespace "github.com/authzed/spicedb/pkg/namespace"
@@ -8,15 +10,19 @@ import (
 	"github.com/authzed/spicedb/pkg/schemadsl/generator"
 )
 
+// GenerateSchema generates a definition per namespace, definitions, relations and
+// permissions are sorted by name so the same config always generates the same schema
 func GenerateSchema(namespaceConfig schema.NamespaceConfigMapType) []string {
 	definitionSchemaStringified := make([]string, 0)
-	for name, config := range namespaceConfig {
+	for _, name := range sortedKeys(namespaceConfig) {
+		config := namespaceConfig[name]
 		roles := make([]*sdbcore.Relation, 0)
 		permissions := make([]*sdbcore.Relation, 0)
 		inheritedNamespaces := make([]*sdbcore.Relation, 0)
 
 		// generate spicedb relations
-		for roleName, principals := range config.Roles {
+		for _, roleName := range sortedKeys(config.Roles) {
+			principals := config.Roles[roleName]
 			relationList := make([]*sdbcore.AllowedRelation, 0)
 			for _, p := range principals {
 				relationList = append(relationList, sdbnamespace.AllowedRelation(processPrincipal(p), "..."))
@@ -25,13 +31,28 @@ func GenerateSchema(namespaceConfig schema.NamespaceConfigMapType) []string {
 			roles = append(roles, sdbnamespace.Relation(roleName, nil, relationList...))
 		}
 
+		parent := parentConfig(namespaceConfig, config)
+
 		// generate spicedb permissions
-		for permissioName, permissionRoles := range config.Permissions {
+		for _, permissioName := range sortedKeys(config.Permissions) {
+			permissionRoles := config.Permissions[permissioName]
+			if expression, ok := config.PermissionExpressions[permissioName]; ok {
+				if expr, err := schema.ParsePermissionExpression(expression); err == nil {
+					permissions = append(permissions, sdbnamespace.Relation(permissioName, expressionRewrite(expr)))
+					continue
+				}
+			}
+
 			rolesList := make([]*sdbcore.SetOperation_Child, 0)
 			for _, role := range permissionRoles {
 				rolesList = append(rolesList, sdbnamespace.ComputedUserset(schema.SpiceDBPermissionInheritanceFormatter(role)))
 			}
 
+			// permissions of the parent with the same name are inherited
+			if _, ok := parent.Permissions[permissioName]; ok {
+				rolesList = append(rolesList, sdbnamespace.TupleToUserset(schema.ParentRelationName, permissioName))
+			}
+
 			permissions = append(permissions, sdbnamespace.Relation(permissioName, sdbnamespace.Union(rolesList[0], rolesList[1:]...)))
 		}
 
@@ -47,10 +68,68 @@ func GenerateSchema(namespaceConfig schema.NamespaceConfigMapType) []string {
 	return definitionSchemaStringified
 }
 
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parentConfig returns the config of the parent resource type of a namespace
+func parentConfig(namespaceConfig schema.NamespaceConfigMapType, config schema.NamespaceConfig) schema.NamespaceConfig {
+	for _, ins := range config.InheritedNamespaces {
+		if ins.Name == schema.ParentRelationName {
+			return namespaceConfig[ins.NamespaceId]
+		}
+	}
+	return schema.NamespaceConfig{}
+}
+
+// expressionRewrite builds the spicedb rewrite for a permission expression
+func expressionRewrite(expr schema.PermissionExpression) *sdbcore.UsersetRewrite {
+	if expr.Operator == "" {
+		return sdbnamespace.Union(expressionChild(expr))
+	}
+
+	children := make([]*sdbcore.SetOperation_Child, 0, len(expr.Children))
+	for _, c := range expr.Children {
+		child := expressionChild(c)
+		if c.Operator == schema.UnionOperator && expr.Operator != schema.UnionOperator {
+			// the source generator leaves out parentheses around nested unions, which changes
+			// "a & (b + c)" into "a & b + c", a single child intersection keeps them
+			child = sdbnamespace.Rewrite(sdbnamespace.Intersection(child))
+		}
+		children = append(children, child)
+	}
+
+	switch expr.Operator {
+	case schema.IntersectionOperator:
+		return sdbnamespace.Intersection(children[0], children[1:]...)
+	case schema.ExclusionOperator:
+		return sdbnamespace.Exclusion(children[0], children[1:]...)
+	default:
+		return sdbnamespace.Union(children[0], children[1:]...)
+	}
+}
+
+func expressionChild(expr schema.PermissionExpression) *sdbcore.SetOperation_Child {
+	switch {
+	case expr.Operator != "":
+		return sdbnamespace.Rewrite(expressionRewrite(expr))
+	case expr.Arrow != "":
+		return sdbnamespace.TupleToUserset(expr.Relation, expr.Arrow)
+	default:
+		return sdbnamespace.ComputedUserset(expr.Relation)
+	}
+}
+
 func processPrincipal(s string) string {
 	return map[string]string{
-		"shield/group":  "shield/group#membership",
-		"shield/user":   "shield/user",
-		"shield/user:*": "shield/user:*",
+		"shield/group":          "shield/group#membership",
+		"shield/user":           "shield/user",
+		"shield/user:*":         "shield/user:*",
+		"shield/serviceaccount": "shield/serviceaccount",
 	}[s]
 }
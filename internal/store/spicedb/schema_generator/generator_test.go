@@ -1,6 +1,7 @@
 package schema_generator
 
 import (
+	"flag"
 	"os"
 	"sort"
 	"strings"
@@ -11,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var update = flag.Bool("update", false, "update golden files")
+
 func makeDefnMap(s []string) map[string][]string {
 	finalMap := make(map[string][]string)
 
@@ -35,3 +38,51 @@ func TestPredefinedSchema(t *testing.T) {
 	expectedPredefinedConfigs := makeDefnMap(strings.Split(string(content), "\n--\n"))
 	assert.Equal(t, expectedPredefinedConfigs, actualPredefinedConfigs)
 }
+
+func TestGenerateSchemaIsStable(t *testing.T) {
+	configMap := schema.NamespaceConfigMapType{
+		schema.OrganizationNamespace: schema.OrganizationNamespaceConfig,
+		schema.ProjectNamespace:      schema.ProjectNamespaceConfig,
+		schema.GroupNamespace:        schema.GroupNamespaceConfig,
+	}
+
+	generated := GenerateSchema(configMap)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, generated, GenerateSchema(configMap))
+	}
+}
+
+// Test to check the schemas generated for resources configs in testdata against their golden files
+func TestResourcesConfigSchema(t *testing.T) {
+	tests := []struct {
+		config string
+		golden string
+	}{
+		{config: "testdata/expressions.yaml", golden: "testdata/expression_schema"},
+		{config: "testdata/hierarchy.yaml", golden: "testdata/hierarchy_schema"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.config, func(t *testing.T) {
+			content, err := os.ReadFile(tt.config)
+			assert.NoError(t, err)
+
+			resourceConfig, err := schema.ParseConfigYaml(content)
+			assert.NoError(t, err)
+
+			configMap := make(schema.NamespaceConfigMapType)
+			for name, config := range resourceConfig {
+				configMap = schema.MergeNamespaceConfigMap(configMap, schema.GetNamespacesForResourceGroup(name, config))
+			}
+			generated := GenerateSchema(configMap)
+
+			if *update {
+				sort.Strings(generated)
+				assert.NoError(t, os.WriteFile(tt.golden, []byte(strings.Join(generated, "\n--\n")), 0o644))
+			}
+
+			golden, err := os.ReadFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, makeDefnMap(strings.Split(string(golden), "\n--\n")), makeDefnMap(generated))
+		})
+	}
+}
@@ -76,8 +76,19 @@ func (b GRPCPayloadHandler) Extract(body *io.ReadCloser, protoIndex string) (int
 }
 
 func (b GRPCPayloadHandler) extractFromRequest(body []byte, protoIndex string) (interface{}, error) {
+	msg, err := b.Message(body)
+	if err != nil {
+		return "", err
+	}
+
+	return FieldFromProtoMessage(msg, protoIndex)
+}
+
+// Message returns the protobuf message of a gRPC payload, fields are queried from
+// it with FieldFromProtoMessage without parsing the payload again
+func (b GRPCPayloadHandler) Message(body []byte) ([]byte, error) {
 	if b.grpcDisabled {
-		return fieldFromProtoMessage(body, protoIndex)
+		return body, nil
 	}
 
 	reqParser := grpcRequestParser{
@@ -86,14 +97,14 @@ func (b GRPCPayloadHandler) extractFromRequest(body []byte, protoIndex string) (
 	}
 	pf, msg, err := reqParser.Parse()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if pf == compressionMade {
 		// unsupported for now
-		return "", errors.New("compressed message, unsupported grpc feature")
+		return nil, errors.New("compressed message, unsupported grpc feature")
 	}
 
-	return fieldFromProtoMessage(msg, protoIndex)
+	return msg, nil
 }
 
 // grpcRequestParser reads complete gRPC messages from the underlying reader
@@ -145,7 +156,9 @@ func (p *grpcRequestParser) Parse() (pf GRPCPayloadCompressionFormat, msg []byte
 	return pf, msg, nil
 }
 
-func fieldFromProtoMessage(msg []byte, tagIndex string) (interface{}, error) {
+// FieldFromProtoMessage returns the field of a protobuf message at the proto
+// index, e.g. "1.2" for a nested field or "1[*].2" for a field of repeated messages
+func FieldFromProtoMessage(msg []byte, tagIndex string) (interface{}, error) {
 	parsedQuery, err := ParseQuery(tagIndex)
 	if err != nil {
 		return nil, err
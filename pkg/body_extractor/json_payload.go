@@ -26,3 +26,18 @@ func (h JSONPayloadHandler) Extract(body *io.ReadCloser, key string) (interface{
 	}
 	return field.Value(), nil
 }
+
+// ExtractMany returns the fields of a JSON payload at the keys, the payload is
+// parsed once for all of them
+func (h JSONPayloadHandler) ExtractMany(body []byte, keys ...string) (map[string]interface{}, error) {
+	fields := gjson.GetManyBytes(body, keys...)
+
+	values := make(map[string]interface{}, len(keys))
+	for i, field := range fields {
+		if !field.Exists() {
+			return nil, errors.Errorf("failed to find field: %s", keys[i])
+		}
+		values[keys[i]] = field.Value()
+	}
+	return values, nil
+}
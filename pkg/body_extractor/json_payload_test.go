@@ -61,3 +61,40 @@ func TestJSONPayloadHandler_Extract(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONPayloadHandler_ExtractMany(t *testing.T) {
+	body := []byte(`{"k1": "v1", "nested_k1": {"k2_2": 1}, "items": [{"id": "a"}, {"id": "b"}]}`)
+
+	tests := []struct {
+		name          string
+		keys          []string
+		want          map[string]any
+		wantErrString string
+	}{
+		{
+			name: "should return values of nested keys and arrays",
+			keys: []string{"k1", "nested_k1.k2_2", "items.#.id"},
+			want: map[string]any{
+				"k1":             "v1",
+				"nested_k1.k2_2": float64(1),
+				"items.#.id":     []any{"a", "b"},
+			},
+		},
+		{
+			name:          "should return error if any field doesn't exist",
+			keys:          []string{"k1", "x"},
+			wantErrString: "failed to find field: x",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := JSONPayloadHandler{}.ExtractMany(body, tt.keys...)
+			if tt.wantErrString != "" {
+				assert.EqualError(t, err, tt.wantErrString)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package db
 
 import (
 	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
 
 	"github.com/golang-migrate/migrate/v4"
@@ -41,6 +43,39 @@ func RunRollback(config Config, embeddedMigrations embed.FS, resourcePath string
 	return err
 }
 
+// HasPendingMigrations reports whether the database is behind the embedded
+// migrations or was left dirty by a failed migration
+func HasPendingMigrations(config Config, embeddedMigrations embed.FS, resourcePath string) (bool, error) {
+	src, err := httpfs.New(http.FS(embeddedMigrations), resourcePath)
+	if err != nil {
+		return false, fmt.Errorf("db migrator: %v", err)
+	}
+	m, err := migrate.NewWithSourceInstance("httpfs", src, config.URL)
+	if err != nil {
+		return false, err
+	}
+	defer m.Close()
+
+	version, dirty, err := m.Version()
+	if err == migrate.ErrNilVersion {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if dirty {
+		return true, nil
+	}
+
+	if _, err := src.Next(version); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func getMigrationInstance(config Config, embeddedMigrations embed.FS, resourcePath string) (*migrate.Migrate, error) {
 	src, err := httpfs.New(http.FS(embeddedMigrations), resourcePath)
 	if err != nil {
@@ -1,6 +1,7 @@
 package httputil
 
 const (
-	HeaderUserAgent = "User-Agent"
-	HeaderXUser     = "X-User"
+	HeaderUserAgent  = "User-Agent"
+	HeaderXUser      = "X-User"
+	HeaderXRequestID = "X-Request-Id"
 )
@@ -4456,6 +4456,8 @@ func (m *Role) validate(all bool) error {
 
 	// no validation rules for NamespaceId
 
+	// no validation rules for OrgId
+
 	if len(errors) > 0 {
 		return RoleMultiError(errors)
 	}
@@ -5460,6 +5462,8 @@ func (m *ListRolesRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for OrgId
+
 	if len(errors) > 0 {
 		return ListRolesRequestMultiError(errors)
 	}
@@ -7264,86 +7268,44 @@ var _ interface {
 	ErrorName() string
 } = ListOrganizationAdminsResponseValidationError{}
 
-// Validate checks the field values on ProjectRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProjectRequestBody) Validate() error {
+// Validate checks the field values on AddOrganizationAdminsRequestBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddOrganizationAdminsRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProjectRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ProjectRequestBodyMultiError, or nil if none found.
-func (m *ProjectRequestBody) ValidateAll() error {
+// ValidateAll checks the field values on AddOrganizationAdminsRequestBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddOrganizationAdminsRequestBodyMultiError, or nil if none found.
+func (m *AddOrganizationAdminsRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProjectRequestBody) validate(all bool) error {
+func (m *AddOrganizationAdminsRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ProjectRequestBody_Name_Pattern.MatchString(m.GetName()) {
-		err := ProjectRequestBodyValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Slug
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectRequestBodyValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectRequestBodyValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectRequestBodyValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for OrgId
-
 	if len(errors) > 0 {
-		return ProjectRequestBodyMultiError(errors)
+		return AddOrganizationAdminsRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectRequestBodyMultiError is an error wrapping multiple validation errors
-// returned by ProjectRequestBody.ValidateAll() if the designated constraints
-// aren't met.
-type ProjectRequestBodyMultiError []error
+// AddOrganizationAdminsRequestBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AddOrganizationAdminsRequestBody.ValidateAll() if the designated
+// constraints aren't met.
+type AddOrganizationAdminsRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectRequestBodyMultiError) Error() string {
+func (m AddOrganizationAdminsRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7352,11 +7314,12 @@ func (m ProjectRequestBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectRequestBodyMultiError) AllErrors() []error { return m }
+func (m AddOrganizationAdminsRequestBodyMultiError) AllErrors() []error { return m }
 
-// ProjectRequestBodyValidationError is the validation error returned by
-// ProjectRequestBody.Validate if the designated constraints aren't met.
-type ProjectRequestBodyValidationError struct {
+// AddOrganizationAdminsRequestBodyValidationError is the validation error
+// returned by AddOrganizationAdminsRequestBody.Validate if the designated
+// constraints aren't met.
+type AddOrganizationAdminsRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7364,24 +7327,24 @@ type ProjectRequestBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectRequestBodyValidationError) Field() string { return e.field }
+func (e AddOrganizationAdminsRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectRequestBodyValidationError) Reason() string { return e.reason }
+func (e AddOrganizationAdminsRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectRequestBodyValidationError) Cause() error { return e.cause }
+func (e AddOrganizationAdminsRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectRequestBodyValidationError) Key() bool { return e.key }
+func (e AddOrganizationAdminsRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectRequestBodyValidationError) ErrorName() string {
-	return "ProjectRequestBodyValidationError"
+func (e AddOrganizationAdminsRequestBodyValidationError) ErrorName() string {
+	return "AddOrganizationAdminsRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProjectRequestBodyValidationError) Error() string {
+func (e AddOrganizationAdminsRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7393,14 +7356,14 @@ func (e ProjectRequestBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProjectRequestBody.%s: %s%s",
+		"invalid %sAddOrganizationAdminsRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectRequestBodyValidationError{}
+var _ error = AddOrganizationAdminsRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7408,37 +7371,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectRequestBodyValidationError{}
-
-var _ProjectRequestBody_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = AddOrganizationAdminsRequestBodyValidationError{}
 
-// Validate checks the field values on CreateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddOrganizationAdminsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProjectRequest) Validate() error {
+func (m *AddOrganizationAdminsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddOrganizationAdminsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateProjectRequestMultiError, or nil if none found.
-func (m *CreateProjectRequest) ValidateAll() error {
+// AddOrganizationAdminsRequestMultiError, or nil if none found.
+func (m *AddOrganizationAdminsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectRequest) validate(all bool) error {
+func (m *AddOrganizationAdminsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if all {
 		switch v := interface{}(m.GetBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateProjectRequestValidationError{
+				errors = append(errors, AddOrganizationAdminsRequestValidationError{
 					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7446,7 +7409,7 @@ func (m *CreateProjectRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateProjectRequestValidationError{
+				errors = append(errors, AddOrganizationAdminsRequestValidationError{
 					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7455,7 +7418,7 @@ func (m *CreateProjectRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateProjectRequestValidationError{
+			return AddOrganizationAdminsRequestValidationError{
 				field:  "Body",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7464,19 +7427,19 @@ func (m *CreateProjectRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateProjectRequestMultiError(errors)
+		return AddOrganizationAdminsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateProjectRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProjectRequestMultiError []error
+// AddOrganizationAdminsRequestMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationAdminsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddOrganizationAdminsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectRequestMultiError) Error() string {
+func (m AddOrganizationAdminsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7485,11 +7448,12 @@ func (m CreateProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
+func (m AddOrganizationAdminsRequestMultiError) AllErrors() []error { return m }
 
-// CreateProjectRequestValidationError is the validation error returned by
-// CreateProjectRequest.Validate if the designated constraints aren't met.
-type CreateProjectRequestValidationError struct {
+// AddOrganizationAdminsRequestValidationError is the validation error returned
+// by AddOrganizationAdminsRequest.Validate if the designated constraints
+// aren't met.
+type AddOrganizationAdminsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7497,24 +7461,24 @@ type CreateProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectRequestValidationError) Field() string { return e.field }
+func (e AddOrganizationAdminsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
+func (e AddOrganizationAdminsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
+func (e AddOrganizationAdminsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectRequestValidationError) Key() bool { return e.key }
+func (e AddOrganizationAdminsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectRequestValidationError) ErrorName() string {
-	return "CreateProjectRequestValidationError"
+func (e AddOrganizationAdminsRequestValidationError) ErrorName() string {
+	return "AddOrganizationAdminsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectRequestValidationError) Error() string {
+func (e AddOrganizationAdminsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7526,14 +7490,14 @@ func (e CreateProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectRequest.%s: %s%s",
+		"invalid %sAddOrganizationAdminsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectRequestValidationError{}
+var _ error = AddOrganizationAdminsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7541,146 +7505,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectRequestValidationError{}
+} = AddOrganizationAdminsRequestValidationError{}
 
-// Validate checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Project) Validate() error {
+// Validate checks the field values on AddOrganizationAdminsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrganizationAdminsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
-func (m *Project) ValidateAll() error {
+// ValidateAll checks the field values on AddOrganizationAdminsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddOrganizationAdminsResponseMultiError, or nil if none found.
+func (m *AddOrganizationAdminsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Project) validate(all bool) error {
+func (m *AddOrganizationAdminsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if !_Project_Name_Pattern.MatchString(m.GetName()) {
-		err := ProjectValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Slug
-
-	// no validation rules for OrgId
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddOrganizationAdminsResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddOrganizationAdminsResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedAt",
+				return AddOrganizationAdminsResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return AddOrganizationAdminsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// AddOrganizationAdminsResponseMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationAdminsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddOrganizationAdminsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m AddOrganizationAdminsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7689,11 +7585,12 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m AddOrganizationAdminsResponseMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// AddOrganizationAdminsResponseValidationError is the validation error
+// returned by AddOrganizationAdminsResponse.Validate if the designated
+// constraints aren't met.
+type AddOrganizationAdminsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7701,22 +7598,24 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e AddOrganizationAdminsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e AddOrganizationAdminsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e AddOrganizationAdminsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e AddOrganizationAdminsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e AddOrganizationAdminsResponseValidationError) ErrorName() string {
+	return "AddOrganizationAdminsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e AddOrganizationAdminsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7728,14 +7627,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sAddOrganizationAdminsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = AddOrganizationAdminsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7743,75 +7642,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
-
-var _Project_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = AddOrganizationAdminsResponseValidationError{}
 
-// Validate checks the field values on CreateProjectResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveOrganizationAdminRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProjectResponse) Validate() error {
+func (m *RemoveOrganizationAdminRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateProjectResponseMultiError, or nil if none found.
-func (m *CreateProjectResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveOrganizationAdminRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationAdminRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationAdminRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectResponse) validate(all bool) error {
+func (m *RemoveOrganizationAdminRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetProject()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateProjectResponseValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateProjectResponseValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateProjectResponseValidationError{
-				field:  "Project",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return CreateProjectResponseMultiError(errors)
+		return RemoveOrganizationAdminRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateProjectResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProjectResponseMultiError []error
+// RemoveOrganizationAdminRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveOrganizationAdminRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveOrganizationAdminRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectResponseMultiError) Error() string {
+func (m RemoveOrganizationAdminRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7820,11 +7692,12 @@ func (m CreateProjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectResponseMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationAdminRequestMultiError) AllErrors() []error { return m }
 
-// CreateProjectResponseValidationError is the validation error returned by
-// CreateProjectResponse.Validate if the designated constraints aren't met.
-type CreateProjectResponseValidationError struct {
+// RemoveOrganizationAdminRequestValidationError is the validation error
+// returned by RemoveOrganizationAdminRequest.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationAdminRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7832,24 +7705,24 @@ type CreateProjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectResponseValidationError) Field() string { return e.field }
+func (e RemoveOrganizationAdminRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectResponseValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationAdminRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectResponseValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationAdminRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectResponseValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationAdminRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectResponseValidationError) ErrorName() string {
-	return "CreateProjectResponseValidationError"
+func (e RemoveOrganizationAdminRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationAdminRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectResponseValidationError) Error() string {
+func (e RemoveOrganizationAdminRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7861,14 +7734,14 @@ func (e CreateProjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectResponse.%s: %s%s",
+		"invalid %sRemoveOrganizationAdminRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectResponseValidationError{}
+var _ error = RemoveOrganizationAdminRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7876,53 +7749,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectResponseValidationError{}
+} = RemoveOrganizationAdminRequestValidationError{}
 
-// Validate checks the field values on GetProjectResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on OrganizationRoleRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProjectResponse) Validate() error {
+func (m *OrganizationRoleRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProjectResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on OrganizationRoleRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProjectResponseMultiError, or nil if none found.
-func (m *GetProjectResponse) ValidateAll() error {
+// OrganizationRoleRequestBodyMultiError, or nil if none found.
+func (m *OrganizationRoleRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProjectResponse) validate(all bool) error {
+func (m *OrganizationRoleRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_OrganizationRoleRequestBody_Name_Pattern.MatchString(m.GetName()) {
+		err := OrganizationRoleRequestBodyValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_]*[a-z0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for NamespaceId
+
 	if all {
-		switch v := interface{}(m.GetProject()).(type) {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetProjectResponseValidationError{
-					field:  "Project",
+				errors = append(errors, OrganizationRoleRequestBodyValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetProjectResponseValidationError{
-					field:  "Project",
+				errors = append(errors, OrganizationRoleRequestBodyValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetProjectResponseValidationError{
-				field:  "Project",
+			return OrganizationRoleRequestBodyValidationError{
+				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7930,19 +7816,19 @@ func (m *GetProjectResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetProjectResponseMultiError(errors)
+		return OrganizationRoleRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProjectResponseMultiError is an error wrapping multiple validation errors
-// returned by GetProjectResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetProjectResponseMultiError []error
+// OrganizationRoleRequestBodyMultiError is an error wrapping multiple
+// validation errors returned by OrganizationRoleRequestBody.ValidateAll() if
+// the designated constraints aren't met.
+type OrganizationRoleRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProjectResponseMultiError) Error() string {
+func (m OrganizationRoleRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7951,11 +7837,12 @@ func (m GetProjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProjectResponseMultiError) AllErrors() []error { return m }
+func (m OrganizationRoleRequestBodyMultiError) AllErrors() []error { return m }
 
-// GetProjectResponseValidationError is the validation error returned by
-// GetProjectResponse.Validate if the designated constraints aren't met.
-type GetProjectResponseValidationError struct {
+// OrganizationRoleRequestBodyValidationError is the validation error returned
+// by OrganizationRoleRequestBody.Validate if the designated constraints
+// aren't met.
+type OrganizationRoleRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7963,24 +7850,24 @@ type GetProjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProjectResponseValidationError) Field() string { return e.field }
+func (e OrganizationRoleRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProjectResponseValidationError) Reason() string { return e.reason }
+func (e OrganizationRoleRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProjectResponseValidationError) Cause() error { return e.cause }
+func (e OrganizationRoleRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProjectResponseValidationError) Key() bool { return e.key }
+func (e OrganizationRoleRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProjectResponseValidationError) ErrorName() string {
-	return "GetProjectResponseValidationError"
+func (e OrganizationRoleRequestBodyValidationError) ErrorName() string {
+	return "OrganizationRoleRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProjectResponseValidationError) Error() string {
+func (e OrganizationRoleRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7992,14 +7879,14 @@ func (e GetProjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProjectResponse.%s: %s%s",
+		"invalid %sOrganizationRoleRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProjectResponseValidationError{}
+var _ error = OrganizationRoleRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8007,53 +7894,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProjectResponseValidationError{}
+} = OrganizationRoleRequestBodyValidationError{}
 
-// Validate checks the field values on UpdateProjectResponse with the rules
-// defined in the proto definition for this message. If any rules are
+var _OrganizationRoleRequestBody_Name_Pattern = regexp.MustCompile("^[a-z][a-z0-9_]*[a-z0-9]$")
+
+// Validate checks the field values on CreateOrganizationRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectResponse) Validate() error {
+func (m *CreateOrganizationRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateProjectResponseMultiError, or nil if none found.
-func (m *UpdateProjectResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateOrganizationRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRoleRequestMultiError, or nil if none found.
+func (m *CreateOrganizationRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectResponse) validate(all bool) error {
+func (m *CreateOrganizationRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if all {
-		switch v := interface{}(m.GetProject()).(type) {
+		switch v := interface{}(m.GetBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateProjectResponseValidationError{
-					field:  "Project",
+				errors = append(errors, CreateOrganizationRoleRequestValidationError{
+					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateProjectResponseValidationError{
-					field:  "Project",
+				errors = append(errors, CreateOrganizationRoleRequestValidationError{
+					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateProjectResponseValidationError{
-				field:  "Project",
+			return CreateOrganizationRoleRequestValidationError{
+				field:  "Body",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8061,19 +7952,19 @@ func (m *UpdateProjectResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateProjectResponseMultiError(errors)
+		return CreateOrganizationRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateProjectResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateProjectResponseMultiError []error
+// CreateOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateOrganizationRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateOrganizationRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectResponseMultiError) Error() string {
+func (m CreateOrganizationRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8082,11 +7973,12 @@ func (m UpdateProjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectResponseMultiError) AllErrors() []error { return m }
+func (m CreateOrganizationRoleRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProjectResponseValidationError is the validation error returned by
-// UpdateProjectResponse.Validate if the designated constraints aren't met.
-type UpdateProjectResponseValidationError struct {
+// CreateOrganizationRoleRequestValidationError is the validation error
+// returned by CreateOrganizationRoleRequest.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8094,24 +7986,24 @@ type UpdateProjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectResponseValidationError) Field() string { return e.field }
+func (e CreateOrganizationRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectResponseValidationError) Reason() string { return e.reason }
+func (e CreateOrganizationRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectResponseValidationError) Cause() error { return e.cause }
+func (e CreateOrganizationRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectResponseValidationError) Key() bool { return e.key }
+func (e CreateOrganizationRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectResponseValidationError) ErrorName() string {
-	return "UpdateProjectResponseValidationError"
+func (e CreateOrganizationRoleRequestValidationError) ErrorName() string {
+	return "CreateOrganizationRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectResponseValidationError) Error() string {
+func (e CreateOrganizationRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8123,14 +8015,14 @@ func (e UpdateProjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectResponse.%s: %s%s",
+		"invalid %sCreateOrganizationRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectResponseValidationError{}
+var _ error = CreateOrganizationRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8138,44 +8030,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectResponseValidationError{}
+} = CreateOrganizationRoleRequestValidationError{}
 
-// Validate checks the field values on ListProjectsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateOrganizationRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectsRequest) Validate() error {
+func (m *CreateOrganizationRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListProjectsRequestMultiError, or nil if none found.
-func (m *ListProjectsRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateOrganizationRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRoleResponseMultiError, or nil if none found.
+func (m *CreateOrganizationRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectsRequest) validate(all bool) error {
+func (m *CreateOrganizationRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListProjectsRequestMultiError(errors)
+		return CreateOrganizationRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectsRequestMultiError []error
+// CreateOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateOrganizationRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateOrganizationRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectsRequestMultiError) Error() string {
+func (m CreateOrganizationRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8184,11 +8105,12 @@ func (m ListProjectsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectsRequestMultiError) AllErrors() []error { return m }
+func (m CreateOrganizationRoleResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectsRequestValidationError is the validation error returned by
-// ListProjectsRequest.Validate if the designated constraints aren't met.
-type ListProjectsRequestValidationError struct {
+// CreateOrganizationRoleResponseValidationError is the validation error
+// returned by CreateOrganizationRoleResponse.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8196,24 +8118,24 @@ type ListProjectsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectsRequestValidationError) Field() string { return e.field }
+func (e CreateOrganizationRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectsRequestValidationError) Reason() string { return e.reason }
+func (e CreateOrganizationRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectsRequestValidationError) Cause() error { return e.cause }
+func (e CreateOrganizationRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectsRequestValidationError) Key() bool { return e.key }
+func (e CreateOrganizationRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectsRequestValidationError) ErrorName() string {
-	return "ListProjectsRequestValidationError"
+func (e CreateOrganizationRoleResponseValidationError) ErrorName() string {
+	return "CreateOrganizationRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectsRequestValidationError) Error() string {
+func (e CreateOrganizationRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8225,14 +8147,14 @@ func (e ListProjectsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectsRequest.%s: %s%s",
+		"invalid %sCreateOrganizationRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectsRequestValidationError{}
+var _ error = CreateOrganizationRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8240,78 +8162,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectsRequestValidationError{}
+} = CreateOrganizationRoleResponseValidationError{}
 
-// Validate checks the field values on ListProjectsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteOrganizationRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectsResponse) Validate() error {
+func (m *DeleteOrganizationRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListProjectsResponseMultiError, or nil if none found.
-func (m *ListProjectsResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteOrganizationRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteOrganizationRoleRequestMultiError, or nil if none found.
+func (m *DeleteOrganizationRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectsResponse) validate(all bool) error {
+func (m *DeleteOrganizationRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProjects() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectsResponseValidationError{
-						field:  fmt.Sprintf("Projects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectsResponseValidationError{
-						field:  fmt.Sprintf("Projects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectsResponseValidationError{
-					field:  fmt.Sprintf("Projects[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Id
 
-	}
+	// no validation rules for RoleId
 
 	if len(errors) > 0 {
-		return ListProjectsResponseMultiError(errors)
+		return DeleteOrganizationRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListProjectsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectsResponseMultiError []error
+// DeleteOrganizationRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteOrganizationRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteOrganizationRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectsResponseMultiError) Error() string {
+func (m DeleteOrganizationRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8320,11 +8212,12 @@ func (m ListProjectsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteOrganizationRoleRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectsResponseValidationError is the validation error returned by
-// ListProjectsResponse.Validate if the designated constraints aren't met.
-type ListProjectsResponseValidationError struct {
+// DeleteOrganizationRoleRequestValidationError is the validation error
+// returned by DeleteOrganizationRoleRequest.Validate if the designated
+// constraints aren't met.
+type DeleteOrganizationRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8332,24 +8225,24 @@ type ListProjectsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectsResponseValidationError) Field() string { return e.field }
+func (e DeleteOrganizationRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteOrganizationRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteOrganizationRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectsResponseValidationError) Key() bool { return e.key }
+func (e DeleteOrganizationRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectsResponseValidationError) ErrorName() string {
-	return "ListProjectsResponseValidationError"
+func (e DeleteOrganizationRoleRequestValidationError) ErrorName() string {
+	return "DeleteOrganizationRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectsResponseValidationError) Error() string {
+func (e DeleteOrganizationRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8361,14 +8254,14 @@ func (e ListProjectsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectsResponse.%s: %s%s",
+		"invalid %sDeleteOrganizationRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectsResponseValidationError{}
+var _ error = DeleteOrganizationRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8376,46 +8269,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectsResponseValidationError{}
+} = DeleteOrganizationRoleRequestValidationError{}
 
-// Validate checks the field values on GetProjectRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetProjectRequest) Validate() error {
+// Validate checks the field values on DeleteOrganizationRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteOrganizationRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetProjectRequestMultiError, or nil if none found.
-func (m *GetProjectRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteOrganizationRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteOrganizationRoleResponseMultiError, or nil if none found.
+func (m *DeleteOrganizationRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProjectRequest) validate(all bool) error {
+func (m *DeleteOrganizationRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return GetProjectRequestMultiError(errors)
+		return DeleteOrganizationRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProjectRequestMultiError is an error wrapping multiple validation errors
-// returned by GetProjectRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetProjectRequestMultiError []error
+// DeleteOrganizationRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteOrganizationRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteOrganizationRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProjectRequestMultiError) Error() string {
+func (m DeleteOrganizationRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8424,11 +8315,12 @@ func (m GetProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProjectRequestMultiError) AllErrors() []error { return m }
+func (m DeleteOrganizationRoleResponseMultiError) AllErrors() []error { return m }
 
-// GetProjectRequestValidationError is the validation error returned by
-// GetProjectRequest.Validate if the designated constraints aren't met.
-type GetProjectRequestValidationError struct {
+// DeleteOrganizationRoleResponseValidationError is the validation error
+// returned by DeleteOrganizationRoleResponse.Validate if the designated
+// constraints aren't met.
+type DeleteOrganizationRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8436,24 +8328,24 @@ type GetProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProjectRequestValidationError) Field() string { return e.field }
+func (e DeleteOrganizationRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProjectRequestValidationError) Reason() string { return e.reason }
+func (e DeleteOrganizationRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProjectRequestValidationError) Cause() error { return e.cause }
+func (e DeleteOrganizationRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProjectRequestValidationError) Key() bool { return e.key }
+func (e DeleteOrganizationRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProjectRequestValidationError) ErrorName() string {
-	return "GetProjectRequestValidationError"
+func (e DeleteOrganizationRoleResponseValidationError) ErrorName() string {
+	return "DeleteOrganizationRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProjectRequestValidationError) Error() string {
+func (e DeleteOrganizationRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8465,14 +8357,14 @@ func (e GetProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProjectRequest.%s: %s%s",
+		"invalid %sDeleteOrganizationRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProjectRequestValidationError{}
+var _ error = DeleteOrganizationRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8480,75 +8372,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProjectRequestValidationError{}
+} = DeleteOrganizationRoleResponseValidationError{}
 
-// Validate checks the field values on UpdateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveOrganizationAdminResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProjectRequest) Validate() error {
+func (m *RemoveOrganizationAdminResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProjectRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateProjectRequestMultiError, or nil if none found.
-func (m *UpdateProjectRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveOrganizationAdminResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationAdminResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationAdminResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProjectRequest) validate(all bool) error {
+func (m *RemoveOrganizationAdminResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateProjectRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RemoveOrganizationAdminResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RemoveOrganizationAdminResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateProjectRequestValidationError{
-					field:  "Body",
+				return RemoveOrganizationAdminResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateProjectRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateProjectRequestMultiError(errors)
+		return RemoveOrganizationAdminResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProjectRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProjectRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateProjectRequestMultiError []error
+// RemoveOrganizationAdminResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveOrganizationAdminResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveOrganizationAdminResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProjectRequestMultiError) Error() string {
+func (m RemoveOrganizationAdminResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8557,11 +8452,12 @@ func (m UpdateProjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProjectRequestMultiError) AllErrors() []error { return m }
+func (m RemoveOrganizationAdminResponseMultiError) AllErrors() []error { return m }
 
-// UpdateProjectRequestValidationError is the validation error returned by
-// UpdateProjectRequest.Validate if the designated constraints aren't met.
-type UpdateProjectRequestValidationError struct {
+// RemoveOrganizationAdminResponseValidationError is the validation error
+// returned by RemoveOrganizationAdminResponse.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationAdminResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8569,24 +8465,24 @@ type UpdateProjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProjectRequestValidationError) Field() string { return e.field }
+func (e RemoveOrganizationAdminResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProjectRequestValidationError) Reason() string { return e.reason }
+func (e RemoveOrganizationAdminResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProjectRequestValidationError) Cause() error { return e.cause }
+func (e RemoveOrganizationAdminResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProjectRequestValidationError) Key() bool { return e.key }
+func (e RemoveOrganizationAdminResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProjectRequestValidationError) ErrorName() string {
-	return "UpdateProjectRequestValidationError"
+func (e RemoveOrganizationAdminResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationAdminResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProjectRequestValidationError) Error() string {
+func (e RemoveOrganizationAdminResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8598,14 +8494,14 @@ func (e UpdateProjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProjectRequest.%s: %s%s",
+		"invalid %sRemoveOrganizationAdminResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProjectRequestValidationError{}
+var _ error = RemoveOrganizationAdminResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8613,46 +8509,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProjectRequestValidationError{}
+} = RemoveOrganizationAdminResponseValidationError{}
 
-// Validate checks the field values on ListProjectAdminsRequest with the rules
+// Validate checks the field values on ProjectRequestBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectAdminsRequest) Validate() error {
+func (m *ProjectRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectAdminsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ProjectRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectAdminsRequestMultiError, or nil if none found.
-func (m *ListProjectAdminsRequest) ValidateAll() error {
+// ProjectRequestBodyMultiError, or nil if none found.
+func (m *ProjectRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectAdminsRequest) validate(all bool) error {
+func (m *ProjectRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if !_ProjectRequestBody_Name_Pattern.MatchString(m.GetName()) {
+		err := ProjectRequestBodyValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Slug
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectRequestBodyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectRequestBodyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectRequestBodyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OrgId
 
 	if len(errors) > 0 {
-		return ListProjectAdminsRequestMultiError(errors)
+		return ProjectRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectAdminsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectAdminsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectAdminsRequestMultiError []error
+// ProjectRequestBodyMultiError is an error wrapping multiple validation errors
+// returned by ProjectRequestBody.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectAdminsRequestMultiError) Error() string {
+func (m ProjectRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8661,11 +8599,11 @@ func (m ListProjectAdminsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectAdminsRequestMultiError) AllErrors() []error { return m }
+func (m ProjectRequestBodyMultiError) AllErrors() []error { return m }
 
-// ListProjectAdminsRequestValidationError is the validation error returned by
-// ListProjectAdminsRequest.Validate if the designated constraints aren't met.
-type ListProjectAdminsRequestValidationError struct {
+// ProjectRequestBodyValidationError is the validation error returned by
+// ProjectRequestBody.Validate if the designated constraints aren't met.
+type ProjectRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8673,24 +8611,24 @@ type ListProjectAdminsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectAdminsRequestValidationError) Field() string { return e.field }
+func (e ProjectRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectAdminsRequestValidationError) Reason() string { return e.reason }
+func (e ProjectRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectAdminsRequestValidationError) Cause() error { return e.cause }
+func (e ProjectRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectAdminsRequestValidationError) Key() bool { return e.key }
+func (e ProjectRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectAdminsRequestValidationError) ErrorName() string {
-	return "ListProjectAdminsRequestValidationError"
+func (e ProjectRequestBodyValidationError) ErrorName() string {
+	return "ProjectRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectAdminsRequestValidationError) Error() string {
+func (e ProjectRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8702,14 +8640,14 @@ func (e ListProjectAdminsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectAdminsRequest.%s: %s%s",
+		"invalid %sProjectRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectAdminsRequestValidationError{}
+var _ error = ProjectRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8717,78 +8655,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectAdminsRequestValidationError{}
+} = ProjectRequestBodyValidationError{}
 
-// Validate checks the field values on ListProjectAdminsResponse with the rules
+var _ProjectRequestBody_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on CreateProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectAdminsResponse) Validate() error {
+func (m *CreateProjectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectAdminsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListProjectAdminsResponseMultiError, or nil if none found.
-func (m *ListProjectAdminsResponse) ValidateAll() error {
+// CreateProjectRequestMultiError, or nil if none found.
+func (m *CreateProjectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectAdminsResponse) validate(all bool) error {
+func (m *CreateProjectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectAdminsResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectAdminsResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListProjectAdminsResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				errors = append(errors, CreateProjectRequestValidationError{
+					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListProjectAdminsResponseMultiError(errors)
+		return CreateProjectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectAdminsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListProjectAdminsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectAdminsResponseMultiError []error
+// CreateProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectAdminsResponseMultiError) Error() string {
+func (m CreateProjectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8797,11 +8732,11 @@ func (m ListProjectAdminsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectAdminsResponseMultiError) AllErrors() []error { return m }
+func (m CreateProjectRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectAdminsResponseValidationError is the validation error returned by
-// ListProjectAdminsResponse.Validate if the designated constraints aren't met.
-type ListProjectAdminsResponseValidationError struct {
+// CreateProjectRequestValidationError is the validation error returned by
+// CreateProjectRequest.Validate if the designated constraints aren't met.
+type CreateProjectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8809,24 +8744,24 @@ type ListProjectAdminsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectAdminsResponseValidationError) Field() string { return e.field }
+func (e CreateProjectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectAdminsResponseValidationError) Reason() string { return e.reason }
+func (e CreateProjectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectAdminsResponseValidationError) Cause() error { return e.cause }
+func (e CreateProjectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectAdminsResponseValidationError) Key() bool { return e.key }
+func (e CreateProjectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectAdminsResponseValidationError) ErrorName() string {
-	return "ListProjectAdminsResponseValidationError"
+func (e CreateProjectRequestValidationError) ErrorName() string {
+	return "CreateProjectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectAdminsResponseValidationError) Error() string {
+func (e CreateProjectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8838,14 +8773,14 @@ func (e ListProjectAdminsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectAdminsResponse.%s: %s%s",
+		"invalid %sCreateProjectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectAdminsResponseValidationError{}
+var _ error = CreateProjectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8853,23 +8788,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectAdminsResponseValidationError{}
+} = CreateProjectRequestValidationError{}
 
-// Validate checks the field values on Action with the rules defined in the
+// Validate checks the field values on Project with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Action) Validate() error {
+func (m *Project) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Action with the rules defined in the
+// ValidateAll checks the field values on Project with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ActionMultiError, or nil if none found.
-func (m *Action) ValidateAll() error {
+// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
+func (m *Project) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Action) validate(all bool) error {
+func (m *Project) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8878,8 +8813,8 @@ func (m *Action) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if !_Action_Name_Pattern.MatchString(m.GetName()) {
-		err := ActionValidationError{
+	if !_Project_Name_Pattern.MatchString(m.GetName()) {
+		err := ProjectValidationError{
 			field:  "Name",
 			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
@@ -8889,29 +8824,33 @@ func (m *Action) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Slug
+
+	// no validation rules for OrgId
+
 	if all {
-		switch v := interface{}(m.GetNamespace()).(type) {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ActionValidationError{
-					field:  "Namespace",
+				errors = append(errors, ProjectValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ActionValidationError{
-					field:  "Namespace",
+				errors = append(errors, ProjectValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ActionValidationError{
-				field:  "Namespace",
+			return ProjectValidationError{
+				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8922,7 +8861,7 @@ func (m *Action) validate(all bool) error {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ActionValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8930,7 +8869,7 @@ func (m *Action) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ActionValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8939,7 +8878,7 @@ func (m *Action) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ActionValidationError{
+			return ProjectValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -8951,7 +8890,7 @@ func (m *Action) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ActionValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8959,7 +8898,7 @@ func (m *Action) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ActionValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8968,7 +8907,7 @@ func (m *Action) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ActionValidationError{
+			return ProjectValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -8976,21 +8915,19 @@ func (m *Action) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for NamespaceId
-
 	if len(errors) > 0 {
-		return ActionMultiError(errors)
+		return ProjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActionMultiError is an error wrapping multiple validation errors returned by
-// Action.ValidateAll() if the designated constraints aren't met.
-type ActionMultiError []error
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActionMultiError) Error() string {
+func (m ProjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8999,11 +8936,11 @@ func (m ActionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActionMultiError) AllErrors() []error { return m }
+func (m ProjectMultiError) AllErrors() []error { return m }
 
-// ActionValidationError is the validation error returned by Action.Validate if
-// the designated constraints aren't met.
-type ActionValidationError struct {
+// ProjectValidationError is the validation error returned by Project.Validate
+// if the designated constraints aren't met.
+type ProjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9011,22 +8948,22 @@ type ActionValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActionValidationError) Field() string { return e.field }
+func (e ProjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActionValidationError) Reason() string { return e.reason }
+func (e ProjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActionValidationError) Cause() error { return e.cause }
+func (e ProjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActionValidationError) Key() bool { return e.key }
+func (e ProjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActionValidationError) ErrorName() string { return "ActionValidationError" }
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ActionValidationError) Error() string {
+func (e ProjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9038,14 +8975,14 @@ func (e ActionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAction.%s: %s%s",
+		"invalid %sProject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActionValidationError{}
+var _ error = ProjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -9053,67 +8990,6378 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActionValidationError{}
+} = ProjectValidationError{}
 
-var _Action_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+var _Project_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-// Validate checks the field values on Namespace with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Namespace) Validate() error {
+// Validate checks the field values on CreateProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProjectResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Namespace with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NamespaceMultiError, or nil
-// if none found.
-func (m *Namespace) ValidateAll() error {
+// ValidateAll checks the field values on CreateProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProjectResponseMultiError, or nil if none found.
+func (m *CreateProjectResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Namespace) validate(all bool) error {
+func (m *CreateProjectResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if !_Namespace_Name_Pattern.MatchString(m.GetName()) {
-		err := NamespaceValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
+		switch v := interface{}(m.GetProject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NamespaceValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, CreateProjectResponseValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, NamespaceValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, CreateProjectResponseValidationError{
+					field:  "Project",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProjectResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateProjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProjectResponseMultiError) AllErrors() []error { return m }
+
+// CreateProjectResponseValidationError is the validation error returned by
+// CreateProjectResponse.Validate if the designated constraints aren't met.
+type CreateProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProjectResponseValidationError) ErrorName() string {
+	return "CreateProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProjectResponseValidationError{}
+
+// Validate checks the field values on GetProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectResponseMultiError, or nil if none found.
+func (m *GetProjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectResponseMultiError is an error wrapping multiple validation errors
+// returned by GetProjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectResponseMultiError) AllErrors() []error { return m }
+
+// GetProjectResponseValidationError is the validation error returned by
+// GetProjectResponse.Validate if the designated constraints aren't met.
+type GetProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectResponseValidationError) ErrorName() string {
+	return "GetProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectResponseValidationError{}
+
+// Validate checks the field values on UpdateProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProjectResponseMultiError, or nil if none found.
+func (m *UpdateProjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProjectResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProjectResponseValidationError is the validation error returned by
+// UpdateProjectResponse.Validate if the designated constraints aren't met.
+type UpdateProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProjectResponseValidationError) ErrorName() string {
+	return "UpdateProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProjectResponseValidationError{}
+
+// Validate checks the field values on ListProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectsRequestMultiError, or nil if none found.
+func (m *ListProjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListProjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectsRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectsRequestValidationError is the validation error returned by
+// ListProjectsRequest.Validate if the designated constraints aren't met.
+type ListProjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectsRequestValidationError) ErrorName() string {
+	return "ListProjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectsRequestValidationError{}
+
+// Validate checks the field values on ListProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectsResponseMultiError, or nil if none found.
+func (m *ListProjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectsResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectsResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProjectsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProjectsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectsResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectsResponseValidationError is the validation error returned by
+// ListProjectsResponse.Validate if the designated constraints aren't met.
+type ListProjectsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectsResponseValidationError) ErrorName() string {
+	return "ListProjectsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectsResponseValidationError{}
+
+// Validate checks the field values on GetProjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectRequestMultiError, or nil if none found.
+func (m *GetProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectRequestValidationError is the validation error returned by
+// GetProjectRequest.Validate if the designated constraints aren't met.
+type GetProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectRequestValidationError) ErrorName() string {
+	return "GetProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectRequestValidationError{}
+
+// Validate checks the field values on UpdateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProjectRequestMultiError, or nil if none found.
+func (m *UpdateProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProjectRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProjectRequestValidationError is the validation error returned by
+// UpdateProjectRequest.Validate if the designated constraints aren't met.
+type UpdateProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProjectRequestValidationError) ErrorName() string {
+	return "UpdateProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProjectRequestValidationError{}
+
+// Validate checks the field values on ListProjectAdminsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectAdminsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectAdminsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectAdminsRequestMultiError, or nil if none found.
+func (m *ListProjectAdminsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectAdminsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ListProjectAdminsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectAdminsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectAdminsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectAdminsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectAdminsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectAdminsRequestMultiError) AllErrors() []error { return m }
+
+// ListProjectAdminsRequestValidationError is the validation error returned by
+// ListProjectAdminsRequest.Validate if the designated constraints aren't met.
+type ListProjectAdminsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectAdminsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectAdminsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectAdminsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectAdminsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectAdminsRequestValidationError) ErrorName() string {
+	return "ListProjectAdminsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectAdminsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectAdminsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectAdminsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectAdminsRequestValidationError{}
+
+// Validate checks the field values on ListProjectAdminsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectAdminsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProjectAdminsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectAdminsResponseMultiError, or nil if none found.
+func (m *ListProjectAdminsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProjectAdminsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectAdminsResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectAdminsResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectAdminsResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListProjectAdminsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectAdminsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProjectAdminsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectAdminsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectAdminsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectAdminsResponseMultiError) AllErrors() []error { return m }
+
+// ListProjectAdminsResponseValidationError is the validation error returned by
+// ListProjectAdminsResponse.Validate if the designated constraints aren't met.
+type ListProjectAdminsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProjectAdminsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProjectAdminsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProjectAdminsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProjectAdminsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProjectAdminsResponseValidationError) ErrorName() string {
+	return "ListProjectAdminsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProjectAdminsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProjectAdminsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProjectAdminsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProjectAdminsResponseValidationError{}
+
+// Validate checks the field values on AddProjectAdminsRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectAdminsRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectAdminsRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectAdminsRequestBodyMultiError, or nil if none found.
+func (m *AddProjectAdminsRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectAdminsRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddProjectAdminsRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectAdminsRequestBodyMultiError is an error wrapping multiple
+// validation errors returned by AddProjectAdminsRequestBody.ValidateAll() if
+// the designated constraints aren't met.
+type AddProjectAdminsRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectAdminsRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectAdminsRequestBodyMultiError) AllErrors() []error { return m }
+
+// AddProjectAdminsRequestBodyValidationError is the validation error returned
+// by AddProjectAdminsRequestBody.Validate if the designated constraints
+// aren't met.
+type AddProjectAdminsRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectAdminsRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectAdminsRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectAdminsRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectAdminsRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectAdminsRequestBodyValidationError) ErrorName() string {
+	return "AddProjectAdminsRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectAdminsRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectAdminsRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectAdminsRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectAdminsRequestBodyValidationError{}
+
+// Validate checks the field values on AddProjectAdminsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectAdminsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectAdminsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectAdminsRequestMultiError, or nil if none found.
+func (m *AddProjectAdminsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectAdminsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddProjectAdminsRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddProjectAdminsRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddProjectAdminsRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddProjectAdminsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectAdminsRequestMultiError is an error wrapping multiple validation
+// errors returned by AddProjectAdminsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectAdminsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectAdminsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectAdminsRequestMultiError) AllErrors() []error { return m }
+
+// AddProjectAdminsRequestValidationError is the validation error returned by
+// AddProjectAdminsRequest.Validate if the designated constraints aren't met.
+type AddProjectAdminsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectAdminsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectAdminsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectAdminsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectAdminsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectAdminsRequestValidationError) ErrorName() string {
+	return "AddProjectAdminsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectAdminsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectAdminsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectAdminsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectAdminsRequestValidationError{}
+
+// Validate checks the field values on AddProjectAdminsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProjectAdminsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectAdminsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProjectAdminsResponseMultiError, or nil if none found.
+func (m *AddProjectAdminsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectAdminsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddProjectAdminsResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddProjectAdminsResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddProjectAdminsResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddProjectAdminsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectAdminsResponseMultiError is an error wrapping multiple validation
+// errors returned by AddProjectAdminsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectAdminsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectAdminsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectAdminsResponseMultiError) AllErrors() []error { return m }
+
+// AddProjectAdminsResponseValidationError is the validation error returned by
+// AddProjectAdminsResponse.Validate if the designated constraints aren't met.
+type AddProjectAdminsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectAdminsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectAdminsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectAdminsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectAdminsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectAdminsResponseValidationError) ErrorName() string {
+	return "AddProjectAdminsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectAdminsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectAdminsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectAdminsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectAdminsResponseValidationError{}
+
+// Validate checks the field values on RemoveProjectAdminRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProjectAdminRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectAdminRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProjectAdminRequestMultiError, or nil if none found.
+func (m *RemoveProjectAdminRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectAdminRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return RemoveProjectAdminRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectAdminRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveProjectAdminRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RemoveProjectAdminRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectAdminRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectAdminRequestMultiError) AllErrors() []error { return m }
+
+// RemoveProjectAdminRequestValidationError is the validation error returned by
+// RemoveProjectAdminRequest.Validate if the designated constraints aren't met.
+type RemoveProjectAdminRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectAdminRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectAdminRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectAdminRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectAdminRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectAdminRequestValidationError) ErrorName() string {
+	return "RemoveProjectAdminRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectAdminRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectAdminRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectAdminRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectAdminRequestValidationError{}
+
+// Validate checks the field values on RemoveProjectAdminResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProjectAdminResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectAdminResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProjectAdminResponseMultiError, or nil if none found.
+func (m *RemoveProjectAdminResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectAdminResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RemoveProjectAdminResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RemoveProjectAdminResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RemoveProjectAdminResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RemoveProjectAdminResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectAdminResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectAdminResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveProjectAdminResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectAdminResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectAdminResponseMultiError) AllErrors() []error { return m }
+
+// RemoveProjectAdminResponseValidationError is the validation error returned
+// by RemoveProjectAdminResponse.Validate if the designated constraints aren't met.
+type RemoveProjectAdminResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectAdminResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectAdminResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectAdminResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectAdminResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectAdminResponseValidationError) ErrorName() string {
+	return "RemoveProjectAdminResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectAdminResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectAdminResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectAdminResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectAdminResponseValidationError{}
+
+// Validate checks the field values on VisibilityRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VisibilityRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VisibilityRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VisibilityRequestBodyMultiError, or nil if none found.
+func (m *VisibilityRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VisibilityRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Permission
+
+	// no validation rules for Public
+
+	if len(errors) > 0 {
+		return VisibilityRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// VisibilityRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by VisibilityRequestBody.ValidateAll() if the designated
+// constraints aren't met.
+type VisibilityRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VisibilityRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VisibilityRequestBodyMultiError) AllErrors() []error { return m }
+
+// VisibilityRequestBodyValidationError is the validation error returned by
+// VisibilityRequestBody.Validate if the designated constraints aren't met.
+type VisibilityRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisibilityRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisibilityRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisibilityRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisibilityRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisibilityRequestBodyValidationError) ErrorName() string {
+	return "VisibilityRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisibilityRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisibilityRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisibilityRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisibilityRequestBodyValidationError{}
+
+// Validate checks the field values on SetProjectVisibilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectVisibilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectVisibilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectVisibilityRequestMultiError, or nil if none found.
+func (m *SetProjectVisibilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectVisibilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetProjectVisibilityRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetProjectVisibilityRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetProjectVisibilityRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetProjectVisibilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectVisibilityRequestMultiError is an error wrapping multiple
+// validation errors returned by SetProjectVisibilityRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectVisibilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectVisibilityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectVisibilityRequestMultiError) AllErrors() []error { return m }
+
+// SetProjectVisibilityRequestValidationError is the validation error returned
+// by SetProjectVisibilityRequest.Validate if the designated constraints
+// aren't met.
+type SetProjectVisibilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectVisibilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectVisibilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectVisibilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectVisibilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectVisibilityRequestValidationError) ErrorName() string {
+	return "SetProjectVisibilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectVisibilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectVisibilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectVisibilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectVisibilityRequestValidationError{}
+
+// Validate checks the field values on SetProjectVisibilityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetProjectVisibilityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectVisibilityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetProjectVisibilityResponseMultiError, or nil if none found.
+func (m *SetProjectVisibilityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectVisibilityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetProjectVisibilityResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetProjectVisibilityResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetProjectVisibilityResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetProjectVisibilityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectVisibilityResponseMultiError is an error wrapping multiple
+// validation errors returned by SetProjectVisibilityResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetProjectVisibilityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectVisibilityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectVisibilityResponseMultiError) AllErrors() []error { return m }
+
+// SetProjectVisibilityResponseValidationError is the validation error returned
+// by SetProjectVisibilityResponse.Validate if the designated constraints
+// aren't met.
+type SetProjectVisibilityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectVisibilityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectVisibilityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectVisibilityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectVisibilityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectVisibilityResponseValidationError) ErrorName() string {
+	return "SetProjectVisibilityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectVisibilityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectVisibilityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectVisibilityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectVisibilityResponseValidationError{}
+
+// Validate checks the field values on Action with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Action) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Action with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ActionMultiError, or nil if none found.
+func (m *Action) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Action) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_Action_Name_Pattern.MatchString(m.GetName()) {
+		err := ActionValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActionValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActionValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActionValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NamespaceId
+
+	if len(errors) > 0 {
+		return ActionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActionMultiError is an error wrapping multiple validation errors returned by
+// Action.ValidateAll() if the designated constraints aren't met.
+type ActionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActionMultiError) AllErrors() []error { return m }
+
+// ActionValidationError is the validation error returned by Action.Validate if
+// the designated constraints aren't met.
+type ActionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActionValidationError) ErrorName() string { return "ActionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActionValidationError{}
+
+var _Action_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on Namespace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Namespace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Namespace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NamespaceMultiError, or nil
+// if none found.
+func (m *Namespace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Namespace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_Namespace_Name_Pattern.MatchString(m.GetName()) {
+		err := NamespaceValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NamespaceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return NamespaceValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NamespaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// NamespaceMultiError is an error wrapping multiple validation errors returned
+// by Namespace.ValidateAll() if the designated constraints aren't met.
+type NamespaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NamespaceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NamespaceMultiError) AllErrors() []error { return m }
+
+// NamespaceValidationError is the validation error returned by
+// Namespace.Validate if the designated constraints aren't met.
+type NamespaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NamespaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NamespaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NamespaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NamespaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NamespaceValidationError) ErrorName() string { return "NamespaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NamespaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNamespace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NamespaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NamespaceValidationError{}
+
+var _Namespace_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on Policy with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Policy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Policy with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PolicyMultiError, or nil if none found.
+func (m *Policy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Policy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyValidationError{
+				field:  "Action",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for RoleId
+
+	// no validation rules for ActionId
+
+	if len(errors) > 0 {
+		return PolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyMultiError is an error wrapping multiple validation errors returned by
+// Policy.ValidateAll() if the designated constraints aren't met.
+type PolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyMultiError) AllErrors() []error { return m }
+
+// PolicyValidationError is the validation error returned by Policy.Validate if
+// the designated constraints aren't met.
+type PolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyValidationError) ErrorName() string { return "PolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyValidationError{}
+
+// Validate checks the field values on ActionRequestBody with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActionRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActionRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActionRequestBodyMultiError, or nil if none found.
+func (m *ActionRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActionRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_ActionRequestBody_Name_Pattern.MatchString(m.GetName()) {
+		err := ActionRequestBodyValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for NamespaceId
+
+	if len(errors) > 0 {
+		return ActionRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActionRequestBodyMultiError is an error wrapping multiple validation errors
+// returned by ActionRequestBody.ValidateAll() if the designated constraints
+// aren't met.
+type ActionRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActionRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActionRequestBodyMultiError) AllErrors() []error { return m }
+
+// ActionRequestBodyValidationError is the validation error returned by
+// ActionRequestBody.Validate if the designated constraints aren't met.
+type ActionRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActionRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActionRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActionRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActionRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActionRequestBodyValidationError) ErrorName() string {
+	return "ActionRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActionRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActionRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActionRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActionRequestBodyValidationError{}
+
+var _ActionRequestBody_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on NamespaceRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NamespaceRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NamespaceRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NamespaceRequestBodyMultiError, or nil if none found.
+func (m *NamespaceRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NamespaceRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_NamespaceRequestBody_Name_Pattern.MatchString(m.GetName()) {
+		err := NamespaceRequestBodyValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NamespaceRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// NamespaceRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by NamespaceRequestBody.ValidateAll() if the designated
+// constraints aren't met.
+type NamespaceRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NamespaceRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NamespaceRequestBodyMultiError) AllErrors() []error { return m }
+
+// NamespaceRequestBodyValidationError is the validation error returned by
+// NamespaceRequestBody.Validate if the designated constraints aren't met.
+type NamespaceRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NamespaceRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NamespaceRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NamespaceRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NamespaceRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NamespaceRequestBodyValidationError) ErrorName() string {
+	return "NamespaceRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NamespaceRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNamespaceRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NamespaceRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NamespaceRequestBodyValidationError{}
+
+var _NamespaceRequestBody_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on PolicyRequestBody with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PolicyRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyRequestBodyMultiError, or nil if none found.
+func (m *PolicyRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleId
+
+	// no validation rules for ActionId
+
+	// no validation rules for NamespaceId
+
+	if len(errors) > 0 {
+		return PolicyRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyRequestBodyMultiError is an error wrapping multiple validation errors
+// returned by PolicyRequestBody.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyRequestBodyMultiError) AllErrors() []error { return m }
+
+// PolicyRequestBodyValidationError is the validation error returned by
+// PolicyRequestBody.Validate if the designated constraints aren't met.
+type PolicyRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyRequestBodyValidationError) ErrorName() string {
+	return "PolicyRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyRequestBodyValidationError{}
+
+// Validate checks the field values on ListActionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListActionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListActionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListActionsRequestMultiError, or nil if none found.
+func (m *ListActionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListActionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListActionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListActionsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListActionsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListActionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListActionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListActionsRequestMultiError) AllErrors() []error { return m }
+
+// ListActionsRequestValidationError is the validation error returned by
+// ListActionsRequest.Validate if the designated constraints aren't met.
+type ListActionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListActionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListActionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListActionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListActionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListActionsRequestValidationError) ErrorName() string {
+	return "ListActionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListActionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListActionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListActionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListActionsRequestValidationError{}
+
+// Validate checks the field values on ListActionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListActionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListActionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListActionsResponseMultiError, or nil if none found.
+func (m *ListActionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListActionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListActionsResponseValidationError{
+						field:  fmt.Sprintf("Actions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListActionsResponseValidationError{
+						field:  fmt.Sprintf("Actions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListActionsResponseValidationError{
+					field:  fmt.Sprintf("Actions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListActionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListActionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListActionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListActionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListActionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListActionsResponseMultiError) AllErrors() []error { return m }
+
+// ListActionsResponseValidationError is the validation error returned by
+// ListActionsResponse.Validate if the designated constraints aren't met.
+type ListActionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListActionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListActionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListActionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListActionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListActionsResponseValidationError) ErrorName() string {
+	return "ListActionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListActionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListActionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListActionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListActionsResponseValidationError{}
+
+// Validate checks the field values on CreateActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateActionRequestMultiError, or nil if none found.
+func (m *CreateActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateActionRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateActionRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateActionRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateActionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateActionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateActionRequestMultiError) AllErrors() []error { return m }
+
+// CreateActionRequestValidationError is the validation error returned by
+// CreateActionRequest.Validate if the designated constraints aren't met.
+type CreateActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateActionRequestValidationError) ErrorName() string {
+	return "CreateActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateActionRequestValidationError{}
+
+// Validate checks the field values on CreateActionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateActionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateActionResponseMultiError, or nil if none found.
+func (m *CreateActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateActionResponseValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateActionResponseValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateActionResponseValidationError{
+				field:  "Action",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateActionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateActionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateActionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateActionResponseMultiError) AllErrors() []error { return m }
+
+// CreateActionResponseValidationError is the validation error returned by
+// CreateActionResponse.Validate if the designated constraints aren't met.
+type CreateActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateActionResponseValidationError) ErrorName() string {
+	return "CreateActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateActionResponseValidationError{}
+
+// Validate checks the field values on GetActionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetActionRequestMultiError, or nil if none found.
+func (m *GetActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetActionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetActionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetActionRequestMultiError) AllErrors() []error { return m }
+
+// GetActionRequestValidationError is the validation error returned by
+// GetActionRequest.Validate if the designated constraints aren't met.
+type GetActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActionRequestValidationError) ErrorName() string { return "GetActionRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActionRequestValidationError{}
+
+// Validate checks the field values on GetActionResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetActionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetActionResponseMultiError, or nil if none found.
+func (m *GetActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetActionResponseValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetActionResponseValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetActionResponseValidationError{
+				field:  "Action",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetActionResponseMultiError is an error wrapping multiple validation errors
+// returned by GetActionResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetActionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetActionResponseMultiError) AllErrors() []error { return m }
+
+// GetActionResponseValidationError is the validation error returned by
+// GetActionResponse.Validate if the designated constraints aren't met.
+type GetActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActionResponseValidationError) ErrorName() string {
+	return "GetActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActionResponseValidationError{}
+
+// Validate checks the field values on UpdateActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateActionRequestMultiError, or nil if none found.
+func (m *UpdateActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateActionRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateActionRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateActionRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateActionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateActionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateActionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateActionRequestValidationError is the validation error returned by
+// UpdateActionRequest.Validate if the designated constraints aren't met.
+type UpdateActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateActionRequestValidationError) ErrorName() string {
+	return "UpdateActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateActionRequestValidationError{}
+
+// Validate checks the field values on UpdateActionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateActionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateActionResponseMultiError, or nil if none found.
+func (m *UpdateActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateActionResponseValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateActionResponseValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateActionResponseValidationError{
+				field:  "Action",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateActionResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateActionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateActionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateActionResponseMultiError) AllErrors() []error { return m }
+
+// UpdateActionResponseValidationError is the validation error returned by
+// UpdateActionResponse.Validate if the designated constraints aren't met.
+type UpdateActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateActionResponseValidationError) ErrorName() string {
+	return "UpdateActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateActionResponseValidationError{}
+
+// Validate checks the field values on ListNamespacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNamespacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNamespacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNamespacesRequestMultiError, or nil if none found.
+func (m *ListNamespacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNamespacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListNamespacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNamespacesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNamespacesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNamespacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNamespacesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNamespacesRequestMultiError) AllErrors() []error { return m }
+
+// ListNamespacesRequestValidationError is the validation error returned by
+// ListNamespacesRequest.Validate if the designated constraints aren't met.
+type ListNamespacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNamespacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNamespacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNamespacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNamespacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNamespacesRequestValidationError) ErrorName() string {
+	return "ListNamespacesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNamespacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNamespacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNamespacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNamespacesRequestValidationError{}
+
+// Validate checks the field values on ListNamespacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNamespacesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNamespacesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNamespacesResponseMultiError, or nil if none found.
+func (m *ListNamespacesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNamespacesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNamespaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNamespacesResponseValidationError{
+						field:  fmt.Sprintf("Namespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNamespacesResponseValidationError{
+						field:  fmt.Sprintf("Namespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNamespacesResponseValidationError{
+					field:  fmt.Sprintf("Namespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNamespacesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNamespacesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNamespacesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListNamespacesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNamespacesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNamespacesResponseMultiError) AllErrors() []error { return m }
+
+// ListNamespacesResponseValidationError is the validation error returned by
+// ListNamespacesResponse.Validate if the designated constraints aren't met.
+type ListNamespacesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNamespacesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNamespacesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNamespacesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNamespacesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNamespacesResponseValidationError) ErrorName() string {
+	return "ListNamespacesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNamespacesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNamespacesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNamespacesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNamespacesResponseValidationError{}
+
+// Validate checks the field values on CreateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateNamespaceRequestMultiError, or nil if none found.
+func (m *CreateNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateNamespaceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateNamespaceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNamespaceRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// CreateNamespaceRequestValidationError is the validation error returned by
+// CreateNamespaceRequest.Validate if the designated constraints aren't met.
+type CreateNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNamespaceRequestValidationError) ErrorName() string {
+	return "CreateNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNamespaceRequestValidationError{}
+
+// Validate checks the field values on CreateNamespaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateNamespaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateNamespaceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateNamespaceResponseMultiError, or nil if none found.
+func (m *CreateNamespaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateNamespaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNamespaceResponseValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateNamespaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateNamespaceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateNamespaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateNamespaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateNamespaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateNamespaceResponseMultiError) AllErrors() []error { return m }
+
+// CreateNamespaceResponseValidationError is the validation error returned by
+// CreateNamespaceResponse.Validate if the designated constraints aren't met.
+type CreateNamespaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNamespaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNamespaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNamespaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNamespaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNamespaceResponseValidationError) ErrorName() string {
+	return "CreateNamespaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNamespaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNamespaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNamespaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNamespaceResponseValidationError{}
+
+// Validate checks the field values on GetNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNamespaceRequestMultiError, or nil if none found.
+func (m *GetNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// GetNamespaceRequestValidationError is the validation error returned by
+// GetNamespaceRequest.Validate if the designated constraints aren't met.
+type GetNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNamespaceRequestValidationError) ErrorName() string {
+	return "GetNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNamespaceRequestValidationError{}
+
+// Validate checks the field values on GetNamespaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNamespaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNamespaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNamespaceResponseMultiError, or nil if none found.
+func (m *GetNamespaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNamespaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNamespaceResponseValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNamespaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNamespaceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetNamespaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetNamespaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNamespaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNamespaceResponseMultiError) AllErrors() []error { return m }
+
+// GetNamespaceResponseValidationError is the validation error returned by
+// GetNamespaceResponse.Validate if the designated constraints aren't met.
+type GetNamespaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNamespaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNamespaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNamespaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNamespaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNamespaceResponseValidationError) ErrorName() string {
+	return "GetNamespaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNamespaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNamespaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNamespaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNamespaceResponseValidationError{}
+
+// Validate checks the field values on UpdateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNamespaceRequestMultiError, or nil if none found.
+func (m *UpdateNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNamespaceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNamespaceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNamespaceRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNamespaceRequestValidationError is the validation error returned by
+// UpdateNamespaceRequest.Validate if the designated constraints aren't met.
+type UpdateNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNamespaceRequestValidationError) ErrorName() string {
+	return "UpdateNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNamespaceRequestValidationError{}
+
+// Validate checks the field values on UpdateNamespaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNamespaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNamespaceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNamespaceResponseMultiError, or nil if none found.
+func (m *UpdateNamespaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNamespaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNamespaceResponseValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNamespaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNamespaceResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateNamespaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNamespaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNamespaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNamespaceResponseMultiError) AllErrors() []error { return m }
+
+// UpdateNamespaceResponseValidationError is the validation error returned by
+// UpdateNamespaceResponse.Validate if the designated constraints aren't met.
+type UpdateNamespaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNamespaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNamespaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNamespaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNamespaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNamespaceResponseValidationError) ErrorName() string {
+	return "UpdateNamespaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNamespaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNamespaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNamespaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNamespaceResponseValidationError{}
+
+// Validate checks the field values on ListPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPoliciesRequestMultiError, or nil if none found.
+func (m *ListPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// ListPoliciesRequestValidationError is the validation error returned by
+// ListPoliciesRequest.Validate if the designated constraints aren't met.
+type ListPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPoliciesRequestValidationError) ErrorName() string {
+	return "ListPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPoliciesRequestValidationError{}
+
+// Validate checks the field values on ListPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPoliciesResponseMultiError, or nil if none found.
+func (m *ListPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPoliciesResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPoliciesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// ListPoliciesResponseValidationError is the validation error returned by
+// ListPoliciesResponse.Validate if the designated constraints aren't met.
+type ListPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPoliciesResponseValidationError) ErrorName() string {
+	return "ListPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPoliciesResponseValidationError{}
+
+// Validate checks the field values on CreatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePolicyRequestMultiError, or nil if none found.
+func (m *CreatePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePolicyRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePolicyRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePolicyRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePolicyRequestMultiError) AllErrors() []error { return m }
+
+// CreatePolicyRequestValidationError is the validation error returned by
+// CreatePolicyRequest.Validate if the designated constraints aren't met.
+type CreatePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePolicyRequestValidationError) ErrorName() string {
+	return "CreatePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePolicyRequestValidationError{}
+
+// Validate checks the field values on CreatePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePolicyResponseMultiError, or nil if none found.
+func (m *CreatePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePolicyResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreatePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePolicyResponseMultiError) AllErrors() []error { return m }
+
+// CreatePolicyResponseValidationError is the validation error returned by
+// CreatePolicyResponse.Validate if the designated constraints aren't met.
+type CreatePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePolicyResponseValidationError) ErrorName() string {
+	return "CreatePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePolicyResponseValidationError{}
+
+// Validate checks the field values on GetPolicyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPolicyRequestMultiError, or nil if none found.
+func (m *GetPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPolicyRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPolicyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetPolicyRequestValidationError is the validation error returned by
+// GetPolicyRequest.Validate if the designated constraints aren't met.
+type GetPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPolicyRequestValidationError) ErrorName() string { return "GetPolicyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPolicyRequestValidationError{}
+
+// Validate checks the field values on GetPolicyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPolicyResponseMultiError, or nil if none found.
+func (m *GetPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPolicyResponseValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPolicyResponseMultiError is an error wrapping multiple validation errors
+// returned by GetPolicyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPolicyResponseMultiError) AllErrors() []error { return m }
+
+// GetPolicyResponseValidationError is the validation error returned by
+// GetPolicyResponse.Validate if the designated constraints aren't met.
+type GetPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPolicyResponseValidationError) ErrorName() string {
+	return "GetPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPolicyResponseValidationError{}
+
+// Validate checks the field values on UpdatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePolicyRequestMultiError, or nil if none found.
+func (m *UpdatePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePolicyRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePolicyRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePolicyRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePolicyRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePolicyRequestValidationError is the validation error returned by
+// UpdatePolicyRequest.Validate if the designated constraints aren't met.
+type UpdatePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePolicyRequestValidationError) ErrorName() string {
+	return "UpdatePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePolicyRequestValidationError{}
+
+// Validate checks the field values on UpdatePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePolicyResponseMultiError, or nil if none found.
+func (m *UpdatePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdatePolicyResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdatePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePolicyResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePolicyResponseValidationError is the validation error returned by
+// UpdatePolicyResponse.Validate if the designated constraints aren't met.
+type UpdatePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePolicyResponseValidationError) ErrorName() string {
+	return "UpdatePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePolicyResponseValidationError{}
+
+// Validate checks the field values on Relation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Relation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Relation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RelationMultiError, or nil
+// if none found.
+func (m *Relation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Relation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ObjectId
+
+	// no validation rules for ObjectNamespace
+
+	// no validation rules for Subject
+
+	// no validation rules for RoleName
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationMultiError is an error wrapping multiple validation errors returned
+// by Relation.ValidateAll() if the designated constraints aren't met.
+type RelationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationMultiError) AllErrors() []error { return m }
+
+// RelationValidationError is the validation error returned by
+// Relation.Validate if the designated constraints aren't met.
+type RelationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationValidationError) ErrorName() string { return "RelationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationValidationError{}
+
+// Validate checks the field values on Resource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Resource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Resource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceMultiError, or nil
+// if none found.
+func (m *Resource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Resource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -9122,28 +15370,2971 @@ func (m *Namespace) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Urn
+
+	// no validation rules for ParentId
+
+	if len(errors) > 0 {
+		return ResourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceMultiError is an error wrapping multiple validation errors returned
+// by Resource.ValidateAll() if the designated constraints aren't met.
+type ResourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceMultiError) AllErrors() []error { return m }
+
+// ResourceValidationError is the validation error returned by
+// Resource.Validate if the designated constraints aren't met.
+type ResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceValidationError{}
+
+// Validate checks the field values on GroupRelation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupRelation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupRelation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupRelationMultiError, or
+// nil if none found.
+func (m *GroupRelation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupRelation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubjectType
+
+	// no validation rules for Role
+
+	switch v := m.Subject.(type) {
+	case *GroupRelation_User:
+		if v == nil {
+			err := GroupRelationValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUser()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupRelationValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupRelationValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupRelationValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GroupRelation_Group:
+		if v == nil {
+			err := GroupRelationValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGroup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupRelationValidationError{
+						field:  "Group",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupRelationValidationError{
+						field:  "Group",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupRelationValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GroupRelationMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupRelationMultiError is an error wrapping multiple validation errors
+// returned by GroupRelation.ValidateAll() if the designated constraints
+// aren't met.
+type GroupRelationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupRelationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupRelationMultiError) AllErrors() []error { return m }
+
+// GroupRelationValidationError is the validation error returned by
+// GroupRelation.Validate if the designated constraints aren't met.
+type GroupRelationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupRelationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupRelationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupRelationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupRelationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupRelationValidationError) ErrorName() string { return "GroupRelationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupRelationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupRelation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupRelationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupRelationValidationError{}
+
+// Validate checks the field values on ListRelationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRelationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelationsRequestMultiError, or nil if none found.
+func (m *ListRelationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListRelationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRelationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRelationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelationsRequestMultiError) AllErrors() []error { return m }
+
+// ListRelationsRequestValidationError is the validation error returned by
+// ListRelationsRequest.Validate if the designated constraints aren't met.
+type ListRelationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelationsRequestValidationError) ErrorName() string {
+	return "ListRelationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelationsRequestValidationError{}
+
+// Validate checks the field values on ListRelationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRelationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelationsResponseMultiError, or nil if none found.
+func (m *ListRelationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRelationsResponseValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRelationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRelationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRelationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelationsResponseMultiError) AllErrors() []error { return m }
+
+// ListRelationsResponseValidationError is the validation error returned by
+// ListRelationsResponse.Validate if the designated constraints aren't met.
+type ListRelationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelationsResponseValidationError) ErrorName() string {
+	return "ListRelationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelationsResponseValidationError{}
+
+// Validate checks the field values on RelationRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationRequestBodyMultiError, or nil if none found.
+func (m *RelationRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectId
+
+	// no validation rules for ObjectNamespace
+
+	// no validation rules for Subject
+
+	// no validation rules for RoleName
+
+	if len(errors) > 0 {
+		return RelationRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by RelationRequestBody.ValidateAll() if the designated
+// constraints aren't met.
+type RelationRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationRequestBodyMultiError) AllErrors() []error { return m }
+
+// RelationRequestBodyValidationError is the validation error returned by
+// RelationRequestBody.Validate if the designated constraints aren't met.
+type RelationRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationRequestBodyValidationError) ErrorName() string {
+	return "RelationRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationRequestBodyValidationError{}
+
+// Validate checks the field values on CreateRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRelationRequestMultiError, or nil if none found.
+func (m *CreateRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRelationRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRelationRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRelationRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRelationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRelationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRelationRequestMultiError) AllErrors() []error { return m }
+
+// CreateRelationRequestValidationError is the validation error returned by
+// CreateRelationRequest.Validate if the designated constraints aren't met.
+type CreateRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRelationRequestValidationError) ErrorName() string {
+	return "CreateRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRelationRequestValidationError{}
+
+// Validate checks the field values on CreateRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRelationResponseMultiError, or nil if none found.
+func (m *CreateRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRelation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRelationResponseValidationError{
+				field:  "Relation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRelationResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRelationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRelationResponseMultiError) AllErrors() []error { return m }
+
+// CreateRelationResponseValidationError is the validation error returned by
+// CreateRelationResponse.Validate if the designated constraints aren't met.
+type CreateRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRelationResponseValidationError) ErrorName() string {
+	return "CreateRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRelationResponseValidationError{}
+
+// Validate checks the field values on GetRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRelationRequestMultiError, or nil if none found.
+func (m *GetRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRelationRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRelationRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelationRequestMultiError) AllErrors() []error { return m }
+
+// GetRelationRequestValidationError is the validation error returned by
+// GetRelationRequest.Validate if the designated constraints aren't met.
+type GetRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelationRequestValidationError) ErrorName() string {
+	return "GetRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelationRequestValidationError{}
+
+// Validate checks the field values on GetRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRelationResponseMultiError, or nil if none found.
+func (m *GetRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRelation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRelationResponseValidationError{
+				field:  "Relation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRelationResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelationResponseMultiError) AllErrors() []error { return m }
+
+// GetRelationResponseValidationError is the validation error returned by
+// GetRelationResponse.Validate if the designated constraints aren't met.
+type GetRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelationResponseValidationError) ErrorName() string {
+	return "GetRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelationResponseValidationError{}
+
+// Validate checks the field values on UpdateRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRelationRequestMultiError, or nil if none found.
+func (m *UpdateRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRelationRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRelationRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRelationRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRelationRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRelationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRelationRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRelationRequestValidationError is the validation error returned by
+// UpdateRelationRequest.Validate if the designated constraints aren't met.
+type UpdateRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRelationRequestValidationError) ErrorName() string {
+	return "UpdateRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRelationRequestValidationError{}
+
+// Validate checks the field values on UpdateRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRelationResponseMultiError, or nil if none found.
+func (m *UpdateRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRelation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRelationResponseValidationError{
+				field:  "Relation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRelationResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRelationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRelationResponseMultiError) AllErrors() []error { return m }
+
+// UpdateRelationResponseValidationError is the validation error returned by
+// UpdateRelationResponse.Validate if the designated constraints aren't met.
+type UpdateRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRelationResponseValidationError) ErrorName() string {
+	return "UpdateRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRelationResponseValidationError{}
+
+// Validate checks the field values on ListGroupRelationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGroupRelationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGroupRelationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGroupRelationsRequestMultiError, or nil if none found.
+func (m *ListGroupRelationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGroupRelationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SubjectType
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return ListGroupRelationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGroupRelationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListGroupRelationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListGroupRelationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGroupRelationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGroupRelationsRequestMultiError) AllErrors() []error { return m }
+
+// ListGroupRelationsRequestValidationError is the validation error returned by
+// ListGroupRelationsRequest.Validate if the designated constraints aren't met.
+type ListGroupRelationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGroupRelationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGroupRelationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGroupRelationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGroupRelationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGroupRelationsRequestValidationError) ErrorName() string {
+	return "ListGroupRelationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupRelationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupRelationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGroupRelationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGroupRelationsRequestValidationError{}
+
+// Validate checks the field values on ListGroupRelationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGroupRelationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGroupRelationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGroupRelationsResponseMultiError, or nil if none found.
+func (m *ListGroupRelationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGroupRelationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListGroupRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListGroupRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListGroupRelationsResponseValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListGroupRelationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGroupRelationsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListGroupRelationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListGroupRelationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGroupRelationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGroupRelationsResponseMultiError) AllErrors() []error { return m }
+
+// ListGroupRelationsResponseValidationError is the validation error returned
+// by ListGroupRelationsResponse.Validate if the designated constraints aren't met.
+type ListGroupRelationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGroupRelationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGroupRelationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGroupRelationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGroupRelationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGroupRelationsResponseValidationError) ErrorName() string {
+	return "ListGroupRelationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupRelationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupRelationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGroupRelationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGroupRelationsResponseValidationError{}
+
+// Validate checks the field values on DeleteRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRelationRequestMultiError, or nil if none found.
+func (m *DeleteRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectId
+
+	// no validation rules for SubjectId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return DeleteRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRelationRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRelationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRelationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRelationRequestValidationError is the validation error returned by
+// DeleteRelationRequest.Validate if the designated constraints aren't met.
+type DeleteRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRelationRequestValidationError) ErrorName() string {
+	return "DeleteRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRelationRequestValidationError{}
+
+// Validate checks the field values on DeleteRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRelationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRelationResponseMultiError, or nil if none found.
+func (m *DeleteRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRelationResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRelationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRelationResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRelationResponseValidationError is the validation error returned by
+// DeleteRelationResponse.Validate if the designated constraints aren't met.
+type DeleteRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRelationResponseValidationError) ErrorName() string {
+	return "DeleteRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRelationResponseValidationError{}
+
+// Validate checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesRequestMultiError, or nil if none found.
+func (m *ListResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for ProjectId
+
+	// no validation rules for OrganizationId
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageNum
+
+	if len(errors) > 0 {
+		return ListResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListResourcesRequestValidationError is the validation error returned by
+// ListResourcesRequest.Validate if the designated constraints aren't met.
+type ListResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesRequestValidationError) ErrorName() string {
+	return "ListResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesRequestValidationError{}
+
+// Validate checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesResponseMultiError, or nil if none found.
+func (m *ListResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ListResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+
+// ListResourcesResponseValidationError is the validation error returned by
+// ListResourcesResponse.Validate if the designated constraints aren't met.
+type ListResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesResponseValidationError) ErrorName() string {
+	return "ListResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesResponseValidationError{}
+
+// Validate checks the field values on ResourceRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResourceRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceRequestBodyMultiError, or nil if none found.
+func (m *ResourceRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ProjectId
+
+	// no validation rules for NamespaceId
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceRequestBodyValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceRequestBodyValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceRequestBodyValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ParentId
+
+	if len(errors) > 0 {
+		return ResourceRequestBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by ResourceRequestBody.ValidateAll() if the designated
+// constraints aren't met.
+type ResourceRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceRequestBodyMultiError) AllErrors() []error { return m }
+
+// ResourceRequestBodyValidationError is the validation error returned by
+// ResourceRequestBody.Validate if the designated constraints aren't met.
+type ResourceRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceRequestBodyValidationError) ErrorName() string {
+	return "ResourceRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceRequestBodyValidationError{}
+
+// Validate checks the field values on CreateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateResourceRequestMultiError, or nil if none found.
+func (m *CreateResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateResourceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateResourceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateResourceRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateResourceRequestMultiError) AllErrors() []error { return m }
+
+// CreateResourceRequestValidationError is the validation error returned by
+// CreateResourceRequest.Validate if the designated constraints aren't met.
+type CreateResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateResourceRequestValidationError) ErrorName() string {
+	return "CreateResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateResourceRequestValidationError{}
+
+// Validate checks the field values on CreateResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateResourceResponseMultiError, or nil if none found.
+func (m *CreateResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateResourceResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateResourceResponseMultiError) AllErrors() []error { return m }
+
+// CreateResourceResponseValidationError is the validation error returned by
+// CreateResourceResponse.Validate if the designated constraints aren't met.
+type CreateResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateResourceResponseValidationError) ErrorName() string {
+	return "CreateResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateResourceResponseValidationError{}
+
+// Validate checks the field values on GetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceRequestMultiError, or nil if none found.
+func (m *GetResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetResourceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceRequestMultiError) AllErrors() []error { return m }
+
+// GetResourceRequestValidationError is the validation error returned by
+// GetResourceRequest.Validate if the designated constraints aren't met.
+type GetResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceRequestValidationError) ErrorName() string {
+	return "GetResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceRequestValidationError{}
+
+// Validate checks the field values on GetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceResponseMultiError, or nil if none found.
+func (m *GetResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceResponseMultiError) AllErrors() []error { return m }
+
+// GetResourceResponseValidationError is the validation error returned by
+// GetResourceResponse.Validate if the designated constraints aren't met.
+type GetResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceResponseValidationError) ErrorName() string {
+	return "GetResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceResponseValidationError{}
+
+// Validate checks the field values on UpdateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateResourceRequestMultiError, or nil if none found.
+func (m *UpdateResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateResourceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateResourceRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateResourceRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateResourceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateResourceRequestValidationError is the validation error returned by
+// UpdateResourceRequest.Validate if the designated constraints aren't met.
+type UpdateResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateResourceRequestValidationError) ErrorName() string {
+	return "UpdateResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateResourceRequestValidationError{}
+
+// Validate checks the field values on UpdateResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateResourceResponseMultiError, or nil if none found.
+func (m *UpdateResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NamespaceValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, UpdateResourceResponseValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, NamespaceValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, UpdateResourceResponseValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return NamespaceValidationError{
-				field:  "UpdatedAt",
+			return UpdateResourceResponseValidationError{
+				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -9151,18 +18342,152 @@ func (m *Namespace) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return NamespaceMultiError(errors)
+		return UpdateResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateResourceResponseMultiError) AllErrors() []error { return m }
+
+// UpdateResourceResponseValidationError is the validation error returned by
+// UpdateResourceResponse.Validate if the designated constraints aren't met.
+type UpdateResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateResourceResponseValidationError) ErrorName() string {
+	return "UpdateResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateResourceResponseValidationError{}
+
+// Validate checks the field values on SetResourceVisibilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetResourceVisibilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetResourceVisibilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetResourceVisibilityRequestMultiError, or nil if none found.
+func (m *SetResourceVisibilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetResourceVisibilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetResourceVisibilityRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetResourceVisibilityRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetResourceVisibilityRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetResourceVisibilityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// NamespaceMultiError is an error wrapping multiple validation errors returned
-// by Namespace.ValidateAll() if the designated constraints aren't met.
-type NamespaceMultiError []error
+// SetResourceVisibilityRequestMultiError is an error wrapping multiple
+// validation errors returned by SetResourceVisibilityRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetResourceVisibilityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NamespaceMultiError) Error() string {
+func (m SetResourceVisibilityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9171,11 +18496,12 @@ func (m NamespaceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NamespaceMultiError) AllErrors() []error { return m }
+func (m SetResourceVisibilityRequestMultiError) AllErrors() []error { return m }
 
-// NamespaceValidationError is the validation error returned by
-// Namespace.Validate if the designated constraints aren't met.
-type NamespaceValidationError struct {
+// SetResourceVisibilityRequestValidationError is the validation error returned
+// by SetResourceVisibilityRequest.Validate if the designated constraints
+// aren't met.
+type SetResourceVisibilityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9183,22 +18509,24 @@ type NamespaceValidationError struct {
 }
 
 // Field function returns field value.
-func (e NamespaceValidationError) Field() string { return e.field }
+func (e SetResourceVisibilityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NamespaceValidationError) Reason() string { return e.reason }
+func (e SetResourceVisibilityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NamespaceValidationError) Cause() error { return e.cause }
+func (e SetResourceVisibilityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NamespaceValidationError) Key() bool { return e.key }
+func (e SetResourceVisibilityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NamespaceValidationError) ErrorName() string { return "NamespaceValidationError" }
+func (e SetResourceVisibilityRequestValidationError) ErrorName() string {
+	return "SetResourceVisibilityRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NamespaceValidationError) Error() string {
+func (e SetResourceVisibilityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9210,14 +18538,14 @@ func (e NamespaceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNamespace.%s: %s%s",
+		"invalid %sSetResourceVisibilityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NamespaceValidationError{}
+var _ error = SetResourceVisibilityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9225,197 +18553,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NamespaceValidationError{}
+} = SetResourceVisibilityRequestValidationError{}
 
-var _Namespace_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-// Validate checks the field values on Policy with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Policy) Validate() error {
+// Validate checks the field values on SetResourceVisibilityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetResourceVisibilityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Policy with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in PolicyMultiError, or nil if none found.
-func (m *Policy) ValidateAll() error {
+// ValidateAll checks the field values on SetResourceVisibilityResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetResourceVisibilityResponseMultiError, or nil if none found.
+func (m *SetResourceVisibilityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Policy) validate(all bool) error {
+func (m *SetResourceVisibilityResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetRole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
-				field:  "Role",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetAction()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Action",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Action",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
-				field:  "Action",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetNamespace()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Namespace",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "Namespace",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
-				field:  "Namespace",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, SetResourceVisibilityResponseValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PolicyValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, SetResourceVisibilityResponseValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PolicyValidationError{
-				field:  "UpdatedAt",
+			return SetResourceVisibilityResponseValidationError{
+				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for NamespaceId
-
-	// no validation rules for RoleId
-
-	// no validation rules for ActionId
-
 	if len(errors) > 0 {
-		return PolicyMultiError(errors)
+		return SetResourceVisibilityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyMultiError is an error wrapping multiple validation errors returned by
-// Policy.ValidateAll() if the designated constraints aren't met.
-type PolicyMultiError []error
+// SetResourceVisibilityResponseMultiError is an error wrapping multiple
+// validation errors returned by SetResourceVisibilityResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetResourceVisibilityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyMultiError) Error() string {
+func (m SetResourceVisibilityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9424,11 +18628,12 @@ func (m PolicyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyMultiError) AllErrors() []error { return m }
+func (m SetResourceVisibilityResponseMultiError) AllErrors() []error { return m }
 
-// PolicyValidationError is the validation error returned by Policy.Validate if
-// the designated constraints aren't met.
-type PolicyValidationError struct {
+// SetResourceVisibilityResponseValidationError is the validation error
+// returned by SetResourceVisibilityResponse.Validate if the designated
+// constraints aren't met.
+type SetResourceVisibilityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9436,22 +18641,24 @@ type PolicyValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyValidationError) Field() string { return e.field }
+func (e SetResourceVisibilityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyValidationError) Reason() string { return e.reason }
+func (e SetResourceVisibilityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyValidationError) Cause() error { return e.cause }
+func (e SetResourceVisibilityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyValidationError) Key() bool { return e.key }
+func (e SetResourceVisibilityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyValidationError) ErrorName() string { return "PolicyValidationError" }
+func (e SetResourceVisibilityResponseValidationError) ErrorName() string {
+	return "SetResourceVisibilityResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PolicyValidationError) Error() string {
+func (e SetResourceVisibilityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9463,14 +18670,14 @@ func (e PolicyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicy.%s: %s%s",
+		"invalid %sSetResourceVisibilityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyValidationError{}
+var _ error = SetResourceVisibilityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9478,35 +18685,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyValidationError{}
+} = SetResourceVisibilityResponseValidationError{}
 
-// Validate checks the field values on ActionRequestBody with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ActionRequestBody) Validate() error {
+// Validate checks the field values on ResourcePermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResourcePermission) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActionRequestBody with the rules
+// ValidateAll checks the field values on ResourcePermission with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ActionRequestBodyMultiError, or nil if none found.
-func (m *ActionRequestBody) ValidateAll() error {
+// ResourcePermissionMultiError, or nil if none found.
+func (m *ResourcePermission) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActionRequestBody) validate(all bool) error {
+func (m *ResourcePermission) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if !_ResourcePermission_ObjectId_Pattern.MatchString(m.GetObjectId()) {
+		err := ResourcePermissionValidationError{
+			field:  "ObjectId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if !_ActionRequestBody_Name_Pattern.MatchString(m.GetName()) {
-		err := ActionRequestBodyValidationError{
-			field:  "Name",
+	if !_ResourcePermission_ObjectNamespace_Pattern.MatchString(m.GetObjectNamespace()) {
+		err := ResourcePermissionValidationError{
+			field:  "ObjectNamespace",
 			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 		if !all {
@@ -9515,22 +18731,31 @@ func (m *ActionRequestBody) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for NamespaceId
+	if !_ResourcePermission_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := ResourcePermissionValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ActionRequestBodyMultiError(errors)
+		return ResourcePermissionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActionRequestBodyMultiError is an error wrapping multiple validation errors
-// returned by ActionRequestBody.ValidateAll() if the designated constraints
+// ResourcePermissionMultiError is an error wrapping multiple validation errors
+// returned by ResourcePermission.ValidateAll() if the designated constraints
 // aren't met.
-type ActionRequestBodyMultiError []error
+type ResourcePermissionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActionRequestBodyMultiError) Error() string {
+func (m ResourcePermissionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9539,11 +18764,11 @@ func (m ActionRequestBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActionRequestBodyMultiError) AllErrors() []error { return m }
+func (m ResourcePermissionMultiError) AllErrors() []error { return m }
 
-// ActionRequestBodyValidationError is the validation error returned by
-// ActionRequestBody.Validate if the designated constraints aren't met.
-type ActionRequestBodyValidationError struct {
+// ResourcePermissionValidationError is the validation error returned by
+// ResourcePermission.Validate if the designated constraints aren't met.
+type ResourcePermissionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9551,24 +18776,24 @@ type ActionRequestBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActionRequestBodyValidationError) Field() string { return e.field }
+func (e ResourcePermissionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActionRequestBodyValidationError) Reason() string { return e.reason }
+func (e ResourcePermissionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActionRequestBodyValidationError) Cause() error { return e.cause }
+func (e ResourcePermissionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActionRequestBodyValidationError) Key() bool { return e.key }
+func (e ResourcePermissionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActionRequestBodyValidationError) ErrorName() string {
-	return "ActionRequestBodyValidationError"
+func (e ResourcePermissionValidationError) ErrorName() string {
+	return "ResourcePermissionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ActionRequestBodyValidationError) Error() string {
+func (e ResourcePermissionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9580,14 +18805,14 @@ func (e ActionRequestBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActionRequestBody.%s: %s%s",
+		"invalid %sResourcePermission.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActionRequestBodyValidationError{}
+var _ error = ResourcePermissionValidationError{}
 
 var _ interface {
 	Field() string
@@ -9595,37 +18820,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActionRequestBodyValidationError{}
+} = ResourcePermissionValidationError{}
 
-var _ActionRequestBody_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+var _ResourcePermission_ObjectId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-// Validate checks the field values on NamespaceRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
+var _ResourcePermission_ObjectNamespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _ResourcePermission_Permission_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on CheckResourcePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *NamespaceRequestBody) Validate() error {
+func (m *CheckResourcePermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NamespaceRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// NamespaceRequestBodyMultiError, or nil if none found.
-func (m *NamespaceRequestBody) ValidateAll() error {
+// ValidateAll checks the field values on CheckResourcePermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckResourcePermissionRequestMultiError, or nil if none found.
+func (m *CheckResourcePermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NamespaceRequestBody) validate(all bool) error {
+func (m *CheckResourcePermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if !_CheckResourcePermissionRequest_ObjectId_Pattern.MatchString(m.GetObjectId()) {
+		err := CheckResourcePermissionRequestValidationError{
+			field:  "ObjectId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if !_NamespaceRequestBody_Name_Pattern.MatchString(m.GetName()) {
-		err := NamespaceRequestBodyValidationError{
-			field:  "Name",
+	if !_CheckResourcePermissionRequest_ObjectNamespace_Pattern.MatchString(m.GetObjectNamespace()) {
+		err := CheckResourcePermissionRequestValidationError{
+			field:  "ObjectNamespace",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CheckResourcePermissionRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := CheckResourcePermissionRequestValidationError{
+			field:  "Permission",
 			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 		if !all {
@@ -9634,20 +18883,54 @@ func (m *NamespaceRequestBody) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetResourcePermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourcePermissionRequestValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourcePermissionRequestValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourcePermissionRequestValidationError{
+					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return NamespaceRequestBodyMultiError(errors)
+		return CheckResourcePermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// NamespaceRequestBodyMultiError is an error wrapping multiple validation
-// errors returned by NamespaceRequestBody.ValidateAll() if the designated
-// constraints aren't met.
-type NamespaceRequestBodyMultiError []error
+// CheckResourcePermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by CheckResourcePermissionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CheckResourcePermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NamespaceRequestBodyMultiError) Error() string {
+func (m CheckResourcePermissionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9656,11 +18939,12 @@ func (m NamespaceRequestBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NamespaceRequestBodyMultiError) AllErrors() []error { return m }
+func (m CheckResourcePermissionRequestMultiError) AllErrors() []error { return m }
 
-// NamespaceRequestBodyValidationError is the validation error returned by
-// NamespaceRequestBody.Validate if the designated constraints aren't met.
-type NamespaceRequestBodyValidationError struct {
+// CheckResourcePermissionRequestValidationError is the validation error
+// returned by CheckResourcePermissionRequest.Validate if the designated
+// constraints aren't met.
+type CheckResourcePermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9668,24 +18952,24 @@ type NamespaceRequestBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e NamespaceRequestBodyValidationError) Field() string { return e.field }
+func (e CheckResourcePermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NamespaceRequestBodyValidationError) Reason() string { return e.reason }
+func (e CheckResourcePermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NamespaceRequestBodyValidationError) Cause() error { return e.cause }
+func (e CheckResourcePermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NamespaceRequestBodyValidationError) Key() bool { return e.key }
+func (e CheckResourcePermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NamespaceRequestBodyValidationError) ErrorName() string {
-	return "NamespaceRequestBodyValidationError"
+func (e CheckResourcePermissionRequestValidationError) ErrorName() string {
+	return "CheckResourcePermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e NamespaceRequestBodyValidationError) Error() string {
+func (e CheckResourcePermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9697,14 +18981,14 @@ func (e NamespaceRequestBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNamespaceRequestBody.%s: %s%s",
+		"invalid %sCheckResourcePermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NamespaceRequestBodyValidationError{}
+var _ error = CheckResourcePermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9712,52 +18996,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NamespaceRequestBodyValidationError{}
+} = CheckResourcePermissionRequestValidationError{}
 
-var _NamespaceRequestBody_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+var _CheckResourcePermissionRequest_ObjectId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-// Validate checks the field values on PolicyRequestBody with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PolicyRequestBody) Validate() error {
+var _CheckResourcePermissionRequest_ObjectNamespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _CheckResourcePermissionRequest_Permission_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on CheckResourcePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcePermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PolicyRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PolicyRequestBodyMultiError, or nil if none found.
-func (m *PolicyRequestBody) ValidateAll() error {
+// ValidateAll checks the field values on CheckResourcePermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckResourcePermissionResponseMultiError, or nil if none found.
+func (m *CheckResourcePermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyRequestBody) validate(all bool) error {
+func (m *CheckResourcePermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RoleId
+	// no validation rules for Status
 
-	// no validation rules for ActionId
+	for idx, item := range m.GetResourcePermissions() {
+		_, _ = idx, item
 
-	// no validation rules for NamespaceId
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourcePermissionResponseValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourcePermissionResponseValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourcePermissionResponseValidationError{
+					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return PolicyRequestBodyMultiError(errors)
+		return CheckResourcePermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyRequestBodyMultiError is an error wrapping multiple validation errors
-// returned by PolicyRequestBody.ValidateAll() if the designated constraints
-// aren't met.
-type PolicyRequestBodyMultiError []error
+// CheckResourcePermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by CheckResourcePermissionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CheckResourcePermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyRequestBodyMultiError) Error() string {
+func (m CheckResourcePermissionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9766,11 +19084,12 @@ func (m PolicyRequestBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyRequestBodyMultiError) AllErrors() []error { return m }
+func (m CheckResourcePermissionResponseMultiError) AllErrors() []error { return m }
 
-// PolicyRequestBodyValidationError is the validation error returned by
-// PolicyRequestBody.Validate if the designated constraints aren't met.
-type PolicyRequestBodyValidationError struct {
+// CheckResourcePermissionResponseValidationError is the validation error
+// returned by CheckResourcePermissionResponse.Validate if the designated
+// constraints aren't met.
+type CheckResourcePermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9778,24 +19097,24 @@ type PolicyRequestBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyRequestBodyValidationError) Field() string { return e.field }
+func (e CheckResourcePermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyRequestBodyValidationError) Reason() string { return e.reason }
+func (e CheckResourcePermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyRequestBodyValidationError) Cause() error { return e.cause }
+func (e CheckResourcePermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyRequestBodyValidationError) Key() bool { return e.key }
+func (e CheckResourcePermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyRequestBodyValidationError) ErrorName() string {
-	return "PolicyRequestBodyValidationError"
+func (e CheckResourcePermissionResponseValidationError) ErrorName() string {
+	return "CheckResourcePermissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyRequestBodyValidationError) Error() string {
+func (e CheckResourcePermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9807,14 +19126,14 @@ func (e PolicyRequestBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyRequestBody.%s: %s%s",
+		"invalid %sCheckResourcePermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyRequestBodyValidationError{}
+var _ error = CheckResourcePermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9822,44 +19141,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyRequestBodyValidationError{}
+} = CheckResourcePermissionResponseValidationError{}
 
-// Validate checks the field values on ListActionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListActionsRequest) Validate() error {
+// Validate checks the field values on CheckResourceUserPermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckResourceUserPermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListActionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListActionsRequestMultiError, or nil if none found.
-func (m *ListActionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on CheckResourceUserPermissionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckResourceUserPermissionRequestMultiError, or nil if none found.
+func (m *CheckResourceUserPermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListActionsRequest) validate(all bool) error {
+func (m *CheckResourceUserPermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	for idx, item := range m.GetResourcePermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourceUserPermissionRequestValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourceUserPermissionRequestValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourceUserPermissionRequestValidationError{
+					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return ListActionsRequestMultiError(errors)
+		return CheckResourceUserPermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListActionsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListActionsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListActionsRequestMultiError []error
+// CheckResourceUserPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckResourceUserPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourceUserPermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListActionsRequestMultiError) Error() string {
+func (m CheckResourceUserPermissionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9868,11 +19225,12 @@ func (m ListActionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListActionsRequestMultiError) AllErrors() []error { return m }
+func (m CheckResourceUserPermissionRequestMultiError) AllErrors() []error { return m }
 
-// ListActionsRequestValidationError is the validation error returned by
-// ListActionsRequest.Validate if the designated constraints aren't met.
-type ListActionsRequestValidationError struct {
+// CheckResourceUserPermissionRequestValidationError is the validation error
+// returned by CheckResourceUserPermissionRequest.Validate if the designated
+// constraints aren't met.
+type CheckResourceUserPermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9880,24 +19238,24 @@ type ListActionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListActionsRequestValidationError) Field() string { return e.field }
+func (e CheckResourceUserPermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListActionsRequestValidationError) Reason() string { return e.reason }
+func (e CheckResourceUserPermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListActionsRequestValidationError) Cause() error { return e.cause }
+func (e CheckResourceUserPermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListActionsRequestValidationError) Key() bool { return e.key }
+func (e CheckResourceUserPermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListActionsRequestValidationError) ErrorName() string {
-	return "ListActionsRequestValidationError"
+func (e CheckResourceUserPermissionRequestValidationError) ErrorName() string {
+	return "CheckResourceUserPermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListActionsRequestValidationError) Error() string {
+func (e CheckResourceUserPermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9909,14 +19267,14 @@ func (e ListActionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListActionsRequest.%s: %s%s",
+		"invalid %sCheckResourceUserPermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListActionsRequestValidationError{}
+var _ error = CheckResourceUserPermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9924,47 +19282,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListActionsRequestValidationError{}
+} = CheckResourceUserPermissionRequestValidationError{}
 
-// Validate checks the field values on ListActionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListActionsResponse) Validate() error {
+// Validate checks the field values on CheckResourceUserPermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckResourceUserPermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListActionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListActionsResponseMultiError, or nil if none found.
-func (m *ListActionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on CheckResourceUserPermissionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckResourceUserPermissionResponseMultiError, or nil if none found.
+func (m *CheckResourceUserPermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListActionsResponse) validate(all bool) error {
+func (m *CheckResourceUserPermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetActions() {
+	for idx, item := range m.GetResourcePermissions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListActionsResponseValidationError{
-						field:  fmt.Sprintf("Actions[%v]", idx),
+					errors = append(errors, CheckResourceUserPermissionResponseValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListActionsResponseValidationError{
-						field:  fmt.Sprintf("Actions[%v]", idx),
+					errors = append(errors, CheckResourceUserPermissionResponseValidationError{
+						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9972,8 +19331,8 @@ func (m *ListActionsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListActionsResponseValidationError{
-					field:  fmt.Sprintf("Actions[%v]", idx),
+				return CheckResourceUserPermissionResponseValidationError{
+					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9983,19 +19342,20 @@ func (m *ListActionsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListActionsResponseMultiError(errors)
+		return CheckResourceUserPermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListActionsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListActionsResponse.ValidateAll() if the designated
+// CheckResourceUserPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckResourceUserPermissionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListActionsResponseMultiError []error
+type CheckResourceUserPermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListActionsResponseMultiError) Error() string {
+func (m CheckResourceUserPermissionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10004,11 +19364,12 @@ func (m ListActionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListActionsResponseMultiError) AllErrors() []error { return m }
+func (m CheckResourceUserPermissionResponseMultiError) AllErrors() []error { return m }
 
-// ListActionsResponseValidationError is the validation error returned by
-// ListActionsResponse.Validate if the designated constraints aren't met.
-type ListActionsResponseValidationError struct {
+// CheckResourceUserPermissionResponseValidationError is the validation error
+// returned by CheckResourceUserPermissionResponse.Validate if the designated
+// constraints aren't met.
+type CheckResourceUserPermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10016,24 +19377,24 @@ type ListActionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListActionsResponseValidationError) Field() string { return e.field }
+func (e CheckResourceUserPermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListActionsResponseValidationError) Reason() string { return e.reason }
+func (e CheckResourceUserPermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListActionsResponseValidationError) Cause() error { return e.cause }
+func (e CheckResourceUserPermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListActionsResponseValidationError) Key() bool { return e.key }
+func (e CheckResourceUserPermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListActionsResponseValidationError) ErrorName() string {
-	return "ListActionsResponseValidationError"
+func (e CheckResourceUserPermissionResponseValidationError) ErrorName() string {
+	return "CheckResourceUserPermissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListActionsResponseValidationError) Error() string {
+func (e CheckResourceUserPermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10045,14 +19406,14 @@ func (e ListActionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListActionsResponse.%s: %s%s",
+		"invalid %sCheckResourceUserPermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListActionsResponseValidationError{}
+var _ error = CheckResourceUserPermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10060,73 +19421,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListActionsResponseValidationError{}
+} = CheckResourceUserPermissionResponseValidationError{}
 
-// Validate checks the field values on CreateActionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListAllUserResourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateActionRequest) Validate() error {
+func (m *ListAllUserResourcesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateActionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAllUserResourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateActionRequestMultiError, or nil if none found.
-func (m *CreateActionRequest) ValidateAll() error {
+// ListAllUserResourcesRequestMultiError, or nil if none found.
+func (m *ListAllUserResourcesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateActionRequest) validate(all bool) error {
+func (m *ListAllUserResourcesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateActionRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateActionRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateActionRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return CreateActionRequestMultiError(errors)
+		return ListAllUserResourcesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateActionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateActionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateActionRequestMultiError []error
+// ListAllUserResourcesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAllUserResourcesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListAllUserResourcesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateActionRequestMultiError) Error() string {
+func (m ListAllUserResourcesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10135,11 +19469,12 @@ func (m CreateActionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateActionRequestMultiError) AllErrors() []error { return m }
+func (m ListAllUserResourcesRequestMultiError) AllErrors() []error { return m }
 
-// CreateActionRequestValidationError is the validation error returned by
-// CreateActionRequest.Validate if the designated constraints aren't met.
-type CreateActionRequestValidationError struct {
+// ListAllUserResourcesRequestValidationError is the validation error returned
+// by ListAllUserResourcesRequest.Validate if the designated constraints
+// aren't met.
+type ListAllUserResourcesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10147,24 +19482,24 @@ type CreateActionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateActionRequestValidationError) Field() string { return e.field }
+func (e ListAllUserResourcesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateActionRequestValidationError) Reason() string { return e.reason }
+func (e ListAllUserResourcesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateActionRequestValidationError) Cause() error { return e.cause }
+func (e ListAllUserResourcesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateActionRequestValidationError) Key() bool { return e.key }
+func (e ListAllUserResourcesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateActionRequestValidationError) ErrorName() string {
-	return "CreateActionRequestValidationError"
+func (e ListAllUserResourcesRequestValidationError) ErrorName() string {
+	return "ListAllUserResourcesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateActionRequestValidationError) Error() string {
+func (e ListAllUserResourcesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10176,14 +19511,14 @@ func (e CreateActionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateActionRequest.%s: %s%s",
+		"invalid %sListAllUserResourcesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateActionRequestValidationError{}
+var _ error = ListAllUserResourcesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10191,24 +19526,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateActionRequestValidationError{}
-
-// Validate checks the field values on CreateActionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+} = ListAllUserResourcesRequestValidationError{}
+
+// Validate checks the field values on ListAllUserResourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateActionResponse) Validate() error {
+func (m *ListAllUserResourcesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateActionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAllUserResourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateActionResponseMultiError, or nil if none found.
-func (m *CreateActionResponse) ValidateAll() error {
+// ListAllUserResourcesResponseMultiError, or nil if none found.
+func (m *ListAllUserResourcesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateActionResponse) validate(all bool) error {
+func (m *ListAllUserResourcesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10216,28 +19551,28 @@ func (m *CreateActionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetAction()).(type) {
+		switch v := interface{}(m.GetResources()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateActionResponseValidationError{
-					field:  "Action",
+				errors = append(errors, ListAllUserResourcesResponseValidationError{
+					field:  "Resources",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateActionResponseValidationError{
-					field:  "Action",
+				errors = append(errors, ListAllUserResourcesResponseValidationError{
+					field:  "Resources",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateActionResponseValidationError{
-				field:  "Action",
+			return ListAllUserResourcesResponseValidationError{
+				field:  "Resources",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10245,19 +19580,19 @@ func (m *CreateActionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateActionResponseMultiError(errors)
+		return ListAllUserResourcesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateActionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateActionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateActionResponseMultiError []error
+// ListAllUserResourcesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAllUserResourcesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAllUserResourcesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateActionResponseMultiError) Error() string {
+func (m ListAllUserResourcesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10266,11 +19601,12 @@ func (m CreateActionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateActionResponseMultiError) AllErrors() []error { return m }
+func (m ListAllUserResourcesResponseMultiError) AllErrors() []error { return m }
 
-// CreateActionResponseValidationError is the validation error returned by
-// CreateActionResponse.Validate if the designated constraints aren't met.
-type CreateActionResponseValidationError struct {
+// ListAllUserResourcesResponseValidationError is the validation error returned
+// by ListAllUserResourcesResponse.Validate if the designated constraints
+// aren't met.
+type ListAllUserResourcesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10278,24 +19614,24 @@ type CreateActionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateActionResponseValidationError) Field() string { return e.field }
+func (e ListAllUserResourcesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateActionResponseValidationError) Reason() string { return e.reason }
+func (e ListAllUserResourcesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateActionResponseValidationError) Cause() error { return e.cause }
+func (e ListAllUserResourcesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateActionResponseValidationError) Key() bool { return e.key }
+func (e ListAllUserResourcesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateActionResponseValidationError) ErrorName() string {
-	return "CreateActionResponseValidationError"
+func (e ListAllUserResourcesResponseValidationError) ErrorName() string {
+	return "ListAllUserResourcesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateActionResponseValidationError) Error() string {
+func (e ListAllUserResourcesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10307,14 +19643,14 @@ func (e CreateActionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateActionResponse.%s: %s%s",
+		"invalid %sListAllUserResourcesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateActionResponseValidationError{}
+var _ error = ListAllUserResourcesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10322,46 +19658,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateActionResponseValidationError{}
+} = ListAllUserResourcesResponseValidationError{}
 
-// Validate checks the field values on GetActionRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetActionRequest) Validate() error {
+// Validate checks the field values on Activity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Activity) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetActionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetActionRequestMultiError, or nil if none found.
-func (m *GetActionRequest) ValidateAll() error {
+// ValidateAll checks the field values on Activity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ActivityMultiError, or nil
+// if none found.
+func (m *Activity) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetActionRequest) validate(all bool) error {
+func (m *Activity) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Actor
+
+	// no validation rules for Action
+
+	// no validation rules for Data
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivityValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivityValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivityValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetActionRequestMultiError(errors)
+		return ActivityMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetActionRequestMultiError is an error wrapping multiple validation errors
-// returned by GetActionRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetActionRequestMultiError []error
+// ActivityMultiError is an error wrapping multiple validation errors returned
+// by Activity.ValidateAll() if the designated constraints aren't met.
+type ActivityMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetActionRequestMultiError) Error() string {
+func (m ActivityMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10370,11 +19740,11 @@ func (m GetActionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetActionRequestMultiError) AllErrors() []error { return m }
+func (m ActivityMultiError) AllErrors() []error { return m }
 
-// GetActionRequestValidationError is the validation error returned by
-// GetActionRequest.Validate if the designated constraints aren't met.
-type GetActionRequestValidationError struct {
+// ActivityValidationError is the validation error returned by
+// Activity.Validate if the designated constraints aren't met.
+type ActivityValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10382,22 +19752,22 @@ type GetActionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetActionRequestValidationError) Field() string { return e.field }
+func (e ActivityValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetActionRequestValidationError) Reason() string { return e.reason }
+func (e ActivityValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetActionRequestValidationError) Cause() error { return e.cause }
+func (e ActivityValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetActionRequestValidationError) Key() bool { return e.key }
+func (e ActivityValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetActionRequestValidationError) ErrorName() string { return "GetActionRequestValidationError" }
+func (e ActivityValidationError) ErrorName() string { return "ActivityValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetActionRequestValidationError) Error() string {
+func (e ActivityValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10409,14 +19779,14 @@ func (e GetActionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetActionRequest.%s: %s%s",
+		"invalid %sActivity.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetActionRequestValidationError{}
+var _ error = ActivityValidationError{}
 
 var _ interface {
 	Field() string
@@ -10424,73 +19794,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetActionRequestValidationError{}
+} = ActivityValidationError{}
 
-// Validate checks the field values on GetActionResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetActionResponse) Validate() error {
+// Validate checks the field values on ListActivitiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListActivitiesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetActionResponse with the rules
+// ValidateAll checks the field values on ListActivitiesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetActionResponseMultiError, or nil if none found.
-func (m *GetActionResponse) ValidateAll() error {
+// ListActivitiesRequestMultiError, or nil if none found.
+func (m *ListActivitiesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetActionResponse) validate(all bool) error {
+func (m *ListActivitiesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAction()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetActionResponseValidationError{
-					field:  "Action",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetActionResponseValidationError{
-					field:  "Action",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetActionResponseValidationError{
-				field:  "Action",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Actor
+
+	// no validation rules for Action
+
+	// no validation rules for Data
+
+	// no validation rules for Metadata
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageNum
 
 	if len(errors) > 0 {
-		return GetActionResponseMultiError(errors)
+		return ListActivitiesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetActionResponseMultiError is an error wrapping multiple validation errors
-// returned by GetActionResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetActionResponseMultiError []error
+// ListActivitiesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListActivitiesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListActivitiesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetActionResponseMultiError) Error() string {
+func (m ListActivitiesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10499,11 +19856,11 @@ func (m GetActionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetActionResponseMultiError) AllErrors() []error { return m }
+func (m ListActivitiesRequestMultiError) AllErrors() []error { return m }
 
-// GetActionResponseValidationError is the validation error returned by
-// GetActionResponse.Validate if the designated constraints aren't met.
-type GetActionResponseValidationError struct {
+// ListActivitiesRequestValidationError is the validation error returned by
+// ListActivitiesRequest.Validate if the designated constraints aren't met.
+type ListActivitiesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10511,24 +19868,24 @@ type GetActionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetActionResponseValidationError) Field() string { return e.field }
+func (e ListActivitiesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetActionResponseValidationError) Reason() string { return e.reason }
+func (e ListActivitiesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetActionResponseValidationError) Cause() error { return e.cause }
+func (e ListActivitiesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetActionResponseValidationError) Key() bool { return e.key }
+func (e ListActivitiesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetActionResponseValidationError) ErrorName() string {
-	return "GetActionResponseValidationError"
+func (e ListActivitiesRequestValidationError) ErrorName() string {
+	return "ListActivitiesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetActionResponseValidationError) Error() string {
+func (e ListActivitiesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10540,14 +19897,14 @@ func (e GetActionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetActionResponse.%s: %s%s",
+		"invalid %sListActivitiesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetActionResponseValidationError{}
+var _ error = ListActivitiesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10555,75 +19912,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetActionResponseValidationError{}
+} = ListActivitiesRequestValidationError{}
 
-// Validate checks the field values on UpdateActionRequest with the rules
+// Validate checks the field values on ListActivitiesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateActionRequest) Validate() error {
+func (m *ListActivitiesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateActionRequest with the rules
+// ValidateAll checks the field values on ListActivitiesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateActionRequestMultiError, or nil if none found.
-func (m *UpdateActionRequest) ValidateAll() error {
+// ListActivitiesResponseMultiError, or nil if none found.
+func (m *ListActivitiesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateActionRequest) validate(all bool) error {
+func (m *ListActivitiesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Count
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateActionRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetActivities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListActivitiesResponseValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListActivitiesResponseValidationError{
+						field:  fmt.Sprintf("Activities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateActionRequestValidationError{
-					field:  "Body",
+				return ListActivitiesResponseValidationError{
+					field:  fmt.Sprintf("Activities[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateActionRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateActionRequestMultiError(errors)
+		return ListActivitiesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateActionRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateActionRequest.ValidateAll() if the designated
+// ListActivitiesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListActivitiesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateActionRequestMultiError []error
+type ListActivitiesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateActionRequestMultiError) Error() string {
+func (m ListActivitiesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10632,11 +19994,11 @@ func (m UpdateActionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateActionRequestMultiError) AllErrors() []error { return m }
+func (m ListActivitiesResponseMultiError) AllErrors() []error { return m }
 
-// UpdateActionRequestValidationError is the validation error returned by
-// UpdateActionRequest.Validate if the designated constraints aren't met.
-type UpdateActionRequestValidationError struct {
+// ListActivitiesResponseValidationError is the validation error returned by
+// ListActivitiesResponse.Validate if the designated constraints aren't met.
+type ListActivitiesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10644,24 +20006,24 @@ type UpdateActionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateActionRequestValidationError) Field() string { return e.field }
+func (e ListActivitiesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateActionRequestValidationError) Reason() string { return e.reason }
+func (e ListActivitiesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateActionRequestValidationError) Cause() error { return e.cause }
+func (e ListActivitiesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateActionRequestValidationError) Key() bool { return e.key }
+func (e ListActivitiesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateActionRequestValidationError) ErrorName() string {
-	return "UpdateActionRequestValidationError"
+func (e ListActivitiesResponseValidationError) ErrorName() string {
+	return "ListActivitiesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateActionRequestValidationError) Error() string {
+func (e ListActivitiesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10673,14 +20035,14 @@ func (e UpdateActionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateActionRequest.%s: %s%s",
+		"invalid %sListActivitiesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateActionRequestValidationError{}
+var _ error = ListActivitiesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10688,73 +20050,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateActionRequestValidationError{}
+} = ListActivitiesResponseValidationError{}
 
-// Validate checks the field values on UpdateActionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpsertResourcesConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateActionResponse) Validate() error {
+func (m *UpsertResourcesConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateActionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpsertResourcesConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateActionResponseMultiError, or nil if none found.
-func (m *UpdateActionResponse) ValidateAll() error {
+// UpsertResourcesConfigRequestMultiError, or nil if none found.
+func (m *UpsertResourcesConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateActionResponse) validate(all bool) error {
+func (m *UpsertResourcesConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAction()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateActionResponseValidationError{
-					field:  "Action",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateActionResponseValidationError{
-					field:  "Action",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateActionResponseValidationError{
-				field:  "Action",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
+
+	// no validation rules for Config
 
 	if len(errors) > 0 {
-		return UpdateActionResponseMultiError(errors)
+		return UpsertResourcesConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateActionResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateActionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateActionResponseMultiError []error
+// UpsertResourcesConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by UpsertResourcesConfigRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpsertResourcesConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateActionResponseMultiError) Error() string {
+func (m UpsertResourcesConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10763,11 +20100,12 @@ func (m UpdateActionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateActionResponseMultiError) AllErrors() []error { return m }
+func (m UpsertResourcesConfigRequestMultiError) AllErrors() []error { return m }
 
-// UpdateActionResponseValidationError is the validation error returned by
-// UpdateActionResponse.Validate if the designated constraints aren't met.
-type UpdateActionResponseValidationError struct {
+// UpsertResourcesConfigRequestValidationError is the validation error returned
+// by UpsertResourcesConfigRequest.Validate if the designated constraints
+// aren't met.
+type UpsertResourcesConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10775,24 +20113,24 @@ type UpdateActionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateActionResponseValidationError) Field() string { return e.field }
+func (e UpsertResourcesConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateActionResponseValidationError) Reason() string { return e.reason }
+func (e UpsertResourcesConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateActionResponseValidationError) Cause() error { return e.cause }
+func (e UpsertResourcesConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateActionResponseValidationError) Key() bool { return e.key }
+func (e UpsertResourcesConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateActionResponseValidationError) ErrorName() string {
-	return "UpdateActionResponseValidationError"
+func (e UpsertResourcesConfigRequestValidationError) ErrorName() string {
+	return "UpsertResourcesConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateActionResponseValidationError) Error() string {
+func (e UpsertResourcesConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10804,14 +20142,14 @@ func (e UpdateActionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateActionResponse.%s: %s%s",
+		"invalid %sUpsertResourcesConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateActionResponseValidationError{}
+var _ error = UpsertResourcesConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10819,44 +20157,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateActionResponseValidationError{}
+} = UpsertResourcesConfigRequestValidationError{}
 
-// Validate checks the field values on ListNamespacesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpsertResourcesConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListNamespacesRequest) Validate() error {
+func (m *UpsertResourcesConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListNamespacesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListNamespacesRequestMultiError, or nil if none found.
-func (m *ListNamespacesRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpsertResourcesConfigResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpsertResourcesConfigResponseMultiError, or nil if none found.
+func (m *UpsertResourcesConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListNamespacesRequest) validate(all bool) error {
+func (m *UpsertResourcesConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Config
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertResourcesConfigResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertResourcesConfigResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertResourcesConfigResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertResourcesConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertResourcesConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertResourcesConfigResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListNamespacesRequestMultiError(errors)
+		return UpsertResourcesConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListNamespacesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListNamespacesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListNamespacesRequestMultiError []error
+// UpsertResourcesConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by UpsertResourcesConfigResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpsertResourcesConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListNamespacesRequestMultiError) Error() string {
+func (m UpsertResourcesConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10865,11 +20267,12 @@ func (m ListNamespacesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListNamespacesRequestMultiError) AllErrors() []error { return m }
+func (m UpsertResourcesConfigResponseMultiError) AllErrors() []error { return m }
 
-// ListNamespacesRequestValidationError is the validation error returned by
-// ListNamespacesRequest.Validate if the designated constraints aren't met.
-type ListNamespacesRequestValidationError struct {
+// UpsertResourcesConfigResponseValidationError is the validation error
+// returned by UpsertResourcesConfigResponse.Validate if the designated
+// constraints aren't met.
+type UpsertResourcesConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10877,24 +20280,24 @@ type ListNamespacesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNamespacesRequestValidationError) Field() string { return e.field }
+func (e UpsertResourcesConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNamespacesRequestValidationError) Reason() string { return e.reason }
+func (e UpsertResourcesConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNamespacesRequestValidationError) Cause() error { return e.cause }
+func (e UpsertResourcesConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNamespacesRequestValidationError) Key() bool { return e.key }
+func (e UpsertResourcesConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNamespacesRequestValidationError) ErrorName() string {
-	return "ListNamespacesRequestValidationError"
+func (e UpsertResourcesConfigResponseValidationError) ErrorName() string {
+	return "UpsertResourcesConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListNamespacesRequestValidationError) Error() string {
+func (e UpsertResourcesConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10906,14 +20309,14 @@ func (e ListNamespacesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNamespacesRequest.%s: %s%s",
+		"invalid %sUpsertResourcesConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNamespacesRequestValidationError{}
+var _ error = UpsertResourcesConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10921,78 +20324,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNamespacesRequestValidationError{}
+} = UpsertResourcesConfigResponseValidationError{}
 
-// Validate checks the field values on ListNamespacesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PlanResourcesConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListNamespacesResponse) Validate() error {
+func (m *PlanResourcesConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListNamespacesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PlanResourcesConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListNamespacesResponseMultiError, or nil if none found.
-func (m *ListNamespacesResponse) ValidateAll() error {
+// PlanResourcesConfigRequestMultiError, or nil if none found.
+func (m *PlanResourcesConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListNamespacesResponse) validate(all bool) error {
+func (m *PlanResourcesConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetNamespaces() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListNamespacesResponseValidationError{
-						field:  fmt.Sprintf("Namespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListNamespacesResponseValidationError{
-						field:  fmt.Sprintf("Namespaces[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListNamespacesResponseValidationError{
-					field:  fmt.Sprintf("Namespaces[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Name
 
-	}
+	// no validation rules for Config
 
 	if len(errors) > 0 {
-		return ListNamespacesResponseMultiError(errors)
+		return PlanResourcesConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListNamespacesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListNamespacesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListNamespacesResponseMultiError []error
+// PlanResourcesConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by PlanResourcesConfigRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PlanResourcesConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListNamespacesResponseMultiError) Error() string {
+func (m PlanResourcesConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11001,11 +20374,11 @@ func (m ListNamespacesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListNamespacesResponseMultiError) AllErrors() []error { return m }
+func (m PlanResourcesConfigRequestMultiError) AllErrors() []error { return m }
 
-// ListNamespacesResponseValidationError is the validation error returned by
-// ListNamespacesResponse.Validate if the designated constraints aren't met.
-type ListNamespacesResponseValidationError struct {
+// PlanResourcesConfigRequestValidationError is the validation error returned
+// by PlanResourcesConfigRequest.Validate if the designated constraints aren't met.
+type PlanResourcesConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11013,24 +20386,24 @@ type ListNamespacesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNamespacesResponseValidationError) Field() string { return e.field }
+func (e PlanResourcesConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNamespacesResponseValidationError) Reason() string { return e.reason }
+func (e PlanResourcesConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNamespacesResponseValidationError) Cause() error { return e.cause }
+func (e PlanResourcesConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNamespacesResponseValidationError) Key() bool { return e.key }
+func (e PlanResourcesConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNamespacesResponseValidationError) ErrorName() string {
-	return "ListNamespacesResponseValidationError"
+func (e PlanResourcesConfigRequestValidationError) ErrorName() string {
+	return "PlanResourcesConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListNamespacesResponseValidationError) Error() string {
+func (e PlanResourcesConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11042,14 +20415,14 @@ func (e ListNamespacesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNamespacesResponse.%s: %s%s",
+		"invalid %sPlanResourcesConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNamespacesResponseValidationError{}
+var _ error = PlanResourcesConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11057,73 +20430,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNamespacesResponseValidationError{}
+} = PlanResourcesConfigRequestValidationError{}
 
-// Validate checks the field values on CreateNamespaceRequest with the rules
+// Validate checks the field values on ResourcesConfigChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateNamespaceRequest) Validate() error {
+func (m *ResourcesConfigChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateNamespaceRequest with the rules
+// ValidateAll checks the field values on ResourcesConfigChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateNamespaceRequestMultiError, or nil if none found.
-func (m *CreateNamespaceRequest) ValidateAll() error {
+// ResourcesConfigChangeMultiError, or nil if none found.
+func (m *ResourcesConfigChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateNamespaceRequest) validate(all bool) error {
+func (m *ResourcesConfigChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateNamespaceRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateNamespaceRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateNamespaceRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Action
+
+	// no validation rules for Entity
+
+	// no validation rules for Id
+
+	// no validation rules for Detail
+
+	// no validation rules for LiveRelations
+
+	// no validation rules for Destructive
 
 	if len(errors) > 0 {
-		return CreateNamespaceRequestMultiError(errors)
+		return ResourcesConfigChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateNamespaceRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateNamespaceRequest.ValidateAll() if the designated
+// ResourcesConfigChangeMultiError is an error wrapping multiple validation
+// errors returned by ResourcesConfigChange.ValidateAll() if the designated
 // constraints aren't met.
-type CreateNamespaceRequestMultiError []error
+type ResourcesConfigChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateNamespaceRequestMultiError) Error() string {
+func (m ResourcesConfigChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11132,11 +20488,11 @@ func (m CreateNamespaceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateNamespaceRequestMultiError) AllErrors() []error { return m }
+func (m ResourcesConfigChangeMultiError) AllErrors() []error { return m }
 
-// CreateNamespaceRequestValidationError is the validation error returned by
-// CreateNamespaceRequest.Validate if the designated constraints aren't met.
-type CreateNamespaceRequestValidationError struct {
+// ResourcesConfigChangeValidationError is the validation error returned by
+// ResourcesConfigChange.Validate if the designated constraints aren't met.
+type ResourcesConfigChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11144,24 +20500,24 @@ type CreateNamespaceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateNamespaceRequestValidationError) Field() string { return e.field }
+func (e ResourcesConfigChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateNamespaceRequestValidationError) Reason() string { return e.reason }
+func (e ResourcesConfigChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateNamespaceRequestValidationError) Cause() error { return e.cause }
+func (e ResourcesConfigChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateNamespaceRequestValidationError) Key() bool { return e.key }
+func (e ResourcesConfigChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateNamespaceRequestValidationError) ErrorName() string {
-	return "CreateNamespaceRequestValidationError"
+func (e ResourcesConfigChangeValidationError) ErrorName() string {
+	return "ResourcesConfigChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateNamespaceRequestValidationError) Error() string {
+func (e ResourcesConfigChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11173,14 +20529,14 @@ func (e CreateNamespaceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateNamespaceRequest.%s: %s%s",
+		"invalid %sResourcesConfigChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateNamespaceRequestValidationError{}
+var _ error = ResourcesConfigChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -11188,73 +20544,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateNamespaceRequestValidationError{}
+} = ResourcesConfigChangeValidationError{}
 
-// Validate checks the field values on CreateNamespaceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PlanResourcesConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateNamespaceResponse) Validate() error {
+func (m *PlanResourcesConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateNamespaceResponse with the
+// ValidateAll checks the field values on PlanResourcesConfigResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateNamespaceResponseMultiError, or nil if none found.
-func (m *CreateNamespaceResponse) ValidateAll() error {
+// PlanResourcesConfigResponseMultiError, or nil if none found.
+func (m *PlanResourcesConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateNamespaceResponse) validate(all bool) error {
+func (m *PlanResourcesConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNamespace()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateNamespaceResponseValidationError{
-					field:  "Namespace",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanResourcesConfigResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanResourcesConfigResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateNamespaceResponseValidationError{
-					field:  "Namespace",
+				return PlanResourcesConfigResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateNamespaceResponseValidationError{
-				field:  "Namespace",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Destructive
+
 	if len(errors) > 0 {
-		return CreateNamespaceResponseMultiError(errors)
+		return PlanResourcesConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateNamespaceResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateNamespaceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateNamespaceResponseMultiError []error
+// PlanResourcesConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by PlanResourcesConfigResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PlanResourcesConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateNamespaceResponseMultiError) Error() string {
+func (m PlanResourcesConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11263,11 +20626,12 @@ func (m CreateNamespaceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateNamespaceResponseMultiError) AllErrors() []error { return m }
+func (m PlanResourcesConfigResponseMultiError) AllErrors() []error { return m }
 
-// CreateNamespaceResponseValidationError is the validation error returned by
-// CreateNamespaceResponse.Validate if the designated constraints aren't met.
-type CreateNamespaceResponseValidationError struct {
+// PlanResourcesConfigResponseValidationError is the validation error returned
+// by PlanResourcesConfigResponse.Validate if the designated constraints
+// aren't met.
+type PlanResourcesConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11275,24 +20639,24 @@ type CreateNamespaceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateNamespaceResponseValidationError) Field() string { return e.field }
+func (e PlanResourcesConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateNamespaceResponseValidationError) Reason() string { return e.reason }
+func (e PlanResourcesConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateNamespaceResponseValidationError) Cause() error { return e.cause }
+func (e PlanResourcesConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateNamespaceResponseValidationError) Key() bool { return e.key }
+func (e PlanResourcesConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateNamespaceResponseValidationError) ErrorName() string {
-	return "CreateNamespaceResponseValidationError"
+func (e PlanResourcesConfigResponseValidationError) ErrorName() string {
+	return "PlanResourcesConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateNamespaceResponseValidationError) Error() string {
+func (e PlanResourcesConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11304,14 +20668,14 @@ func (e CreateNamespaceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateNamespaceResponse.%s: %s%s",
+		"invalid %sPlanResourcesConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateNamespaceResponseValidationError{}
+var _ error = PlanResourcesConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11319,46 +20683,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateNamespaceResponseValidationError{}
+} = PlanResourcesConfigResponseValidationError{}
 
-// Validate checks the field values on GetNamespaceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetNamespaceRequest) Validate() error {
+// Validate checks the field values on SchemaVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetNamespaceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetNamespaceRequestMultiError, or nil if none found.
-func (m *GetNamespaceRequest) ValidateAll() error {
+// ValidateAll checks the field values on SchemaVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaVersionMultiError, or
+// nil if none found.
+func (m *SchemaVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetNamespaceRequest) validate(all bool) error {
+func (m *SchemaVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Version
+
+	// no validation rules for Schema
+
+	// no validation rules for Config
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaVersionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetNamespaceRequestMultiError(errors)
+		return SchemaVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetNamespaceRequestMultiError is an error wrapping multiple validation
-// errors returned by GetNamespaceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetNamespaceRequestMultiError []error
+// SchemaVersionMultiError is an error wrapping multiple validation errors
+// returned by SchemaVersion.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetNamespaceRequestMultiError) Error() string {
+func (m SchemaVersionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11367,11 +20764,11 @@ func (m GetNamespaceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetNamespaceRequestMultiError) AllErrors() []error { return m }
+func (m SchemaVersionMultiError) AllErrors() []error { return m }
 
-// GetNamespaceRequestValidationError is the validation error returned by
-// GetNamespaceRequest.Validate if the designated constraints aren't met.
-type GetNamespaceRequestValidationError struct {
+// SchemaVersionValidationError is the validation error returned by
+// SchemaVersion.Validate if the designated constraints aren't met.
+type SchemaVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11379,24 +20776,22 @@ type GetNamespaceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetNamespaceRequestValidationError) Field() string { return e.field }
+func (e SchemaVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetNamespaceRequestValidationError) Reason() string { return e.reason }
+func (e SchemaVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetNamespaceRequestValidationError) Cause() error { return e.cause }
+func (e SchemaVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetNamespaceRequestValidationError) Key() bool { return e.key }
+func (e SchemaVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetNamespaceRequestValidationError) ErrorName() string {
-	return "GetNamespaceRequestValidationError"
-}
+func (e SchemaVersionValidationError) ErrorName() string { return "SchemaVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetNamespaceRequestValidationError) Error() string {
+func (e SchemaVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11408,14 +20803,14 @@ func (e GetNamespaceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetNamespaceRequest.%s: %s%s",
+		"invalid %sSchemaVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetNamespaceRequestValidationError{}
+var _ error = SchemaVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -11423,73 +20818,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetNamespaceRequestValidationError{}
+} = SchemaVersionValidationError{}
 
-// Validate checks the field values on GetNamespaceResponse with the rules
+// Validate checks the field values on ListSchemaVersionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetNamespaceResponse) Validate() error {
+func (m *ListSchemaVersionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetNamespaceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListSchemaVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetNamespaceResponseMultiError, or nil if none found.
-func (m *GetNamespaceResponse) ValidateAll() error {
+// ListSchemaVersionsRequestMultiError, or nil if none found.
+func (m *ListSchemaVersionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetNamespaceResponse) validate(all bool) error {
+func (m *ListSchemaVersionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNamespace()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetNamespaceResponseValidationError{
-					field:  "Namespace",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetNamespaceResponseValidationError{
-					field:  "Namespace",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetNamespaceResponseValidationError{
-				field:  "Namespace",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetNamespaceResponseMultiError(errors)
+		return ListSchemaVersionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetNamespaceResponseMultiError is an error wrapping multiple validation
-// errors returned by GetNamespaceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetNamespaceResponseMultiError []error
+// ListSchemaVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSchemaVersionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListSchemaVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetNamespaceResponseMultiError) Error() string {
+func (m ListSchemaVersionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11498,11 +20864,11 @@ func (m GetNamespaceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetNamespaceResponseMultiError) AllErrors() []error { return m }
+func (m ListSchemaVersionsRequestMultiError) AllErrors() []error { return m }
 
-// GetNamespaceResponseValidationError is the validation error returned by
-// GetNamespaceResponse.Validate if the designated constraints aren't met.
-type GetNamespaceResponseValidationError struct {
+// ListSchemaVersionsRequestValidationError is the validation error returned by
+// ListSchemaVersionsRequest.Validate if the designated constraints aren't met.
+type ListSchemaVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11510,24 +20876,24 @@ type GetNamespaceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetNamespaceResponseValidationError) Field() string { return e.field }
+func (e ListSchemaVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetNamespaceResponseValidationError) Reason() string { return e.reason }
+func (e ListSchemaVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetNamespaceResponseValidationError) Cause() error { return e.cause }
+func (e ListSchemaVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetNamespaceResponseValidationError) Key() bool { return e.key }
+func (e ListSchemaVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetNamespaceResponseValidationError) ErrorName() string {
-	return "GetNamespaceResponseValidationError"
+func (e ListSchemaVersionsRequestValidationError) ErrorName() string {
+	return "ListSchemaVersionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetNamespaceResponseValidationError) Error() string {
+func (e ListSchemaVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11539,14 +20905,14 @@ func (e GetNamespaceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetNamespaceResponse.%s: %s%s",
+		"invalid %sListSchemaVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetNamespaceResponseValidationError{}
+var _ error = ListSchemaVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11554,75 +20920,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetNamespaceResponseValidationError{}
+} = ListSchemaVersionsRequestValidationError{}
 
-// Validate checks the field values on UpdateNamespaceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListSchemaVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateNamespaceRequest) Validate() error {
+func (m *ListSchemaVersionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateNamespaceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListSchemaVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateNamespaceRequestMultiError, or nil if none found.
-func (m *UpdateNamespaceRequest) ValidateAll() error {
+// ListSchemaVersionsResponseMultiError, or nil if none found.
+func (m *ListSchemaVersionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateNamespaceRequest) validate(all bool) error {
+func (m *ListSchemaVersionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateNamespaceRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSchemaVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSchemaVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateNamespaceRequestValidationError{
-					field:  "Body",
+				return ListSchemaVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateNamespaceRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateNamespaceRequestMultiError(errors)
+		return ListSchemaVersionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateNamespaceRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateNamespaceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateNamespaceRequestMultiError []error
+// ListSchemaVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSchemaVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListSchemaVersionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateNamespaceRequestMultiError) Error() string {
+func (m ListSchemaVersionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11631,11 +21000,11 @@ func (m UpdateNamespaceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateNamespaceRequestMultiError) AllErrors() []error { return m }
+func (m ListSchemaVersionsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateNamespaceRequestValidationError is the validation error returned by
-// UpdateNamespaceRequest.Validate if the designated constraints aren't met.
-type UpdateNamespaceRequestValidationError struct {
+// ListSchemaVersionsResponseValidationError is the validation error returned
+// by ListSchemaVersionsResponse.Validate if the designated constraints aren't met.
+type ListSchemaVersionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11643,24 +21012,24 @@ type UpdateNamespaceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateNamespaceRequestValidationError) Field() string { return e.field }
+func (e ListSchemaVersionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateNamespaceRequestValidationError) Reason() string { return e.reason }
+func (e ListSchemaVersionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateNamespaceRequestValidationError) Cause() error { return e.cause }
+func (e ListSchemaVersionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateNamespaceRequestValidationError) Key() bool { return e.key }
+func (e ListSchemaVersionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateNamespaceRequestValidationError) ErrorName() string {
-	return "UpdateNamespaceRequestValidationError"
+func (e ListSchemaVersionsResponseValidationError) ErrorName() string {
+	return "ListSchemaVersionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateNamespaceRequestValidationError) Error() string {
+func (e ListSchemaVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11672,14 +21041,14 @@ func (e UpdateNamespaceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateNamespaceRequest.%s: %s%s",
+		"invalid %sListSchemaVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateNamespaceRequestValidationError{}
+var _ error = ListSchemaVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11687,73 +21056,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateNamespaceRequestValidationError{}
+} = ListSchemaVersionsResponseValidationError{}
 
-// Validate checks the field values on UpdateNamespaceResponse with the rules
+// Validate checks the field values on UpsertRulesConfigRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateNamespaceResponse) Validate() error {
+func (m *UpsertRulesConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateNamespaceResponse with the
+// ValidateAll checks the field values on UpsertRulesConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateNamespaceResponseMultiError, or nil if none found.
-func (m *UpdateNamespaceResponse) ValidateAll() error {
+// UpsertRulesConfigRequestMultiError, or nil if none found.
+func (m *UpsertRulesConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateNamespaceResponse) validate(all bool) error {
+func (m *UpsertRulesConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNamespace()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateNamespaceResponseValidationError{
-					field:  "Namespace",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateNamespaceResponseValidationError{
-					field:  "Namespace",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateNamespaceResponseValidationError{
-				field:  "Namespace",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
+
+	// no validation rules for Config
 
 	if len(errors) > 0 {
-		return UpdateNamespaceResponseMultiError(errors)
+		return UpsertRulesConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateNamespaceResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateNamespaceResponse.ValidateAll() if the designated
+// UpsertRulesConfigRequestMultiError is an error wrapping multiple validation
+// errors returned by UpsertRulesConfigRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateNamespaceResponseMultiError []error
+type UpsertRulesConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateNamespaceResponseMultiError) Error() string {
+func (m UpsertRulesConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11762,11 +21106,11 @@ func (m UpdateNamespaceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateNamespaceResponseMultiError) AllErrors() []error { return m }
+func (m UpsertRulesConfigRequestMultiError) AllErrors() []error { return m }
 
-// UpdateNamespaceResponseValidationError is the validation error returned by
-// UpdateNamespaceResponse.Validate if the designated constraints aren't met.
-type UpdateNamespaceResponseValidationError struct {
+// UpsertRulesConfigRequestValidationError is the validation error returned by
+// UpsertRulesConfigRequest.Validate if the designated constraints aren't met.
+type UpsertRulesConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11774,24 +21118,24 @@ type UpdateNamespaceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateNamespaceResponseValidationError) Field() string { return e.field }
+func (e UpsertRulesConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateNamespaceResponseValidationError) Reason() string { return e.reason }
+func (e UpsertRulesConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateNamespaceResponseValidationError) Cause() error { return e.cause }
+func (e UpsertRulesConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateNamespaceResponseValidationError) Key() bool { return e.key }
+func (e UpsertRulesConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateNamespaceResponseValidationError) ErrorName() string {
-	return "UpdateNamespaceResponseValidationError"
+func (e UpsertRulesConfigRequestValidationError) ErrorName() string {
+	return "UpsertRulesConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateNamespaceResponseValidationError) Error() string {
+func (e UpsertRulesConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11803,14 +21147,14 @@ func (e UpdateNamespaceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateNamespaceResponse.%s: %s%s",
+		"invalid %sUpsertRulesConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateNamespaceResponseValidationError{}
+var _ error = UpsertRulesConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11818,44 +21162,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateNamespaceResponseValidationError{}
+} = UpsertRulesConfigRequestValidationError{}
 
-// Validate checks the field values on ListPoliciesRequest with the rules
+// Validate checks the field values on UpsertRulesConfigResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPoliciesRequest) Validate() error {
+func (m *UpsertRulesConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPoliciesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpsertRulesConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPoliciesRequestMultiError, or nil if none found.
-func (m *ListPoliciesRequest) ValidateAll() error {
+// UpsertRulesConfigResponseMultiError, or nil if none found.
+func (m *UpsertRulesConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPoliciesRequest) validate(all bool) error {
+func (m *UpsertRulesConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Config
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertRulesConfigResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertRulesConfigResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertRulesConfigResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertRulesConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertRulesConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertRulesConfigResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListPoliciesRequestMultiError(errors)
+		return UpsertRulesConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPoliciesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPoliciesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListPoliciesRequestMultiError []error
+// UpsertRulesConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertRulesConfigResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpsertRulesConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPoliciesRequestMultiError) Error() string {
+func (m UpsertRulesConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11864,11 +21272,11 @@ func (m ListPoliciesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPoliciesRequestMultiError) AllErrors() []error { return m }
+func (m UpsertRulesConfigResponseMultiError) AllErrors() []error { return m }
 
-// ListPoliciesRequestValidationError is the validation error returned by
-// ListPoliciesRequest.Validate if the designated constraints aren't met.
-type ListPoliciesRequestValidationError struct {
+// UpsertRulesConfigResponseValidationError is the validation error returned by
+// UpsertRulesConfigResponse.Validate if the designated constraints aren't met.
+type UpsertRulesConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11876,24 +21284,24 @@ type ListPoliciesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPoliciesRequestValidationError) Field() string { return e.field }
+func (e UpsertRulesConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPoliciesRequestValidationError) Reason() string { return e.reason }
+func (e UpsertRulesConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPoliciesRequestValidationError) Cause() error { return e.cause }
+func (e UpsertRulesConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPoliciesRequestValidationError) Key() bool { return e.key }
+func (e UpsertRulesConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPoliciesRequestValidationError) ErrorName() string {
-	return "ListPoliciesRequestValidationError"
+func (e UpsertRulesConfigResponseValidationError) ErrorName() string {
+	return "UpsertRulesConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPoliciesRequestValidationError) Error() string {
+func (e UpsertRulesConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11905,14 +21313,14 @@ func (e ListPoliciesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPoliciesRequest.%s: %s%s",
+		"invalid %sUpsertRulesConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPoliciesRequestValidationError{}
+var _ error = UpsertRulesConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11920,78 +21328,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPoliciesRequestValidationError{}
+} = UpsertRulesConfigResponseValidationError{}
 
-// Validate checks the field values on ListPoliciesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPoliciesResponse) Validate() error {
+// Validate checks the field values on ServiceAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceAccount) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPoliciesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListPoliciesResponseMultiError, or nil if none found.
-func (m *ListPoliciesResponse) ValidateAll() error {
+// ValidateAll checks the field values on ServiceAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceAccountMultiError,
+// or nil if none found.
+func (m *ServiceAccount) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPoliciesResponse) validate(all bool) error {
+func (m *ServiceAccount) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPoliciesResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPoliciesResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListPoliciesResponseValidationError{
-					field:  fmt.Sprintf("Policies[%v]", idx),
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListPoliciesResponseMultiError(errors)
+		return ServiceAccountMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPoliciesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListPoliciesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListPoliciesResponseMultiError []error
+// ServiceAccountMultiError is an error wrapping multiple validation errors
+// returned by ServiceAccount.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceAccountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPoliciesResponseMultiError) Error() string {
+func (m ServiceAccountMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12000,11 +21467,11 @@ func (m ListPoliciesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPoliciesResponseMultiError) AllErrors() []error { return m }
+func (m ServiceAccountMultiError) AllErrors() []error { return m }
 
-// ListPoliciesResponseValidationError is the validation error returned by
-// ListPoliciesResponse.Validate if the designated constraints aren't met.
-type ListPoliciesResponseValidationError struct {
+// ServiceAccountValidationError is the validation error returned by
+// ServiceAccount.Validate if the designated constraints aren't met.
+type ServiceAccountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12012,24 +21479,22 @@ type ListPoliciesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPoliciesResponseValidationError) Field() string { return e.field }
+func (e ServiceAccountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPoliciesResponseValidationError) Reason() string { return e.reason }
+func (e ServiceAccountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPoliciesResponseValidationError) Cause() error { return e.cause }
+func (e ServiceAccountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPoliciesResponseValidationError) Key() bool { return e.key }
+func (e ServiceAccountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPoliciesResponseValidationError) ErrorName() string {
-	return "ListPoliciesResponseValidationError"
-}
+func (e ServiceAccountValidationError) ErrorName() string { return "ServiceAccountValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListPoliciesResponseValidationError) Error() string {
+func (e ServiceAccountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12041,14 +21506,14 @@ func (e ListPoliciesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPoliciesResponse.%s: %s%s",
+		"invalid %sServiceAccount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPoliciesResponseValidationError{}
+var _ error = ServiceAccountValidationError{}
 
 var _ interface {
 	Field() string
@@ -12056,53 +21521,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPoliciesResponseValidationError{}
+} = ServiceAccountValidationError{}
 
-// Validate checks the field values on CreatePolicyRequest with the rules
+// Validate checks the field values on ServiceAccountRequestBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePolicyRequest) Validate() error {
+func (m *ServiceAccountRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ServiceAccountRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePolicyRequestMultiError, or nil if none found.
-func (m *CreatePolicyRequest) ValidateAll() error {
+// ServiceAccountRequestBodyMultiError, or nil if none found.
+func (m *ServiceAccountRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePolicyRequest) validate(all bool) error {
+func (m *ServiceAccountRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_ServiceAccountRequestBody_Name_Pattern.MatchString(m.GetName()) {
+		err := ServiceAccountRequestBodyValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OrgId
+
 	if all {
-		switch v := interface{}(m.GetBody()).(type) {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreatePolicyRequestValidationError{
-					field:  "Body",
+				errors = append(errors, ServiceAccountRequestBodyValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreatePolicyRequestValidationError{
-					field:  "Body",
+				errors = append(errors, ServiceAccountRequestBodyValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreatePolicyRequestValidationError{
-				field:  "Body",
+			return ServiceAccountRequestBodyValidationError{
+				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -12110,19 +21588,19 @@ func (m *CreatePolicyRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreatePolicyRequestMultiError(errors)
+		return ServiceAccountRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePolicyRequestMultiError is an error wrapping multiple validation
-// errors returned by CreatePolicyRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreatePolicyRequestMultiError []error
+// ServiceAccountRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by ServiceAccountRequestBody.ValidateAll() if the
+// designated constraints aren't met.
+type ServiceAccountRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePolicyRequestMultiError) Error() string {
+func (m ServiceAccountRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12131,11 +21609,11 @@ func (m CreatePolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePolicyRequestMultiError) AllErrors() []error { return m }
+func (m ServiceAccountRequestBodyMultiError) AllErrors() []error { return m }
 
-// CreatePolicyRequestValidationError is the validation error returned by
-// CreatePolicyRequest.Validate if the designated constraints aren't met.
-type CreatePolicyRequestValidationError struct {
+// ServiceAccountRequestBodyValidationError is the validation error returned by
+// ServiceAccountRequestBody.Validate if the designated constraints aren't met.
+type ServiceAccountRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12143,24 +21621,24 @@ type CreatePolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePolicyRequestValidationError) Field() string { return e.field }
+func (e ServiceAccountRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePolicyRequestValidationError) Reason() string { return e.reason }
+func (e ServiceAccountRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePolicyRequestValidationError) Cause() error { return e.cause }
+func (e ServiceAccountRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePolicyRequestValidationError) Key() bool { return e.key }
+func (e ServiceAccountRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePolicyRequestValidationError) ErrorName() string {
-	return "CreatePolicyRequestValidationError"
+func (e ServiceAccountRequestBodyValidationError) ErrorName() string {
+	return "ServiceAccountRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePolicyRequestValidationError) Error() string {
+func (e ServiceAccountRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12172,14 +21650,14 @@ func (e CreatePolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePolicyRequest.%s: %s%s",
+		"invalid %sServiceAccountRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePolicyRequestValidationError{}
+var _ error = ServiceAccountRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12187,78 +21665,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePolicyRequestValidationError{}
+} = ServiceAccountRequestBodyValidationError{}
 
-// Validate checks the field values on CreatePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
+var _ServiceAccountRequestBody_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePolicyResponse) Validate() error {
+func (m *CreateServiceAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePolicyResponseMultiError, or nil if none found.
-func (m *CreatePolicyResponse) ValidateAll() error {
+// CreateServiceAccountRequestMultiError, or nil if none found.
+func (m *CreateServiceAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePolicyResponse) validate(all bool) error {
+func (m *CreateServiceAccountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreatePolicyResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreatePolicyResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateServiceAccountRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CreatePolicyResponseValidationError{
-					field:  fmt.Sprintf("Policies[%v]", idx),
+				errors = append(errors, CreateServiceAccountRequestValidationError{
+					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateServiceAccountRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return CreatePolicyResponseMultiError(errors)
+		return CreateServiceAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePolicyResponseMultiError is an error wrapping multiple validation
-// errors returned by CreatePolicyResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreatePolicyResponseMultiError []error
+// CreateServiceAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePolicyResponseMultiError) Error() string {
+func (m CreateServiceAccountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12267,11 +21742,12 @@ func (m CreatePolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePolicyResponseMultiError) AllErrors() []error { return m }
+func (m CreateServiceAccountRequestMultiError) AllErrors() []error { return m }
 
-// CreatePolicyResponseValidationError is the validation error returned by
-// CreatePolicyResponse.Validate if the designated constraints aren't met.
-type CreatePolicyResponseValidationError struct {
+// CreateServiceAccountRequestValidationError is the validation error returned
+// by CreateServiceAccountRequest.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12279,24 +21755,24 @@ type CreatePolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePolicyResponseValidationError) Field() string { return e.field }
+func (e CreateServiceAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePolicyResponseValidationError) Reason() string { return e.reason }
+func (e CreateServiceAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePolicyResponseValidationError) Cause() error { return e.cause }
+func (e CreateServiceAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePolicyResponseValidationError) Key() bool { return e.key }
+func (e CreateServiceAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePolicyResponseValidationError) ErrorName() string {
-	return "CreatePolicyResponseValidationError"
+func (e CreateServiceAccountRequestValidationError) ErrorName() string {
+	return "CreateServiceAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePolicyResponseValidationError) Error() string {
+func (e CreateServiceAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12308,14 +21784,14 @@ func (e CreatePolicyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePolicyResponse.%s: %s%s",
+		"invalid %sCreateServiceAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePolicyResponseValidationError{}
+var _ error = CreateServiceAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12323,46 +21799,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePolicyResponseValidationError{}
+} = CreateServiceAccountRequestValidationError{}
 
-// Validate checks the field values on GetPolicyRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetPolicyRequest) Validate() error {
+// Validate checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPolicyRequestMultiError, or nil if none found.
-func (m *GetPolicyRequest) ValidateAll() error {
+// CreateServiceAccountResponseMultiError, or nil if none found.
+func (m *CreateServiceAccountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPolicyRequest) validate(all bool) error {
+func (m *CreateServiceAccountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetServiceAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServiceAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateServiceAccountResponseValidationError{
+				field:  "ServiceAccount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetPolicyRequestMultiError(errors)
+		return CreateServiceAccountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPolicyRequestMultiError is an error wrapping multiple validation errors
-// returned by GetPolicyRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetPolicyRequestMultiError []error
+// CreateServiceAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPolicyRequestMultiError) Error() string {
+func (m CreateServiceAccountResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12371,11 +21874,12 @@ func (m GetPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPolicyRequestMultiError) AllErrors() []error { return m }
+func (m CreateServiceAccountResponseMultiError) AllErrors() []error { return m }
 
-// GetPolicyRequestValidationError is the validation error returned by
-// GetPolicyRequest.Validate if the designated constraints aren't met.
-type GetPolicyRequestValidationError struct {
+// CreateServiceAccountResponseValidationError is the validation error returned
+// by CreateServiceAccountResponse.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12383,22 +21887,24 @@ type GetPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPolicyRequestValidationError) Field() string { return e.field }
+func (e CreateServiceAccountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPolicyRequestValidationError) Reason() string { return e.reason }
+func (e CreateServiceAccountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPolicyRequestValidationError) Cause() error { return e.cause }
+func (e CreateServiceAccountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPolicyRequestValidationError) Key() bool { return e.key }
+func (e CreateServiceAccountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPolicyRequestValidationError) ErrorName() string { return "GetPolicyRequestValidationError" }
+func (e CreateServiceAccountResponseValidationError) ErrorName() string {
+	return "CreateServiceAccountResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetPolicyRequestValidationError) Error() string {
+func (e CreateServiceAccountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12410,14 +21916,14 @@ func (e GetPolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPolicyRequest.%s: %s%s",
+		"invalid %sCreateServiceAccountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPolicyRequestValidationError{}
+var _ error = CreateServiceAccountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12425,73 +21931,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPolicyRequestValidationError{}
+} = CreateServiceAccountResponseValidationError{}
 
-// Validate checks the field values on GetPolicyResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetPolicyResponse) Validate() error {
+// Validate checks the field values on GetServiceAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetServiceAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPolicyResponseMultiError, or nil if none found.
-func (m *GetPolicyResponse) ValidateAll() error {
+// GetServiceAccountRequestMultiError, or nil if none found.
+func (m *GetServiceAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
-
-func (m *GetPolicyResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetPolicy()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetPolicyResponseValidationError{
-					field:  "Policy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetPolicyResponseValidationError{
-					field:  "Policy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetPolicyResponseValidationError{
-				field:  "Policy",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+
+func (m *GetServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return GetPolicyResponseMultiError(errors)
+		return GetServiceAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPolicyResponseMultiError is an error wrapping multiple validation errors
-// returned by GetPolicyResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetPolicyResponseMultiError []error
+// GetServiceAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by GetServiceAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetServiceAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPolicyResponseMultiError) Error() string {
+func (m GetServiceAccountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12500,11 +21979,11 @@ func (m GetPolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPolicyResponseMultiError) AllErrors() []error { return m }
+func (m GetServiceAccountRequestMultiError) AllErrors() []error { return m }
 
-// GetPolicyResponseValidationError is the validation error returned by
-// GetPolicyResponse.Validate if the designated constraints aren't met.
-type GetPolicyResponseValidationError struct {
+// GetServiceAccountRequestValidationError is the validation error returned by
+// GetServiceAccountRequest.Validate if the designated constraints aren't met.
+type GetServiceAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12512,24 +21991,24 @@ type GetPolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPolicyResponseValidationError) Field() string { return e.field }
+func (e GetServiceAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPolicyResponseValidationError) Reason() string { return e.reason }
+func (e GetServiceAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPolicyResponseValidationError) Cause() error { return e.cause }
+func (e GetServiceAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPolicyResponseValidationError) Key() bool { return e.key }
+func (e GetServiceAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPolicyResponseValidationError) ErrorName() string {
-	return "GetPolicyResponseValidationError"
+func (e GetServiceAccountRequestValidationError) ErrorName() string {
+	return "GetServiceAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPolicyResponseValidationError) Error() string {
+func (e GetServiceAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12541,14 +22020,14 @@ func (e GetPolicyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPolicyResponse.%s: %s%s",
+		"invalid %sGetServiceAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPolicyResponseValidationError{}
+var _ error = GetServiceAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12556,55 +22035,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPolicyResponseValidationError{}
+} = GetServiceAccountRequestValidationError{}
 
-// Validate checks the field values on UpdatePolicyRequest with the rules
+// Validate checks the field values on GetServiceAccountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePolicyRequest) Validate() error {
+func (m *GetServiceAccountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePolicyRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePolicyRequestMultiError, or nil if none found.
-func (m *UpdatePolicyRequest) ValidateAll() error {
+// GetServiceAccountResponseMultiError, or nil if none found.
+func (m *GetServiceAccountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePolicyRequest) validate(all bool) error {
+func (m *GetServiceAccountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if all {
-		switch v := interface{}(m.GetBody()).(type) {
+		switch v := interface{}(m.GetServiceAccount()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdatePolicyRequestValidationError{
-					field:  "Body",
+				errors = append(errors, GetServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdatePolicyRequestValidationError{
-					field:  "Body",
+				errors = append(errors, GetServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetServiceAccount()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdatePolicyRequestValidationError{
-				field:  "Body",
+			return GetServiceAccountResponseValidationError{
+				field:  "ServiceAccount",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -12612,19 +22089,19 @@ func (m *UpdatePolicyRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdatePolicyRequestMultiError(errors)
+		return GetServiceAccountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePolicyRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdatePolicyRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePolicyRequestMultiError []error
+// GetServiceAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by GetServiceAccountResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetServiceAccountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePolicyRequestMultiError) Error() string {
+func (m GetServiceAccountResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12633,11 +22110,11 @@ func (m UpdatePolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePolicyRequestMultiError) AllErrors() []error { return m }
+func (m GetServiceAccountResponseMultiError) AllErrors() []error { return m }
 
-// UpdatePolicyRequestValidationError is the validation error returned by
-// UpdatePolicyRequest.Validate if the designated constraints aren't met.
-type UpdatePolicyRequestValidationError struct {
+// GetServiceAccountResponseValidationError is the validation error returned by
+// GetServiceAccountResponse.Validate if the designated constraints aren't met.
+type GetServiceAccountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12645,24 +22122,24 @@ type UpdatePolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePolicyRequestValidationError) Field() string { return e.field }
+func (e GetServiceAccountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePolicyRequestValidationError) Reason() string { return e.reason }
+func (e GetServiceAccountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePolicyRequestValidationError) Cause() error { return e.cause }
+func (e GetServiceAccountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePolicyRequestValidationError) Key() bool { return e.key }
+func (e GetServiceAccountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePolicyRequestValidationError) ErrorName() string {
-	return "UpdatePolicyRequestValidationError"
+func (e GetServiceAccountResponseValidationError) ErrorName() string {
+	return "GetServiceAccountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePolicyRequestValidationError) Error() string {
+func (e GetServiceAccountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12674,14 +22151,14 @@ func (e UpdatePolicyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePolicyRequest.%s: %s%s",
+		"invalid %sGetServiceAccountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePolicyRequestValidationError{}
+var _ error = GetServiceAccountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12689,78 +22166,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePolicyRequestValidationError{}
+} = GetServiceAccountResponseValidationError{}
 
-// Validate checks the field values on UpdatePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePolicyResponse) Validate() error {
+func (m *ListServiceAccountsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePolicyResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePolicyResponseMultiError, or nil if none found.
-func (m *UpdatePolicyResponse) ValidateAll() error {
+// ListServiceAccountsRequestMultiError, or nil if none found.
+func (m *ListServiceAccountsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePolicyResponse) validate(all bool) error {
+func (m *ListServiceAccountsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdatePolicyResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdatePolicyResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdatePolicyResponseValidationError{
-					field:  fmt.Sprintf("Policies[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for OrgId
 
 	if len(errors) > 0 {
-		return UpdatePolicyResponseMultiError(errors)
+		return ListServiceAccountsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePolicyResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdatePolicyResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePolicyResponseMultiError []error
+// ListServiceAccountsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAccountsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePolicyResponseMultiError) Error() string {
+func (m ListServiceAccountsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12769,11 +22214,11 @@ func (m UpdatePolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePolicyResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAccountsRequestMultiError) AllErrors() []error { return m }
 
-// UpdatePolicyResponseValidationError is the validation error returned by
-// UpdatePolicyResponse.Validate if the designated constraints aren't met.
-type UpdatePolicyResponseValidationError struct {
+// ListServiceAccountsRequestValidationError is the validation error returned
+// by ListServiceAccountsRequest.Validate if the designated constraints aren't met.
+type ListServiceAccountsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12781,24 +22226,24 @@ type UpdatePolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePolicyResponseValidationError) Field() string { return e.field }
+func (e ListServiceAccountsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePolicyResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAccountsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePolicyResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAccountsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePolicyResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAccountsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePolicyResponseValidationError) ErrorName() string {
-	return "UpdatePolicyResponseValidationError"
+func (e ListServiceAccountsRequestValidationError) ErrorName() string {
+	return "ListServiceAccountsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePolicyResponseValidationError) Error() string {
+func (e ListServiceAccountsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12810,14 +22255,14 @@ func (e UpdatePolicyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePolicyResponse.%s: %s%s",
+		"invalid %sListServiceAccountsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePolicyResponseValidationError{}
+var _ error = ListServiceAccountsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12825,111 +22270,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePolicyResponseValidationError{}
+} = ListServiceAccountsRequestValidationError{}
 
-// Validate checks the field values on Relation with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Relation) Validate() error {
+// Validate checks the field values on ListServiceAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListServiceAccountsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Relation with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RelationMultiError, or nil
-// if none found.
-func (m *Relation) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAccountsResponseMultiError, or nil if none found.
+func (m *ListServiceAccountsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Relation) validate(all bool) error {
+func (m *ListServiceAccountsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for ObjectId
-
-	// no validation rules for ObjectNamespace
-
-	// no validation rules for Subject
-
-	// no validation rules for RoleName
+	for idx, item := range m.GetServiceAccounts() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "CreatedAt",
+				return ListServiceAccountsResponseValidationError{
+					field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
 	if len(errors) > 0 {
-		return RelationMultiError(errors)
+		return ListServiceAccountsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationMultiError is an error wrapping multiple validation errors returned
-// by Relation.ValidateAll() if the designated constraints aren't met.
-type RelationMultiError []error
+// ListServiceAccountsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAccountsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationMultiError) Error() string {
+func (m ListServiceAccountsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12938,11 +22350,12 @@ func (m RelationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationMultiError) AllErrors() []error { return m }
+func (m ListServiceAccountsResponseMultiError) AllErrors() []error { return m }
 
-// RelationValidationError is the validation error returned by
-// Relation.Validate if the designated constraints aren't met.
-type RelationValidationError struct {
+// ListServiceAccountsResponseValidationError is the validation error returned
+// by ListServiceAccountsResponse.Validate if the designated constraints
+// aren't met.
+type ListServiceAccountsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12950,22 +22363,24 @@ type RelationValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationValidationError) Field() string { return e.field }
+func (e ListServiceAccountsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationValidationError) Reason() string { return e.reason }
+func (e ListServiceAccountsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationValidationError) Cause() error { return e.cause }
+func (e ListServiceAccountsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationValidationError) Key() bool { return e.key }
+func (e ListServiceAccountsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationValidationError) ErrorName() string { return "RelationValidationError" }
+func (e ListServiceAccountsResponseValidationError) ErrorName() string {
+	return "ListServiceAccountsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RelationValidationError) Error() string {
+func (e ListServiceAccountsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12977,14 +22392,14 @@ func (e RelationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelation.%s: %s%s",
+		"invalid %sListServiceAccountsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationValidationError{}
+var _ error = ListServiceAccountsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12992,24 +22407,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationValidationError{}
-
-// Validate checks the field values on Resource with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Resource) Validate() error {
-	return m.validate(false)
-}
+} = ListServiceAccountsResponseValidationError{}
 
-// ValidateAll checks the field values on Resource with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ResourceMultiError, or nil
-// if none found.
-func (m *Resource) ValidateAll() error {
+// Validate checks the field values on ServiceAccountKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ServiceAccountKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceAccountKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServiceAccountKeyMultiError, or nil if none found.
+func (m *ServiceAccountKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Resource) validate(all bool) error {
+func (m *ServiceAccountKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13018,60 +22433,31 @@ func (m *Resource) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
-
-	if all {
-		switch v := interface{}(m.GetProject()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "Project",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
-				field:  "Project",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ServiceAccountId
 
 	if all {
-		switch v := interface{}(m.GetOrganization()).(type) {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "Organization",
+				errors = append(errors, ServiceAccountKeyValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "Organization",
+				errors = append(errors, ServiceAccountKeyValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
-				field:  "Organization",
+			return ServiceAccountKeyValidationError{
+				field:  "ExpiresAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13079,28 +22465,28 @@ func (m *Resource) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetNamespace()).(type) {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "Namespace",
+				errors = append(errors, ServiceAccountKeyValidationError{
+					field:  "RevokedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "Namespace",
+				errors = append(errors, ServiceAccountKeyValidationError{
+					field:  "RevokedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
-				field:  "Namespace",
+			return ServiceAccountKeyValidationError{
+				field:  "RevokedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -13111,7 +22497,7 @@ func (m *Resource) validate(all bool) error {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, ServiceAccountKeyValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13119,7 +22505,7 @@ func (m *Resource) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
+				errors = append(errors, ServiceAccountKeyValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13128,7 +22514,7 @@ func (m *Resource) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
+			return ServiceAccountKeyValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -13136,79 +22522,153 @@ func (m *Resource) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return ServiceAccountKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceAccountKeyMultiError is an error wrapping multiple validation errors
+// returned by ServiceAccountKey.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceAccountKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceAccountKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceAccountKeyMultiError) AllErrors() []error { return m }
+
+// ServiceAccountKeyValidationError is the validation error returned by
+// ServiceAccountKey.Validate if the designated constraints aren't met.
+type ServiceAccountKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceAccountKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceAccountKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceAccountKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceAccountKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceAccountKeyValidationError) ErrorName() string {
+	return "ServiceAccountKeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServiceAccountKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceAccountKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceAccountKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceAccountKeyValidationError{}
+
+// Validate checks the field values on CreateServiceAccountKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountKeyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountKeyRequestMultiError, or nil if none found.
+func (m *CreateServiceAccountKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Id
+
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "User",
+				errors = append(errors, CreateServiceAccountKeyRequestValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ResourceValidationError{
-					field:  "User",
+				errors = append(errors, CreateServiceAccountKeyRequestValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ResourceValidationError{
-				field:  "User",
+			return CreateServiceAccountKeyRequestValidationError{
+				field:  "ExpiresAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Urn
-
 	if len(errors) > 0 {
-		return ResourceMultiError(errors)
+		return CreateServiceAccountKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResourceMultiError is an error wrapping multiple validation errors returned
-// by Resource.ValidateAll() if the designated constraints aren't met.
-type ResourceMultiError []error
+// CreateServiceAccountKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountKeyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateServiceAccountKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourceMultiError) Error() string {
+func (m CreateServiceAccountKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13217,11 +22677,12 @@ func (m ResourceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourceMultiError) AllErrors() []error { return m }
+func (m CreateServiceAccountKeyRequestMultiError) AllErrors() []error { return m }
 
-// ResourceValidationError is the validation error returned by
-// Resource.Validate if the designated constraints aren't met.
-type ResourceValidationError struct {
+// CreateServiceAccountKeyRequestValidationError is the validation error
+// returned by CreateServiceAccountKeyRequest.Validate if the designated
+// constraints aren't met.
+type CreateServiceAccountKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13229,22 +22690,24 @@ type ResourceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourceValidationError) Field() string { return e.field }
+func (e CreateServiceAccountKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourceValidationError) Reason() string { return e.reason }
+func (e CreateServiceAccountKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourceValidationError) Cause() error { return e.cause }
+func (e CreateServiceAccountKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourceValidationError) Key() bool { return e.key }
+func (e CreateServiceAccountKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+func (e CreateServiceAccountKeyRequestValidationError) ErrorName() string {
+	return "CreateServiceAccountKeyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ResourceValidationError) Error() string {
+func (e CreateServiceAccountKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13256,14 +22719,14 @@ func (e ResourceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResource.%s: %s%s",
+		"invalid %sCreateServiceAccountKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourceValidationError{}
+var _ error = CreateServiceAccountKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13271,135 +22734,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourceValidationError{}
+} = CreateServiceAccountKeyRequestValidationError{}
 
-// Validate checks the field values on GroupRelation with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GroupRelation) Validate() error {
+// Validate checks the field values on CreateServiceAccountKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GroupRelation with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GroupRelationMultiError, or
-// nil if none found.
-func (m *GroupRelation) ValidateAll() error {
+// ValidateAll checks the field values on CreateServiceAccountKeyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountKeyResponseMultiError, or nil if none found.
+func (m *CreateServiceAccountKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GroupRelation) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for SubjectType
-
-	// no validation rules for Role
-
-	switch v := m.Subject.(type) {
-	case *GroupRelation_User:
-		if v == nil {
-			err := GroupRelationValidationError{
-				field:  "Subject",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetUser()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GroupRelationValidationError{
-						field:  "User",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GroupRelationValidationError{
-						field:  "User",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GroupRelationValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *GroupRelation_Group:
-		if v == nil {
-			err := GroupRelationValidationError{
-				field:  "Subject",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+func (m *CreateServiceAccountKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-		if all {
-			switch v := interface{}(m.GetGroup()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GroupRelationValidationError{
-						field:  "Group",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GroupRelationValidationError{
-						field:  "Group",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateServiceAccountKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GroupRelationValidationError{
-					field:  "Group",
+				errors = append(errors, CreateServiceAccountKeyResponseValidationError{
+					field:  "Key",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateServiceAccountKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return GroupRelationMultiError(errors)
+		return CreateServiceAccountKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GroupRelationMultiError is an error wrapping multiple validation errors
-// returned by GroupRelation.ValidateAll() if the designated constraints
-// aren't met.
-type GroupRelationMultiError []error
+// CreateServiceAccountKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountKeyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateServiceAccountKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GroupRelationMultiError) Error() string {
+func (m CreateServiceAccountKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13408,11 +22811,12 @@ func (m GroupRelationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GroupRelationMultiError) AllErrors() []error { return m }
+func (m CreateServiceAccountKeyResponseMultiError) AllErrors() []error { return m }
 
-// GroupRelationValidationError is the validation error returned by
-// GroupRelation.Validate if the designated constraints aren't met.
-type GroupRelationValidationError struct {
+// CreateServiceAccountKeyResponseValidationError is the validation error
+// returned by CreateServiceAccountKeyResponse.Validate if the designated
+// constraints aren't met.
+type CreateServiceAccountKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13420,22 +22824,24 @@ type GroupRelationValidationError struct {
 }
 
 // Field function returns field value.
-func (e GroupRelationValidationError) Field() string { return e.field }
+func (e CreateServiceAccountKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GroupRelationValidationError) Reason() string { return e.reason }
+func (e CreateServiceAccountKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GroupRelationValidationError) Cause() error { return e.cause }
+func (e CreateServiceAccountKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GroupRelationValidationError) Key() bool { return e.key }
+func (e CreateServiceAccountKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GroupRelationValidationError) ErrorName() string { return "GroupRelationValidationError" }
+func (e CreateServiceAccountKeyResponseValidationError) ErrorName() string {
+	return "CreateServiceAccountKeyResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GroupRelationValidationError) Error() string {
+func (e CreateServiceAccountKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13447,14 +22853,14 @@ func (e GroupRelationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGroupRelation.%s: %s%s",
+		"invalid %sCreateServiceAccountKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GroupRelationValidationError{}
+var _ error = CreateServiceAccountKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13462,44 +22868,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GroupRelationValidationError{}
+} = CreateServiceAccountKeyResponseValidationError{}
 
-// Validate checks the field values on ListRelationsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListServiceAccountKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRelationsRequest) Validate() error {
+func (m *ListServiceAccountKeysRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRelationsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListRelationsRequestMultiError, or nil if none found.
-func (m *ListRelationsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAccountKeysRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListServiceAccountKeysRequestMultiError, or nil if none found.
+func (m *ListServiceAccountKeysRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRelationsRequest) validate(all bool) error {
+func (m *ListServiceAccountKeysRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return ListRelationsRequestMultiError(errors)
+		return ListServiceAccountKeysRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRelationsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListRelationsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListRelationsRequestMultiError []error
+// ListServiceAccountKeysRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountKeysRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListServiceAccountKeysRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRelationsRequestMultiError) Error() string {
+func (m ListServiceAccountKeysRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13508,11 +22916,12 @@ func (m ListRelationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRelationsRequestMultiError) AllErrors() []error { return m }
+func (m ListServiceAccountKeysRequestMultiError) AllErrors() []error { return m }
 
-// ListRelationsRequestValidationError is the validation error returned by
-// ListRelationsRequest.Validate if the designated constraints aren't met.
-type ListRelationsRequestValidationError struct {
+// ListServiceAccountKeysRequestValidationError is the validation error
+// returned by ListServiceAccountKeysRequest.Validate if the designated
+// constraints aren't met.
+type ListServiceAccountKeysRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13520,24 +22929,24 @@ type ListRelationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRelationsRequestValidationError) Field() string { return e.field }
+func (e ListServiceAccountKeysRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRelationsRequestValidationError) Reason() string { return e.reason }
+func (e ListServiceAccountKeysRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRelationsRequestValidationError) Cause() error { return e.cause }
+func (e ListServiceAccountKeysRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRelationsRequestValidationError) Key() bool { return e.key }
+func (e ListServiceAccountKeysRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRelationsRequestValidationError) ErrorName() string {
-	return "ListRelationsRequestValidationError"
+func (e ListServiceAccountKeysRequestValidationError) ErrorName() string {
+	return "ListServiceAccountKeysRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRelationsRequestValidationError) Error() string {
+func (e ListServiceAccountKeysRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13549,14 +22958,14 @@ func (e ListRelationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRelationsRequest.%s: %s%s",
+		"invalid %sListServiceAccountKeysRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRelationsRequestValidationError{}
+var _ error = ListServiceAccountKeysRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13564,47 +22973,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRelationsRequestValidationError{}
+} = ListServiceAccountKeysRequestValidationError{}
 
-// Validate checks the field values on ListRelationsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListServiceAccountKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRelationsResponse) Validate() error {
+func (m *ListServiceAccountKeysResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRelationsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListRelationsResponseMultiError, or nil if none found.
-func (m *ListRelationsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAccountKeysResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListServiceAccountKeysResponseMultiError, or nil if none found.
+func (m *ListServiceAccountKeysResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRelationsResponse) validate(all bool) error {
+func (m *ListServiceAccountKeysResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRelations() {
+	for idx, item := range m.GetKeys() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRelationsResponseValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
+					errors = append(errors, ListServiceAccountKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRelationsResponseValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
+					errors = append(errors, ListServiceAccountKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -13612,8 +23021,8 @@ func (m *ListRelationsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListRelationsResponseValidationError{
-					field:  fmt.Sprintf("Relations[%v]", idx),
+				return ListServiceAccountKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -13623,19 +23032,19 @@ func (m *ListRelationsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListRelationsResponseMultiError(errors)
+		return ListServiceAccountKeysResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRelationsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListRelationsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListRelationsResponseMultiError []error
+// ListServiceAccountKeysResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountKeysResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListServiceAccountKeysResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRelationsResponseMultiError) Error() string {
+func (m ListServiceAccountKeysResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13644,11 +23053,12 @@ func (m ListRelationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRelationsResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAccountKeysResponseMultiError) AllErrors() []error { return m }
 
-// ListRelationsResponseValidationError is the validation error returned by
-// ListRelationsResponse.Validate if the designated constraints aren't met.
-type ListRelationsResponseValidationError struct {
+// ListServiceAccountKeysResponseValidationError is the validation error
+// returned by ListServiceAccountKeysResponse.Validate if the designated
+// constraints aren't met.
+type ListServiceAccountKeysResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13656,24 +23066,24 @@ type ListRelationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRelationsResponseValidationError) Field() string { return e.field }
+func (e ListServiceAccountKeysResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRelationsResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAccountKeysResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRelationsResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAccountKeysResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRelationsResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAccountKeysResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRelationsResponseValidationError) ErrorName() string {
-	return "ListRelationsResponseValidationError"
+func (e ListServiceAccountKeysResponseValidationError) ErrorName() string {
+	return "ListServiceAccountKeysResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRelationsResponseValidationError) Error() string {
+func (e ListServiceAccountKeysResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13685,14 +23095,14 @@ func (e ListRelationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRelationsResponse.%s: %s%s",
+		"invalid %sListServiceAccountKeysResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRelationsResponseValidationError{}
+var _ error = ListServiceAccountKeysResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13700,52 +23110,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRelationsResponseValidationError{}
+} = ListServiceAccountKeysResponseValidationError{}
 
-// Validate checks the field values on RelationRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RotateServiceAccountKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationRequestBody) Validate() error {
+func (m *RotateServiceAccountKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RelationRequestBodyMultiError, or nil if none found.
-func (m *RelationRequestBody) ValidateAll() error {
+// ValidateAll checks the field values on RotateServiceAccountKeyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RotateServiceAccountKeyRequestMultiError, or nil if none found.
+func (m *RotateServiceAccountKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationRequestBody) validate(all bool) error {
+func (m *RotateServiceAccountKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ObjectId
-
-	// no validation rules for ObjectNamespace
+	// no validation rules for Id
 
-	// no validation rules for Subject
+	// no validation rules for KeyId
 
-	// no validation rules for RoleName
+	if all {
+		switch v := interface{}(m.GetGracePeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RotateServiceAccountKeyRequestValidationError{
+					field:  "GracePeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RotateServiceAccountKeyRequestValidationError{
+					field:  "GracePeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGracePeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RotateServiceAccountKeyRequestValidationError{
+				field:  "GracePeriod",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RelationRequestBodyMultiError(errors)
+		return RotateServiceAccountKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationRequestBodyMultiError is an error wrapping multiple validation
-// errors returned by RelationRequestBody.ValidateAll() if the designated
-// constraints aren't met.
-type RelationRequestBodyMultiError []error
+// RotateServiceAccountKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by RotateServiceAccountKeyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RotateServiceAccountKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationRequestBodyMultiError) Error() string {
+func (m RotateServiceAccountKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13754,11 +23189,12 @@ func (m RelationRequestBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationRequestBodyMultiError) AllErrors() []error { return m }
+func (m RotateServiceAccountKeyRequestMultiError) AllErrors() []error { return m }
 
-// RelationRequestBodyValidationError is the validation error returned by
-// RelationRequestBody.Validate if the designated constraints aren't met.
-type RelationRequestBodyValidationError struct {
+// RotateServiceAccountKeyRequestValidationError is the validation error
+// returned by RotateServiceAccountKeyRequest.Validate if the designated
+// constraints aren't met.
+type RotateServiceAccountKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13766,24 +23202,24 @@ type RelationRequestBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationRequestBodyValidationError) Field() string { return e.field }
+func (e RotateServiceAccountKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationRequestBodyValidationError) Reason() string { return e.reason }
+func (e RotateServiceAccountKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationRequestBodyValidationError) Cause() error { return e.cause }
+func (e RotateServiceAccountKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationRequestBodyValidationError) Key() bool { return e.key }
+func (e RotateServiceAccountKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationRequestBodyValidationError) ErrorName() string {
-	return "RelationRequestBodyValidationError"
+func (e RotateServiceAccountKeyRequestValidationError) ErrorName() string {
+	return "RotateServiceAccountKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationRequestBodyValidationError) Error() string {
+func (e RotateServiceAccountKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13795,14 +23231,14 @@ func (e RelationRequestBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationRequestBody.%s: %s%s",
+		"invalid %sRotateServiceAccountKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationRequestBodyValidationError{}
+var _ error = RotateServiceAccountKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13810,24 +23246,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationRequestBodyValidationError{}
+} = RotateServiceAccountKeyRequestValidationError{}
 
-// Validate checks the field values on CreateRelationRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RotateServiceAccountKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRelationRequest) Validate() error {
+func (m *RotateServiceAccountKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRelationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateRelationRequestMultiError, or nil if none found.
-func (m *CreateRelationRequest) ValidateAll() error {
+// ValidateAll checks the field values on RotateServiceAccountKeyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RotateServiceAccountKeyResponseMultiError, or nil if none found.
+func (m *RotateServiceAccountKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRelationRequest) validate(all bool) error {
+func (m *RotateServiceAccountKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13835,48 +23271,50 @@ func (m *CreateRelationRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetBody()).(type) {
+		switch v := interface{}(m.GetKey()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRelationRequestValidationError{
-					field:  "Body",
+				errors = append(errors, RotateServiceAccountKeyResponseValidationError{
+					field:  "Key",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRelationRequestValidationError{
-					field:  "Body",
+				errors = append(errors, RotateServiceAccountKeyResponseValidationError{
+					field:  "Key",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateRelationRequestValidationError{
-				field:  "Body",
+			return RotateServiceAccountKeyResponseValidationError{
+				field:  "Key",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return CreateRelationRequestMultiError(errors)
+		return RotateServiceAccountKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRelationRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateRelationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateRelationRequestMultiError []error
+// RotateServiceAccountKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by RotateServiceAccountKeyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RotateServiceAccountKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRelationRequestMultiError) Error() string {
+func (m RotateServiceAccountKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13885,11 +23323,12 @@ func (m CreateRelationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRelationRequestMultiError) AllErrors() []error { return m }
+func (m RotateServiceAccountKeyResponseMultiError) AllErrors() []error { return m }
 
-// CreateRelationRequestValidationError is the validation error returned by
-// CreateRelationRequest.Validate if the designated constraints aren't met.
-type CreateRelationRequestValidationError struct {
+// RotateServiceAccountKeyResponseValidationError is the validation error
+// returned by RotateServiceAccountKeyResponse.Validate if the designated
+// constraints aren't met.
+type RotateServiceAccountKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13897,24 +23336,24 @@ type CreateRelationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRelationRequestValidationError) Field() string { return e.field }
+func (e RotateServiceAccountKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRelationRequestValidationError) Reason() string { return e.reason }
+func (e RotateServiceAccountKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRelationRequestValidationError) Cause() error { return e.cause }
+func (e RotateServiceAccountKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRelationRequestValidationError) Key() bool { return e.key }
+func (e RotateServiceAccountKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRelationRequestValidationError) ErrorName() string {
-	return "CreateRelationRequestValidationError"
+func (e RotateServiceAccountKeyResponseValidationError) ErrorName() string {
+	return "RotateServiceAccountKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRelationRequestValidationError) Error() string {
+func (e RotateServiceAccountKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13926,14 +23365,14 @@ func (e CreateRelationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRelationRequest.%s: %s%s",
+		"invalid %sRotateServiceAccountKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRelationRequestValidationError{}
+var _ error = RotateServiceAccountKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13941,73 +23380,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRelationRequestValidationError{}
+} = RotateServiceAccountKeyResponseValidationError{}
 
-// Validate checks the field values on CreateRelationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAccountKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRelationResponse) Validate() error {
+func (m *RevokeServiceAccountKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRelationResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateRelationResponseMultiError, or nil if none found.
-func (m *CreateRelationResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAccountKeyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAccountKeyRequestMultiError, or nil if none found.
+func (m *RevokeServiceAccountKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRelationResponse) validate(all bool) error {
+func (m *RevokeServiceAccountKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRelation()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRelationResponseValidationError{
-					field:  "Relation",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRelationResponseValidationError{
-					field:  "Relation",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRelationResponseValidationError{
-				field:  "Relation",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for KeyId
 
 	if len(errors) > 0 {
-		return CreateRelationResponseMultiError(errors)
+		return RevokeServiceAccountKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRelationResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateRelationResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateRelationResponseMultiError []error
+// RevokeServiceAccountKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAccountKeyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAccountKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRelationResponseMultiError) Error() string {
+func (m RevokeServiceAccountKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14016,11 +23430,12 @@ func (m CreateRelationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRelationResponseMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAccountKeyRequestMultiError) AllErrors() []error { return m }
 
-// CreateRelationResponseValidationError is the validation error returned by
-// CreateRelationResponse.Validate if the designated constraints aren't met.
-type CreateRelationResponseValidationError struct {
+// RevokeServiceAccountKeyRequestValidationError is the validation error
+// returned by RevokeServiceAccountKeyRequest.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAccountKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14028,24 +23443,24 @@ type CreateRelationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRelationResponseValidationError) Field() string { return e.field }
+func (e RevokeServiceAccountKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRelationResponseValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAccountKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRelationResponseValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAccountKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRelationResponseValidationError) Key() bool { return e.key }
+func (e RevokeServiceAccountKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRelationResponseValidationError) ErrorName() string {
-	return "CreateRelationResponseValidationError"
+func (e RevokeServiceAccountKeyRequestValidationError) ErrorName() string {
+	return "RevokeServiceAccountKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRelationResponseValidationError) Error() string {
+func (e RevokeServiceAccountKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14057,14 +23472,14 @@ func (e CreateRelationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRelationResponse.%s: %s%s",
+		"invalid %sRevokeServiceAccountKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRelationResponseValidationError{}
+var _ error = RevokeServiceAccountKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14072,46 +23487,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRelationResponseValidationError{}
+} = RevokeServiceAccountKeyRequestValidationError{}
 
-// Validate checks the field values on GetRelationRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAccountKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRelationRequest) Validate() error {
+func (m *RevokeServiceAccountKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRelationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRelationRequestMultiError, or nil if none found.
-func (m *GetRelationRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAccountKeyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAccountKeyResponseMultiError, or nil if none found.
+func (m *RevokeServiceAccountKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRelationRequest) validate(all bool) error {
+func (m *RevokeServiceAccountKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return GetRelationRequestMultiError(errors)
+		return RevokeServiceAccountKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRelationRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRelationRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetRelationRequestMultiError []error
+// RevokeServiceAccountKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAccountKeyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAccountKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRelationRequestMultiError) Error() string {
+func (m RevokeServiceAccountKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14120,11 +23533,12 @@ func (m GetRelationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRelationRequestMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAccountKeyResponseMultiError) AllErrors() []error { return m }
 
-// GetRelationRequestValidationError is the validation error returned by
-// GetRelationRequest.Validate if the designated constraints aren't met.
-type GetRelationRequestValidationError struct {
+// RevokeServiceAccountKeyResponseValidationError is the validation error
+// returned by RevokeServiceAccountKeyResponse.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAccountKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14132,24 +23546,24 @@ type GetRelationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRelationRequestValidationError) Field() string { return e.field }
+func (e RevokeServiceAccountKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRelationRequestValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAccountKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRelationRequestValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAccountKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRelationRequestValidationError) Key() bool { return e.key }
+func (e RevokeServiceAccountKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRelationRequestValidationError) ErrorName() string {
-	return "GetRelationRequestValidationError"
+func (e RevokeServiceAccountKeyResponseValidationError) ErrorName() string {
+	return "RevokeServiceAccountKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRelationRequestValidationError) Error() string {
+func (e RevokeServiceAccountKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14161,14 +23575,14 @@ func (e GetRelationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRelationRequest.%s: %s%s",
+		"invalid %sRevokeServiceAccountKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRelationRequestValidationError{}
+var _ error = RevokeServiceAccountKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14176,73 +23590,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRelationRequestValidationError{}
+} = RevokeServiceAccountKeyResponseValidationError{}
 
-// Validate checks the field values on GetRelationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddPlatformAdminRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRelationResponse) Validate() error {
+func (m *AddPlatformAdminRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRelationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddPlatformAdminRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRelationResponseMultiError, or nil if none found.
-func (m *GetRelationResponse) ValidateAll() error {
+// AddPlatformAdminRequestBodyMultiError, or nil if none found.
+func (m *AddPlatformAdminRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRelationResponse) validate(all bool) error {
+func (m *AddPlatformAdminRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRelation()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRelationResponseValidationError{
-					field:  "Relation",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRelationResponseValidationError{
-					field:  "Relation",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetRelationResponseValidationError{
-				field:  "Relation",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return GetRelationResponseMultiError(errors)
+		return AddPlatformAdminRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRelationResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRelationResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetRelationResponseMultiError []error
+// AddPlatformAdminRequestBodyMultiError is an error wrapping multiple
+// validation errors returned by AddPlatformAdminRequestBody.ValidateAll() if
+// the designated constraints aren't met.
+type AddPlatformAdminRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRelationResponseMultiError) Error() string {
+func (m AddPlatformAdminRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14251,11 +23638,12 @@ func (m GetRelationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRelationResponseMultiError) AllErrors() []error { return m }
+func (m AddPlatformAdminRequestBodyMultiError) AllErrors() []error { return m }
 
-// GetRelationResponseValidationError is the validation error returned by
-// GetRelationResponse.Validate if the designated constraints aren't met.
-type GetRelationResponseValidationError struct {
+// AddPlatformAdminRequestBodyValidationError is the validation error returned
+// by AddPlatformAdminRequestBody.Validate if the designated constraints
+// aren't met.
+type AddPlatformAdminRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14263,24 +23651,24 @@ type GetRelationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRelationResponseValidationError) Field() string { return e.field }
+func (e AddPlatformAdminRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRelationResponseValidationError) Reason() string { return e.reason }
+func (e AddPlatformAdminRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRelationResponseValidationError) Cause() error { return e.cause }
+func (e AddPlatformAdminRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRelationResponseValidationError) Key() bool { return e.key }
+func (e AddPlatformAdminRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRelationResponseValidationError) ErrorName() string {
-	return "GetRelationResponseValidationError"
+func (e AddPlatformAdminRequestBodyValidationError) ErrorName() string {
+	return "AddPlatformAdminRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRelationResponseValidationError) Error() string {
+func (e AddPlatformAdminRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14292,14 +23680,14 @@ func (e GetRelationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRelationResponse.%s: %s%s",
+		"invalid %sAddPlatformAdminRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRelationResponseValidationError{}
+var _ error = AddPlatformAdminRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14307,37 +23695,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRelationResponseValidationError{}
+} = AddPlatformAdminRequestBodyValidationError{}
 
-// Validate checks the field values on UpdateRelationRequest with the rules
+// Validate checks the field values on AddPlatformAdminRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRelationRequest) Validate() error {
+func (m *AddPlatformAdminRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRelationRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddPlatformAdminRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRelationRequestMultiError, or nil if none found.
-func (m *UpdateRelationRequest) ValidateAll() error {
+// AddPlatformAdminRequestMultiError, or nil if none found.
+func (m *AddPlatformAdminRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRelationRequest) validate(all bool) error {
+func (m *AddPlatformAdminRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if all {
 		switch v := interface{}(m.GetBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRelationRequestValidationError{
+				errors = append(errors, AddPlatformAdminRequestValidationError{
 					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14345,7 +23731,7 @@ func (m *UpdateRelationRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRelationRequestValidationError{
+				errors = append(errors, AddPlatformAdminRequestValidationError{
 					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14354,7 +23740,7 @@ func (m *UpdateRelationRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateRelationRequestValidationError{
+			return AddPlatformAdminRequestValidationError{
 				field:  "Body",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14363,19 +23749,19 @@ func (m *UpdateRelationRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateRelationRequestMultiError(errors)
+		return AddPlatformAdminRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRelationRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateRelationRequest.ValidateAll() if the designated
+// AddPlatformAdminRequestMultiError is an error wrapping multiple validation
+// errors returned by AddPlatformAdminRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateRelationRequestMultiError []error
+type AddPlatformAdminRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRelationRequestMultiError) Error() string {
+func (m AddPlatformAdminRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14384,11 +23770,11 @@ func (m UpdateRelationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRelationRequestMultiError) AllErrors() []error { return m }
+func (m AddPlatformAdminRequestMultiError) AllErrors() []error { return m }
 
-// UpdateRelationRequestValidationError is the validation error returned by
-// UpdateRelationRequest.Validate if the designated constraints aren't met.
-type UpdateRelationRequestValidationError struct {
+// AddPlatformAdminRequestValidationError is the validation error returned by
+// AddPlatformAdminRequest.Validate if the designated constraints aren't met.
+type AddPlatformAdminRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14396,24 +23782,24 @@ type UpdateRelationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRelationRequestValidationError) Field() string { return e.field }
+func (e AddPlatformAdminRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRelationRequestValidationError) Reason() string { return e.reason }
+func (e AddPlatformAdminRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRelationRequestValidationError) Cause() error { return e.cause }
+func (e AddPlatformAdminRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRelationRequestValidationError) Key() bool { return e.key }
+func (e AddPlatformAdminRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRelationRequestValidationError) ErrorName() string {
-	return "UpdateRelationRequestValidationError"
+func (e AddPlatformAdminRequestValidationError) ErrorName() string {
+	return "AddPlatformAdminRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRelationRequestValidationError) Error() string {
+func (e AddPlatformAdminRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14425,14 +23811,14 @@ func (e UpdateRelationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRelationRequest.%s: %s%s",
+		"invalid %sAddPlatformAdminRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRelationRequestValidationError{}
+var _ error = AddPlatformAdminRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14440,24 +23826,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRelationRequestValidationError{}
+} = AddPlatformAdminRequestValidationError{}
 
-// Validate checks the field values on UpdateRelationResponse with the rules
+// Validate checks the field values on AddPlatformAdminResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRelationResponse) Validate() error {
+func (m *AddPlatformAdminResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRelationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddPlatformAdminResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRelationResponseMultiError, or nil if none found.
-func (m *UpdateRelationResponse) ValidateAll() error {
+// AddPlatformAdminResponseMultiError, or nil if none found.
+func (m *AddPlatformAdminResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRelationResponse) validate(all bool) error {
+func (m *AddPlatformAdminResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14465,28 +23851,28 @@ func (m *UpdateRelationResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetRelation()).(type) {
+		switch v := interface{}(m.GetUser()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRelationResponseValidationError{
-					field:  "Relation",
+				errors = append(errors, AddPlatformAdminResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRelationResponseValidationError{
-					field:  "Relation",
+				errors = append(errors, AddPlatformAdminResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateRelationResponseValidationError{
-				field:  "Relation",
+			return AddPlatformAdminResponseValidationError{
+				field:  "User",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -14494,19 +23880,19 @@ func (m *UpdateRelationResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateRelationResponseMultiError(errors)
+		return AddPlatformAdminResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRelationResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateRelationResponse.ValidateAll() if the designated
+// AddPlatformAdminResponseMultiError is an error wrapping multiple validation
+// errors returned by AddPlatformAdminResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateRelationResponseMultiError []error
+type AddPlatformAdminResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRelationResponseMultiError) Error() string {
+func (m AddPlatformAdminResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14515,11 +23901,11 @@ func (m UpdateRelationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRelationResponseMultiError) AllErrors() []error { return m }
+func (m AddPlatformAdminResponseMultiError) AllErrors() []error { return m }
 
-// UpdateRelationResponseValidationError is the validation error returned by
-// UpdateRelationResponse.Validate if the designated constraints aren't met.
-type UpdateRelationResponseValidationError struct {
+// AddPlatformAdminResponseValidationError is the validation error returned by
+// AddPlatformAdminResponse.Validate if the designated constraints aren't met.
+type AddPlatformAdminResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14527,24 +23913,24 @@ type UpdateRelationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRelationResponseValidationError) Field() string { return e.field }
+func (e AddPlatformAdminResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRelationResponseValidationError) Reason() string { return e.reason }
+func (e AddPlatformAdminResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRelationResponseValidationError) Cause() error { return e.cause }
+func (e AddPlatformAdminResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRelationResponseValidationError) Key() bool { return e.key }
+func (e AddPlatformAdminResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRelationResponseValidationError) ErrorName() string {
-	return "UpdateRelationResponseValidationError"
+func (e AddPlatformAdminResponseValidationError) ErrorName() string {
+	return "AddPlatformAdminResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRelationResponseValidationError) Error() string {
+func (e AddPlatformAdminResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14556,14 +23942,14 @@ func (e UpdateRelationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRelationResponse.%s: %s%s",
+		"invalid %sAddPlatformAdminResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRelationResponseValidationError{}
+var _ error = AddPlatformAdminResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14571,50 +23957,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRelationResponseValidationError{}
+} = AddPlatformAdminResponseValidationError{}
 
-// Validate checks the field values on ListGroupRelationsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemovePlatformAdminRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListGroupRelationsRequest) Validate() error {
+func (m *RemovePlatformAdminRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListGroupRelationsRequest with the
+// ValidateAll checks the field values on RemovePlatformAdminRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListGroupRelationsRequestMultiError, or nil if none found.
-func (m *ListGroupRelationsRequest) ValidateAll() error {
+// RemovePlatformAdminRequestMultiError, or nil if none found.
+func (m *RemovePlatformAdminRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListGroupRelationsRequest) validate(all bool) error {
+func (m *RemovePlatformAdminRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for SubjectType
-
-	// no validation rules for Role
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return ListGroupRelationsRequestMultiError(errors)
+		return RemovePlatformAdminRequestMultiError(errors)
 	}
 
-	return nil
-}
-
-// ListGroupRelationsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListGroupRelationsRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListGroupRelationsRequestMultiError []error
+	return nil
+}
+
+// RemovePlatformAdminRequestMultiError is an error wrapping multiple
+// validation errors returned by RemovePlatformAdminRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemovePlatformAdminRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListGroupRelationsRequestMultiError) Error() string {
+func (m RemovePlatformAdminRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14623,11 +24005,11 @@ func (m ListGroupRelationsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListGroupRelationsRequestMultiError) AllErrors() []error { return m }
+func (m RemovePlatformAdminRequestMultiError) AllErrors() []error { return m }
 
-// ListGroupRelationsRequestValidationError is the validation error returned by
-// ListGroupRelationsRequest.Validate if the designated constraints aren't met.
-type ListGroupRelationsRequestValidationError struct {
+// RemovePlatformAdminRequestValidationError is the validation error returned
+// by RemovePlatformAdminRequest.Validate if the designated constraints aren't met.
+type RemovePlatformAdminRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14635,24 +24017,24 @@ type ListGroupRelationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListGroupRelationsRequestValidationError) Field() string { return e.field }
+func (e RemovePlatformAdminRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListGroupRelationsRequestValidationError) Reason() string { return e.reason }
+func (e RemovePlatformAdminRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListGroupRelationsRequestValidationError) Cause() error { return e.cause }
+func (e RemovePlatformAdminRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListGroupRelationsRequestValidationError) Key() bool { return e.key }
+func (e RemovePlatformAdminRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListGroupRelationsRequestValidationError) ErrorName() string {
-	return "ListGroupRelationsRequestValidationError"
+func (e RemovePlatformAdminRequestValidationError) ErrorName() string {
+	return "RemovePlatformAdminRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListGroupRelationsRequestValidationError) Error() string {
+func (e RemovePlatformAdminRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14664,14 +24046,14 @@ func (e ListGroupRelationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListGroupRelationsRequest.%s: %s%s",
+		"invalid %sRemovePlatformAdminRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListGroupRelationsRequestValidationError{}
+var _ error = RemovePlatformAdminRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14679,78 +24061,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListGroupRelationsRequestValidationError{}
+} = RemovePlatformAdminRequestValidationError{}
 
-// Validate checks the field values on ListGroupRelationsResponse with the
+// Validate checks the field values on RemovePlatformAdminResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListGroupRelationsResponse) Validate() error {
+func (m *RemovePlatformAdminResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListGroupRelationsResponse with the
+// ValidateAll checks the field values on RemovePlatformAdminResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListGroupRelationsResponseMultiError, or nil if none found.
-func (m *ListGroupRelationsResponse) ValidateAll() error {
+// RemovePlatformAdminResponseMultiError, or nil if none found.
+func (m *RemovePlatformAdminResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListGroupRelationsResponse) validate(all bool) error {
+func (m *RemovePlatformAdminResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRelations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListGroupRelationsResponseValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListGroupRelationsResponseValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListGroupRelationsResponseValidationError{
-					field:  fmt.Sprintf("Relations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListGroupRelationsResponseMultiError(errors)
+		return RemovePlatformAdminResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListGroupRelationsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListGroupRelationsResponse.ValidateAll() if
+// RemovePlatformAdminResponseMultiError is an error wrapping multiple
+// validation errors returned by RemovePlatformAdminResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListGroupRelationsResponseMultiError []error
+type RemovePlatformAdminResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListGroupRelationsResponseMultiError) Error() string {
+func (m RemovePlatformAdminResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14759,11 +24107,12 @@ func (m ListGroupRelationsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListGroupRelationsResponseMultiError) AllErrors() []error { return m }
+func (m RemovePlatformAdminResponseMultiError) AllErrors() []error { return m }
 
-// ListGroupRelationsResponseValidationError is the validation error returned
-// by ListGroupRelationsResponse.Validate if the designated constraints aren't met.
-type ListGroupRelationsResponseValidationError struct {
+// RemovePlatformAdminResponseValidationError is the validation error returned
+// by RemovePlatformAdminResponse.Validate if the designated constraints
+// aren't met.
+type RemovePlatformAdminResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14771,24 +24120,24 @@ type ListGroupRelationsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListGroupRelationsResponseValidationError) Field() string { return e.field }
+func (e RemovePlatformAdminResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListGroupRelationsResponseValidationError) Reason() string { return e.reason }
+func (e RemovePlatformAdminResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListGroupRelationsResponseValidationError) Cause() error { return e.cause }
+func (e RemovePlatformAdminResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListGroupRelationsResponseValidationError) Key() bool { return e.key }
+func (e RemovePlatformAdminResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListGroupRelationsResponseValidationError) ErrorName() string {
-	return "ListGroupRelationsResponseValidationError"
+func (e RemovePlatformAdminResponseValidationError) ErrorName() string {
+	return "RemovePlatformAdminResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListGroupRelationsResponseValidationError) Error() string {
+func (e RemovePlatformAdminResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14800,14 +24149,14 @@ func (e ListGroupRelationsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListGroupRelationsResponse.%s: %s%s",
+		"invalid %sRemovePlatformAdminResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListGroupRelationsResponseValidationError{}
+var _ error = RemovePlatformAdminResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14815,50 +24164,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListGroupRelationsResponseValidationError{}
+} = RemovePlatformAdminResponseValidationError{}
 
-// Validate checks the field values on DeleteRelationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRelationRequest) Validate() error {
+// Validate checks the field values on AddPlatformImpersonatorRequestBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddPlatformImpersonatorRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRelationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteRelationRequestMultiError, or nil if none found.
-func (m *DeleteRelationRequest) ValidateAll() error {
+// ValidateAll checks the field values on AddPlatformImpersonatorRequestBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddPlatformImpersonatorRequestBodyMultiError, or nil if none found.
+func (m *AddPlatformImpersonatorRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRelationRequest) validate(all bool) error {
+func (m *AddPlatformImpersonatorRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ObjectId
-
-	// no validation rules for SubjectId
-
-	// no validation rules for Role
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return DeleteRelationRequestMultiError(errors)
+		return AddPlatformImpersonatorRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRelationRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteRelationRequest.ValidateAll() if the designated
+// AddPlatformImpersonatorRequestBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AddPlatformImpersonatorRequestBody.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteRelationRequestMultiError []error
+type AddPlatformImpersonatorRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRelationRequestMultiError) Error() string {
+func (m AddPlatformImpersonatorRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14867,11 +24214,12 @@ func (m DeleteRelationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRelationRequestMultiError) AllErrors() []error { return m }
+func (m AddPlatformImpersonatorRequestBodyMultiError) AllErrors() []error { return m }
 
-// DeleteRelationRequestValidationError is the validation error returned by
-// DeleteRelationRequest.Validate if the designated constraints aren't met.
-type DeleteRelationRequestValidationError struct {
+// AddPlatformImpersonatorRequestBodyValidationError is the validation error
+// returned by AddPlatformImpersonatorRequestBody.Validate if the designated
+// constraints aren't met.
+type AddPlatformImpersonatorRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14879,24 +24227,24 @@ type DeleteRelationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRelationRequestValidationError) Field() string { return e.field }
+func (e AddPlatformImpersonatorRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRelationRequestValidationError) Reason() string { return e.reason }
+func (e AddPlatformImpersonatorRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRelationRequestValidationError) Cause() error { return e.cause }
+func (e AddPlatformImpersonatorRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRelationRequestValidationError) Key() bool { return e.key }
+func (e AddPlatformImpersonatorRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRelationRequestValidationError) ErrorName() string {
-	return "DeleteRelationRequestValidationError"
+func (e AddPlatformImpersonatorRequestBodyValidationError) ErrorName() string {
+	return "AddPlatformImpersonatorRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRelationRequestValidationError) Error() string {
+func (e AddPlatformImpersonatorRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14908,14 +24256,14 @@ func (e DeleteRelationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRelationRequest.%s: %s%s",
+		"invalid %sAddPlatformImpersonatorRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRelationRequestValidationError{}
+var _ error = AddPlatformImpersonatorRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14923,46 +24271,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRelationRequestValidationError{}
+} = AddPlatformImpersonatorRequestBodyValidationError{}
 
-// Validate checks the field values on DeleteRelationResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddPlatformImpersonatorRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRelationResponse) Validate() error {
+func (m *AddPlatformImpersonatorRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRelationResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteRelationResponseMultiError, or nil if none found.
-func (m *DeleteRelationResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddPlatformImpersonatorRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddPlatformImpersonatorRequestMultiError, or nil if none found.
+func (m *AddPlatformImpersonatorRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRelationResponse) validate(all bool) error {
+func (m *AddPlatformImpersonatorRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddPlatformImpersonatorRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddPlatformImpersonatorRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddPlatformImpersonatorRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteRelationResponseMultiError(errors)
+		return AddPlatformImpersonatorRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRelationResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteRelationResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteRelationResponseMultiError []error
+// AddPlatformImpersonatorRequestMultiError is an error wrapping multiple
+// validation errors returned by AddPlatformImpersonatorRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AddPlatformImpersonatorRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRelationResponseMultiError) Error() string {
+func (m AddPlatformImpersonatorRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14971,11 +24346,12 @@ func (m DeleteRelationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRelationResponseMultiError) AllErrors() []error { return m }
+func (m AddPlatformImpersonatorRequestMultiError) AllErrors() []error { return m }
 
-// DeleteRelationResponseValidationError is the validation error returned by
-// DeleteRelationResponse.Validate if the designated constraints aren't met.
-type DeleteRelationResponseValidationError struct {
+// AddPlatformImpersonatorRequestValidationError is the validation error
+// returned by AddPlatformImpersonatorRequest.Validate if the designated
+// constraints aren't met.
+type AddPlatformImpersonatorRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14983,24 +24359,24 @@ type DeleteRelationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRelationResponseValidationError) Field() string { return e.field }
+func (e AddPlatformImpersonatorRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRelationResponseValidationError) Reason() string { return e.reason }
+func (e AddPlatformImpersonatorRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRelationResponseValidationError) Cause() error { return e.cause }
+func (e AddPlatformImpersonatorRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRelationResponseValidationError) Key() bool { return e.key }
+func (e AddPlatformImpersonatorRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRelationResponseValidationError) ErrorName() string {
-	return "DeleteRelationResponseValidationError"
+func (e AddPlatformImpersonatorRequestValidationError) ErrorName() string {
+	return "AddPlatformImpersonatorRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRelationResponseValidationError) Error() string {
+func (e AddPlatformImpersonatorRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15012,14 +24388,14 @@ func (e DeleteRelationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRelationResponse.%s: %s%s",
+		"invalid %sAddPlatformImpersonatorRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRelationResponseValidationError{}
+var _ error = AddPlatformImpersonatorRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15027,56 +24403,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRelationResponseValidationError{}
+} = AddPlatformImpersonatorRequestValidationError{}
 
-// Validate checks the field values on ListResourcesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddPlatformImpersonatorResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesRequest) Validate() error {
+func (m *AddPlatformImpersonatorResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListResourcesRequestMultiError, or nil if none found.
-func (m *ListResourcesRequest) ValidateAll() error {
+// ValidateAll checks the field values on AddPlatformImpersonatorResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddPlatformImpersonatorResponseMultiError, or nil if none found.
+func (m *AddPlatformImpersonatorResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesRequest) validate(all bool) error {
+func (m *AddPlatformImpersonatorResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GroupId
-
-	// no validation rules for ProjectId
-
-	// no validation rules for OrganizationId
-
-	// no validation rules for NamespaceId
-
-	// no validation rules for PageSize
-
-	// no validation rules for PageNum
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddPlatformImpersonatorResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddPlatformImpersonatorResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddPlatformImpersonatorResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListResourcesRequestMultiError(errors)
+		return AddPlatformImpersonatorResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListResourcesRequestMultiError []error
+// AddPlatformImpersonatorResponseMultiError is an error wrapping multiple
+// validation errors returned by AddPlatformImpersonatorResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddPlatformImpersonatorResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesRequestMultiError) Error() string {
+func (m AddPlatformImpersonatorResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15085,11 +24478,12 @@ func (m ListResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+func (m AddPlatformImpersonatorResponseMultiError) AllErrors() []error { return m }
 
-// ListResourcesRequestValidationError is the validation error returned by
-// ListResourcesRequest.Validate if the designated constraints aren't met.
-type ListResourcesRequestValidationError struct {
+// AddPlatformImpersonatorResponseValidationError is the validation error
+// returned by AddPlatformImpersonatorResponse.Validate if the designated
+// constraints aren't met.
+type AddPlatformImpersonatorResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15097,24 +24491,24 @@ type ListResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesRequestValidationError) Field() string { return e.field }
+func (e AddPlatformImpersonatorResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+func (e AddPlatformImpersonatorResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+func (e AddPlatformImpersonatorResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+func (e AddPlatformImpersonatorResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesRequestValidationError) ErrorName() string {
-	return "ListResourcesRequestValidationError"
+func (e AddPlatformImpersonatorResponseValidationError) ErrorName() string {
+	return "AddPlatformImpersonatorResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesRequestValidationError) Error() string {
+func (e AddPlatformImpersonatorResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15126,14 +24520,14 @@ func (e ListResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesRequest.%s: %s%s",
+		"invalid %sAddPlatformImpersonatorResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesRequestValidationError{}
+var _ error = AddPlatformImpersonatorResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15141,80 +24535,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesRequestValidationError{}
-
-// Validate checks the field values on ListResourcesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesResponse) Validate() error {
+} = AddPlatformImpersonatorResponseValidationError{}
+
+// Validate checks the field values on RemovePlatformImpersonatorRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemovePlatformImpersonatorRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListResourcesResponseMultiError, or nil if none found.
-func (m *ListResourcesResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemovePlatformImpersonatorRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemovePlatformImpersonatorRequestMultiError, or nil if none found.
+func (m *RemovePlatformImpersonatorRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesResponse) validate(all bool) error {
+func (m *RemovePlatformImpersonatorRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResources() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListResourcesResponseValidationError{
-					field:  fmt.Sprintf("Resources[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return ListResourcesResponseMultiError(errors)
+		return RemovePlatformImpersonatorRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// RemovePlatformImpersonatorRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemovePlatformImpersonatorRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListResourcesResponseMultiError []error
+type RemovePlatformImpersonatorRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesResponseMultiError) Error() string {
+func (m RemovePlatformImpersonatorRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15223,11 +24585,12 @@ func (m ListResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+func (m RemovePlatformImpersonatorRequestMultiError) AllErrors() []error { return m }
 
-// ListResourcesResponseValidationError is the validation error returned by
-// ListResourcesResponse.Validate if the designated constraints aren't met.
-type ListResourcesResponseValidationError struct {
+// RemovePlatformImpersonatorRequestValidationError is the validation error
+// returned by RemovePlatformImpersonatorRequest.Validate if the designated
+// constraints aren't met.
+type RemovePlatformImpersonatorRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15235,24 +24598,24 @@ type ListResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesResponseValidationError) Field() string { return e.field }
+func (e RemovePlatformImpersonatorRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+func (e RemovePlatformImpersonatorRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+func (e RemovePlatformImpersonatorRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+func (e RemovePlatformImpersonatorRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesResponseValidationError) ErrorName() string {
-	return "ListResourcesResponseValidationError"
+func (e RemovePlatformImpersonatorRequestValidationError) ErrorName() string {
+	return "RemovePlatformImpersonatorRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesResponseValidationError) Error() string {
+func (e RemovePlatformImpersonatorRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15264,14 +24627,14 @@ func (e ListResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesResponse.%s: %s%s",
+		"invalid %sRemovePlatformImpersonatorRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesResponseValidationError{}
+var _ error = RemovePlatformImpersonatorRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15279,84 +24642,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesResponseValidationError{}
+} = RemovePlatformImpersonatorRequestValidationError{}
 
-// Validate checks the field values on ResourceRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResourceRequestBody) Validate() error {
+// Validate checks the field values on RemovePlatformImpersonatorResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemovePlatformImpersonatorResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResourceRequestBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ResourceRequestBodyMultiError, or nil if none found.
-func (m *ResourceRequestBody) ValidateAll() error {
+// ValidateAll checks the field values on RemovePlatformImpersonatorResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemovePlatformImpersonatorResponseMultiError, or nil if none found.
+func (m *RemovePlatformImpersonatorResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResourceRequestBody) validate(all bool) error {
+func (m *RemovePlatformImpersonatorResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for ProjectId
-
-	// no validation rules for NamespaceId
-
-	for idx, item := range m.GetRelations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ResourceRequestBodyValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ResourceRequestBodyValidationError{
-						field:  fmt.Sprintf("Relations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ResourceRequestBodyValidationError{
-					field:  fmt.Sprintf("Relations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ResourceRequestBodyMultiError(errors)
+		return RemovePlatformImpersonatorResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResourceRequestBodyMultiError is an error wrapping multiple validation
-// errors returned by ResourceRequestBody.ValidateAll() if the designated
+// RemovePlatformImpersonatorResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemovePlatformImpersonatorResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ResourceRequestBodyMultiError []error
+type RemovePlatformImpersonatorResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourceRequestBodyMultiError) Error() string {
+func (m RemovePlatformImpersonatorResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15365,11 +24690,12 @@ func (m ResourceRequestBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourceRequestBodyMultiError) AllErrors() []error { return m }
+func (m RemovePlatformImpersonatorResponseMultiError) AllErrors() []error { return m }
 
-// ResourceRequestBodyValidationError is the validation error returned by
-// ResourceRequestBody.Validate if the designated constraints aren't met.
-type ResourceRequestBodyValidationError struct {
+// RemovePlatformImpersonatorResponseValidationError is the validation error
+// returned by RemovePlatformImpersonatorResponse.Validate if the designated
+// constraints aren't met.
+type RemovePlatformImpersonatorResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15377,24 +24703,24 @@ type ResourceRequestBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourceRequestBodyValidationError) Field() string { return e.field }
+func (e RemovePlatformImpersonatorResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourceRequestBodyValidationError) Reason() string { return e.reason }
+func (e RemovePlatformImpersonatorResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourceRequestBodyValidationError) Cause() error { return e.cause }
+func (e RemovePlatformImpersonatorResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourceRequestBodyValidationError) Key() bool { return e.key }
+func (e RemovePlatformImpersonatorResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourceRequestBodyValidationError) ErrorName() string {
-	return "ResourceRequestBodyValidationError"
+func (e RemovePlatformImpersonatorResponseValidationError) ErrorName() string {
+	return "RemovePlatformImpersonatorResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResourceRequestBodyValidationError) Error() string {
+func (e RemovePlatformImpersonatorResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15406,14 +24732,14 @@ func (e ResourceRequestBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResourceRequestBody.%s: %s%s",
+		"invalid %sRemovePlatformImpersonatorResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourceRequestBodyValidationError{}
+var _ error = RemovePlatformImpersonatorResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15421,53 +24747,152 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourceRequestBodyValidationError{}
+} = RemovePlatformImpersonatorResponseValidationError{}
 
-// Validate checks the field values on CreateResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateResourceRequest) Validate() error {
+// Validate checks the field values on Invitation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invitation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateResourceRequestMultiError, or nil if none found.
-func (m *CreateResourceRequest) ValidateAll() error {
+// ValidateAll checks the field values on Invitation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InvitationMultiError, or
+// nil if none found.
+func (m *Invitation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateResourceRequest) validate(all bool) error {
+func (m *Invitation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for OrgId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Role
+
+	// no validation rules for InvitedBy
+
 	if all {
-		switch v := interface{}(m.GetBody()).(type) {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateResourceRequestValidationError{
-					field:  "Body",
+				errors = append(errors, InvitationValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateResourceRequestValidationError{
-					field:  "Body",
+				errors = append(errors, InvitationValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateResourceRequestValidationError{
-				field:  "Body",
+			return InvitationValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAcceptedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "AcceptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "AcceptedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcceptedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "AcceptedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvitationValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15475,19 +24900,18 @@ func (m *CreateResourceRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateResourceRequestMultiError(errors)
+		return InvitationMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateResourceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateResourceRequestMultiError []error
+// InvitationMultiError is an error wrapping multiple validation errors
+// returned by Invitation.ValidateAll() if the designated constraints aren't met.
+type InvitationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateResourceRequestMultiError) Error() string {
+func (m InvitationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15496,11 +24920,11 @@ func (m CreateResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateResourceRequestMultiError) AllErrors() []error { return m }
-
-// CreateResourceRequestValidationError is the validation error returned by
-// CreateResourceRequest.Validate if the designated constraints aren't met.
-type CreateResourceRequestValidationError struct {
+func (m InvitationMultiError) AllErrors() []error { return m }
+
+// InvitationValidationError is the validation error returned by
+// Invitation.Validate if the designated constraints aren't met.
+type InvitationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15508,24 +24932,22 @@ type CreateResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateResourceRequestValidationError) Field() string { return e.field }
+func (e InvitationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateResourceRequestValidationError) Reason() string { return e.reason }
+func (e InvitationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateResourceRequestValidationError) Cause() error { return e.cause }
+func (e InvitationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateResourceRequestValidationError) Key() bool { return e.key }
+func (e InvitationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateResourceRequestValidationError) ErrorName() string {
-	return "CreateResourceRequestValidationError"
-}
+func (e InvitationValidationError) ErrorName() string { return "InvitationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateResourceRequestValidationError) Error() string {
+func (e InvitationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15537,14 +24959,14 @@ func (e CreateResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateResourceRequest.%s: %s%s",
+		"invalid %sInvitation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateResourceRequestValidationError{}
+var _ error = InvitationValidationError{}
 
 var _ interface {
 	Field() string
@@ -15552,73 +24974,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateResourceRequestValidationError{}
+} = InvitationValidationError{}
 
-// Validate checks the field values on CreateResourceResponse with the rules
+// Validate checks the field values on InvitationRequestBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateResourceResponse) Validate() error {
+func (m *InvitationRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateResourceResponse with the rules
+// ValidateAll checks the field values on InvitationRequestBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateResourceResponseMultiError, or nil if none found.
-func (m *CreateResourceResponse) ValidateAll() error {
+// InvitationRequestBodyMultiError, or nil if none found.
+func (m *InvitationRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateResourceResponse) validate(all bool) error {
+func (m *InvitationRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateResourceResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateResourceResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = InvitationRequestBodyValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
 		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateResourceResponseValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for OrgId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Role
+
 	if len(errors) > 0 {
-		return CreateResourceResponseMultiError(errors)
+		return InvitationRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateResourceResponse.ValidateAll() if the designated
+func (m *InvitationRequestBody) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *InvitationRequestBody) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// InvitationRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by InvitationRequestBody.ValidateAll() if the designated
 // constraints aren't met.
-type CreateResourceResponseMultiError []error
+type InvitationRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateResourceResponseMultiError) Error() string {
+func (m InvitationRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15627,11 +25088,11 @@ func (m CreateResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateResourceResponseMultiError) AllErrors() []error { return m }
+func (m InvitationRequestBodyMultiError) AllErrors() []error { return m }
 
-// CreateResourceResponseValidationError is the validation error returned by
-// CreateResourceResponse.Validate if the designated constraints aren't met.
-type CreateResourceResponseValidationError struct {
+// InvitationRequestBodyValidationError is the validation error returned by
+// InvitationRequestBody.Validate if the designated constraints aren't met.
+type InvitationRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15639,24 +25100,24 @@ type CreateResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateResourceResponseValidationError) Field() string { return e.field }
+func (e InvitationRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateResourceResponseValidationError) Reason() string { return e.reason }
+func (e InvitationRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateResourceResponseValidationError) Cause() error { return e.cause }
+func (e InvitationRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateResourceResponseValidationError) Key() bool { return e.key }
+func (e InvitationRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateResourceResponseValidationError) ErrorName() string {
-	return "CreateResourceResponseValidationError"
+func (e InvitationRequestBodyValidationError) ErrorName() string {
+	return "InvitationRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateResourceResponseValidationError) Error() string {
+func (e InvitationRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15668,14 +25129,14 @@ func (e CreateResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateResourceResponse.%s: %s%s",
+		"invalid %sInvitationRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateResourceResponseValidationError{}
+var _ error = InvitationRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15683,46 +25144,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateResourceResponseValidationError{}
+} = InvitationRequestBodyValidationError{}
 
-// Validate checks the field values on GetResourceRequest with the rules
+// Validate checks the field values on CreateInvitationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceRequest) Validate() error {
+func (m *CreateInvitationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetResourceRequestMultiError, or nil if none found.
-func (m *GetResourceRequest) ValidateAll() error {
+// CreateInvitationRequestMultiError, or nil if none found.
+func (m *CreateInvitationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceRequest) validate(all bool) error {
+func (m *CreateInvitationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInvitationRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInvitationRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInvitationRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetResourceRequestMultiError(errors)
+		return CreateInvitationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResourceRequestMultiError is an error wrapping multiple validation errors
-// returned by GetResourceRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetResourceRequestMultiError []error
+// CreateInvitationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateInvitationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInvitationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceRequestMultiError) Error() string {
+func (m CreateInvitationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15731,11 +25219,11 @@ func (m GetResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceRequestMultiError) AllErrors() []error { return m }
+func (m CreateInvitationRequestMultiError) AllErrors() []error { return m }
 
-// GetResourceRequestValidationError is the validation error returned by
-// GetResourceRequest.Validate if the designated constraints aren't met.
-type GetResourceRequestValidationError struct {
+// CreateInvitationRequestValidationError is the validation error returned by
+// CreateInvitationRequest.Validate if the designated constraints aren't met.
+type CreateInvitationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15743,24 +25231,24 @@ type GetResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceRequestValidationError) Field() string { return e.field }
+func (e CreateInvitationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceRequestValidationError) Reason() string { return e.reason }
+func (e CreateInvitationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceRequestValidationError) Cause() error { return e.cause }
+func (e CreateInvitationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceRequestValidationError) Key() bool { return e.key }
+func (e CreateInvitationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceRequestValidationError) ErrorName() string {
-	return "GetResourceRequestValidationError"
+func (e CreateInvitationRequestValidationError) ErrorName() string {
+	return "CreateInvitationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetResourceRequestValidationError) Error() string {
+func (e CreateInvitationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15772,14 +25260,14 @@ func (e GetResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceRequest.%s: %s%s",
+		"invalid %sCreateInvitationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceRequestValidationError{}
+var _ error = CreateInvitationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15787,24 +25275,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceRequestValidationError{}
+} = CreateInvitationRequestValidationError{}
 
-// Validate checks the field values on GetResourceResponse with the rules
+// Validate checks the field values on CreateInvitationResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceResponse) Validate() error {
+func (m *CreateInvitationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetResourceResponseMultiError, or nil if none found.
-func (m *GetResourceResponse) ValidateAll() error {
+// CreateInvitationResponseMultiError, or nil if none found.
+func (m *CreateInvitationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceResponse) validate(all bool) error {
+func (m *CreateInvitationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15812,28 +25300,28 @@ func (m *GetResourceResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetResource()).(type) {
+		switch v := interface{}(m.GetInvitation()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
-					field:  "Resource",
+				errors = append(errors, CreateInvitationResponseValidationError{
+					field:  "Invitation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
-					field:  "Resource",
+				errors = append(errors, CreateInvitationResponseValidationError{
+					field:  "Invitation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetResourceResponseValidationError{
-				field:  "Resource",
+			return CreateInvitationResponseValidationError{
+				field:  "Invitation",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15841,19 +25329,19 @@ func (m *GetResourceResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetResourceResponseMultiError(errors)
+		return CreateInvitationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by GetResourceResponse.ValidateAll() if the designated
+// CreateInvitationResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateInvitationResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetResourceResponseMultiError []error
+type CreateInvitationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceResponseMultiError) Error() string {
+func (m CreateInvitationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15862,11 +25350,11 @@ func (m GetResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceResponseMultiError) AllErrors() []error { return m }
+func (m CreateInvitationResponseMultiError) AllErrors() []error { return m }
 
-// GetResourceResponseValidationError is the validation error returned by
-// GetResourceResponse.Validate if the designated constraints aren't met.
-type GetResourceResponseValidationError struct {
+// CreateInvitationResponseValidationError is the validation error returned by
+// CreateInvitationResponse.Validate if the designated constraints aren't met.
+type CreateInvitationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15874,24 +25362,24 @@ type GetResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceResponseValidationError) Field() string { return e.field }
+func (e CreateInvitationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceResponseValidationError) Reason() string { return e.reason }
+func (e CreateInvitationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceResponseValidationError) Cause() error { return e.cause }
+func (e CreateInvitationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceResponseValidationError) Key() bool { return e.key }
+func (e CreateInvitationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceResponseValidationError) ErrorName() string {
-	return "GetResourceResponseValidationError"
+func (e CreateInvitationResponseValidationError) ErrorName() string {
+	return "CreateInvitationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetResourceResponseValidationError) Error() string {
+func (e CreateInvitationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15903,14 +25391,14 @@ func (e GetResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceResponse.%s: %s%s",
+		"invalid %sCreateInvitationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceResponseValidationError{}
+var _ error = CreateInvitationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15918,75 +25406,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceResponseValidationError{}
+} = CreateInvitationResponseValidationError{}
 
-// Validate checks the field values on UpdateResourceRequest with the rules
+// Validate checks the field values on ListInvitationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateResourceRequest) Validate() error {
+func (m *ListInvitationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateResourceRequest with the rules
+// ValidateAll checks the field values on ListInvitationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateResourceRequestMultiError, or nil if none found.
-func (m *UpdateResourceRequest) ValidateAll() error {
+// ListInvitationsRequestMultiError, or nil if none found.
+func (m *ListInvitationsRequest) ValidateAll() error {
 	return m.validate(true)
-}
-
-func (m *UpdateResourceRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateResourceRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateResourceRequestValidationError{
-					field:  "Body",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateResourceRequestValidationError{
-				field:  "Body",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+}
+
+func (m *ListInvitationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for OrgId
+
+	// no validation rules for GroupId
+
 	if len(errors) > 0 {
-		return UpdateResourceRequestMultiError(errors)
+		return ListInvitationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateResourceRequest.ValidateAll() if the designated
+// ListInvitationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInvitationsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateResourceRequestMultiError []error
+type ListInvitationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateResourceRequestMultiError) Error() string {
+func (m ListInvitationsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15995,11 +25456,11 @@ func (m UpdateResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateResourceRequestMultiError) AllErrors() []error { return m }
+func (m ListInvitationsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateResourceRequestValidationError is the validation error returned by
-// UpdateResourceRequest.Validate if the designated constraints aren't met.
-type UpdateResourceRequestValidationError struct {
+// ListInvitationsRequestValidationError is the validation error returned by
+// ListInvitationsRequest.Validate if the designated constraints aren't met.
+type ListInvitationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16007,24 +25468,24 @@ type UpdateResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateResourceRequestValidationError) Field() string { return e.field }
+func (e ListInvitationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateResourceRequestValidationError) Reason() string { return e.reason }
+func (e ListInvitationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateResourceRequestValidationError) Cause() error { return e.cause }
+func (e ListInvitationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateResourceRequestValidationError) Key() bool { return e.key }
+func (e ListInvitationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateResourceRequestValidationError) ErrorName() string {
-	return "UpdateResourceRequestValidationError"
+func (e ListInvitationsRequestValidationError) ErrorName() string {
+	return "ListInvitationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateResourceRequestValidationError) Error() string {
+func (e ListInvitationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16036,14 +25497,14 @@ func (e UpdateResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateResourceRequest.%s: %s%s",
+		"invalid %sListInvitationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateResourceRequestValidationError{}
+var _ error = ListInvitationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16051,73 +25512,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateResourceRequestValidationError{}
+} = ListInvitationsRequestValidationError{}
 
-// Validate checks the field values on UpdateResourceResponse with the rules
+// Validate checks the field values on ListInvitationsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateResourceResponse) Validate() error {
+func (m *ListInvitationsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListInvitationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateResourceResponseMultiError, or nil if none found.
-func (m *UpdateResourceResponse) ValidateAll() error {
+// ListInvitationsResponseMultiError, or nil if none found.
+func (m *ListInvitationsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateResourceResponse) validate(all bool) error {
+func (m *ListInvitationsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateResourceResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetInvitations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateResourceResponseValidationError{
-					field:  "Resource",
+				return ListInvitationsResponseValidationError{
+					field:  fmt.Sprintf("Invitations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateResourceResponseValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateResourceResponseMultiError(errors)
+		return ListInvitationsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateResourceResponse.ValidateAll() if the designated
+// ListInvitationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInvitationsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateResourceResponseMultiError []error
+type ListInvitationsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateResourceResponseMultiError) Error() string {
+func (m ListInvitationsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16126,11 +25592,11 @@ func (m UpdateResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateResourceResponseMultiError) AllErrors() []error { return m }
+func (m ListInvitationsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateResourceResponseValidationError is the validation error returned by
-// UpdateResourceResponse.Validate if the designated constraints aren't met.
-type UpdateResourceResponseValidationError struct {
+// ListInvitationsResponseValidationError is the validation error returned by
+// ListInvitationsResponse.Validate if the designated constraints aren't met.
+type ListInvitationsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16138,24 +25604,24 @@ type UpdateResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateResourceResponseValidationError) Field() string { return e.field }
+func (e ListInvitationsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateResourceResponseValidationError) Reason() string { return e.reason }
+func (e ListInvitationsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateResourceResponseValidationError) Cause() error { return e.cause }
+func (e ListInvitationsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateResourceResponseValidationError) Key() bool { return e.key }
+func (e ListInvitationsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateResourceResponseValidationError) ErrorName() string {
-	return "UpdateResourceResponseValidationError"
+func (e ListInvitationsResponseValidationError) ErrorName() string {
+	return "ListInvitationsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateResourceResponseValidationError) Error() string {
+func (e ListInvitationsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16167,14 +25633,14 @@ func (e UpdateResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateResourceResponse.%s: %s%s",
+		"invalid %sListInvitationsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateResourceResponseValidationError{}
+var _ error = ListInvitationsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16182,77 +25648,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateResourceResponseValidationError{}
+} = ListInvitationsResponseValidationError{}
 
-// Validate checks the field values on ResourcePermission with the rules
+// Validate checks the field values on RevokeInvitationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResourcePermission) Validate() error {
+func (m *RevokeInvitationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResourcePermission with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResourcePermissionMultiError, or nil if none found.
-func (m *ResourcePermission) ValidateAll() error {
+// RevokeInvitationRequestMultiError, or nil if none found.
+func (m *RevokeInvitationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResourcePermission) validate(all bool) error {
+func (m *RevokeInvitationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ResourcePermission_ObjectId_Pattern.MatchString(m.GetObjectId()) {
-		err := ResourcePermissionValidationError{
-			field:  "ObjectId",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_ResourcePermission_ObjectNamespace_Pattern.MatchString(m.GetObjectNamespace()) {
-		err := ResourcePermissionValidationError{
-			field:  "ObjectNamespace",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_ResourcePermission_Permission_Pattern.MatchString(m.GetPermission()) {
-		err := ResourcePermissionValidationError{
-			field:  "Permission",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ResourcePermissionMultiError(errors)
+		return RevokeInvitationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResourcePermissionMultiError is an error wrapping multiple validation errors
-// returned by ResourcePermission.ValidateAll() if the designated constraints
-// aren't met.
-type ResourcePermissionMultiError []error
+// RevokeInvitationRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeInvitationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeInvitationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourcePermissionMultiError) Error() string {
+func (m RevokeInvitationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16261,11 +25696,11 @@ func (m ResourcePermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourcePermissionMultiError) AllErrors() []error { return m }
+func (m RevokeInvitationRequestMultiError) AllErrors() []error { return m }
 
-// ResourcePermissionValidationError is the validation error returned by
-// ResourcePermission.Validate if the designated constraints aren't met.
-type ResourcePermissionValidationError struct {
+// RevokeInvitationRequestValidationError is the validation error returned by
+// RevokeInvitationRequest.Validate if the designated constraints aren't met.
+type RevokeInvitationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16273,24 +25708,24 @@ type ResourcePermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourcePermissionValidationError) Field() string { return e.field }
+func (e RevokeInvitationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourcePermissionValidationError) Reason() string { return e.reason }
+func (e RevokeInvitationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourcePermissionValidationError) Cause() error { return e.cause }
+func (e RevokeInvitationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourcePermissionValidationError) Key() bool { return e.key }
+func (e RevokeInvitationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourcePermissionValidationError) ErrorName() string {
-	return "ResourcePermissionValidationError"
+func (e RevokeInvitationRequestValidationError) ErrorName() string {
+	return "RevokeInvitationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResourcePermissionValidationError) Error() string {
+func (e RevokeInvitationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16302,14 +25737,14 @@ func (e ResourcePermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResourcePermission.%s: %s%s",
+		"invalid %sRevokeInvitationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourcePermissionValidationError{}
+var _ error = RevokeInvitationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16317,117 +25752,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourcePermissionValidationError{}
-
-var _ResourcePermission_ObjectId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = RevokeInvitationRequestValidationError{}
 
-var _ResourcePermission_ObjectNamespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _ResourcePermission_Permission_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-// Validate checks the field values on CheckResourcePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeInvitationResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckResourcePermissionRequest) Validate() error {
+func (m *RevokeInvitationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResourcePermissionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckResourcePermissionRequestMultiError, or nil if none found.
-func (m *CheckResourcePermissionRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeInvitationResponseMultiError, or nil if none found.
+func (m *RevokeInvitationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourcePermissionRequest) validate(all bool) error {
+func (m *RevokeInvitationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CheckResourcePermissionRequest_ObjectId_Pattern.MatchString(m.GetObjectId()) {
-		err := CheckResourcePermissionRequestValidationError{
-			field:  "ObjectId",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_CheckResourcePermissionRequest_ObjectNamespace_Pattern.MatchString(m.GetObjectNamespace()) {
-		err := CheckResourcePermissionRequestValidationError{
-			field:  "ObjectNamespace",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_CheckResourcePermissionRequest_Permission_Pattern.MatchString(m.GetPermission()) {
-		err := CheckResourcePermissionRequestValidationError{
-			field:  "Permission",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetResourcePermissions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourcePermissionRequestValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourcePermissionRequestValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CheckResourcePermissionRequestValidationError{
-					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return CheckResourcePermissionRequestMultiError(errors)
+		return RevokeInvitationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourcePermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by CheckResourcePermissionRequest.ValidateAll()
-// if the designated constraints aren't met.
-type CheckResourcePermissionRequestMultiError []error
+// RevokeInvitationResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeInvitationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeInvitationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourcePermissionRequestMultiError) Error() string {
+func (m RevokeInvitationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16436,12 +25798,11 @@ func (m CheckResourcePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourcePermissionRequestMultiError) AllErrors() []error { return m }
-
-// CheckResourcePermissionRequestValidationError is the validation error
-// returned by CheckResourcePermissionRequest.Validate if the designated
-// constraints aren't met.
-type CheckResourcePermissionRequestValidationError struct {
+func (m RevokeInvitationResponseMultiError) AllErrors() []error { return m }
+
+// RevokeInvitationResponseValidationError is the validation error returned by
+// RevokeInvitationResponse.Validate if the designated constraints aren't met.
+type RevokeInvitationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16449,24 +25810,24 @@ type CheckResourcePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourcePermissionRequestValidationError) Field() string { return e.field }
+func (e RevokeInvitationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourcePermissionRequestValidationError) Reason() string { return e.reason }
+func (e RevokeInvitationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourcePermissionRequestValidationError) Cause() error { return e.cause }
+func (e RevokeInvitationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourcePermissionRequestValidationError) Key() bool { return e.key }
+func (e RevokeInvitationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourcePermissionRequestValidationError) ErrorName() string {
-	return "CheckResourcePermissionRequestValidationError"
+func (e RevokeInvitationResponseValidationError) ErrorName() string {
+	return "RevokeInvitationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourcePermissionRequestValidationError) Error() string {
+func (e RevokeInvitationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16478,14 +25839,14 @@ func (e CheckResourcePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourcePermissionRequest.%s: %s%s",
+		"invalid %sRevokeInvitationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourcePermissionRequestValidationError{}
+var _ error = RevokeInvitationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16493,86 +25854,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourcePermissionRequestValidationError{}
-
-var _CheckResourcePermissionRequest_ObjectId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _CheckResourcePermissionRequest_ObjectNamespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _CheckResourcePermissionRequest_Permission_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = RevokeInvitationResponseValidationError{}
 
-// Validate checks the field values on CheckResourcePermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AcceptInvitationRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckResourcePermissionResponse) Validate() error {
+func (m *AcceptInvitationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResourcePermissionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckResourcePermissionResponseMultiError, or nil if none found.
-func (m *CheckResourcePermissionResponse) ValidateAll() error {
+// ValidateAll checks the field values on AcceptInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptInvitationRequestMultiError, or nil if none found.
+func (m *AcceptInvitationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourcePermissionResponse) validate(all bool) error {
+func (m *AcceptInvitationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
-
-	for idx, item := range m.GetResourcePermissions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourcePermissionResponseValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourcePermissionResponseValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CheckResourcePermissionResponseValidationError{
-					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CheckResourcePermissionResponseMultiError(errors)
+		return AcceptInvitationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourcePermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by CheckResourcePermissionResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CheckResourcePermissionResponseMultiError []error
+// AcceptInvitationRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptInvitationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptInvitationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourcePermissionResponseMultiError) Error() string {
+func (m AcceptInvitationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16581,12 +25902,11 @@ func (m CheckResourcePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourcePermissionResponseMultiError) AllErrors() []error { return m }
+func (m AcceptInvitationRequestMultiError) AllErrors() []error { return m }
 
-// CheckResourcePermissionResponseValidationError is the validation error
-// returned by CheckResourcePermissionResponse.Validate if the designated
-// constraints aren't met.
-type CheckResourcePermissionResponseValidationError struct {
+// AcceptInvitationRequestValidationError is the validation error returned by
+// AcceptInvitationRequest.Validate if the designated constraints aren't met.
+type AcceptInvitationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16594,24 +25914,24 @@ type CheckResourcePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourcePermissionResponseValidationError) Field() string { return e.field }
+func (e AcceptInvitationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourcePermissionResponseValidationError) Reason() string { return e.reason }
+func (e AcceptInvitationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourcePermissionResponseValidationError) Cause() error { return e.cause }
+func (e AcceptInvitationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourcePermissionResponseValidationError) Key() bool { return e.key }
+func (e AcceptInvitationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourcePermissionResponseValidationError) ErrorName() string {
-	return "CheckResourcePermissionResponseValidationError"
+func (e AcceptInvitationRequestValidationError) ErrorName() string {
+	return "AcceptInvitationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourcePermissionResponseValidationError) Error() string {
+func (e AcceptInvitationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16623,14 +25943,14 @@ func (e CheckResourcePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourcePermissionResponse.%s: %s%s",
+		"invalid %sAcceptInvitationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourcePermissionResponseValidationError{}
+var _ error = AcceptInvitationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16638,82 +25958,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourcePermissionResponseValidationError{}
+} = AcceptInvitationRequestValidationError{}
 
-// Validate checks the field values on CheckResourceUserPermissionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckResourceUserPermissionRequest) Validate() error {
+// Validate checks the field values on AcceptInvitationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptInvitationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResourceUserPermissionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckResourceUserPermissionRequestMultiError, or nil if none found.
-func (m *CheckResourceUserPermissionRequest) ValidateAll() error {
+// ValidateAll checks the field values on AcceptInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptInvitationResponseMultiError, or nil if none found.
+func (m *AcceptInvitationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceUserPermissionRequest) validate(all bool) error {
+func (m *AcceptInvitationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	for idx, item := range m.GetResourcePermissions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourceUserPermissionRequestValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourceUserPermissionRequestValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptInvitationResponseValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CheckResourceUserPermissionRequestValidationError{
-					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+				errors = append(errors, AcceptInvitationResponseValidationError{
+					field:  "Invitation",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptInvitationResponseValidationError{
+				field:  "Invitation",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return CheckResourceUserPermissionRequestMultiError(errors)
+		return AcceptInvitationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceUserPermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckResourceUserPermissionRequest.ValidateAll() if the designated
+// AcceptInvitationResponseMultiError is an error wrapping multiple validation
+// errors returned by AcceptInvitationResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CheckResourceUserPermissionRequestMultiError []error
+type AcceptInvitationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceUserPermissionRequestMultiError) Error() string {
+func (m AcceptInvitationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16722,12 +26033,11 @@ func (m CheckResourceUserPermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceUserPermissionRequestMultiError) AllErrors() []error { return m }
+func (m AcceptInvitationResponseMultiError) AllErrors() []error { return m }
 
-// CheckResourceUserPermissionRequestValidationError is the validation error
-// returned by CheckResourceUserPermissionRequest.Validate if the designated
-// constraints aren't met.
-type CheckResourceUserPermissionRequestValidationError struct {
+// AcceptInvitationResponseValidationError is the validation error returned by
+// AcceptInvitationResponse.Validate if the designated constraints aren't met.
+type AcceptInvitationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16735,24 +26045,24 @@ type CheckResourceUserPermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceUserPermissionRequestValidationError) Field() string { return e.field }
+func (e AcceptInvitationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceUserPermissionRequestValidationError) Reason() string { return e.reason }
+func (e AcceptInvitationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceUserPermissionRequestValidationError) Cause() error { return e.cause }
+func (e AcceptInvitationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceUserPermissionRequestValidationError) Key() bool { return e.key }
+func (e AcceptInvitationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceUserPermissionRequestValidationError) ErrorName() string {
-	return "CheckResourceUserPermissionRequestValidationError"
+func (e AcceptInvitationResponseValidationError) ErrorName() string {
+	return "AcceptInvitationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceUserPermissionRequestValidationError) Error() string {
+func (e AcceptInvitationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16764,14 +26074,14 @@ func (e CheckResourceUserPermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceUserPermissionRequest.%s: %s%s",
+		"invalid %sAcceptInvitationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceUserPermissionRequestValidationError{}
+var _ error = AcceptInvitationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16779,80 +26089,178 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourceUserPermissionRequestValidationError{}
+} = AcceptInvitationResponseValidationError{}
 
-// Validate checks the field values on CheckResourceUserPermissionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckResourceUserPermissionResponse) Validate() error {
+// Validate checks the field values on AccessRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResourceUserPermissionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckResourceUserPermissionResponseMultiError, or nil if none found.
-func (m *CheckResourceUserPermissionResponse) ValidateAll() error {
+// ValidateAll checks the field values on AccessRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessRequestMultiError, or
+// nil if none found.
+func (m *AccessRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResourceUserPermissionResponse) validate(all bool) error {
+func (m *AccessRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResourcePermissions() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckResourceUserPermissionResponseValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckResourceUserPermissionResponseValidationError{
-						field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for UserId
+
+	// no validation rules for ObjectNamespace
+
+	// no validation rules for ObjectId
+
+	// no validation rules for Role
+
+	// no validation rules for Reason
+
+	// no validation rules for State
+
+	// no validation rules for ReviewedBy
+
+	if all {
+		switch v := interface{}(m.GetReviewedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRequestValidationError{
+				field:  "ReviewedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReviewComment
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CheckResourceUserPermissionResponseValidationError{
-					field:  fmt.Sprintf("ResourcePermissions[%v]", idx),
+				errors = append(errors, AccessRequestValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRequestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRequestValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return CheckResourceUserPermissionResponseMultiError(errors)
+		return AccessRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResourceUserPermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckResourceUserPermissionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CheckResourceUserPermissionResponseMultiError []error
+// AccessRequestMultiError is an error wrapping multiple validation errors
+// returned by AccessRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AccessRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResourceUserPermissionResponseMultiError) Error() string {
+func (m AccessRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16861,12 +26269,11 @@ func (m CheckResourceUserPermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResourceUserPermissionResponseMultiError) AllErrors() []error { return m }
+func (m AccessRequestMultiError) AllErrors() []error { return m }
 
-// CheckResourceUserPermissionResponseValidationError is the validation error
-// returned by CheckResourceUserPermissionResponse.Validate if the designated
-// constraints aren't met.
-type CheckResourceUserPermissionResponseValidationError struct {
+// AccessRequestValidationError is the validation error returned by
+// AccessRequest.Validate if the designated constraints aren't met.
+type AccessRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16874,24 +26281,22 @@ type CheckResourceUserPermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResourceUserPermissionResponseValidationError) Field() string { return e.field }
+func (e AccessRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResourceUserPermissionResponseValidationError) Reason() string { return e.reason }
+func (e AccessRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResourceUserPermissionResponseValidationError) Cause() error { return e.cause }
+func (e AccessRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResourceUserPermissionResponseValidationError) Key() bool { return e.key }
+func (e AccessRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResourceUserPermissionResponseValidationError) ErrorName() string {
-	return "CheckResourceUserPermissionResponseValidationError"
-}
+func (e AccessRequestValidationError) ErrorName() string { return "AccessRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CheckResourceUserPermissionResponseValidationError) Error() string {
+func (e AccessRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16903,14 +26308,14 @@ func (e CheckResourceUserPermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResourceUserPermissionResponse.%s: %s%s",
+		"invalid %sAccessRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResourceUserPermissionResponseValidationError{}
+var _ error = AccessRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16918,46 +26323,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResourceUserPermissionResponseValidationError{}
+} = AccessRequestValidationError{}
 
-// Validate checks the field values on ListAllUserResourcesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AccessRequestRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListAllUserResourcesRequest) Validate() error {
+func (m *AccessRequestRequestBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListAllUserResourcesRequest with the
+// ValidateAll checks the field values on AccessRequestRequestBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListAllUserResourcesRequestMultiError, or nil if none found.
-func (m *ListAllUserResourcesRequest) ValidateAll() error {
+// AccessRequestRequestBodyMultiError, or nil if none found.
+func (m *AccessRequestRequestBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListAllUserResourcesRequest) validate(all bool) error {
+func (m *AccessRequestRequestBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for ObjectNamespace
+
+	// no validation rules for ObjectId
+
+	// no validation rules for Role
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return ListAllUserResourcesRequestMultiError(errors)
+		return AccessRequestRequestBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListAllUserResourcesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListAllUserResourcesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListAllUserResourcesRequestMultiError []error
+// AccessRequestRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by AccessRequestRequestBody.ValidateAll() if the designated
+// constraints aren't met.
+type AccessRequestRequestBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListAllUserResourcesRequestMultiError) Error() string {
+func (m AccessRequestRequestBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16966,12 +26377,11 @@ func (m ListAllUserResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListAllUserResourcesRequestMultiError) AllErrors() []error { return m }
+func (m AccessRequestRequestBodyMultiError) AllErrors() []error { return m }
 
-// ListAllUserResourcesRequestValidationError is the validation error returned
-// by ListAllUserResourcesRequest.Validate if the designated constraints
-// aren't met.
-type ListAllUserResourcesRequestValidationError struct {
+// AccessRequestRequestBodyValidationError is the validation error returned by
+// AccessRequestRequestBody.Validate if the designated constraints aren't met.
+type AccessRequestRequestBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16979,24 +26389,24 @@ type ListAllUserResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListAllUserResourcesRequestValidationError) Field() string { return e.field }
+func (e AccessRequestRequestBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListAllUserResourcesRequestValidationError) Reason() string { return e.reason }
+func (e AccessRequestRequestBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListAllUserResourcesRequestValidationError) Cause() error { return e.cause }
+func (e AccessRequestRequestBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListAllUserResourcesRequestValidationError) Key() bool { return e.key }
+func (e AccessRequestRequestBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListAllUserResourcesRequestValidationError) ErrorName() string {
-	return "ListAllUserResourcesRequestValidationError"
+func (e AccessRequestRequestBodyValidationError) ErrorName() string {
+	return "AccessRequestRequestBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListAllUserResourcesRequestValidationError) Error() string {
+func (e AccessRequestRequestBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17008,14 +26418,14 @@ func (e ListAllUserResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListAllUserResourcesRequest.%s: %s%s",
+		"invalid %sAccessRequestRequestBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListAllUserResourcesRequestValidationError{}
+var _ error = AccessRequestRequestBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -17023,24 +26433,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListAllUserResourcesRequestValidationError{}
+} = AccessRequestRequestBodyValidationError{}
 
-// Validate checks the field values on ListAllUserResourcesResponse with the
+// Validate checks the field values on CreateAccessRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListAllUserResourcesResponse) Validate() error {
+func (m *CreateAccessRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListAllUserResourcesResponse with the
+// ValidateAll checks the field values on CreateAccessRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListAllUserResourcesResponseMultiError, or nil if none found.
-func (m *ListAllUserResourcesResponse) ValidateAll() error {
+// CreateAccessRequestRequestMultiError, or nil if none found.
+func (m *CreateAccessRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListAllUserResourcesResponse) validate(all bool) error {
+func (m *CreateAccessRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -17048,28 +26458,28 @@ func (m *ListAllUserResourcesResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetResources()).(type) {
+		switch v := interface{}(m.GetBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListAllUserResourcesResponseValidationError{
-					field:  "Resources",
+				errors = append(errors, CreateAccessRequestRequestValidationError{
+					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListAllUserResourcesResponseValidationError{
-					field:  "Resources",
+				errors = append(errors, CreateAccessRequestRequestValidationError{
+					field:  "Body",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListAllUserResourcesResponseValidationError{
-				field:  "Resources",
+			return CreateAccessRequestRequestValidationError{
+				field:  "Body",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -17077,19 +26487,19 @@ func (m *ListAllUserResourcesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListAllUserResourcesResponseMultiError(errors)
+		return CreateAccessRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListAllUserResourcesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListAllUserResourcesResponse.ValidateAll() if
+// CreateAccessRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateAccessRequestRequest.ValidateAll() if
 // the designated constraints aren't met.
-type ListAllUserResourcesResponseMultiError []error
+type CreateAccessRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListAllUserResourcesResponseMultiError) Error() string {
+func (m CreateAccessRequestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17098,12 +26508,11 @@ func (m ListAllUserResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListAllUserResourcesResponseMultiError) AllErrors() []error { return m }
+func (m CreateAccessRequestRequestMultiError) AllErrors() []error { return m }
 
-// ListAllUserResourcesResponseValidationError is the validation error returned
-// by ListAllUserResourcesResponse.Validate if the designated constraints
-// aren't met.
-type ListAllUserResourcesResponseValidationError struct {
+// CreateAccessRequestRequestValidationError is the validation error returned
+// by CreateAccessRequestRequest.Validate if the designated constraints aren't met.
+type CreateAccessRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17111,24 +26520,24 @@ type ListAllUserResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListAllUserResourcesResponseValidationError) Field() string { return e.field }
+func (e CreateAccessRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListAllUserResourcesResponseValidationError) Reason() string { return e.reason }
+func (e CreateAccessRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListAllUserResourcesResponseValidationError) Cause() error { return e.cause }
+func (e CreateAccessRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListAllUserResourcesResponseValidationError) Key() bool { return e.key }
+func (e CreateAccessRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListAllUserResourcesResponseValidationError) ErrorName() string {
-	return "ListAllUserResourcesResponseValidationError"
+func (e CreateAccessRequestRequestValidationError) ErrorName() string {
+	return "CreateAccessRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListAllUserResourcesResponseValidationError) Error() string {
+func (e CreateAccessRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17140,14 +26549,14 @@ func (e ListAllUserResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListAllUserResourcesResponse.%s: %s%s",
+		"invalid %sCreateAccessRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListAllUserResourcesResponseValidationError{}
+var _ error = CreateAccessRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17155,61 +26564,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListAllUserResourcesResponseValidationError{}
+} = CreateAccessRequestRequestValidationError{}
 
-// Validate checks the field values on Activity with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Activity) Validate() error {
+// Validate checks the field values on CreateAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccessRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Activity with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ActivityMultiError, or nil
-// if none found.
-func (m *Activity) ValidateAll() error {
+// ValidateAll checks the field values on CreateAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccessRequestResponseMultiError, or nil if none found.
+func (m *CreateAccessRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Activity) validate(all bool) error {
+func (m *CreateAccessRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Actor
-
-	// no validation rules for Action
-
-	// no validation rules for Data
-
-	// no validation rules for Metadata
-
 	if all {
-		switch v := interface{}(m.GetTimestamp()).(type) {
+		switch v := interface{}(m.GetAccessRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ActivityValidationError{
-					field:  "Timestamp",
+				errors = append(errors, CreateAccessRequestResponseValidationError{
+					field:  "AccessRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ActivityValidationError{
-					field:  "Timestamp",
+				errors = append(errors, CreateAccessRequestResponseValidationError{
+					field:  "AccessRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAccessRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ActivityValidationError{
-				field:  "Timestamp",
+			return CreateAccessRequestResponseValidationError{
+				field:  "AccessRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -17217,18 +26618,19 @@ func (m *Activity) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ActivityMultiError(errors)
+		return CreateAccessRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActivityMultiError is an error wrapping multiple validation errors returned
-// by Activity.ValidateAll() if the designated constraints aren't met.
-type ActivityMultiError []error
+// CreateAccessRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateAccessRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateAccessRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivityMultiError) Error() string {
+func (m CreateAccessRequestResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17237,11 +26639,12 @@ func (m ActivityMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivityMultiError) AllErrors() []error { return m }
+func (m CreateAccessRequestResponseMultiError) AllErrors() []error { return m }
 
-// ActivityValidationError is the validation error returned by
-// Activity.Validate if the designated constraints aren't met.
-type ActivityValidationError struct {
+// CreateAccessRequestResponseValidationError is the validation error returned
+// by CreateAccessRequestResponse.Validate if the designated constraints
+// aren't met.
+type CreateAccessRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17249,22 +26652,24 @@ type ActivityValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivityValidationError) Field() string { return e.field }
+func (e CreateAccessRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivityValidationError) Reason() string { return e.reason }
+func (e CreateAccessRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivityValidationError) Cause() error { return e.cause }
+func (e CreateAccessRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivityValidationError) Key() bool { return e.key }
+func (e CreateAccessRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivityValidationError) ErrorName() string { return "ActivityValidationError" }
+func (e CreateAccessRequestResponseValidationError) ErrorName() string {
+	return "CreateAccessRequestResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ActivityValidationError) Error() string {
+func (e CreateAccessRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17276,14 +26681,14 @@ func (e ActivityValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivity.%s: %s%s",
+		"invalid %sCreateAccessRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivityValidationError{}
+var _ error = CreateAccessRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17291,60 +26696,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivityValidationError{}
+} = CreateAccessRequestResponseValidationError{}
 
-// Validate checks the field values on ListActivitiesRequest with the rules
+// Validate checks the field values on ListAccessRequestsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListActivitiesRequest) Validate() error {
+func (m *ListAccessRequestsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListActivitiesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAccessRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListActivitiesRequestMultiError, or nil if none found.
-func (m *ListActivitiesRequest) ValidateAll() error {
+// ListAccessRequestsRequestMultiError, or nil if none found.
+func (m *ListAccessRequestsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListActivitiesRequest) validate(all bool) error {
+func (m *ListAccessRequestsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Actor
-
-	// no validation rules for Action
-
-	// no validation rules for Data
-
-	// no validation rules for Metadata
-
-	// no validation rules for StartTime
-
-	// no validation rules for EndTime
+	// no validation rules for ObjectNamespace
 
-	// no validation rules for PageSize
+	// no validation rules for ObjectId
 
-	// no validation rules for PageNum
+	// no validation rules for State
 
 	if len(errors) > 0 {
-		return ListActivitiesRequestMultiError(errors)
+		return ListAccessRequestsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListActivitiesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListActivitiesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListActivitiesRequestMultiError []error
+// ListAccessRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAccessRequestsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListAccessRequestsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListActivitiesRequestMultiError) Error() string {
+func (m ListAccessRequestsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17353,11 +26748,11 @@ func (m ListActivitiesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListActivitiesRequestMultiError) AllErrors() []error { return m }
+func (m ListAccessRequestsRequestMultiError) AllErrors() []error { return m }
 
-// ListActivitiesRequestValidationError is the validation error returned by
-// ListActivitiesRequest.Validate if the designated constraints aren't met.
-type ListActivitiesRequestValidationError struct {
+// ListAccessRequestsRequestValidationError is the validation error returned by
+// ListAccessRequestsRequest.Validate if the designated constraints aren't met.
+type ListAccessRequestsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17365,24 +26760,24 @@ type ListActivitiesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListActivitiesRequestValidationError) Field() string { return e.field }
+func (e ListAccessRequestsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListActivitiesRequestValidationError) Reason() string { return e.reason }
+func (e ListAccessRequestsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListActivitiesRequestValidationError) Cause() error { return e.cause }
+func (e ListAccessRequestsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListActivitiesRequestValidationError) Key() bool { return e.key }
+func (e ListAccessRequestsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListActivitiesRequestValidationError) ErrorName() string {
-	return "ListActivitiesRequestValidationError"
+func (e ListAccessRequestsRequestValidationError) ErrorName() string {
+	return "ListAccessRequestsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListActivitiesRequestValidationError) Error() string {
+func (e ListAccessRequestsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17394,14 +26789,14 @@ func (e ListActivitiesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListActivitiesRequest.%s: %s%s",
+		"invalid %sListAccessRequestsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListActivitiesRequestValidationError{}
+var _ error = ListAccessRequestsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17409,49 +26804,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListActivitiesRequestValidationError{}
+} = ListAccessRequestsRequestValidationError{}
 
-// Validate checks the field values on ListActivitiesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListAccessRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListActivitiesResponse) Validate() error {
+func (m *ListAccessRequestsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListActivitiesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAccessRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListActivitiesResponseMultiError, or nil if none found.
-func (m *ListActivitiesResponse) ValidateAll() error {
+// ListAccessRequestsResponseMultiError, or nil if none found.
+func (m *ListAccessRequestsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListActivitiesResponse) validate(all bool) error {
+func (m *ListAccessRequestsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Count
-
-	for idx, item := range m.GetActivities() {
+	for idx, item := range m.GetAccessRequests() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListActivitiesResponseValidationError{
-						field:  fmt.Sprintf("Activities[%v]", idx),
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccessRequestsResponseValidationError{
+						field:  fmt.Sprintf("AccessRequests[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListActivitiesResponseValidationError{
-						field:  fmt.Sprintf("Activities[%v]", idx),
+					errors = append(errors, ListAccessRequestsResponseValidationError{
+						field:  fmt.Sprintf("AccessRequests[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -17459,8 +26852,8 @@ func (m *ListActivitiesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListActivitiesResponseValidationError{
-					field:  fmt.Sprintf("Activities[%v]", idx),
+				return ListAccessRequestsResponseValidationError{
+					field:  fmt.Sprintf("AccessRequests[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -17470,19 +26863,19 @@ func (m *ListActivitiesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListActivitiesResponseMultiError(errors)
+		return ListAccessRequestsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListActivitiesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListActivitiesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListActivitiesResponseMultiError []error
+// ListAccessRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAccessRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessRequestsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListActivitiesResponseMultiError) Error() string {
+func (m ListAccessRequestsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17491,11 +26884,11 @@ func (m ListActivitiesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListActivitiesResponseMultiError) AllErrors() []error { return m }
+func (m ListAccessRequestsResponseMultiError) AllErrors() []error { return m }
 
-// ListActivitiesResponseValidationError is the validation error returned by
-// ListActivitiesResponse.Validate if the designated constraints aren't met.
-type ListActivitiesResponseValidationError struct {
+// ListAccessRequestsResponseValidationError is the validation error returned
+// by ListAccessRequestsResponse.Validate if the designated constraints aren't met.
+type ListAccessRequestsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17503,24 +26896,24 @@ type ListActivitiesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListActivitiesResponseValidationError) Field() string { return e.field }
+func (e ListAccessRequestsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListActivitiesResponseValidationError) Reason() string { return e.reason }
+func (e ListAccessRequestsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListActivitiesResponseValidationError) Cause() error { return e.cause }
+func (e ListAccessRequestsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListActivitiesResponseValidationError) Key() bool { return e.key }
+func (e ListAccessRequestsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListActivitiesResponseValidationError) ErrorName() string {
-	return "ListActivitiesResponseValidationError"
+func (e ListAccessRequestsResponseValidationError) ErrorName() string {
+	return "ListAccessRequestsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListActivitiesResponseValidationError) Error() string {
+func (e ListAccessRequestsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17532,14 +26925,14 @@ func (e ListActivitiesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListActivitiesResponse.%s: %s%s",
+		"invalid %sListAccessRequestsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListActivitiesResponseValidationError{}
+var _ error = ListAccessRequestsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17547,48 +26940,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListActivitiesResponseValidationError{}
+} = ListAccessRequestsResponseValidationError{}
 
-// Validate checks the field values on UpsertResourcesConfigRequest with the
+// Validate checks the field values on ApproveAccessRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertResourcesConfigRequest) Validate() error {
+func (m *ApproveAccessRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertResourcesConfigRequest with the
+// ValidateAll checks the field values on ApproveAccessRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertResourcesConfigRequestMultiError, or nil if none found.
-func (m *UpsertResourcesConfigRequest) ValidateAll() error {
+// ApproveAccessRequestRequestMultiError, or nil if none found.
+func (m *ApproveAccessRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertResourcesConfigRequest) validate(all bool) error {
+func (m *ApproveAccessRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Id
 
-	// no validation rules for Config
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveAccessRequestRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveAccessRequestRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveAccessRequestRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Comment
 
 	if len(errors) > 0 {
-		return UpsertResourcesConfigRequestMultiError(errors)
+		return ApproveAccessRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertResourcesConfigRequestMultiError is an error wrapping multiple
-// validation errors returned by UpsertResourcesConfigRequest.ValidateAll() if
+// ApproveAccessRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveAccessRequestRequest.ValidateAll() if
 // the designated constraints aren't met.
-type UpsertResourcesConfigRequestMultiError []error
+type ApproveAccessRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertResourcesConfigRequestMultiError) Error() string {
+func (m ApproveAccessRequestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17597,12 +27019,12 @@ func (m UpsertResourcesConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertResourcesConfigRequestMultiError) AllErrors() []error { return m }
+func (m ApproveAccessRequestRequestMultiError) AllErrors() []error { return m }
 
-// UpsertResourcesConfigRequestValidationError is the validation error returned
-// by UpsertResourcesConfigRequest.Validate if the designated constraints
+// ApproveAccessRequestRequestValidationError is the validation error returned
+// by ApproveAccessRequestRequest.Validate if the designated constraints
 // aren't met.
-type UpsertResourcesConfigRequestValidationError struct {
+type ApproveAccessRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17610,24 +27032,24 @@ type UpsertResourcesConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertResourcesConfigRequestValidationError) Field() string { return e.field }
+func (e ApproveAccessRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertResourcesConfigRequestValidationError) Reason() string { return e.reason }
+func (e ApproveAccessRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertResourcesConfigRequestValidationError) Cause() error { return e.cause }
+func (e ApproveAccessRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertResourcesConfigRequestValidationError) Key() bool { return e.key }
+func (e ApproveAccessRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertResourcesConfigRequestValidationError) ErrorName() string {
-	return "UpsertResourcesConfigRequestValidationError"
+func (e ApproveAccessRequestRequestValidationError) ErrorName() string {
+	return "ApproveAccessRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertResourcesConfigRequestValidationError) Error() string {
+func (e ApproveAccessRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17639,14 +27061,14 @@ func (e UpsertResourcesConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertResourcesConfigRequest.%s: %s%s",
+		"invalid %sApproveAccessRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertResourcesConfigRequestValidationError{}
+var _ error = ApproveAccessRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17654,88 +27076,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertResourcesConfigRequestValidationError{}
+} = ApproveAccessRequestRequestValidationError{}
 
-// Validate checks the field values on UpsertResourcesConfigResponse with the
+// Validate checks the field values on ApproveAccessRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertResourcesConfigResponse) Validate() error {
+func (m *ApproveAccessRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertResourcesConfigResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpsertResourcesConfigResponseMultiError, or nil if none found.
-func (m *UpsertResourcesConfigResponse) ValidateAll() error {
+// ValidateAll checks the field values on ApproveAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveAccessRequestResponseMultiError, or nil if none found.
+func (m *ApproveAccessRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertResourcesConfigResponse) validate(all bool) error {
+func (m *ApproveAccessRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for Config
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertResourcesConfigResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertResourcesConfigResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpsertResourcesConfigResponseValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetAccessRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertResourcesConfigResponseValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, ApproveAccessRequestResponseValidationError{
+					field:  "AccessRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertResourcesConfigResponseValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, ApproveAccessRequestResponseValidationError{
+					field:  "AccessRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAccessRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpsertResourcesConfigResponseValidationError{
-				field:  "UpdatedAt",
+			return ApproveAccessRequestResponseValidationError{
+				field:  "AccessRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -17743,19 +27130,19 @@ func (m *UpsertResourcesConfigResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpsertResourcesConfigResponseMultiError(errors)
+		return ApproveAccessRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertResourcesConfigResponseMultiError is an error wrapping multiple
-// validation errors returned by UpsertResourcesConfigResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpsertResourcesConfigResponseMultiError []error
+// ApproveAccessRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveAccessRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveAccessRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertResourcesConfigResponseMultiError) Error() string {
+func (m ApproveAccessRequestResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17764,12 +27151,12 @@ func (m UpsertResourcesConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertResourcesConfigResponseMultiError) AllErrors() []error { return m }
+func (m ApproveAccessRequestResponseMultiError) AllErrors() []error { return m }
 
-// UpsertResourcesConfigResponseValidationError is the validation error
-// returned by UpsertResourcesConfigResponse.Validate if the designated
-// constraints aren't met.
-type UpsertResourcesConfigResponseValidationError struct {
+// ApproveAccessRequestResponseValidationError is the validation error returned
+// by ApproveAccessRequestResponse.Validate if the designated constraints
+// aren't met.
+type ApproveAccessRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17777,24 +27164,24 @@ type UpsertResourcesConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertResourcesConfigResponseValidationError) Field() string { return e.field }
+func (e ApproveAccessRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertResourcesConfigResponseValidationError) Reason() string { return e.reason }
+func (e ApproveAccessRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertResourcesConfigResponseValidationError) Cause() error { return e.cause }
+func (e ApproveAccessRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertResourcesConfigResponseValidationError) Key() bool { return e.key }
+func (e ApproveAccessRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertResourcesConfigResponseValidationError) ErrorName() string {
-	return "UpsertResourcesConfigResponseValidationError"
+func (e ApproveAccessRequestResponseValidationError) ErrorName() string {
+	return "ApproveAccessRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertResourcesConfigResponseValidationError) Error() string {
+func (e ApproveAccessRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17806,14 +27193,14 @@ func (e UpsertResourcesConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertResourcesConfigResponse.%s: %s%s",
+		"invalid %sApproveAccessRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertResourcesConfigResponseValidationError{}
+var _ error = ApproveAccessRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17821,48 +27208,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertResourcesConfigResponseValidationError{}
+} = ApproveAccessRequestResponseValidationError{}
 
-// Validate checks the field values on UpsertRulesConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RejectAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertRulesConfigRequest) Validate() error {
+func (m *RejectAccessRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertRulesConfigRequest with the
+// ValidateAll checks the field values on RejectAccessRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertRulesConfigRequestMultiError, or nil if none found.
-func (m *UpsertRulesConfigRequest) ValidateAll() error {
+// RejectAccessRequestRequestMultiError, or nil if none found.
+func (m *RejectAccessRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertRulesConfigRequest) validate(all bool) error {
+func (m *RejectAccessRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Id
 
-	// no validation rules for Config
+	// no validation rules for Comment
 
 	if len(errors) > 0 {
-		return UpsertRulesConfigRequestMultiError(errors)
+		return RejectAccessRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertRulesConfigRequestMultiError is an error wrapping multiple validation
-// errors returned by UpsertRulesConfigRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpsertRulesConfigRequestMultiError []error
+// RejectAccessRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by RejectAccessRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RejectAccessRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertRulesConfigRequestMultiError) Error() string {
+func (m RejectAccessRequestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17871,11 +27258,11 @@ func (m UpsertRulesConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertRulesConfigRequestMultiError) AllErrors() []error { return m }
+func (m RejectAccessRequestRequestMultiError) AllErrors() []error { return m }
 
-// UpsertRulesConfigRequestValidationError is the validation error returned by
-// UpsertRulesConfigRequest.Validate if the designated constraints aren't met.
-type UpsertRulesConfigRequestValidationError struct {
+// RejectAccessRequestRequestValidationError is the validation error returned
+// by RejectAccessRequestRequest.Validate if the designated constraints aren't met.
+type RejectAccessRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17883,24 +27270,24 @@ type UpsertRulesConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertRulesConfigRequestValidationError) Field() string { return e.field }
+func (e RejectAccessRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertRulesConfigRequestValidationError) Reason() string { return e.reason }
+func (e RejectAccessRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertRulesConfigRequestValidationError) Cause() error { return e.cause }
+func (e RejectAccessRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertRulesConfigRequestValidationError) Key() bool { return e.key }
+func (e RejectAccessRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertRulesConfigRequestValidationError) ErrorName() string {
-	return "UpsertRulesConfigRequestValidationError"
+func (e RejectAccessRequestRequestValidationError) ErrorName() string {
+	return "RejectAccessRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertRulesConfigRequestValidationError) Error() string {
+func (e RejectAccessRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17912,14 +27299,14 @@ func (e UpsertRulesConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertRulesConfigRequest.%s: %s%s",
+		"invalid %sRejectAccessRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertRulesConfigRequestValidationError{}
+var _ error = RejectAccessRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17927,88 +27314,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertRulesConfigRequestValidationError{}
+} = RejectAccessRequestRequestValidationError{}
 
-// Validate checks the field values on UpsertRulesConfigResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RejectAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertRulesConfigResponse) Validate() error {
+func (m *RejectAccessRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertRulesConfigResponse with the
+// ValidateAll checks the field values on RejectAccessRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertRulesConfigResponseMultiError, or nil if none found.
-func (m *UpsertRulesConfigResponse) ValidateAll() error {
+// RejectAccessRequestResponseMultiError, or nil if none found.
+func (m *RejectAccessRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertRulesConfigResponse) validate(all bool) error {
+func (m *RejectAccessRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for Config
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertRulesConfigResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertRulesConfigResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpsertRulesConfigResponseValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetAccessRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertRulesConfigResponseValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, RejectAccessRequestResponseValidationError{
+					field:  "AccessRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertRulesConfigResponseValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, RejectAccessRequestResponseValidationError{
+					field:  "AccessRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAccessRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpsertRulesConfigResponseValidationError{
-				field:  "UpdatedAt",
+			return RejectAccessRequestResponseValidationError{
+				field:  "AccessRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -18016,19 +27368,19 @@ func (m *UpsertRulesConfigResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpsertRulesConfigResponseMultiError(errors)
+		return RejectAccessRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertRulesConfigResponseMultiError is an error wrapping multiple validation
-// errors returned by UpsertRulesConfigResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpsertRulesConfigResponseMultiError []error
+// RejectAccessRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by RejectAccessRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RejectAccessRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertRulesConfigResponseMultiError) Error() string {
+func (m RejectAccessRequestResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18037,11 +27389,12 @@ func (m UpsertRulesConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertRulesConfigResponseMultiError) AllErrors() []error { return m }
+func (m RejectAccessRequestResponseMultiError) AllErrors() []error { return m }
 
-// UpsertRulesConfigResponseValidationError is the validation error returned by
-// UpsertRulesConfigResponse.Validate if the designated constraints aren't met.
-type UpsertRulesConfigResponseValidationError struct {
+// RejectAccessRequestResponseValidationError is the validation error returned
+// by RejectAccessRequestResponse.Validate if the designated constraints
+// aren't met.
+type RejectAccessRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18049,24 +27402,24 @@ type UpsertRulesConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertRulesConfigResponseValidationError) Field() string { return e.field }
+func (e RejectAccessRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertRulesConfigResponseValidationError) Reason() string { return e.reason }
+func (e RejectAccessRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertRulesConfigResponseValidationError) Cause() error { return e.cause }
+func (e RejectAccessRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertRulesConfigResponseValidationError) Key() bool { return e.key }
+func (e RejectAccessRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertRulesConfigResponseValidationError) ErrorName() string {
-	return "UpsertRulesConfigResponseValidationError"
+func (e RejectAccessRequestResponseValidationError) ErrorName() string {
+	return "RejectAccessRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertRulesConfigResponseValidationError) Error() string {
+func (e RejectAccessRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18078,14 +27431,14 @@ func (e UpsertRulesConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertRulesConfigResponse.%s: %s%s",
+		"invalid %sRejectAccessRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertRulesConfigResponseValidationError{}
+var _ error = RejectAccessRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18093,7 +27446,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertRulesConfigResponseValidationError{}
+} = RejectAccessRequestResponseValidationError{}
 
 // Validate checks the field values on
 // CheckResourcePermissionResponse_ResourcePermissionResponse with the rules
@@ -41,11 +41,18 @@ const (
 	ShieldService_GetOrganization_FullMethodName             = "/gotocompany.shield.v1beta1.ShieldService/GetOrganization"
 	ShieldService_UpdateOrganization_FullMethodName          = "/gotocompany.shield.v1beta1.ShieldService/UpdateOrganization"
 	ShieldService_ListOrganizationAdmins_FullMethodName      = "/gotocompany.shield.v1beta1.ShieldService/ListOrganizationAdmins"
+	ShieldService_AddOrganizationAdmins_FullMethodName       = "/gotocompany.shield.v1beta1.ShieldService/AddOrganizationAdmins"
+	ShieldService_RemoveOrganizationAdmin_FullMethodName     = "/gotocompany.shield.v1beta1.ShieldService/RemoveOrganizationAdmin"
+	ShieldService_CreateOrganizationRole_FullMethodName      = "/gotocompany.shield.v1beta1.ShieldService/CreateOrganizationRole"
+	ShieldService_DeleteOrganizationRole_FullMethodName      = "/gotocompany.shield.v1beta1.ShieldService/DeleteOrganizationRole"
 	ShieldService_ListProjects_FullMethodName                = "/gotocompany.shield.v1beta1.ShieldService/ListProjects"
 	ShieldService_CreateProject_FullMethodName               = "/gotocompany.shield.v1beta1.ShieldService/CreateProject"
 	ShieldService_GetProject_FullMethodName                  = "/gotocompany.shield.v1beta1.ShieldService/GetProject"
 	ShieldService_UpdateProject_FullMethodName               = "/gotocompany.shield.v1beta1.ShieldService/UpdateProject"
 	ShieldService_ListProjectAdmins_FullMethodName           = "/gotocompany.shield.v1beta1.ShieldService/ListProjectAdmins"
+	ShieldService_AddProjectAdmins_FullMethodName            = "/gotocompany.shield.v1beta1.ShieldService/AddProjectAdmins"
+	ShieldService_RemoveProjectAdmin_FullMethodName          = "/gotocompany.shield.v1beta1.ShieldService/RemoveProjectAdmin"
+	ShieldService_SetProjectVisibility_FullMethodName        = "/gotocompany.shield.v1beta1.ShieldService/SetProjectVisibility"
 	ShieldService_ListActions_FullMethodName                 = "/gotocompany.shield.v1beta1.ShieldService/ListActions"
 	ShieldService_CreateAction_FullMethodName                = "/gotocompany.shield.v1beta1.ShieldService/CreateAction"
 	ShieldService_ListNamespaces_FullMethodName              = "/gotocompany.shield.v1beta1.ShieldService/ListNamespaces"
@@ -62,11 +69,33 @@ const (
 	ShieldService_CreateResource_FullMethodName              = "/gotocompany.shield.v1beta1.ShieldService/CreateResource"
 	ShieldService_GetResource_FullMethodName                 = "/gotocompany.shield.v1beta1.ShieldService/GetResource"
 	ShieldService_UpdateResource_FullMethodName              = "/gotocompany.shield.v1beta1.ShieldService/UpdateResource"
+	ShieldService_SetResourceVisibility_FullMethodName       = "/gotocompany.shield.v1beta1.ShieldService/SetResourceVisibility"
 	ShieldService_ListAllUserResources_FullMethodName        = "/gotocompany.shield.v1beta1.ShieldService/ListAllUserResources"
 	ShieldService_CheckResourcePermission_FullMethodName     = "/gotocompany.shield.v1beta1.ShieldService/CheckResourcePermission"
 	ShieldService_ListActivities_FullMethodName              = "/gotocompany.shield.v1beta1.ShieldService/ListActivities"
 	ShieldService_UpsertResourcesConfig_FullMethodName       = "/gotocompany.shield.v1beta1.ShieldService/UpsertResourcesConfig"
+	ShieldService_PlanResourcesConfig_FullMethodName         = "/gotocompany.shield.v1beta1.ShieldService/PlanResourcesConfig"
+	ShieldService_ListSchemaVersions_FullMethodName          = "/gotocompany.shield.v1beta1.ShieldService/ListSchemaVersions"
 	ShieldService_UpsertRulesConfig_FullMethodName           = "/gotocompany.shield.v1beta1.ShieldService/UpsertRulesConfig"
+	ShieldService_ListServiceAccounts_FullMethodName         = "/gotocompany.shield.v1beta1.ShieldService/ListServiceAccounts"
+	ShieldService_CreateServiceAccount_FullMethodName        = "/gotocompany.shield.v1beta1.ShieldService/CreateServiceAccount"
+	ShieldService_GetServiceAccount_FullMethodName           = "/gotocompany.shield.v1beta1.ShieldService/GetServiceAccount"
+	ShieldService_ListServiceAccountKeys_FullMethodName      = "/gotocompany.shield.v1beta1.ShieldService/ListServiceAccountKeys"
+	ShieldService_CreateServiceAccountKey_FullMethodName     = "/gotocompany.shield.v1beta1.ShieldService/CreateServiceAccountKey"
+	ShieldService_RotateServiceAccountKey_FullMethodName     = "/gotocompany.shield.v1beta1.ShieldService/RotateServiceAccountKey"
+	ShieldService_RevokeServiceAccountKey_FullMethodName     = "/gotocompany.shield.v1beta1.ShieldService/RevokeServiceAccountKey"
+	ShieldService_AddPlatformAdmin_FullMethodName            = "/gotocompany.shield.v1beta1.ShieldService/AddPlatformAdmin"
+	ShieldService_RemovePlatformAdmin_FullMethodName         = "/gotocompany.shield.v1beta1.ShieldService/RemovePlatformAdmin"
+	ShieldService_AddPlatformImpersonator_FullMethodName     = "/gotocompany.shield.v1beta1.ShieldService/AddPlatformImpersonator"
+	ShieldService_RemovePlatformImpersonator_FullMethodName  = "/gotocompany.shield.v1beta1.ShieldService/RemovePlatformImpersonator"
+	ShieldService_ListInvitations_FullMethodName             = "/gotocompany.shield.v1beta1.ShieldService/ListInvitations"
+	ShieldService_CreateInvitation_FullMethodName            = "/gotocompany.shield.v1beta1.ShieldService/CreateInvitation"
+	ShieldService_RevokeInvitation_FullMethodName            = "/gotocompany.shield.v1beta1.ShieldService/RevokeInvitation"
+	ShieldService_AcceptInvitation_FullMethodName            = "/gotocompany.shield.v1beta1.ShieldService/AcceptInvitation"
+	ShieldService_ListAccessRequests_FullMethodName          = "/gotocompany.shield.v1beta1.ShieldService/ListAccessRequests"
+	ShieldService_CreateAccessRequest_FullMethodName         = "/gotocompany.shield.v1beta1.ShieldService/CreateAccessRequest"
+	ShieldService_ApproveAccessRequest_FullMethodName        = "/gotocompany.shield.v1beta1.ShieldService/ApproveAccessRequest"
+	ShieldService_RejectAccessRequest_FullMethodName         = "/gotocompany.shield.v1beta1.ShieldService/RejectAccessRequest"
 )
 
 // ShieldServiceClient is the client API for ShieldService service.
@@ -99,12 +128,19 @@ type ShieldServiceClient interface {
 	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
 	UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error)
 	ListOrganizationAdmins(ctx context.Context, in *ListOrganizationAdminsRequest, opts ...grpc.CallOption) (*ListOrganizationAdminsResponse, error)
+	AddOrganizationAdmins(ctx context.Context, in *AddOrganizationAdminsRequest, opts ...grpc.CallOption) (*AddOrganizationAdminsResponse, error)
+	RemoveOrganizationAdmin(ctx context.Context, in *RemoveOrganizationAdminRequest, opts ...grpc.CallOption) (*RemoveOrganizationAdminResponse, error)
+	CreateOrganizationRole(ctx context.Context, in *CreateOrganizationRoleRequest, opts ...grpc.CallOption) (*CreateOrganizationRoleResponse, error)
+	DeleteOrganizationRole(ctx context.Context, in *DeleteOrganizationRoleRequest, opts ...grpc.CallOption) (*DeleteOrganizationRoleResponse, error)
 	// Projects
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	ListProjectAdmins(ctx context.Context, in *ListProjectAdminsRequest, opts ...grpc.CallOption) (*ListProjectAdminsResponse, error)
+	AddProjectAdmins(ctx context.Context, in *AddProjectAdminsRequest, opts ...grpc.CallOption) (*AddProjectAdminsResponse, error)
+	RemoveProjectAdmin(ctx context.Context, in *RemoveProjectAdminRequest, opts ...grpc.CallOption) (*RemoveProjectAdminResponse, error)
+	SetProjectVisibility(ctx context.Context, in *SetProjectVisibilityRequest, opts ...grpc.CallOption) (*SetProjectVisibilityResponse, error)
 	// Actions
 	ListActions(ctx context.Context, in *ListActionsRequest, opts ...grpc.CallOption) (*ListActionsResponse, error)
 	CreateAction(ctx context.Context, in *CreateActionRequest, opts ...grpc.CallOption) (*CreateActionResponse, error)
@@ -126,13 +162,35 @@ type ShieldServiceClient interface {
 	CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*CreateResourceResponse, error)
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
 	UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*UpdateResourceResponse, error)
+	SetResourceVisibility(ctx context.Context, in *SetResourceVisibilityRequest, opts ...grpc.CallOption) (*SetResourceVisibilityResponse, error)
 	ListAllUserResources(ctx context.Context, in *ListAllUserResourcesRequest, opts ...grpc.CallOption) (*ListAllUserResourcesResponse, error)
 	// Authz
 	CheckResourcePermission(ctx context.Context, in *CheckResourcePermissionRequest, opts ...grpc.CallOption) (*CheckResourcePermissionResponse, error)
 	// Activity
 	ListActivities(ctx context.Context, in *ListActivitiesRequest, opts ...grpc.CallOption) (*ListActivitiesResponse, error)
 	UpsertResourcesConfig(ctx context.Context, in *UpsertResourcesConfigRequest, opts ...grpc.CallOption) (*UpsertResourcesConfigResponse, error)
+	PlanResourcesConfig(ctx context.Context, in *PlanResourcesConfigRequest, opts ...grpc.CallOption) (*PlanResourcesConfigResponse, error)
+	ListSchemaVersions(ctx context.Context, in *ListSchemaVersionsRequest, opts ...grpc.CallOption) (*ListSchemaVersionsResponse, error)
 	UpsertRulesConfig(ctx context.Context, in *UpsertRulesConfigRequest, opts ...grpc.CallOption) (*UpsertRulesConfigResponse, error)
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*GetServiceAccountResponse, error)
+	ListServiceAccountKeys(ctx context.Context, in *ListServiceAccountKeysRequest, opts ...grpc.CallOption) (*ListServiceAccountKeysResponse, error)
+	CreateServiceAccountKey(ctx context.Context, in *CreateServiceAccountKeyRequest, opts ...grpc.CallOption) (*CreateServiceAccountKeyResponse, error)
+	RotateServiceAccountKey(ctx context.Context, in *RotateServiceAccountKeyRequest, opts ...grpc.CallOption) (*RotateServiceAccountKeyResponse, error)
+	RevokeServiceAccountKey(ctx context.Context, in *RevokeServiceAccountKeyRequest, opts ...grpc.CallOption) (*RevokeServiceAccountKeyResponse, error)
+	AddPlatformAdmin(ctx context.Context, in *AddPlatformAdminRequest, opts ...grpc.CallOption) (*AddPlatformAdminResponse, error)
+	RemovePlatformAdmin(ctx context.Context, in *RemovePlatformAdminRequest, opts ...grpc.CallOption) (*RemovePlatformAdminResponse, error)
+	AddPlatformImpersonator(ctx context.Context, in *AddPlatformImpersonatorRequest, opts ...grpc.CallOption) (*AddPlatformImpersonatorResponse, error)
+	RemovePlatformImpersonator(ctx context.Context, in *RemovePlatformImpersonatorRequest, opts ...grpc.CallOption) (*RemovePlatformImpersonatorResponse, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
+	ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error)
+	CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error)
+	ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error)
+	RejectAccessRequest(ctx context.Context, in *RejectAccessRequestRequest, opts ...grpc.CallOption) (*RejectAccessRequestResponse, error)
 }
 
 type shieldServiceClient struct {
@@ -341,6 +399,42 @@ func (c *shieldServiceClient) ListOrganizationAdmins(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *shieldServiceClient) AddOrganizationAdmins(ctx context.Context, in *AddOrganizationAdminsRequest, opts ...grpc.CallOption) (*AddOrganizationAdminsResponse, error) {
+	out := new(AddOrganizationAdminsResponse)
+	err := c.cc.Invoke(ctx, ShieldService_AddOrganizationAdmins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RemoveOrganizationAdmin(ctx context.Context, in *RemoveOrganizationAdminRequest, opts ...grpc.CallOption) (*RemoveOrganizationAdminResponse, error) {
+	out := new(RemoveOrganizationAdminResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RemoveOrganizationAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) CreateOrganizationRole(ctx context.Context, in *CreateOrganizationRoleRequest, opts ...grpc.CallOption) (*CreateOrganizationRoleResponse, error) {
+	out := new(CreateOrganizationRoleResponse)
+	err := c.cc.Invoke(ctx, ShieldService_CreateOrganizationRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) DeleteOrganizationRole(ctx context.Context, in *DeleteOrganizationRoleRequest, opts ...grpc.CallOption) (*DeleteOrganizationRoleResponse, error) {
+	out := new(DeleteOrganizationRoleResponse)
+	err := c.cc.Invoke(ctx, ShieldService_DeleteOrganizationRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shieldServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
 	out := new(ListProjectsResponse)
 	err := c.cc.Invoke(ctx, ShieldService_ListProjects_FullMethodName, in, out, opts...)
@@ -386,6 +480,33 @@ func (c *shieldServiceClient) ListProjectAdmins(ctx context.Context, in *ListPro
 	return out, nil
 }
 
+func (c *shieldServiceClient) AddProjectAdmins(ctx context.Context, in *AddProjectAdminsRequest, opts ...grpc.CallOption) (*AddProjectAdminsResponse, error) {
+	out := new(AddProjectAdminsResponse)
+	err := c.cc.Invoke(ctx, ShieldService_AddProjectAdmins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RemoveProjectAdmin(ctx context.Context, in *RemoveProjectAdminRequest, opts ...grpc.CallOption) (*RemoveProjectAdminResponse, error) {
+	out := new(RemoveProjectAdminResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RemoveProjectAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) SetProjectVisibility(ctx context.Context, in *SetProjectVisibilityRequest, opts ...grpc.CallOption) (*SetProjectVisibilityResponse, error) {
+	out := new(SetProjectVisibilityResponse)
+	err := c.cc.Invoke(ctx, ShieldService_SetProjectVisibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shieldServiceClient) ListActions(ctx context.Context, in *ListActionsRequest, opts ...grpc.CallOption) (*ListActionsResponse, error) {
 	out := new(ListActionsResponse)
 	err := c.cc.Invoke(ctx, ShieldService_ListActions_FullMethodName, in, out, opts...)
@@ -530,6 +651,15 @@ func (c *shieldServiceClient) UpdateResource(ctx context.Context, in *UpdateReso
 	return out, nil
 }
 
+func (c *shieldServiceClient) SetResourceVisibility(ctx context.Context, in *SetResourceVisibilityRequest, opts ...grpc.CallOption) (*SetResourceVisibilityResponse, error) {
+	out := new(SetResourceVisibilityResponse)
+	err := c.cc.Invoke(ctx, ShieldService_SetResourceVisibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shieldServiceClient) ListAllUserResources(ctx context.Context, in *ListAllUserResourcesRequest, opts ...grpc.CallOption) (*ListAllUserResourcesResponse, error) {
 	out := new(ListAllUserResourcesResponse)
 	err := c.cc.Invoke(ctx, ShieldService_ListAllUserResources_FullMethodName, in, out, opts...)
@@ -566,6 +696,24 @@ func (c *shieldServiceClient) UpsertResourcesConfig(ctx context.Context, in *Ups
 	return out, nil
 }
 
+func (c *shieldServiceClient) PlanResourcesConfig(ctx context.Context, in *PlanResourcesConfigRequest, opts ...grpc.CallOption) (*PlanResourcesConfigResponse, error) {
+	out := new(PlanResourcesConfigResponse)
+	err := c.cc.Invoke(ctx, ShieldService_PlanResourcesConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) ListSchemaVersions(ctx context.Context, in *ListSchemaVersionsRequest, opts ...grpc.CallOption) (*ListSchemaVersionsResponse, error) {
+	out := new(ListSchemaVersionsResponse)
+	err := c.cc.Invoke(ctx, ShieldService_ListSchemaVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shieldServiceClient) UpsertRulesConfig(ctx context.Context, in *UpsertRulesConfigRequest, opts ...grpc.CallOption) (*UpsertRulesConfigResponse, error) {
 	out := new(UpsertRulesConfigResponse)
 	err := c.cc.Invoke(ctx, ShieldService_UpsertRulesConfig_FullMethodName, in, out, opts...)
@@ -575,6 +723,177 @@ func (c *shieldServiceClient) UpsertRulesConfig(ctx context.Context, in *UpsertR
 	return out, nil
 }
 
+func (c *shieldServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ShieldService_ListServiceAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, ShieldService_CreateServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*GetServiceAccountResponse, error) {
+	out := new(GetServiceAccountResponse)
+	err := c.cc.Invoke(ctx, ShieldService_GetServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) ListServiceAccountKeys(ctx context.Context, in *ListServiceAccountKeysRequest, opts ...grpc.CallOption) (*ListServiceAccountKeysResponse, error) {
+	out := new(ListServiceAccountKeysResponse)
+	err := c.cc.Invoke(ctx, ShieldService_ListServiceAccountKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) CreateServiceAccountKey(ctx context.Context, in *CreateServiceAccountKeyRequest, opts ...grpc.CallOption) (*CreateServiceAccountKeyResponse, error) {
+	out := new(CreateServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, ShieldService_CreateServiceAccountKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RotateServiceAccountKey(ctx context.Context, in *RotateServiceAccountKeyRequest, opts ...grpc.CallOption) (*RotateServiceAccountKeyResponse, error) {
+	out := new(RotateServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RotateServiceAccountKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RevokeServiceAccountKey(ctx context.Context, in *RevokeServiceAccountKeyRequest, opts ...grpc.CallOption) (*RevokeServiceAccountKeyResponse, error) {
+	out := new(RevokeServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RevokeServiceAccountKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) AddPlatformAdmin(ctx context.Context, in *AddPlatformAdminRequest, opts ...grpc.CallOption) (*AddPlatformAdminResponse, error) {
+	out := new(AddPlatformAdminResponse)
+	err := c.cc.Invoke(ctx, ShieldService_AddPlatformAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RemovePlatformAdmin(ctx context.Context, in *RemovePlatformAdminRequest, opts ...grpc.CallOption) (*RemovePlatformAdminResponse, error) {
+	out := new(RemovePlatformAdminResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RemovePlatformAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) AddPlatformImpersonator(ctx context.Context, in *AddPlatformImpersonatorRequest, opts ...grpc.CallOption) (*AddPlatformImpersonatorResponse, error) {
+	out := new(AddPlatformImpersonatorResponse)
+	err := c.cc.Invoke(ctx, ShieldService_AddPlatformImpersonator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RemovePlatformImpersonator(ctx context.Context, in *RemovePlatformImpersonatorRequest, opts ...grpc.CallOption) (*RemovePlatformImpersonatorResponse, error) {
+	out := new(RemovePlatformImpersonatorResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RemovePlatformImpersonator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, ShieldService_ListInvitations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error) {
+	out := new(CreateInvitationResponse)
+	err := c.cc.Invoke(ctx, ShieldService_CreateInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error) {
+	out := new(RevokeInvitationResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RevokeInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	out := new(AcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, ShieldService_AcceptInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) ListAccessRequests(ctx context.Context, in *ListAccessRequestsRequest, opts ...grpc.CallOption) (*ListAccessRequestsResponse, error) {
+	out := new(ListAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, ShieldService_ListAccessRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) CreateAccessRequest(ctx context.Context, in *CreateAccessRequestRequest, opts ...grpc.CallOption) (*CreateAccessRequestResponse, error) {
+	out := new(CreateAccessRequestResponse)
+	err := c.cc.Invoke(ctx, ShieldService_CreateAccessRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) ApproveAccessRequest(ctx context.Context, in *ApproveAccessRequestRequest, opts ...grpc.CallOption) (*ApproveAccessRequestResponse, error) {
+	out := new(ApproveAccessRequestResponse)
+	err := c.cc.Invoke(ctx, ShieldService_ApproveAccessRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shieldServiceClient) RejectAccessRequest(ctx context.Context, in *RejectAccessRequestRequest, opts ...grpc.CallOption) (*RejectAccessRequestResponse, error) {
+	out := new(RejectAccessRequestResponse)
+	err := c.cc.Invoke(ctx, ShieldService_RejectAccessRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShieldServiceServer is the server API for ShieldService service.
 // All implementations must embed UnimplementedShieldServiceServer
 // for forward compatibility
@@ -605,12 +924,19 @@ type ShieldServiceServer interface {
 	GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
 	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
 	ListOrganizationAdmins(context.Context, *ListOrganizationAdminsRequest) (*ListOrganizationAdminsResponse, error)
+	AddOrganizationAdmins(context.Context, *AddOrganizationAdminsRequest) (*AddOrganizationAdminsResponse, error)
+	RemoveOrganizationAdmin(context.Context, *RemoveOrganizationAdminRequest) (*RemoveOrganizationAdminResponse, error)
+	CreateOrganizationRole(context.Context, *CreateOrganizationRoleRequest) (*CreateOrganizationRoleResponse, error)
+	DeleteOrganizationRole(context.Context, *DeleteOrganizationRoleRequest) (*DeleteOrganizationRoleResponse, error)
 	// Projects
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
 	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	ListProjectAdmins(context.Context, *ListProjectAdminsRequest) (*ListProjectAdminsResponse, error)
+	AddProjectAdmins(context.Context, *AddProjectAdminsRequest) (*AddProjectAdminsResponse, error)
+	RemoveProjectAdmin(context.Context, *RemoveProjectAdminRequest) (*RemoveProjectAdminResponse, error)
+	SetProjectVisibility(context.Context, *SetProjectVisibilityRequest) (*SetProjectVisibilityResponse, error)
 	// Actions
 	ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error)
 	CreateAction(context.Context, *CreateActionRequest) (*CreateActionResponse, error)
@@ -632,13 +958,35 @@ type ShieldServiceServer interface {
 	CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error)
 	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
 	UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceResponse, error)
+	SetResourceVisibility(context.Context, *SetResourceVisibilityRequest) (*SetResourceVisibilityResponse, error)
 	ListAllUserResources(context.Context, *ListAllUserResourcesRequest) (*ListAllUserResourcesResponse, error)
 	// Authz
 	CheckResourcePermission(context.Context, *CheckResourcePermissionRequest) (*CheckResourcePermissionResponse, error)
 	// Activity
 	ListActivities(context.Context, *ListActivitiesRequest) (*ListActivitiesResponse, error)
 	UpsertResourcesConfig(context.Context, *UpsertResourcesConfigRequest) (*UpsertResourcesConfigResponse, error)
+	PlanResourcesConfig(context.Context, *PlanResourcesConfigRequest) (*PlanResourcesConfigResponse, error)
+	ListSchemaVersions(context.Context, *ListSchemaVersionsRequest) (*ListSchemaVersionsResponse, error)
 	UpsertRulesConfig(context.Context, *UpsertRulesConfigRequest) (*UpsertRulesConfigResponse, error)
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	GetServiceAccount(context.Context, *GetServiceAccountRequest) (*GetServiceAccountResponse, error)
+	ListServiceAccountKeys(context.Context, *ListServiceAccountKeysRequest) (*ListServiceAccountKeysResponse, error)
+	CreateServiceAccountKey(context.Context, *CreateServiceAccountKeyRequest) (*CreateServiceAccountKeyResponse, error)
+	RotateServiceAccountKey(context.Context, *RotateServiceAccountKeyRequest) (*RotateServiceAccountKeyResponse, error)
+	RevokeServiceAccountKey(context.Context, *RevokeServiceAccountKeyRequest) (*RevokeServiceAccountKeyResponse, error)
+	AddPlatformAdmin(context.Context, *AddPlatformAdminRequest) (*AddPlatformAdminResponse, error)
+	RemovePlatformAdmin(context.Context, *RemovePlatformAdminRequest) (*RemovePlatformAdminResponse, error)
+	AddPlatformImpersonator(context.Context, *AddPlatformImpersonatorRequest) (*AddPlatformImpersonatorResponse, error)
+	RemovePlatformImpersonator(context.Context, *RemovePlatformImpersonatorRequest) (*RemovePlatformImpersonatorResponse, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+	ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error)
+	CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error)
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error)
+	RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*RejectAccessRequestResponse, error)
 	mustEmbedUnimplementedShieldServiceServer()
 }
 
@@ -712,6 +1060,18 @@ func (UnimplementedShieldServiceServer) UpdateOrganization(context.Context, *Upd
 func (UnimplementedShieldServiceServer) ListOrganizationAdmins(context.Context, *ListOrganizationAdminsRequest) (*ListOrganizationAdminsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationAdmins not implemented")
 }
+func (UnimplementedShieldServiceServer) AddOrganizationAdmins(context.Context, *AddOrganizationAdminsRequest) (*AddOrganizationAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationAdmins not implemented")
+}
+func (UnimplementedShieldServiceServer) RemoveOrganizationAdmin(context.Context, *RemoveOrganizationAdminRequest) (*RemoveOrganizationAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationAdmin not implemented")
+}
+func (UnimplementedShieldServiceServer) CreateOrganizationRole(context.Context, *CreateOrganizationRoleRequest) (*CreateOrganizationRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationRole not implemented")
+}
+func (UnimplementedShieldServiceServer) DeleteOrganizationRole(context.Context, *DeleteOrganizationRoleRequest) (*DeleteOrganizationRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationRole not implemented")
+}
 func (UnimplementedShieldServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
@@ -727,6 +1087,15 @@ func (UnimplementedShieldServiceServer) UpdateProject(context.Context, *UpdatePr
 func (UnimplementedShieldServiceServer) ListProjectAdmins(context.Context, *ListProjectAdminsRequest) (*ListProjectAdminsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectAdmins not implemented")
 }
+func (UnimplementedShieldServiceServer) AddProjectAdmins(context.Context, *AddProjectAdminsRequest) (*AddProjectAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectAdmins not implemented")
+}
+func (UnimplementedShieldServiceServer) RemoveProjectAdmin(context.Context, *RemoveProjectAdminRequest) (*RemoveProjectAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectAdmin not implemented")
+}
+func (UnimplementedShieldServiceServer) SetProjectVisibility(context.Context, *SetProjectVisibilityRequest) (*SetProjectVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectVisibility not implemented")
+}
 func (UnimplementedShieldServiceServer) ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListActions not implemented")
 }
@@ -775,6 +1144,9 @@ func (UnimplementedShieldServiceServer) GetResource(context.Context, *GetResourc
 func (UnimplementedShieldServiceServer) UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateResource not implemented")
 }
+func (UnimplementedShieldServiceServer) SetResourceVisibility(context.Context, *SetResourceVisibilityRequest) (*SetResourceVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResourceVisibility not implemented")
+}
 func (UnimplementedShieldServiceServer) ListAllUserResources(context.Context, *ListAllUserResourcesRequest) (*ListAllUserResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllUserResources not implemented")
 }
@@ -787,9 +1159,72 @@ func (UnimplementedShieldServiceServer) ListActivities(context.Context, *ListAct
 func (UnimplementedShieldServiceServer) UpsertResourcesConfig(context.Context, *UpsertResourcesConfigRequest) (*UpsertResourcesConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertResourcesConfig not implemented")
 }
+func (UnimplementedShieldServiceServer) PlanResourcesConfig(context.Context, *PlanResourcesConfigRequest) (*PlanResourcesConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanResourcesConfig not implemented")
+}
+func (UnimplementedShieldServiceServer) ListSchemaVersions(context.Context, *ListSchemaVersionsRequest) (*ListSchemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemaVersions not implemented")
+}
 func (UnimplementedShieldServiceServer) UpsertRulesConfig(context.Context, *UpsertRulesConfigRequest) (*UpsertRulesConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertRulesConfig not implemented")
 }
+func (UnimplementedShieldServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedShieldServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedShieldServiceServer) GetServiceAccount(context.Context, *GetServiceAccountRequest) (*GetServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccount not implemented")
+}
+func (UnimplementedShieldServiceServer) ListServiceAccountKeys(context.Context, *ListServiceAccountKeysRequest) (*ListServiceAccountKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccountKeys not implemented")
+}
+func (UnimplementedShieldServiceServer) CreateServiceAccountKey(context.Context, *CreateServiceAccountKeyRequest) (*CreateServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccountKey not implemented")
+}
+func (UnimplementedShieldServiceServer) RotateServiceAccountKey(context.Context, *RotateServiceAccountKeyRequest) (*RotateServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceAccountKey not implemented")
+}
+func (UnimplementedShieldServiceServer) RevokeServiceAccountKey(context.Context, *RevokeServiceAccountKeyRequest) (*RevokeServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceAccountKey not implemented")
+}
+func (UnimplementedShieldServiceServer) AddPlatformAdmin(context.Context, *AddPlatformAdminRequest) (*AddPlatformAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlatformAdmin not implemented")
+}
+func (UnimplementedShieldServiceServer) RemovePlatformAdmin(context.Context, *RemovePlatformAdminRequest) (*RemovePlatformAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlatformAdmin not implemented")
+}
+func (UnimplementedShieldServiceServer) AddPlatformImpersonator(context.Context, *AddPlatformImpersonatorRequest) (*AddPlatformImpersonatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlatformImpersonator not implemented")
+}
+func (UnimplementedShieldServiceServer) RemovePlatformImpersonator(context.Context, *RemovePlatformImpersonatorRequest) (*RemovePlatformImpersonatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlatformImpersonator not implemented")
+}
+func (UnimplementedShieldServiceServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedShieldServiceServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedShieldServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedShieldServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedShieldServiceServer) ListAccessRequests(context.Context, *ListAccessRequestsRequest) (*ListAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessRequests not implemented")
+}
+func (UnimplementedShieldServiceServer) CreateAccessRequest(context.Context, *CreateAccessRequestRequest) (*CreateAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessRequest not implemented")
+}
+func (UnimplementedShieldServiceServer) ApproveAccessRequest(context.Context, *ApproveAccessRequestRequest) (*ApproveAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccessRequest not implemented")
+}
+func (UnimplementedShieldServiceServer) RejectAccessRequest(context.Context, *RejectAccessRequestRequest) (*RejectAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAccessRequest not implemented")
+}
 func (UnimplementedShieldServiceServer) mustEmbedUnimplementedShieldServiceServer() {}
 
 // UnsafeShieldServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1199,135 +1634,261 @@ func _ShieldService_ListOrganizationAdmins_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectsRequest)
+func _ShieldService_AddOrganizationAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationAdminsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShieldServiceServer).ListProjects(ctx, in)
+		return srv.(ShieldServiceServer).AddOrganizationAdmins(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShieldService_ListProjects_FullMethodName,
+		FullMethod: ShieldService_AddOrganizationAdmins_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShieldServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+		return srv.(ShieldServiceServer).AddOrganizationAdmins(ctx, req.(*AddOrganizationAdminsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _ShieldService_RemoveOrganizationAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationAdminRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShieldServiceServer).CreateProject(ctx, in)
+		return srv.(ShieldServiceServer).RemoveOrganizationAdmin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShieldService_CreateProject_FullMethodName,
+		FullMethod: ShieldService_RemoveOrganizationAdmin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShieldServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(ShieldServiceServer).RemoveOrganizationAdmin(ctx, req.(*RemoveOrganizationAdminRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRequest)
+func _ShieldService_CreateOrganizationRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShieldServiceServer).GetProject(ctx, in)
+		return srv.(ShieldServiceServer).CreateOrganizationRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShieldService_GetProject_FullMethodName,
+		FullMethod: ShieldService_CreateOrganizationRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShieldServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+		return srv.(ShieldServiceServer).CreateOrganizationRole(ctx, req.(*CreateOrganizationRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _ShieldService_DeleteOrganizationRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShieldServiceServer).UpdateProject(ctx, in)
+		return srv.(ShieldServiceServer).DeleteOrganizationRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShieldService_UpdateProject_FullMethodName,
+		FullMethod: ShieldService_DeleteOrganizationRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShieldServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+		return srv.(ShieldServiceServer).DeleteOrganizationRole(ctx, req.(*DeleteOrganizationRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_ListProjectAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectAdminsRequest)
+func _ShieldService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShieldServiceServer).ListProjectAdmins(ctx, in)
+		return srv.(ShieldServiceServer).ListProjects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShieldService_ListProjectAdmins_FullMethodName,
+		FullMethod: ShieldService_ListProjects_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShieldServiceServer).ListProjectAdmins(ctx, req.(*ListProjectAdminsRequest))
+		return srv.(ShieldServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_ListActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListActionsRequest)
+func _ShieldService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShieldServiceServer).ListActions(ctx, in)
+		return srv.(ShieldServiceServer).CreateProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShieldService_ListActions_FullMethodName,
+		FullMethod: ShieldService_CreateProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShieldServiceServer).ListActions(ctx, req.(*ListActionsRequest))
+		return srv.(ShieldServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_CreateAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateActionRequest)
+func _ShieldService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShieldServiceServer).CreateAction(ctx, in)
+		return srv.(ShieldServiceServer).GetProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShieldService_CreateAction_FullMethodName,
+		FullMethod: ShieldService_GetProject_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShieldServiceServer).CreateAction(ctx, req.(*CreateActionRequest))
+		return srv.(ShieldServiceServer).GetProject(ctx, req.(*GetProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShieldService_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListNamespacesRequest)
-	if err := dec(in); err != nil {
+func _ShieldService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ListProjectAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ListProjectAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ListProjectAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ListProjectAdmins(ctx, req.(*ListProjectAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_AddProjectAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).AddProjectAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_AddProjectAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).AddProjectAdmins(ctx, req.(*AddProjectAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_RemoveProjectAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).RemoveProjectAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_RemoveProjectAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).RemoveProjectAdmin(ctx, req.(*RemoveProjectAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_SetProjectVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).SetProjectVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_SetProjectVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).SetProjectVisibility(ctx, req.(*SetProjectVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ListActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ListActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ListActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ListActions(ctx, req.(*ListActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_CreateAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).CreateAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_CreateAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).CreateAction(ctx, req.(*CreateActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -1577,6 +2138,24 @@ func _ShieldService_UpdateResource_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShieldService_SetResourceVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetResourceVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).SetResourceVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_SetResourceVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).SetResourceVisibility(ctx, req.(*SetResourceVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShieldService_ListAllUserResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAllUserResourcesRequest)
 	if err := dec(in); err != nil {
@@ -1649,6 +2228,42 @@ func _ShieldService_UpsertResourcesConfig_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShieldService_PlanResourcesConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanResourcesConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).PlanResourcesConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_PlanResourcesConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).PlanResourcesConfig(ctx, req.(*PlanResourcesConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ListSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ListSchemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ListSchemaVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ListSchemaVersions(ctx, req.(*ListSchemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShieldService_UpsertRulesConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpsertRulesConfigRequest)
 	if err := dec(in); err != nil {
@@ -1667,6 +2282,348 @@ func _ShieldService_UpsertRulesConfig_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShieldService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_GetServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).GetServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_GetServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).GetServiceAccount(ctx, req.(*GetServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ListServiceAccountKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ListServiceAccountKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ListServiceAccountKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ListServiceAccountKeys(ctx, req.(*ListServiceAccountKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_CreateServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).CreateServiceAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_CreateServiceAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).CreateServiceAccountKey(ctx, req.(*CreateServiceAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_RotateServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).RotateServiceAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_RotateServiceAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).RotateServiceAccountKey(ctx, req.(*RotateServiceAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_RevokeServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).RevokeServiceAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_RevokeServiceAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).RevokeServiceAccountKey(ctx, req.(*RevokeServiceAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_AddPlatformAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlatformAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).AddPlatformAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_AddPlatformAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).AddPlatformAdmin(ctx, req.(*AddPlatformAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_RemovePlatformAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlatformAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).RemovePlatformAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_RemovePlatformAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).RemovePlatformAdmin(ctx, req.(*RemovePlatformAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_AddPlatformImpersonator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlatformImpersonatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).AddPlatformImpersonator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_AddPlatformImpersonator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).AddPlatformImpersonator(ctx, req.(*AddPlatformImpersonatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_RemovePlatformImpersonator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlatformImpersonatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).RemovePlatformImpersonator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_RemovePlatformImpersonator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).RemovePlatformImpersonator(ctx, req.(*RemovePlatformImpersonatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_CreateInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ListAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ListAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ListAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ListAccessRequests(ctx, req.(*ListAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_CreateAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).CreateAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_CreateAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).CreateAccessRequest(ctx, req.(*CreateAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_ApproveAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).ApproveAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_ApproveAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).ApproveAccessRequest(ctx, req.(*ApproveAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShieldService_RejectAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShieldServiceServer).RejectAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShieldService_RejectAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShieldServiceServer).RejectAccessRequest(ctx, req.(*RejectAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShieldService_ServiceDesc is the grpc.ServiceDesc for ShieldService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1762,6 +2719,22 @@ var ShieldService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrganizationAdmins",
 			Handler:    _ShieldService_ListOrganizationAdmins_Handler,
 		},
+		{
+			MethodName: "AddOrganizationAdmins",
+			Handler:    _ShieldService_AddOrganizationAdmins_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationAdmin",
+			Handler:    _ShieldService_RemoveOrganizationAdmin_Handler,
+		},
+		{
+			MethodName: "CreateOrganizationRole",
+			Handler:    _ShieldService_CreateOrganizationRole_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationRole",
+			Handler:    _ShieldService_DeleteOrganizationRole_Handler,
+		},
 		{
 			MethodName: "ListProjects",
 			Handler:    _ShieldService_ListProjects_Handler,
@@ -1782,6 +2755,18 @@ var ShieldService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProjectAdmins",
 			Handler:    _ShieldService_ListProjectAdmins_Handler,
 		},
+		{
+			MethodName: "AddProjectAdmins",
+			Handler:    _ShieldService_AddProjectAdmins_Handler,
+		},
+		{
+			MethodName: "RemoveProjectAdmin",
+			Handler:    _ShieldService_RemoveProjectAdmin_Handler,
+		},
+		{
+			MethodName: "SetProjectVisibility",
+			Handler:    _ShieldService_SetProjectVisibility_Handler,
+		},
 		{
 			MethodName: "ListActions",
 			Handler:    _ShieldService_ListActions_Handler,
@@ -1846,6 +2831,10 @@ var ShieldService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateResource",
 			Handler:    _ShieldService_UpdateResource_Handler,
 		},
+		{
+			MethodName: "SetResourceVisibility",
+			Handler:    _ShieldService_SetResourceVisibility_Handler,
+		},
 		{
 			MethodName: "ListAllUserResources",
 			Handler:    _ShieldService_ListAllUserResources_Handler,
@@ -1862,10 +2851,94 @@ var ShieldService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertResourcesConfig",
 			Handler:    _ShieldService_UpsertResourcesConfig_Handler,
 		},
+		{
+			MethodName: "PlanResourcesConfig",
+			Handler:    _ShieldService_PlanResourcesConfig_Handler,
+		},
+		{
+			MethodName: "ListSchemaVersions",
+			Handler:    _ShieldService_ListSchemaVersions_Handler,
+		},
 		{
 			MethodName: "UpsertRulesConfig",
 			Handler:    _ShieldService_UpsertRulesConfig_Handler,
 		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _ShieldService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _ShieldService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "GetServiceAccount",
+			Handler:    _ShieldService_GetServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccountKeys",
+			Handler:    _ShieldService_ListServiceAccountKeys_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccountKey",
+			Handler:    _ShieldService_CreateServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "RotateServiceAccountKey",
+			Handler:    _ShieldService_RotateServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAccountKey",
+			Handler:    _ShieldService_RevokeServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "AddPlatformAdmin",
+			Handler:    _ShieldService_AddPlatformAdmin_Handler,
+		},
+		{
+			MethodName: "RemovePlatformAdmin",
+			Handler:    _ShieldService_RemovePlatformAdmin_Handler,
+		},
+		{
+			MethodName: "AddPlatformImpersonator",
+			Handler:    _ShieldService_AddPlatformImpersonator_Handler,
+		},
+		{
+			MethodName: "RemovePlatformImpersonator",
+			Handler:    _ShieldService_RemovePlatformImpersonator_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _ShieldService_ListInvitations_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _ShieldService_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _ShieldService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _ShieldService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "ListAccessRequests",
+			Handler:    _ShieldService_ListAccessRequests_Handler,
+		},
+		{
+			MethodName: "CreateAccessRequest",
+			Handler:    _ShieldService_CreateAccessRequest_Handler,
+		},
+		{
+			MethodName: "ApproveAccessRequest",
+			Handler:    _ShieldService_ApproveAccessRequest_Handler,
+		},
+		{
+			MethodName: "RejectAccessRequest",
+			Handler:    _ShieldService_RejectAccessRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gotocompany/shield/v1beta1/shield.proto",
@@ -22,6 +22,7 @@ import (
 	"github.com/goto/shield/internal/proxy"
 	"github.com/goto/shield/internal/proxy/hook"
 	authz_hook "github.com/goto/shield/internal/proxy/hook/authz"
+	"github.com/goto/shield/internal/proxy/middleware"
 	"github.com/goto/shield/internal/proxy/middleware/attributes"
 	basic_auth "github.com/goto/shield/internal/proxy/middleware/basic_auth"
 	"github.com/goto/shield/internal/proxy/middleware/prefix"
@@ -57,7 +58,7 @@ func TestREST(t *testing.T) {
 	}
 
 	responseHooks := hookPipeline(log.NewNoop())
-	h2cProxy := proxy.NewH2c(proxy.NewH2cRoundTripper(log.NewNoop(), responseHooks), proxy.NewDirector())
+	h2cProxy := proxy.NewH2c(proxy.NewH2cRoundTripper(log.NewNoop(), responseHooks), proxy.NewDirector(baseCtx, log.NewNoop(), "X-Shield-Email", "X-Shield-User-Id"))
 	ruleRepo := blob.NewRuleRepository(log.NewNoop(), blobFS)
 	if err := ruleRepo.InitCache(baseCtx, time.Minute); err != nil {
 		t.Fatal(err)
@@ -188,7 +189,7 @@ func BenchmarkProxyOverHttp(b *testing.B) {
 		b.Fatal(err)
 	}
 
-	h2cProxy := proxy.NewH2c(proxy.NewH2cRoundTripper(log.NewNoop(), hook.New()), proxy.NewDirector())
+	h2cProxy := proxy.NewH2c(proxy.NewH2cRoundTripper(log.NewNoop(), hook.New()), proxy.NewDirector(baseCtx, log.NewNoop(), "X-Shield-Email", "X-Shield-User-Id"))
 	ruleRepo := blob.NewRuleRepository(log.NewNoop(), blobFS)
 	if err := ruleRepo.InitCache(baseCtx, time.Minute); err != nil {
 		b.Fatal(err)
@@ -302,8 +303,9 @@ func buildPipeline(logger log.Logger, proxy http.Handler, ruleService *rule.Serv
 	// Note: execution order is bottom up
 	prefixWare := prefix.New(logger, proxy)
 	// casbinAuthz := authz.New(logger, "", server.Deps{}, prefixWare)
-	basicAuthn := basic_auth.New(logger, prefixWare)
-	attributeExtractor := attributes.New(logger, basicAuthn, "X-Auth-Email", projectService)
+	errWriter := middleware.NewErrorWriter(middleware.ErrorVerbosityMinimal)
+	basicAuthn := basic_auth.New(logger, prefixWare, errWriter, basic_auth.NewUserDB(logger))
+	attributeExtractor := attributes.New(logger, basicAuthn, errWriter, "X-Auth-Email", projectService)
 	matchWare := rulematch.New(logger.(*log.Zap), attributeExtractor, rulematch.NewRouteMatcher(ruleService))
 	return matchWare
 }